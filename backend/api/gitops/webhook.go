@@ -40,6 +40,8 @@ import (
 	// Register azure plugin.
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/git"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	"github.com/bytebase/bytebase/backend/store"
@@ -548,6 +550,169 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
 	})
 
+	g.POST("/gitea/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		// Forgejo sends both the X-Forgejo-* and the X-Gitea-* headers.
+		// This shouldn't happen as we only setup webhook to receive push event, just in case.
+		eventType := gitea.WebhookType(c.Request().Header.Get("X-Gitea-Event"))
+		if eventType != gitea.WebhookPush {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid webhook event type, got %s, want %s", eventType, gitea.WebhookPush))
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+		}
+		var pushEvent gitea.WebhookPushEvent
+		if err := json.Unmarshal(body, &pushEvent); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed push event").SetInternal(err)
+		}
+		repositoryID := pushEvent.Repository.FullName
+
+		nonBytebaseCommitList := filterGiteaBytebaseCommit(pushEvent.Commits)
+		if len(nonBytebaseCommitList) == 0 {
+			var commitList []string
+			for _, commit := range pushEvent.Commits {
+				commitList = append(commitList, commit.ID)
+			}
+			slog.Debug("all commits are created by Bytebase",
+				slog.String("repoURL", pushEvent.Repository.HTMLURL),
+				slog.String("repoName", pushEvent.Repository.FullName),
+				slog.String("commits", strings.Join(commitList, ", ")),
+			)
+			return c.String(http.StatusOK, "OK")
+		}
+		pushEvent.Commits = nonBytebaseCommitList
+
+		filter := func(repo *store.RepositoryMessage) (bool, error) {
+			// Gitea signs the payload the same way as GitHub except that the signature has no "sha256=" prefix.
+			ok, err := validateGitHubWebhookSignature256(c.Request().Header.Get("X-Gitea-Signature"), repo.WebhookSecretToken, body)
+			if err != nil {
+				return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate Gitea webhook signature").SetInternal(err)
+			}
+			if !ok {
+				return false, nil
+			}
+
+			return s.isWebhookEventBranch(pushEvent.Ref, repo.BranchFilter)
+		}
+		repositoryList, err := s.filterRepository(ctx, c.Param("id"), repositoryID, filter)
+		if err != nil {
+			return err
+		}
+		if len(repositoryList) == 0 {
+			slog.Debug("Empty handle repo list. Ignore this push event.")
+			return c.String(http.StatusOK, "OK")
+		}
+
+		baseVCSPushEvent := pushEvent.ToVCS()
+
+		createdMessages, err := s.processPushEvent(ctx, repositoryList, baseVCSPushEvent)
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
+	})
+
+	// The generic Git push event is sent by the post-receive hook of a plain Git server.
+	// Unlike the hosted VCS, the payload only contains the ref update, so the commits are read from the repository mirror.
+	g.POST("/git/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+		}
+		var pushEvent git.WebhookPushEvent
+		if err := json.Unmarshal(body, &pushEvent); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed push event").SetInternal(err)
+		}
+		if pushEvent.After == "" || strings.Trim(pushEvent.After, "0") == "" {
+			// The branch is deleted.
+			return c.String(http.StatusOK, "OK")
+		}
+
+		filter := func(repo *store.RepositoryMessage) (bool, error) {
+			// The plain Git server cannot be configured with the per repository secret token by Bytebase,
+			// so the payload is signed with the secret of the version control.
+			externalVCS, err := s.store.GetExternalVersionControlV2(ctx, repo.VCSUID)
+			if err != nil {
+				return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to find the version control").SetInternal(err)
+			}
+			if externalVCS == nil {
+				return false, nil
+			}
+			ok, err := validateGitHubWebhookSignature256(c.Request().Header.Get(git.SignatureHeader), externalVCS.Secret, body)
+			if err != nil {
+				return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate Git webhook signature").SetInternal(err)
+			}
+			if !ok {
+				return false, nil
+			}
+
+			return s.isWebhookEventBranch(pushEvent.Ref, repo.BranchFilter)
+		}
+		repositoryList, err := s.filterRepository(ctx, c.Param("id"), pushEvent.Repository.URL, filter)
+		if err != nil {
+			return err
+		}
+		if len(repositoryList) == 0 {
+			slog.Debug("Empty handle repo list. Ignore this push event.")
+			return c.String(http.StatusOK, "OK")
+		}
+		repo := repositoryList[0]
+
+		commits, err := git.ListCommits(
+			ctx,
+			common.OauthContext{
+				AccessToken: repo.repository.AccessToken,
+			},
+			repo.repository.ExternalID,
+			pushEvent.Before,
+			pushEvent.After,
+		)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Failed to list commits between %q and %q", pushEvent.Before, pushEvent.After)).SetInternal(err)
+		}
+		var commitList []vcs.Commit
+		for _, commit := range commits {
+			if commit.AuthorName == vcs.BytebaseAuthorName && commit.AuthorEmail == vcs.BytebaseAuthorEmail {
+				continue
+			}
+			commitList = append(commitList, *commit)
+		}
+		if len(commitList) == 0 {
+			slog.Debug("all commits are created by Bytebase",
+				slog.String("repoURL", pushEvent.Repository.URL),
+				slog.String("ref", pushEvent.Ref),
+			)
+			return c.String(http.StatusOK, "OK")
+		}
+
+		repositoryURL := pushEvent.Repository.WebURL
+		if repositoryURL == "" {
+			repositoryURL = repo.repository.WebURL
+		}
+		baseVCSPushEvent := vcs.PushEvent{
+			VCSType:            vcs.Git,
+			Ref:                pushEvent.Ref,
+			Before:             pushEvent.Before,
+			After:              pushEvent.After,
+			RepositoryID:       repo.repository.ExternalID,
+			RepositoryURL:      repositoryURL,
+			RepositoryFullPath: repo.repository.FullPath,
+			AuthorName:         pushEvent.Pusher,
+			CommitList:         commitList,
+		}
+
+		createdMessages, err := s.processPushEvent(ctx, repositoryList, baseVCSPushEvent)
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
+	})
+
 	// id is the webhookEndpointID in repository
 	// This endpoint is generated and injected into GitHub action & GitLab CI during the VCS setup.
 	g.POST("/sql-review/:id", func(c echo.Context) error {
@@ -695,7 +860,7 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 
 		response := &api.VCSSQLReviewResult{}
 		switch repo.vcs.Type {
-		case vcs.GitHub:
+		case vcs.GitHub, vcs.Gitea:
 			response = convertSQLAdviceToGitHubActionResult(sqlFileName2Advice)
 		case vcs.GitLab:
 			response = convertSQLAdviceToGitLabCIResult(sqlFileName2Advice)
@@ -2426,6 +2591,17 @@ func filterGitHubBytebaseCommit(list []github.WebhookCommit) []github.WebhookCom
 	return result
 }
 
+func filterGiteaBytebaseCommit(list []gitea.WebhookCommit) []gitea.WebhookCommit {
+	var result []gitea.WebhookCommit
+	for _, commit := range list {
+		if commit.Author.Name == vcs.BytebaseAuthorName && commit.Author.Email == vcs.BytebaseAuthorEmail {
+			continue
+		}
+		result = append(result, commit)
+	}
+	return result
+}
+
 func filterGitLabBytebaseCommit(list []gitlab.WebhookCommit) []gitlab.WebhookCommit {
 	var result []gitlab.WebhookCommit
 	for _, commit := range list {
@@ -0,0 +1,332 @@
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// attributeGetter returns the values of the attribute path in lower case, such as "username" and "emails.value".
+type attributeGetter func(path string) []any
+
+// filterExpr is a parsed SCIM filter, see https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.2.
+type filterExpr interface {
+	match(getter attributeGetter) bool
+}
+
+type logicalExpr struct {
+	// op is either "and" or "or".
+	op          string
+	left, right filterExpr
+}
+
+func (e *logicalExpr) match(getter attributeGetter) bool {
+	if e.op == "and" {
+		return e.left.match(getter) && e.right.match(getter)
+	}
+	return e.left.match(getter) || e.right.match(getter)
+}
+
+type notExpr struct {
+	expr filterExpr
+}
+
+func (e *notExpr) match(getter attributeGetter) bool {
+	return !e.expr.match(getter)
+}
+
+type compareExpr struct {
+	path  string
+	op    string
+	value any
+}
+
+func (e *compareExpr) match(getter attributeGetter) bool {
+	values := getter(e.path)
+	if e.op == "pr" {
+		for _, v := range values {
+			if v != nil && v != "" {
+				return true
+			}
+		}
+		return false
+	}
+	if e.op == "ne" {
+		for _, v := range values {
+			if compareValue(v, "eq", e.value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		if compareValue(v, e.op, e.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareValue compares the attribute value with the filter value.
+// Strings are compared case-insensitively since none of the supported attributes are case exact.
+func compareValue(attr any, op string, value any) bool {
+	switch a := attr.(type) {
+	case string:
+		v, ok := value.(string)
+		if !ok {
+			return false
+		}
+		a, v = strings.ToLower(a), strings.ToLower(v)
+		switch op {
+		case "eq":
+			return a == v
+		case "co":
+			return strings.Contains(a, v)
+		case "sw":
+			return strings.HasPrefix(a, v)
+		case "ew":
+			return strings.HasSuffix(a, v)
+		case "gt":
+			return a > v
+		case "ge":
+			return a >= v
+		case "lt":
+			return a < v
+		case "le":
+			return a <= v
+		}
+	case bool:
+		v, ok := value.(bool)
+		return ok && op == "eq" && a == v
+	}
+	return false
+}
+
+// parseFilter parses the SCIM filter. The value path filters such as `emails[type eq "work"]` are not supported.
+func parseFilter(filter string) (filterExpr, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, newBadRequestError("invalidFilter", "unexpected token %q in filter", p.tokens[p.pos])
+	}
+	return expr, nil
+}
+
+func tokenizeFilter(filter string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(filter); {
+		switch c := filter[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(filter) && filter[j] != '"'; j++ {
+				if filter[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(filter) {
+				return nil, newBadRequestError("invalidFilter", "unterminated string in filter")
+			}
+			tokens = append(tokens, filter[i:j+1])
+			i = j + 1
+		case c == '[' || c == ']':
+			return nil, newBadRequestError("invalidFilter", "value path filter is not supported")
+		default:
+			j := i
+			for ; j < len(filter) && !strings.ContainsRune(" \t()\"[]", rune(filter[j])); j++ {
+			}
+			tokens = append(tokens, filter[i:j])
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *filterParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{op: "or", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{op: "and", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterExpr, error) {
+	if strings.EqualFold(p.peek(), "not") {
+		p.next()
+		if p.peek() != "(" {
+			return nil, newBadRequestError("invalidFilter", "expect \"(\" after \"not\"")
+		}
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: expr}, nil
+	}
+	if p.peek() == "(" {
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, newBadRequestError("invalidFilter", "missing \")\" in filter")
+		}
+		return expr, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *filterParser) parseCompare() (filterExpr, error) {
+	path := p.next()
+	if path == "" || path == ")" || strings.HasPrefix(path, "\"") {
+		return nil, newBadRequestError("invalidFilter", "expect attribute path in filter")
+	}
+	op := strings.ToLower(p.next())
+	switch op {
+	case "pr":
+		return &compareExpr{path: normalizeAttributePath(path), op: op}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, newBadRequestError("invalidFilter", "unsupported operator %q in filter", op)
+	}
+	value, err := parseFilterValue(p.next())
+	if err != nil {
+		return nil, err
+	}
+	return &compareExpr{path: normalizeAttributePath(path), op: op, value: value}, nil
+}
+
+func parseFilterValue(token string) (any, error) {
+	switch {
+	case strings.HasPrefix(token, "\""):
+		var s string
+		if err := json.Unmarshal([]byte(token), &s); err != nil {
+			return nil, newBadRequestError("invalidFilter", "invalid string %s in filter", token)
+		}
+		return s, nil
+	case strings.EqualFold(token, "true"):
+		return true, nil
+	case strings.EqualFold(token, "false"):
+		return false, nil
+	case strings.EqualFold(token, "null"):
+		return nil, nil
+	}
+	if _, err := strconv.ParseFloat(token, 64); err == nil {
+		return token, nil
+	}
+	return nil, newBadRequestError("invalidFilter", "invalid value %q in filter", token)
+}
+
+// normalizeAttributePath lower-cases the attribute path and strips the schema URN prefix,
+// e.g. "urn:ietf:params:scim:schemas:core:2.0:User:userName" becomes "username".
+func normalizeAttributePath(path string) string {
+	path = strings.ToLower(path)
+	for _, schema := range []string{userSchema, groupSchema} {
+		if prefix := strings.ToLower(schema) + ":"; strings.HasPrefix(path, prefix) {
+			return strings.TrimPrefix(path, prefix)
+		}
+	}
+	return path
+}
+
+// parseBool parses the boolean value from the identity providers.
+// Azure AD sends the string "True" and "False" for boolean attributes.
+func parseBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return false, newBadRequestError("invalidValue", "invalid boolean value %s", string(raw))
+	}
+	b, err := strconv.ParseBool(strings.ToLower(s))
+	if err != nil {
+		return false, newBadRequestError("invalidValue", "invalid boolean value %s", string(raw))
+	}
+	return b, nil
+}
+
+// parseValuePath parses the patch path with a value filter, such as `members[value eq "101"]`.
+// It returns the attribute, the filter and the sub-attribute.
+func parseValuePath(path string) (string, filterExpr, string, error) {
+	start := strings.Index(path, "[")
+	if start < 0 {
+		attr, sub, _ := strings.Cut(normalizeAttributePath(path), ".")
+		return attr, nil, sub, nil
+	}
+	end := strings.LastIndex(path, "]")
+	if end < start {
+		return "", nil, "", newBadRequestError("invalidPath", "invalid path %q", path)
+	}
+	filter, err := parseFilter(path[start+1 : end])
+	if err != nil {
+		return "", nil, "", err
+	}
+	return normalizeAttributePath(path[:start]), filter, strings.ToLower(strings.TrimPrefix(path[end+1:], ".")), nil
+}
+
+// subAttributeGetter returns the getter for the sub-attributes of a multi-valued attribute element.
+func subAttributeGetter(element map[string]any) attributeGetter {
+	return func(path string) []any {
+		for k, v := range element {
+			if strings.EqualFold(k, path) {
+				return []any{v}
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,65 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	active := true
+	user := &User{
+		ID:          "101",
+		UserName:    "alice@example.com",
+		DisplayName: "Alice",
+		Emails:      []MultiValuedAttribute{{Value: "alice@example.com", Type: "work", Primary: true}},
+		Active:      &active,
+	}
+	tests := []struct {
+		filter string
+		match  bool
+		err    bool
+	}{
+		{filter: `userName eq "alice@example.com"`, match: true},
+		{filter: `UserName EQ "ALICE@example.com"`, match: true},
+		{filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice@example.com"`, match: true},
+		{filter: `userName eq "bob@example.com"`, match: false},
+		{filter: `userName ne "bob@example.com"`, match: true},
+		{filter: `emails.value co "example"`, match: true},
+		{filter: `displayName sw "Al" and active eq true`, match: true},
+		{filter: `displayName sw "Bo" or id eq 101`, match: true},
+		{filter: `displayName sw "Bo" or (id eq "102" and active eq true)`, match: false},
+		{filter: `not (active eq false)`, match: true},
+		{filter: `externalId pr`, match: false},
+		{filter: `userName pr`, match: true},
+		{filter: `emails[type eq "work"]`, err: true},
+		{filter: `userName eq`, err: true},
+		{filter: `userName foo "a"`, err: true},
+		{filter: `userName eq "a`, err: true},
+		{filter: `(userName eq "a"`, err: true},
+	}
+	for _, test := range tests {
+		filter, err := parseFilter(test.filter)
+		if test.err {
+			require.Error(t, err, test.filter)
+			continue
+		}
+		require.NoError(t, err, test.filter)
+		require.Equal(t, test.match, filter.match(user.attributes), test.filter)
+	}
+}
+
+func TestParseValuePath(t *testing.T) {
+	attr, filter, sub, err := parseValuePath(`emails[type eq "work"].value`)
+	require.NoError(t, err)
+	require.Equal(t, "emails", attr)
+	require.Equal(t, "value", sub)
+	require.True(t, filter.match(subAttributeGetter(map[string]any{"type": "work"})))
+	require.False(t, filter.match(subAttributeGetter(map[string]any{"type": "home"})))
+
+	attr, filter, sub, err = parseValuePath("name.givenName")
+	require.NoError(t, err)
+	require.Equal(t, "name", attr)
+	require.Nil(t, filter)
+	require.Equal(t, "givenname", sub)
+}
@@ -0,0 +1,375 @@
+package scim
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// Group is the SCIM group resource.
+type Group struct {
+	Schemas     []string       `json:"schemas"`
+	ID          string         `json:"id,omitempty"`
+	ExternalID  string         `json:"externalId,omitempty"`
+	DisplayName string         `json:"displayName"`
+	Members     []*GroupMember `json:"members,omitempty"`
+	Meta        *Meta          `json:"meta,omitempty"`
+}
+
+// GroupMember is the member of the SCIM group.
+type GroupMember struct {
+	// Value is the SCIM user ID.
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+func (s *Service) listGroups(c echo.Context) error {
+	ctx := c.Request().Context()
+	var filter filterExpr
+	if v := c.QueryParam("filter"); v != "" {
+		f, err := parseFilter(v)
+		if err != nil {
+			return handleError(c, err)
+		}
+		filter = f
+	}
+	excludeMembers := slices.Contains(strings.Split(strings.ToLower(c.QueryParam("excludedAttributes")), ","), "members")
+
+	groups, err := s.store.ListUserGroups(ctx, &store.FindUserGroupMessage{})
+	if err != nil {
+		return internalError(c, errors.Wrap(err, "failed to list user groups"))
+	}
+	var scimGroups []*Group
+	for _, group := range groups {
+		scimGroup, err := s.convertToGroup(c, group)
+		if err != nil {
+			return internalError(c, err)
+		}
+		if filter != nil && !filter.match(scimGroup.attributes) {
+			continue
+		}
+		if excludeMembers {
+			scimGroup.Members = nil
+		}
+		scimGroups = append(scimGroups, scimGroup)
+	}
+	startIndex, count := getPage(c)
+	return writeResponse(c, http.StatusOK, newListResponse(scimGroups, startIndex, count))
+}
+
+func (s *Service) getGroup(c echo.Context) error {
+	group, err := s.findGroup(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	scimGroup, err := s.convertToGroup(c, group)
+	if err != nil {
+		return internalError(c, err)
+	}
+	return writeResponse(c, http.StatusOK, scimGroup)
+}
+
+func (s *Service) createGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	scimGroup := &Group{}
+	if err := decodeBody(c, scimGroup); err != nil {
+		return handleError(c, err)
+	}
+	if scimGroup.DisplayName == "" {
+		return errorResponse(c, http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	groups, err := s.store.ListUserGroups(ctx, &store.FindUserGroupMessage{})
+	if err != nil {
+		return internalError(c, errors.Wrap(err, "failed to list user groups"))
+	}
+	resourceID := getGroupResourceID(scimGroup.DisplayName)
+	for _, group := range groups {
+		if group.ResourceID == resourceID || strings.EqualFold(group.Title, scimGroup.DisplayName) {
+			return errorResponse(c, http.StatusConflict, "uniqueness", "group "+scimGroup.DisplayName+" already exists")
+		}
+	}
+	memberIDs, err := s.convertToMemberIDs(ctx, scimGroup.Members)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	group, err := s.store.CreateUserGroup(ctx, &store.UserGroupMessage{
+		ResourceID: resourceID,
+		Title:      scimGroup.DisplayName,
+		ExternalID: scimGroup.ExternalID,
+		MemberIDs:  memberIDs,
+	}, api.SystemBotID)
+	if err != nil {
+		return internalError(c, errors.Wrap(err, "failed to create user group"))
+	}
+	response, err := s.convertToGroup(c, group)
+	if err != nil {
+		return internalError(c, err)
+	}
+	if response.Meta.Location != "" {
+		c.Response().Header().Set(echo.HeaderLocation, response.Meta.Location)
+	}
+	return writeResponse(c, http.StatusCreated, response)
+}
+
+func (s *Service) replaceGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	group, err := s.findGroup(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	scimGroup := &Group{}
+	if err := decodeBody(c, scimGroup); err != nil {
+		return handleError(c, err)
+	}
+	return s.updateGroup(c, group, scimGroup)
+}
+
+func (s *Service) patchGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	group, err := s.findGroup(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	request := &PatchRequest{}
+	if err := decodeBody(c, request); err != nil {
+		return handleError(c, err)
+	}
+	scimGroup, err := s.convertToGroup(c, group)
+	if err != nil {
+		return internalError(c, err)
+	}
+	for _, operation := range request.Operations {
+		if err := applyGroupPatch(scimGroup, operation); err != nil {
+			return handleError(c, err)
+		}
+	}
+	return s.updateGroup(c, group, scimGroup)
+}
+
+func (s *Service) deleteGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	group, err := s.findGroup(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	if err := s.store.DeleteUserGroup(ctx, group.UID); err != nil {
+		return internalError(c, errors.Wrap(err, "failed to delete user group"))
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Service) findGroup(ctx context.Context, id string) (*store.UserGroupMessage, error) {
+	group, err := s.store.GetUserGroup(ctx, &store.FindUserGroupMessage{ResourceID: &id})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user group %q", id)
+	}
+	if group == nil {
+		return nil, &scimError{status: http.StatusNotFound, detail: "group " + id + " not found"}
+	}
+	return group, nil
+}
+
+func (s *Service) updateGroup(c echo.Context, group *store.UserGroupMessage, scimGroup *Group) error {
+	ctx := c.Request().Context()
+	if scimGroup.DisplayName == "" {
+		return errorResponse(c, http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	memberIDs, err := s.convertToMemberIDs(ctx, scimGroup.Members)
+	if err != nil {
+		return handleError(c, err)
+	}
+	patch := &store.UpdateUserGroupMessage{
+		UID:       group.UID,
+		UpdaterID: api.SystemBotID,
+	}
+	if scimGroup.DisplayName != group.Title {
+		patch.Title = &scimGroup.DisplayName
+	}
+	slices.Sort(memberIDs)
+	existingMemberIDs := slices.Clone(group.MemberIDs)
+	slices.Sort(existingMemberIDs)
+	if !slices.Equal(memberIDs, existingMemberIDs) {
+		if group.IdentityProviderUID != nil {
+			return errorResponse(c, http.StatusBadRequest, "mutability", "cannot update the members of group "+group.ResourceID+" synced from the identity provider")
+		}
+		patch.MemberIDs = &memberIDs
+	}
+	if patch.Title != nil || patch.MemberIDs != nil {
+		if group, err = s.store.UpdateUserGroup(ctx, patch); err != nil {
+			return internalError(c, errors.Wrap(err, "failed to update user group"))
+		}
+	}
+	response, err := s.convertToGroup(c, group)
+	if err != nil {
+		return internalError(c, err)
+	}
+	return writeResponse(c, http.StatusOK, response)
+}
+
+func (s *Service) convertToMemberIDs(ctx context.Context, members []*GroupMember) ([]int, error) {
+	memberIDs := []int{}
+	for _, member := range members {
+		user, err := s.findUser(ctx, member.Value)
+		if err != nil {
+			var e *scimError
+			if errors.As(err, &e) {
+				return nil, newBadRequestError("invalidValue", "member %q not found", member.Value)
+			}
+			return nil, err
+		}
+		if !slices.Contains(memberIDs, user.ID) {
+			memberIDs = append(memberIDs, user.ID)
+		}
+	}
+	return memberIDs, nil
+}
+
+func (s *Service) convertToGroup(c echo.Context, group *store.UserGroupMessage) (*Group, error) {
+	scimGroup := &Group{
+		Schemas:     []string{groupSchema},
+		ID:          group.ResourceID,
+		ExternalID:  group.ExternalID,
+		DisplayName: group.Title,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Location:     s.getLocation(c, "Groups", group.ResourceID),
+		},
+	}
+	for _, memberID := range group.MemberIDs {
+		user, err := s.store.GetUserByID(c.Request().Context(), memberID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %d", memberID)
+		}
+		if user == nil {
+			continue
+		}
+		scimGroup.Members = append(scimGroup.Members, &GroupMember{
+			Value:   strconv.Itoa(user.ID),
+			Display: user.Name,
+		})
+	}
+	return scimGroup, nil
+}
+
+func (g *Group) attributes(path string) []any {
+	switch path {
+	case "id":
+		return []any{g.ID}
+	case "externalid":
+		return []any{g.ExternalID}
+	case "displayname":
+		return []any{g.DisplayName}
+	case "members", "members.value":
+		var values []any
+		for _, member := range g.Members {
+			values = append(values, member.Value)
+		}
+		return values
+	}
+	return nil
+}
+
+// applyGroupPatch applies the patch operation to the SCIM group.
+func applyGroupPatch(group *Group, operation *PatchOperation) error {
+	op := strings.ToLower(operation.Op)
+	if op != "add" && op != "replace" && op != "remove" {
+		return newBadRequestError("invalidSyntax", "invalid patch operation %q", operation.Op)
+	}
+	if operation.Path != "" {
+		return applyGroupAttribute(group, op, operation.Path, operation.Value)
+	}
+	if op == "remove" {
+		return newBadRequestError("noTarget", "path is required for remove operation")
+	}
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal(operation.Value, &values); err != nil {
+		return newBadRequestError("invalidValue", "invalid patch value %s", string(operation.Value))
+	}
+	for path, value := range values {
+		if err := applyGroupAttribute(group, op, path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyGroupAttribute(group *Group, op, path string, value json.RawMessage) error {
+	attr, filter, _, err := parseValuePath(path)
+	if err != nil {
+		return err
+	}
+	switch attr {
+	case "displayname":
+		return setString(&group.DisplayName, op, value)
+	case "externalid":
+		return setString(&group.ExternalID, op, value)
+	case "members":
+		var members []*GroupMember
+		if len(value) > 0 {
+			if err := json.Unmarshal(value, &members); err != nil {
+				return newBadRequestError("invalidValue", "invalid members %s", string(value))
+			}
+		}
+		switch op {
+		case "add":
+			for _, member := range members {
+				if !slices.ContainsFunc(group.Members, func(m *GroupMember) bool { return m.Value == member.Value }) {
+					group.Members = append(group.Members, member)
+				}
+			}
+		case "replace":
+			group.Members = members
+		case "remove":
+			// Remove the members matching the filter such as `members[value eq "101"]`,
+			// or the members in the value, or all members if neither is set.
+			var remaining []*GroupMember
+			for _, member := range group.Members {
+				remove := false
+				switch {
+				case filter != nil:
+					remove = filter.match(subAttributeGetter(map[string]any{"value": member.Value, "display": member.Display}))
+				case len(members) > 0:
+					remove = slices.ContainsFunc(members, func(m *GroupMember) bool { return m.Value == member.Value })
+				default:
+					remove = true
+				}
+				if !remove {
+					remaining = append(remaining, member)
+				}
+			}
+			group.Members = remaining
+		}
+	}
+	return nil
+}
+
+// getGroupResourceID generates the user group resource ID from the SCIM group display name.
+func getGroupResourceID(displayName string) string {
+	var b strings.Builder
+	b.WriteString("scim-")
+	for _, r := range strings.ToLower(displayName) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else if !strings.HasSuffix(b.String(), "-") {
+			b.WriteRune('-')
+		}
+	}
+	slug := b.String()
+	if len(slug) > 54 {
+		slug = slug[:54]
+	}
+	hash := sha256.Sum256([]byte(displayName))
+	return strings.TrimSuffix(slug, "-") + "-" + hex.EncodeToString(hash[:])[:8]
+}
@@ -0,0 +1,40 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyGroupPatch(t *testing.T) {
+	group := &Group{
+		DisplayName: "Engineering",
+		Members:     []*GroupMember{{Value: "101"}, {Value: "102"}},
+	}
+	operations := []*PatchOperation{
+		{Op: "add", Path: "members", Value: json.RawMessage(`[{"value": "102"}, {"value": "103"}, {"value": "104"}]`)},
+		{Op: "remove", Path: `members[value eq "101"]`},
+		{Op: "Remove", Path: "members", Value: json.RawMessage(`[{"value": "104"}]`)},
+		{Op: "replace", Value: json.RawMessage(`{"id": "ignored", "displayName": "Platform"}`)},
+	}
+	for _, operation := range operations {
+		require.NoError(t, applyGroupPatch(group, operation))
+	}
+	require.Equal(t, "Platform", group.DisplayName)
+	require.Equal(t, []*GroupMember{{Value: "102"}, {Value: "103"}}, group.Members)
+
+	require.NoError(t, applyGroupPatch(group, &PatchOperation{Op: "replace", Path: "members", Value: json.RawMessage(`[{"value": "105"}]`)}))
+	require.Equal(t, []*GroupMember{{Value: "105"}}, group.Members)
+
+	require.NoError(t, applyGroupPatch(group, &PatchOperation{Op: "remove", Path: "members"}))
+	require.Empty(t, group.Members)
+}
+
+func TestGetGroupResourceID(t *testing.T) {
+	id := getGroupResourceID("Engineering Team")
+	require.Regexp(t, `^scim-engineering-team-[0-9a-f]{8}$`, id)
+	require.Equal(t, id, getGroupResourceID("Engineering Team"))
+	require.NotEqual(t, id, getGroupResourceID("Engineering-Team"))
+	require.LessOrEqual(t, len(getGroupResourceID("A very long group name that exceeds the resource ID length limit")), 63)
+}
@@ -0,0 +1,240 @@
+// Package scim is the package for the SCIM 2.0 provisioning APIs.
+// Identity providers such as Okta and Azure AD use the APIs to provision and deprovision
+// Bytebase users and user groups, see https://datatracker.ietf.org/doc/html/rfc7644.
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// APIPrefix is the API prefix for SCIM.
+	APIPrefix = "/scim/v2"
+
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	contentType = "application/scim+json; charset=UTF-8"
+
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// Service is the API endpoint for handling SCIM requests.
+type Service struct {
+	store          *store.Store
+	licenseService enterpriseAPI.LicenseService
+}
+
+// NewService creates a SCIM service.
+func NewService(store *store.Store, licenseService enterpriseAPI.LicenseService) *Service {
+	return &Service{
+		store:          store,
+		licenseService: licenseService,
+	}
+}
+
+// RegisterRoutes registers the SCIM routes.
+func (s *Service) RegisterRoutes(g *echo.Group) {
+	g.Use(s.authenticate)
+
+	g.GET("/ServiceProviderConfig", s.getServiceProviderConfig)
+
+	g.GET("/Users", s.listUsers)
+	g.POST("/Users", s.createUser)
+	g.GET("/Users/:id", s.getUser)
+	g.PUT("/Users/:id", s.replaceUser)
+	g.PATCH("/Users/:id", s.patchUser)
+	g.DELETE("/Users/:id", s.deleteUser)
+
+	g.GET("/Groups", s.listGroups)
+	g.POST("/Groups", s.createGroup)
+	g.GET("/Groups/:id", s.getGroup)
+	g.PUT("/Groups/:id", s.replaceGroup)
+	g.PATCH("/Groups/:id", s.patchGroup)
+	g.DELETE("/Groups/:id", s.deleteGroup)
+}
+
+// authenticate checks the bearer token against the workspace SCIM token.
+// SCIM is disabled if the token is not set.
+func (s *Service) authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		settingName := api.SettingSCIMToken
+		setting, err := s.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &settingName})
+		if err != nil {
+			return internalError(c, errors.Wrap(err, "failed to get SCIM token setting"))
+		}
+		if setting == nil || setting.Value == "" {
+			return errorResponse(c, http.StatusUnauthorized, "", "SCIM provisioning is not enabled")
+		}
+		token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(setting.Value)) != 1 {
+			return errorResponse(c, http.StatusUnauthorized, "", "invalid bearer token")
+		}
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureSSO); err != nil {
+			return errorResponse(c, http.StatusForbidden, "", err.Error())
+		}
+		return next(c)
+	}
+}
+
+func (*Service) getServiceProviderConfig(c echo.Context) error {
+	return writeResponse(c, http.StatusOK, map[string]any{
+		"schemas":        []string{serviceProviderConfigSchema},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxPageSize},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication scheme using the workspace SCIM token.",
+			},
+		},
+	})
+}
+
+// Meta is the resource metadata.
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location,omitempty"`
+}
+
+// ListResponse is the response of listing resources.
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// PatchRequest is the request of patching a resource.
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is a patch operation.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Error is the SCIM error response.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// scimError is an error with the HTTP status and SCIM error type.
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func newBadRequestError(scimType, format string, args ...any) error {
+	return &scimError{status: http.StatusBadRequest, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+func writeResponse(c echo.Context, code int, v any) error {
+	c.Response().Header().Set(echo.HeaderContentType, contentType)
+	return c.JSON(code, v)
+}
+
+func errorResponse(c echo.Context, code int, scimType, detail string) error {
+	return writeResponse(c, code, &Error{
+		Schemas:  []string{errorSchema},
+		Status:   strconv.Itoa(code),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+// handleError writes the error response, the errors other than scimError are internal errors.
+func handleError(c echo.Context, err error) error {
+	var e *scimError
+	if errors.As(err, &e) {
+		return errorResponse(c, e.status, e.scimType, e.detail)
+	}
+	return internalError(c, err)
+}
+
+func internalError(c echo.Context, err error) error {
+	slog.Error("SCIM request failed", slog.String("method", c.Request().Method), slog.String("path", c.Request().URL.Path), log.BBError(err))
+	return errorResponse(c, http.StatusInternalServerError, "", "internal server error")
+}
+
+func decodeBody(c echo.Context, v any) error {
+	if err := json.NewDecoder(c.Request().Body).Decode(v); err != nil {
+		return newBadRequestError("invalidSyntax", "failed to parse request body: %v", err)
+	}
+	return nil
+}
+
+// getPage returns the 1-based start index and the page size of the list request.
+func getPage(c echo.Context) (int, int) {
+	startIndex, err := strconv.Atoi(c.QueryParam("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(c.QueryParam("count"))
+	if err != nil || count < 0 {
+		count = defaultPageSize
+	}
+	if count > maxPageSize {
+		count = maxPageSize
+	}
+	return startIndex, count
+}
+
+func newListResponse[T any](resources []T, startIndex, count int) *ListResponse {
+	response := &ListResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		Resources:    []any{},
+	}
+	for i := startIndex - 1; i >= 0 && i < len(resources) && len(response.Resources) < count; i++ {
+		response.Resources = append(response.Resources, resources[i])
+	}
+	response.ItemsPerPage = len(response.Resources)
+	return response
+}
+
+func (s *Service) getLocation(c echo.Context, resourceType, id string) string {
+	setting, err := s.store.GetWorkspaceGeneralSetting(c.Request().Context())
+	if err != nil || setting.ExternalUrl == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%s/%s/%s", setting.ExternalUrl, APIPrefix, resourceType, id)
+}
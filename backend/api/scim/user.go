@@ -0,0 +1,571 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/exp/slices"
+
+	"github.com/bytebase/bytebase/backend/common"
+	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// User is the SCIM user resource.
+type User struct {
+	Schemas      []string               `json:"schemas"`
+	ID           string                 `json:"id,omitempty"`
+	ExternalID   string                 `json:"externalId,omitempty"`
+	UserName     string                 `json:"userName"`
+	Name         *Name                  `json:"name,omitempty"`
+	DisplayName  string                 `json:"displayName,omitempty"`
+	Emails       []MultiValuedAttribute `json:"emails,omitempty"`
+	PhoneNumbers []MultiValuedAttribute `json:"phoneNumbers,omitempty"`
+	Active       *bool                  `json:"active,omitempty"`
+	Meta         *Meta                  `json:"meta,omitempty"`
+}
+
+// Name is the name of the SCIM user.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// MultiValuedAttribute is the element of the multi-valued attributes such as emails.
+type MultiValuedAttribute struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+func (s *Service) listUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+	var filter filterExpr
+	if v := c.QueryParam("filter"); v != "" {
+		f, err := parseFilter(v)
+		if err != nil {
+			return handleError(c, err)
+		}
+		filter = f
+	}
+
+	endUser := api.EndUser
+	users, err := s.store.ListUsers(ctx, &store.FindUserMessage{Type: &endUser, ShowDeleted: true})
+	if err != nil {
+		return internalError(c, errors.Wrap(err, "failed to list users"))
+	}
+	var scimUsers []*User
+	for _, user := range users {
+		scimUser := s.convertToUser(c, user)
+		if filter != nil && !filter.match(scimUser.attributes) {
+			continue
+		}
+		scimUsers = append(scimUsers, scimUser)
+	}
+	startIndex, count := getPage(c)
+	return writeResponse(c, http.StatusOK, newListResponse(scimUsers, startIndex, count))
+}
+
+func (s *Service) getUser(c echo.Context) error {
+	user, err := s.findUser(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeResponse(c, http.StatusOK, s.convertToUser(c, user))
+}
+
+func (s *Service) createUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	scimUser := &User{}
+	if err := decodeBody(c, scimUser); err != nil {
+		return handleError(c, err)
+	}
+	email, err := getUserEmail(scimUser)
+	if err != nil {
+		return handleError(c, err)
+	}
+	existing, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email, ShowDeleted: true})
+	if err != nil {
+		return internalError(c, errors.Wrapf(err, "failed to get user %q", email))
+	}
+	if existing != nil {
+		return errorResponse(c, http.StatusConflict, "uniqueness", "user "+email+" already exists")
+	}
+	active := scimUser.Active == nil || *scimUser.Active
+	if active {
+		if err := s.userCountGuard(ctx); err != nil {
+			return handleError(c, err)
+		}
+	}
+
+	// The provisioned users sign in with SSO, the password is random and never returned.
+	password, err := common.RandomString(32)
+	if err != nil {
+		return internalError(c, errors.Wrap(err, "failed to generate password"))
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return internalError(c, errors.Wrap(err, "failed to generate password hash"))
+	}
+	user, err := s.store.CreateUser(ctx, &store.UserMessage{
+		Email:        email,
+		Name:         getUserTitle(scimUser, email),
+		Phone:        getUserPhone(scimUser),
+		Type:         api.EndUser,
+		PasswordHash: string(passwordHash),
+	}, api.SystemBotID)
+	if err != nil {
+		return internalError(c, errors.Wrap(err, "failed to create user"))
+	}
+	if !active {
+		deletePatch := true
+		if user, err = s.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Delete: &deletePatch}, api.SystemBotID); err != nil {
+			return internalError(c, errors.Wrap(err, "failed to deactivate user"))
+		}
+	}
+
+	bytes, err := json.Marshal(api.ActivityMemberCreatePayload{
+		PrincipalID:    user.ID,
+		PrincipalName:  user.Name,
+		PrincipalEmail: user.Email,
+		MemberStatus:   api.Active,
+		Role:           user.Role,
+	})
+	if err != nil {
+		return internalError(c, errors.Wrap(err, "failed to construct activity payload"))
+	}
+	if _, err := s.store.CreateActivityV2(ctx, &store.ActivityMessage{
+		CreatorUID:   api.SystemBotID,
+		ContainerUID: user.ID,
+		Type:         api.ActivityMemberCreate,
+		Level:        api.ActivityInfo,
+		Payload:      string(bytes),
+	}); err != nil {
+		return internalError(c, errors.Wrap(err, "failed to create activity"))
+	}
+
+	response := s.convertToUser(c, user)
+	if response.Meta.Location != "" {
+		c.Response().Header().Set(echo.HeaderLocation, response.Meta.Location)
+	}
+	return writeResponse(c, http.StatusCreated, response)
+}
+
+func (s *Service) replaceUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	scimUser := &User{}
+	if err := decodeBody(c, scimUser); err != nil {
+		return handleError(c, err)
+	}
+	user, err = s.updateUser(ctx, user, scimUser)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeResponse(c, http.StatusOK, s.convertToUser(c, user))
+}
+
+func (s *Service) patchUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	request := &PatchRequest{}
+	if err := decodeBody(c, request); err != nil {
+		return handleError(c, err)
+	}
+	scimUser := s.convertToUser(c, user)
+	for _, operation := range request.Operations {
+		if err := applyUserPatch(scimUser, operation); err != nil {
+			return handleError(c, err)
+		}
+	}
+	user, err = s.updateUser(ctx, user, scimUser)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeResponse(c, http.StatusOK, s.convertToUser(c, user))
+}
+
+func (s *Service) deleteUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	if !user.MemberDeleted {
+		if err := s.ownerGuard(ctx, user); err != nil {
+			return handleError(c, err)
+		}
+		deletePatch := true
+		if _, err := s.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Delete: &deletePatch}, api.SystemBotID); err != nil {
+			return internalError(c, errors.Wrap(err, "failed to delete user"))
+		}
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Service) findUser(ctx context.Context, id string) (*store.UserMessage, error) {
+	userID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, &scimError{status: http.StatusNotFound, detail: "user " + id + " not found"}
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user %d", userID)
+	}
+	if user == nil || user.Type != api.EndUser {
+		return nil, &scimError{status: http.StatusNotFound, detail: "user " + id + " not found"}
+	}
+	return user, nil
+}
+
+// updateUser updates the user to match the SCIM user, deactivating the user if it is inactive.
+func (s *Service) updateUser(ctx context.Context, user *store.UserMessage, scimUser *User) (*store.UserMessage, error) {
+	email, err := getUserEmail(scimUser)
+	if err != nil {
+		return nil, err
+	}
+	patch := &store.UpdateUserMessage{}
+	updated := false
+	if email != user.Email {
+		existing, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email, ShowDeleted: true})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %q", email)
+		}
+		if existing != nil {
+			return nil, &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: "user " + email + " already exists"}
+		}
+		patch.Email = &email
+		updated = true
+	}
+	if title := getUserTitle(scimUser, email); title != user.Name {
+		patch.Name = &title
+		updated = true
+	}
+	if phone := getUserPhone(scimUser); phone != user.Phone {
+		patch.Phone = &phone
+		updated = true
+	}
+	if active := scimUser.Active == nil || *scimUser.Active; active == user.MemberDeleted {
+		if active {
+			if err := s.userCountGuard(ctx); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := s.ownerGuard(ctx, user); err != nil {
+				return nil, err
+			}
+		}
+		deletePatch := !active
+		patch.Delete = &deletePatch
+		updated = true
+	}
+	if !updated {
+		return user, nil
+	}
+	user, err = s.store.UpdateUser(ctx, user.ID, patch, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update user")
+	}
+	return user, nil
+}
+
+func (s *Service) userCountGuard(ctx context.Context) error {
+	userLimit := s.licenseService.GetPlanLimitValue(ctx, enterpriseAPI.PlanLimitMaximumUser)
+	count, err := s.store.CountActiveUsers(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to count active users")
+	}
+	if int64(count) >= userLimit {
+		return &scimError{status: http.StatusForbidden, detail: "reached the maximum user count " + strconv.FormatInt(userLimit, 10)}
+	}
+	return nil
+}
+
+// ownerGuard prevents the identity provider from deactivating the last workspace owner.
+func (s *Service) ownerGuard(ctx context.Context, user *store.UserMessage) error {
+	if user.Role != api.Owner {
+		return nil
+	}
+	owner := api.Owner
+	owners, err := s.store.ListUsers(ctx, &store.FindUserMessage{Role: &owner})
+	if err != nil {
+		return errors.Wrap(err, "failed to list workspace owners")
+	}
+	if len(owners) <= 1 {
+		return newBadRequestError("mutability", "cannot deactivate the last workspace owner %s", user.Email)
+	}
+	return nil
+}
+
+func (s *Service) convertToUser(c echo.Context, user *store.UserMessage) *User {
+	id := strconv.Itoa(user.ID)
+	active := !user.MemberDeleted
+	scimUser := &User{
+		Schemas:     []string{userSchema},
+		ID:          id,
+		UserName:    user.Email,
+		Name:        &Name{Formatted: user.Name},
+		DisplayName: user.Name,
+		Emails:      []MultiValuedAttribute{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Location:     s.getLocation(c, "Users", id),
+		},
+	}
+	if user.Phone != "" {
+		scimUser.PhoneNumbers = []MultiValuedAttribute{{Value: user.Phone, Type: "work", Primary: true}}
+	}
+	return scimUser
+}
+
+func (u *User) attributes(path string) []any {
+	switch path {
+	case "id":
+		return []any{u.ID}
+	case "externalid":
+		return []any{u.ExternalID}
+	case "username":
+		return []any{u.UserName}
+	case "displayname":
+		return []any{u.DisplayName}
+	case "active":
+		return []any{u.Active == nil || *u.Active}
+	case "name.formatted", "name.givenname", "name.familyname":
+		if u.Name == nil {
+			return nil
+		}
+		return []any{map[string]string{
+			"name.formatted":  u.Name.Formatted,
+			"name.givenname":  u.Name.GivenName,
+			"name.familyname": u.Name.FamilyName,
+		}[path]}
+	case "emails", "emails.value":
+		var values []any
+		for _, email := range u.Emails {
+			values = append(values, email.Value)
+		}
+		return values
+	case "phonenumbers", "phonenumbers.value":
+		var values []any
+		for _, phone := range u.PhoneNumbers {
+			values = append(values, phone.Value)
+		}
+		return values
+	}
+	return nil
+}
+
+// applyUserPatch applies the patch operation to the SCIM user.
+// The unsupported attributes such as the enterprise extension are ignored.
+func applyUserPatch(user *User, operation *PatchOperation) error {
+	op := strings.ToLower(operation.Op)
+	if op != "add" && op != "replace" && op != "remove" {
+		return newBadRequestError("invalidSyntax", "invalid patch operation %q", operation.Op)
+	}
+	if operation.Path != "" {
+		return applyUserAttribute(user, op, operation.Path, operation.Value)
+	}
+	if op == "remove" {
+		return newBadRequestError("noTarget", "path is required for remove operation")
+	}
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal(operation.Value, &values); err != nil {
+		return newBadRequestError("invalidValue", "invalid patch value %s", string(operation.Value))
+	}
+	for path, value := range values {
+		if err := applyUserAttribute(user, op, path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyUserAttribute(user *User, op, path string, value json.RawMessage) error {
+	attr, filter, sub, err := parseValuePath(path)
+	if err != nil {
+		return err
+	}
+	switch attr {
+	case "active":
+		if op == "remove" {
+			user.Active = nil
+			return nil
+		}
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		user.Active = &active
+	case "username":
+		return setString(&user.UserName, op, value)
+	case "displayname":
+		return setString(&user.DisplayName, op, value)
+	case "externalid":
+		return setString(&user.ExternalID, op, value)
+	case "name":
+		if user.Name == nil {
+			user.Name = &Name{}
+		}
+		switch sub {
+		case "":
+			if op == "remove" {
+				user.Name = &Name{}
+				return nil
+			}
+			name := &Name{}
+			if err := json.Unmarshal(value, name); err != nil {
+				return newBadRequestError("invalidValue", "invalid name %s", string(value))
+			}
+			user.Name = name
+		case "formatted":
+			return setString(&user.Name.Formatted, op, value)
+		case "givenname":
+			return setString(&user.Name.GivenName, op, value)
+		case "familyname":
+			return setString(&user.Name.FamilyName, op, value)
+		}
+	case "emails":
+		return setMultiValuedAttribute(&user.Emails, op, filter, sub, value)
+	case "phonenumbers":
+		return setMultiValuedAttribute(&user.PhoneNumbers, op, filter, sub, value)
+	}
+	return nil
+}
+
+func setString(s *string, op string, value json.RawMessage) error {
+	if op == "remove" {
+		*s = ""
+		return nil
+	}
+	if err := json.Unmarshal(value, s); err != nil {
+		return newBadRequestError("invalidValue", "invalid string value %s", string(value))
+	}
+	return nil
+}
+
+// setMultiValuedAttribute applies the operation to the multi-valued attribute.
+// Only the "value" sub-attribute is supported, e.g. `emails[type eq "work"].value`.
+func setMultiValuedAttribute(attributes *[]MultiValuedAttribute, op string, filter filterExpr, sub string, value json.RawMessage) error {
+	if sub != "" && sub != "value" {
+		return nil
+	}
+	if filter == nil && sub == "" {
+		if op == "remove" {
+			*attributes = nil
+			return nil
+		}
+		var values []MultiValuedAttribute
+		if err := json.Unmarshal(value, &values); err != nil {
+			return newBadRequestError("invalidValue", "invalid multi-valued attribute %s", string(value))
+		}
+		if op == "add" {
+			*attributes = append(*attributes, values...)
+		} else {
+			*attributes = values
+		}
+		return nil
+	}
+
+	var matched []int
+	for i, attribute := range *attributes {
+		element := map[string]any{"value": attribute.Value, "type": attribute.Type, "primary": attribute.Primary}
+		if filter == nil && (attribute.Primary || len(*attributes) == 1) || filter != nil && filter.match(subAttributeGetter(element)) {
+			matched = append(matched, i)
+		}
+	}
+	if op == "remove" {
+		var remaining []MultiValuedAttribute
+		for i, attribute := range *attributes {
+			if !slices.Contains(matched, i) {
+				remaining = append(remaining, attribute)
+			}
+		}
+		*attributes = remaining
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(value, &v); err != nil {
+		return newBadRequestError("invalidValue", "invalid string value %s", string(value))
+	}
+	if len(matched) == 0 {
+		attribute := MultiValuedAttribute{Value: v, Primary: len(*attributes) == 0}
+		// Keep the type from the filter such as `emails[type eq "work"]`.
+		if e, ok := filter.(*compareExpr); ok && e.path == "type" && e.op == "eq" {
+			attribute.Type, _ = e.value.(string)
+		}
+		*attributes = append(*attributes, attribute)
+		return nil
+	}
+	for _, i := range matched {
+		(*attributes)[i].Value = v
+	}
+	return nil
+}
+
+// getPrimaryValue returns the value of the primary element or the first element.
+func getPrimaryValue(attributes []MultiValuedAttribute) string {
+	for _, attribute := range attributes {
+		if attribute.Primary {
+			return attribute.Value
+		}
+	}
+	if len(attributes) > 0 {
+		return attributes[0].Value
+	}
+	return ""
+}
+
+// getUserEmail returns the Bytebase user email. The userName is used if it is an email address,
+// otherwise the primary email is used, e.g. Azure AD may use the UPN as the userName.
+func getUserEmail(user *User) (string, error) {
+	for _, candidate := range []string{user.UserName, getPrimaryValue(user.Emails)} {
+		if candidate == "" {
+			continue
+		}
+		if address, err := mail.ParseAddress(candidate); err == nil && address.Address == candidate {
+			return strings.ToLower(candidate), nil
+		}
+	}
+	return "", newBadRequestError("invalidValue", "userName or emails must contain a valid email address")
+}
+
+func getUserTitle(user *User, email string) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	if user.Name != nil {
+		if user.Name.Formatted != "" {
+			return user.Name.Formatted
+		}
+		if title := strings.TrimSpace(user.Name.GivenName + " " + user.Name.FamilyName); title != "" {
+			return title
+		}
+	}
+	name, _, _ := strings.Cut(email, "@")
+	return name
+}
+
+// getUserPhone returns the primary phone number, the invalid phone number is ignored.
+func getUserPhone(user *User) string {
+	phone := getPrimaryValue(user.PhoneNumbers)
+	if phone == "" || common.ValidatePhone(phone) != nil {
+		return ""
+	}
+	return phone
+}
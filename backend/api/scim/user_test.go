@@ -0,0 +1,79 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyUserPatch(t *testing.T) {
+	active := true
+	user := &User{
+		UserName:    "alice@example.com",
+		DisplayName: "Alice",
+		Emails:      []MultiValuedAttribute{{Value: "alice@example.com", Type: "work", Primary: true}},
+		Active:      &active,
+	}
+	// Azure AD style operations.
+	request := &PatchRequest{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "displayName", "value": "Alice Liddell"},
+			{"op": "Replace", "path": "emails[type eq \"work\"].value", "value": "liddell@example.com"},
+			{"op": "Add", "path": "phoneNumbers[type eq \"mobile\"].value", "value": "+14155552671"},
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "Add", "path": "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department", "value": "R&D"}
+		]
+	}`), request))
+	for _, operation := range request.Operations {
+		require.NoError(t, applyUserPatch(user, operation))
+	}
+	require.Equal(t, "Alice Liddell", user.DisplayName)
+	require.Equal(t, []MultiValuedAttribute{{Value: "liddell@example.com", Type: "work", Primary: true}}, user.Emails)
+	require.Equal(t, []MultiValuedAttribute{{Value: "+14155552671", Type: "mobile", Primary: true}}, user.PhoneNumbers)
+	require.False(t, *user.Active)
+	require.Equal(t, "+14155552671", getUserPhone(user))
+
+	// Okta style operation without path.
+	require.NoError(t, applyUserPatch(user, &PatchOperation{Op: "replace", Value: json.RawMessage(`{"active": true, "name.givenName": "Alice"}`)}))
+	require.True(t, *user.Active)
+	require.Equal(t, "Alice", user.Name.GivenName)
+
+	require.NoError(t, applyUserPatch(user, &PatchOperation{Op: "remove", Path: `phoneNumbers[type eq "mobile"]`}))
+	require.Empty(t, user.PhoneNumbers)
+
+	require.Error(t, applyUserPatch(user, &PatchOperation{Op: "move", Path: "active"}))
+	require.Error(t, applyUserPatch(user, &PatchOperation{Op: "remove"}))
+	require.Error(t, applyUserPatch(user, &PatchOperation{Op: "replace", Path: "active", Value: json.RawMessage(`"maybe"`)}))
+}
+
+func TestGetUserEmail(t *testing.T) {
+	tests := []struct {
+		user *User
+		want string
+		err  bool
+	}{
+		{user: &User{UserName: "Alice@Example.com"}, want: "alice@example.com"},
+		{user: &User{UserName: "alice", Emails: []MultiValuedAttribute{{Value: "home@example.com"}, {Value: "work@example.com", Primary: true}}}, want: "work@example.com"},
+		{user: &User{UserName: "alice"}, err: true},
+		{user: &User{UserName: "Alice <alice@example.com>"}, err: true},
+	}
+	for _, test := range tests {
+		email, err := getUserEmail(test.user)
+		if test.err {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, test.want, email)
+	}
+}
+
+func TestGetUserTitle(t *testing.T) {
+	require.Equal(t, "Alice", getUserTitle(&User{DisplayName: "Alice", Name: &Name{Formatted: "Alice L"}}, "a@example.com"))
+	require.Equal(t, "Alice L", getUserTitle(&User{Name: &Name{Formatted: "Alice L"}}, "a@example.com"))
+	require.Equal(t, "Alice Liddell", getUserTitle(&User{Name: &Name{GivenName: "Alice", FamilyName: "Liddell"}}, "a@example.com"))
+	require.Equal(t, "a", getUserTitle(&User{}, "a@example.com"))
+}
@@ -126,6 +126,20 @@ func (in *ACLInterceptor) aclInterceptorDo(ctx context.Context, fullMethod strin
 		}
 	}
 
+	if isProjectMemberMethod(methodName) {
+		projectID, err := in.getDatabaseProject(ctx, request)
+		if err != nil {
+			return status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		projectRoles, err := in.getProjectRoles(ctx, user, projectID)
+		if err != nil {
+			return status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if len(projectRoles) == 0 {
+			return status.Errorf(codes.PermissionDenied, "only the member of project %q can access method %q", projectID, methodName)
+		}
+	}
+
 	if isTransferDatabaseMethods(methodName) {
 		projectIDs, err := in.getTransferDatabaseToProjects(ctx, request)
 		if err != nil {
@@ -175,7 +189,7 @@ func (in *ACLInterceptor) getProjectRoles(ctx context.Context, user *store.UserM
 	}
 	roles := map[api.Role]bool{}
 	for _, binding := range projectPolicy.Bindings {
-		for _, member := range binding.Members {
+		for _, member := range binding.GetAllMembers() {
 			if member.ID == user.ID {
 				roles[binding.Role] = true
 				break
@@ -219,6 +233,40 @@ func getProjectIDs(req any) ([]string, error) {
 	return nil, nil
 }
 
+// getDatabaseProject gets the project of the database read by the request.
+func (in *ACLInterceptor) getDatabaseProject(ctx context.Context, req any) (string, error) {
+	var databaseName string
+	switch request := req.(type) {
+	case *v1pb.ListClassificationSuggestionsRequest:
+		databaseName = request.Parent
+	default:
+		return "", errors.Errorf("unexpected request %T", req)
+	}
+	instanceID, databaseID, err := common.GetInstanceDatabaseID(databaseName)
+	if err != nil {
+		return "", err
+	}
+	instance, err := in.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get instance %s", instanceID)
+	}
+	if instance == nil {
+		return "", errors.Errorf("instance %q not found", instanceID)
+	}
+	database, err := in.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseID,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return "", err
+	}
+	if database == nil {
+		return "", errors.Errorf("database %q not found", databaseName)
+	}
+	return database.ProjectID, nil
+}
+
 func (in *ACLInterceptor) getTransferDatabaseToProjects(ctx context.Context, req any) ([]string, error) {
 	var requests []*v1pb.UpdateDatabaseRequest
 	if request, ok := req.(*v1pb.UpdateDatabaseRequest); ok {
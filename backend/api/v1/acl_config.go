@@ -11,28 +11,32 @@ const (
 )
 
 var ownerAndDBAMethods = map[string]bool{
-	"EnvironmentService/CreateEnvironment":   true,
-	"EnvironmentService/UpdateEnvironment":   true,
-	"EnvironmentService/DeleteEnvironment":   true,
-	"EnvironmentService/UndeleteEnvironment": true,
-	"EnvironmentService/UpdateBackupSetting": true,
-	"InstanceService/CreateInstance":         true,
-	"InstanceService/UpdateInstance":         true,
-	"InstanceService/DeleteInstance":         true,
-	"InstanceService/UndeleteInstance":       true,
-	"InstanceService/AddDataSource":          true,
-	"InstanceService/RemoveDataSource":       true,
-	"InstanceService/UpdateDataSource":       true,
-	"SubscriptionService/TrialSubscription":  true,
-	"RiskService/CreateRisk":                 true,
-	"RiskService/UpdateRisk":                 true,
-	"RiskService/DeleteRisk":                 true,
-	"SettingService/SetSetting":              true,
-	"RoleService/CreateRole":                 true,
-	"RoleService/UpdateRole":                 true,
-	"RoleService/DeleteRole":                 true,
-	"ActuatorService/UpdateActuatorInfo":     true,
-	"ActuatorService/ListDebugLog":           true,
+	"EnvironmentService/CreateEnvironment":           true,
+	"EnvironmentService/UpdateEnvironment":           true,
+	"EnvironmentService/DeleteEnvironment":           true,
+	"EnvironmentService/UndeleteEnvironment":         true,
+	"EnvironmentService/UpdateBackupSetting":         true,
+	"InstanceService/CreateInstance":                 true,
+	"InstanceService/UpdateInstance":                 true,
+	"InstanceService/DeleteInstance":                 true,
+	"InstanceService/UndeleteInstance":               true,
+	"InstanceService/AddDataSource":                  true,
+	"InstanceService/RemoveDataSource":               true,
+	"InstanceService/UpdateDataSource":               true,
+	"SubscriptionService/TrialSubscription":          true,
+	"RiskService/CreateRisk":                         true,
+	"RiskService/UpdateRisk":                         true,
+	"RiskService/DeleteRisk":                         true,
+	"SettingService/SetSetting":                      true,
+	"RoleService/CreateRole":                         true,
+	"RoleService/UpdateRole":                         true,
+	"RoleService/DeleteRole":                         true,
+	"UserGroupService/CreateUserGroup":               true,
+	"UserGroupService/UpdateUserGroup":               true,
+	"UserGroupService/DeleteUserGroup":               true,
+	"ActuatorService/UpdateActuatorInfo":             true,
+	"ActuatorService/ListDebugLog":                   true,
+	"DatabaseService/UpdateClassificationSuggestion": true,
 }
 
 var projectOwnerMethods = map[string]bool{
@@ -43,6 +47,11 @@ var projectOwnerMethods = map[string]bool{
 	"SubscriptionService/UpdateSubscription": true,
 }
 
+// projectMemberMethods are the methods reading the data of a database, which are only allowed to the members of the project of the database.
+var projectMemberMethods = map[string]bool{
+	"DatabaseService/ListClassificationSuggestions": true,
+}
+
 var transferDatabaseMethods = map[string]bool{
 	"DatabaseService/UpdateDatabase":       true,
 	"DatabaseService/BatchUpdateDatabases": true,
@@ -56,6 +65,10 @@ func isProjectOwnerMethod(methodName string) bool {
 	return projectOwnerMethods[methodName]
 }
 
+func isProjectMemberMethod(methodName string) bool {
+	return projectMemberMethods[methodName]
+}
+
 func isTransferDatabaseMethods(methodName string) bool {
 	return transferDatabaseMethods[methodName]
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/mail"
 	"regexp"
 	"strings"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/api/auth"
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
@@ -32,6 +34,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
 	"github.com/bytebase/bytebase/backend/plugin/metric"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
+	"github.com/bytebase/bytebase/backend/runner/usergroupsync"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -346,6 +349,20 @@ func (s *AuthService) UpdateUser(ctx context.Context, request *v1pb.UpdateUserRe
 				}
 			}
 			patch.Phone = &request.User.Phone
+		case "attributes":
+			if role != api.Owner {
+				return nil, status.Errorf(codes.PermissionDenied, "only workspace owner can update user attributes")
+			}
+			for key := range request.User.Attributes {
+				if key == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "user attribute key must not be empty")
+				}
+			}
+			// Keep the linked identities, which are only set on login.
+			patch.Profile = &storepb.UserProfile{
+				Attributes: request.User.Attributes,
+				Identities: user.Profile.GetIdentities(),
+			}
 		}
 	}
 	if passwordPatch != nil {
@@ -508,6 +525,9 @@ func convertToUser(user *store.UserMessage) *v1pb.User {
 		convertedUser.MfaSecret = user.MFAConfig.TempOtpSecret
 		convertedUser.RecoveryCodes = user.MFAConfig.TempRecoveryCodes
 	}
+	if user.Profile != nil {
+		convertedUser.Attributes = user.Profile.Attributes
+	}
 	return convertedUser
 }
 
@@ -764,6 +784,8 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 				UserFilter:       idpConfig.UserFilter,
 				SecurityProtocol: ldap.SecurityProtocol(idpConfig.SecurityProtocol),
 				FieldMapping:     idpConfig.FieldMapping,
+				GroupBaseDN:      idpConfig.GroupBaseDn,
+				GroupFilter:      idpConfig.GroupFilter,
 			},
 		)
 		if err != nil {
@@ -831,9 +853,41 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 		user = users[0]
 	}
 
+	if !user.MemberDeleted {
+		if user, err = s.linkIdentity(ctx, user, idp, userInfo.Identifier); err != nil {
+			return nil, err
+		}
+	}
+	if usergroupsync.IsGroupSyncEnabled(idp) && !user.MemberDeleted {
+		if err := usergroupsync.SyncUserGroups(ctx, s.store, idp, user.ID, userInfo.Groups); err != nil {
+			slog.Warn("Failed to sync user groups on login", slog.String("email", user.Email), log.BBError(err))
+		}
+	}
+
 	return user, nil
 }
 
+// linkIdentity links the identity in the identity provider to the user, which the user group sync matches the directory users by.
+func (s *AuthService) linkIdentity(ctx context.Context, user *store.UserMessage, idp *store.IdentityProviderMessage, identifier string) (*store.UserMessage, error) {
+	for _, identity := range user.Profile.GetIdentities() {
+		if int(identity.IdpUid) == idp.UID && identity.Identifier == identifier {
+			return user, nil
+		}
+	}
+	profile := &storepb.UserProfile{
+		Attributes: user.Profile.GetAttributes(),
+		Identities: append(slices.Clone(user.Profile.GetIdentities()), &storepb.IdentityProviderIdentity{
+			IdpUid:     int32(idp.UID),
+			Identifier: identifier,
+		}),
+	}
+	updatedUser, err := s.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Profile: profile}, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to link identity provider user to user %q: %v", user.Email, err)
+	}
+	return updatedUser, nil
+}
+
 func challengeMFACode(user *store.UserMessage, mfaCode string) error {
 	if !validateWithCodeAndSecret(mfaCode, user.MFAConfig.OtpSecret) {
 		return status.Errorf(codes.Unauthenticated, "invalid MFA code")
@@ -27,6 +27,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -66,15 +67,17 @@ type DatabaseService struct {
 	backupRunner   *backuprun.Runner
 	schemaSyncer   *schemasync.Syncer
 	licenseService enterpriseAPI.LicenseService
+	profile        *config.Profile
 }
 
 // NewDatabaseService creates a new DatabaseService.
-func NewDatabaseService(store *store.Store, br *backuprun.Runner, schemaSyncer *schemasync.Syncer, licenseService enterpriseAPI.LicenseService) *DatabaseService {
+func NewDatabaseService(store *store.Store, br *backuprun.Runner, schemaSyncer *schemasync.Syncer, licenseService enterpriseAPI.LicenseService, profile *config.Profile) *DatabaseService {
 	return &DatabaseService{
 		store:          store,
 		backupRunner:   br,
 		schemaSyncer:   schemaSyncer,
 		licenseService: licenseService,
+		profile:        profile,
 	}
 }
 
@@ -661,6 +664,11 @@ func (s *DatabaseService) UpdateBackupSetting(ctx context.Context, request *v1pb
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	if backupSetting.Enabled {
+		if err := db.CheckCapability(instance.Engine, db.CapabilityDump); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot enable backup for database %q: %v", databaseName, err)
+		}
+	}
 	principalID := ctx.Value(common.PrincipalIDContextKey).(int)
 	backupSetting, err = s.store.UpsertBackupSettingV2(ctx, principalID, backupSetting)
 	if err != nil {
@@ -752,6 +760,10 @@ func (s *DatabaseService) CreateBackup(ctx context.Context, request *v1pb.Create
 		return nil, status.Errorf(codes.AlreadyExists, "backup %q in database %q already exists", backupName, databaseName)
 	}
 
+	if err := db.CheckCapability(instance.Engine, db.CapabilityDump); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+
 	creatorID := ctx.Value(common.PrincipalIDContextKey).(int)
 	backup, err := s.backupRunner.ScheduleBackupTask(ctx, database, backupName, api.BackupTypeManual, creatorID)
 	if err != nil {
@@ -1765,13 +1777,14 @@ func convertToBackup(backup *store.BackupMessage, instanceID string, databaseNam
 		backupType = v1pb.Backup_PITR
 	}
 	return &v1pb.Backup{
-		Name:       fmt.Sprintf("%s%s/%s%s/%s%s", common.InstanceNamePrefix, instanceID, common.DatabaseIDPrefix, databaseName, common.BackupPrefix, backup.Name),
-		CreateTime: createTime,
-		UpdateTime: updateTime,
-		State:      backupState,
-		BackupType: backupType,
-		Comment:    backup.Comment,
-		Uid:        fmt.Sprintf("%d", backup.UID),
+		Name:           fmt.Sprintf("%s%s/%s%s/%s%s", common.InstanceNamePrefix, instanceID, common.DatabaseIDPrefix, databaseName, common.BackupPrefix, backup.Name),
+		CreateTime:     createTime,
+		UpdateTime:     updateTime,
+		State:          backupState,
+		BackupType:     backupType,
+		Comment:        backup.Comment,
+		Uid:            fmt.Sprintf("%d", backup.UID),
+		StorageBackend: convertToV1PBBackupStorageBackend(backup.StorageBackend),
 	}
 }
 
@@ -1956,8 +1969,9 @@ func convertDatabaseConfig(config *storepb.DatabaseConfig) []*v1pb.SchemaConfig
 			}
 			for _, column := range table.ColumnConfigs {
 				t.ColumnConfigs = append(t.ColumnConfigs, &v1pb.ColumnConfig{
-					Name:           column.Name,
-					SemanticTypeId: column.SemanticTypeId,
+					Name:             column.Name,
+					SemanticTypeId:   column.SemanticTypeId,
+					ClassificationId: column.ClassificationId,
 				})
 			}
 			s.TableConfigs = append(s.TableConfigs, t)
@@ -1981,8 +1995,9 @@ func convertV1DatabaseConfig(databaseName string, schemaConfig []*v1pb.SchemaCon
 			}
 			for _, column := range table.ColumnConfigs {
 				t.ColumnConfigs = append(t.ColumnConfigs, &storepb.ColumnConfig{
-					Name:           column.Name,
-					SemanticTypeId: column.SemanticTypeId,
+					Name:             column.Name,
+					SemanticTypeId:   column.SemanticTypeId,
+					ClassificationId: column.ClassificationId,
 				})
 			}
 			s.TableConfigs = append(s.TableConfigs, t)
@@ -2058,9 +2073,41 @@ func convertToBackupSetting(backupSetting *store.BackupSettingMessage, instanceI
 		BackupRetainDuration: period,
 		CronSchedule:         cronSchedule,
 		HookUrl:              backupSetting.HookURL,
+		StorageBackend:       convertToV1PBBackupStorageBackend(backupSetting.StorageBackend),
 	}, nil
 }
 
+func convertToV1PBBackupStorageBackend(storageBackend api.BackupStorageBackend) v1pb.BackupStorageBackend {
+	switch storageBackend {
+	case api.BackupStorageBackendLocal:
+		return v1pb.BackupStorageBackend_LOCAL
+	case api.BackupStorageBackendS3:
+		return v1pb.BackupStorageBackend_S3
+	case api.BackupStorageBackendGCS:
+		return v1pb.BackupStorageBackend_GCS
+	case api.BackupStorageBackendAzureBlob:
+		return v1pb.BackupStorageBackend_AZURE_BLOB
+	}
+	return v1pb.BackupStorageBackend_BACKUP_STORAGE_BACKEND_UNSPECIFIED
+}
+
+// convertToAPIBackupStorageBackend converts the storage backend, and BACKUP_STORAGE_BACKEND_UNSPECIFIED is converted to empty which means inheriting.
+func convertToAPIBackupStorageBackend(storageBackend v1pb.BackupStorageBackend) (api.BackupStorageBackend, error) {
+	switch storageBackend {
+	case v1pb.BackupStorageBackend_BACKUP_STORAGE_BACKEND_UNSPECIFIED:
+		return "", nil
+	case v1pb.BackupStorageBackend_LOCAL:
+		return api.BackupStorageBackendLocal, nil
+	case v1pb.BackupStorageBackend_S3:
+		return api.BackupStorageBackendS3, nil
+	case v1pb.BackupStorageBackend_GCS:
+		return api.BackupStorageBackendGCS, nil
+	case v1pb.BackupStorageBackend_AZURE_BLOB:
+		return api.BackupStorageBackendAzureBlob, nil
+	}
+	return "", errors.Errorf("invalid backup storage backend %v", storageBackend)
+}
+
 func (s *DatabaseService) validateAndConvertToStoreBackupSetting(ctx context.Context, backupSetting *v1pb.BackupSetting, database *store.DatabaseMessage) (*store.BackupSettingMessage, error) {
 	enable := backupSetting.CronSchedule != ""
 	hourOfDay := 0
@@ -2076,6 +2123,13 @@ func (s *DatabaseService) validateAndConvertToStoreBackupSetting(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
+	storageBackend, err := convertToAPIBackupStorageBackend(backupSetting.StorageBackend)
+	if err != nil {
+		return nil, err
+	}
+	if storageBackend != "" && !s.profile.IsBackupStorageBackendAvailable(storageBackend) {
+		return nil, &common.Error{Code: common.Invalid, Err: errors.Errorf("backup storage backend %s is not configured", storageBackend)}
+	}
 	setting := &store.BackupSettingMessage{
 		DatabaseUID:       database.UID,
 		Enabled:           enable,
@@ -2083,6 +2137,7 @@ func (s *DatabaseService) validateAndConvertToStoreBackupSetting(ctx context.Con
 		DayOfWeek:         dayOfWeek,
 		RetentionPeriodTs: periodTs,
 		HookURL:           backupSetting.HookUrl,
+		StorageBackend:    storageBackend,
 	}
 
 	environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{
@@ -2174,7 +2229,7 @@ func isProjectOwnerOrDeveloper(principalID int, projectPolicy *store.IAMPolicyMe
 		if binding.Role != api.Owner && binding.Role != api.Developer {
 			continue
 		}
-		for _, member := range binding.Members {
+		for _, member := range binding.GetAllMembers() {
 			if member.ID == principalID {
 				return true
 			}
@@ -0,0 +1,294 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ListClassificationSuggestions lists the classification suggestions of a database proposed by the sensitive data discovery.
+func (s *DatabaseService) ListClassificationSuggestions(ctx context.Context, request *v1pb.ListClassificationSuggestionsRequest) (*v1pb.ListClassificationSuggestionsResponse, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	_, database, err := s.getInstanceDatabase(ctx, instanceID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := parseFilter(request.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var pageToken storepb.PageToken
+	if request.PageToken != "" {
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.Limit != request.PageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "request page size does not match the page token")
+		}
+	} else {
+		pageToken.Limit = request.PageSize
+	}
+	limit := int(pageToken.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	limitPlusOne := limit + 1
+	offset := int(pageToken.Offset)
+
+	find := &store.FindClassificationSuggestionMessage{
+		DatabaseUID: &database.UID,
+		Limit:       &limitPlusOne,
+		Offset:      &offset,
+	}
+	for _, spec := range filters {
+		switch spec.key {
+		case "state":
+			if spec.operator != comparatorTypeEqual {
+				return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "state" filter`)
+			}
+			state, err := convertToStoreClassificationSuggestionState(spec.value)
+			if err != nil {
+				return nil, err
+			}
+			find.State = &state
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter key %q", spec.key)
+		}
+	}
+
+	suggestions, err := s.store.ListClassificationSuggestions(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list classification suggestions, error: %v", err)
+	}
+	nextPageToken := ""
+	if len(suggestions) == limitPlusOne {
+		suggestions = suggestions[:limit]
+		if nextPageToken, err = getPageToken(limit, offset+limit); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListClassificationSuggestionsResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, suggestion := range suggestions {
+		response.ClassificationSuggestions = append(response.ClassificationSuggestions, convertToClassificationSuggestion(suggestion, database))
+	}
+	return response, nil
+}
+
+// UpdateClassificationSuggestion accepts or rejects a pending classification suggestion.
+// Accepting the suggestion sets the classification of the column in the database config.
+func (s *DatabaseService) UpdateClassificationSuggestion(ctx context.Context, request *v1pb.UpdateClassificationSuggestionRequest) (*v1pb.ClassificationSuggestion, error) {
+	if request.ClassificationSuggestion == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "classification suggestion must be set")
+	}
+	if request.UpdateMask == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
+	}
+	instanceID, databaseName, suggestionID, err := common.GetInstanceDatabaseIDClassificationSuggestion(request.ClassificationSuggestion.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, database, err := s.getInstanceDatabase(ctx, instanceID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+
+	suggestion, err := s.store.GetClassificationSuggestion(ctx, &store.FindClassificationSuggestionMessage{
+		ID:          &suggestionID,
+		DatabaseUID: &database.UID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if suggestion == nil {
+		return nil, status.Errorf(codes.NotFound, "classification suggestion %q not found", request.ClassificationSuggestion.Name)
+	}
+
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "state":
+			var state store.ClassificationSuggestionState
+			switch request.ClassificationSuggestion.State {
+			case v1pb.ClassificationSuggestion_ACCEPTED:
+				state = store.ClassificationSuggestionAccepted
+			case v1pb.ClassificationSuggestion_REJECTED:
+				state = store.ClassificationSuggestionRejected
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "classification suggestion can only be accepted or rejected")
+			}
+			// Only the review that moves the suggestion out of the pending state takes effect.
+			updated, err := s.store.UpdateClassificationSuggestionState(ctx, suggestion.ID, store.ClassificationSuggestionPending, state)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, err.Error())
+			}
+			if updated == nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "classification suggestion %q is not pending", request.ClassificationSuggestion.Name)
+			}
+			if state == store.ClassificationSuggestionAccepted {
+				if err := s.setColumnClassification(ctx, database, updated); err != nil {
+					// Restore the suggestion for review again.
+					if _, restoreErr := s.store.UpdateClassificationSuggestionState(ctx, suggestion.ID, state, store.ClassificationSuggestionPending); restoreErr != nil {
+						slog.Error("Failed to restore classification suggestion", slog.Int("suggestion", suggestion.ID), log.BBError(restoreErr))
+					}
+					return nil, err
+				}
+			}
+			suggestion = updated
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update mask path %q", path)
+		}
+	}
+
+	if suggestion.State == store.ClassificationSuggestionAccepted {
+		// Sync the schema to apply the classification to the database metadata.
+		// The classification is applied in the next sync if it fails.
+		if err := s.schemaSyncer.SyncDatabaseSchema(ctx, database, false /* force */); err != nil {
+			slog.Warn("Failed to sync database schema after accepting classification suggestion",
+				slog.String("instance", instanceID),
+				slog.String("database", databaseName),
+				log.BBError(err))
+		}
+	}
+	return convertToClassificationSuggestion(suggestion, database), nil
+}
+
+// setColumnClassification sets the classification of the suggested column in the database config.
+func (s *DatabaseService) setColumnClassification(ctx context.Context, database *store.DatabaseMessage, suggestion *store.ClassificationSuggestionMessage) error {
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	if dbSchema == nil {
+		return status.Errorf(codes.FailedPrecondition, "database schema metadata not found")
+	}
+	config := dbSchema.Config
+	if config == nil {
+		config = &storepb.DatabaseConfig{Name: database.DatabaseName}
+	}
+
+	var schemaConfig *storepb.SchemaConfig
+	for _, sc := range config.SchemaConfigs {
+		if sc.Name == suggestion.Schema {
+			schemaConfig = sc
+			break
+		}
+	}
+	if schemaConfig == nil {
+		schemaConfig = &storepb.SchemaConfig{Name: suggestion.Schema}
+		config.SchemaConfigs = append(config.SchemaConfigs, schemaConfig)
+	}
+	var tableConfig *storepb.TableConfig
+	for _, tc := range schemaConfig.TableConfigs {
+		if tc.Name == suggestion.Table {
+			tableConfig = tc
+			break
+		}
+	}
+	if tableConfig == nil {
+		tableConfig = &storepb.TableConfig{Name: suggestion.Table}
+		schemaConfig.TableConfigs = append(schemaConfig.TableConfigs, tableConfig)
+	}
+	var columnConfig *storepb.ColumnConfig
+	for _, cc := range tableConfig.ColumnConfigs {
+		if cc.Name == suggestion.Column {
+			columnConfig = cc
+			break
+		}
+	}
+	if columnConfig == nil {
+		columnConfig = &storepb.ColumnConfig{Name: suggestion.Column}
+		tableConfig.ColumnConfigs = append(tableConfig.ColumnConfigs, columnConfig)
+	}
+	columnConfig.ClassificationId = suggestion.ClassificationID
+
+	principalID := ctx.Value(common.PrincipalIDContextKey).(int)
+	if err := s.store.UpdateDBSchema(ctx, database.UID, &store.UpdateDBSchemaMessage{Config: config}, principalID); err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func (s *DatabaseService) getInstanceDatabase(ctx context.Context, instanceID, databaseName string) (*store.InstanceMessage, *store.DatabaseMessage, error) {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		ResourceID: &instanceID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if instance == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	return instance, database, nil
+}
+
+func convertToStoreClassificationSuggestionState(state string) (store.ClassificationSuggestionState, error) {
+	switch state {
+	case v1pb.ClassificationSuggestion_PENDING.String():
+		return store.ClassificationSuggestionPending, nil
+	case v1pb.ClassificationSuggestion_ACCEPTED.String():
+		return store.ClassificationSuggestionAccepted, nil
+	case v1pb.ClassificationSuggestion_REJECTED.String():
+		return store.ClassificationSuggestionRejected, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid classification suggestion state %q", state)
+	}
+}
+
+func convertToClassificationSuggestion(suggestion *store.ClassificationSuggestionMessage, database *store.DatabaseMessage) *v1pb.ClassificationSuggestion {
+	state := v1pb.ClassificationSuggestion_STATE_UNSPECIFIED
+	switch suggestion.State {
+	case store.ClassificationSuggestionPending:
+		state = v1pb.ClassificationSuggestion_PENDING
+	case store.ClassificationSuggestionAccepted:
+		state = v1pb.ClassificationSuggestion_ACCEPTED
+	case store.ClassificationSuggestionRejected:
+		state = v1pb.ClassificationSuggestion_REJECTED
+	}
+	return &v1pb.ClassificationSuggestion{
+		Name:             fmt.Sprintf("%s%s/%s%s/%s%d", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName, common.ClassificationSuggestionPrefix, suggestion.ID),
+		Schema:           suggestion.Schema,
+		Table:            suggestion.Table,
+		Column:           suggestion.Column,
+		ClassificationId: suggestion.ClassificationID,
+		DetectorId:       suggestion.DetectorID,
+		Confidence:       suggestion.Confidence,
+		State:            state,
+		CreateTime:       timestamppb.New(time.Unix(suggestion.CreatedTs, 0)),
+		UpdateTime:       timestamppb.New(time.Unix(suggestion.UpdatedTs, 0)),
+	}
+}
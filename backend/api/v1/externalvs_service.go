@@ -317,6 +317,10 @@ func convertToExternalVersionControl(externalVersionControl *store.ExternalVersi
 		tp = v1pb.ExternalVersionControl_BITBUCKET
 	case vcs.AzureDevOps:
 		tp = v1pb.ExternalVersionControl_AZURE_DEVOPS
+	case vcs.Gitea:
+		tp = v1pb.ExternalVersionControl_GITEA
+	case vcs.Git:
+		tp = v1pb.ExternalVersionControl_GIT
 	}
 
 	return &v1pb.ExternalVersionControl{
@@ -339,7 +343,8 @@ func checkAndConvertToStoreVersionControl(externalVersionControl *v1pb.ExternalV
 	if externalVersionControl.Url == "" {
 		return nil, errors.Errorf("Empty ExternalVersionControl.Url")
 	}
-	if externalVersionControl.ApplicationId == "" {
+	// The plain Git server has no OAuth application, the secret is used to sign the push events.
+	if externalVersionControl.ApplicationId == "" && externalVersionControl.Type != v1pb.ExternalVersionControl_GIT {
 		return nil, errors.Errorf("Empty ExternalVersionControl.ApplicationId")
 	}
 	if externalVersionControl.Secret == "" {
@@ -372,6 +377,10 @@ func convertExternalVersionControlTypeToVCSType(tp v1pb.ExternalVersionControl_T
 		return vcs.Bitbucket, nil
 	case v1pb.ExternalVersionControl_AZURE_DEVOPS:
 		return vcs.AzureDevOps, nil
+	case v1pb.ExternalVersionControl_GITEA:
+		return vcs.Gitea, nil
+	case v1pb.ExternalVersionControl_GIT:
+		return vcs.Git, nil
 	}
 	return "", errors.Errorf("unknown external version control type: %v", tp)
 }
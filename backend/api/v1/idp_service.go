@@ -303,6 +303,8 @@ func (s *IdentityProviderService) TestIdentityProvider(ctx context.Context, requ
 				UserFilter:       identityProviderConfig.UserFilter,
 				SecurityProtocol: ldap.SecurityProtocol(identityProviderConfig.SecurityProtocol),
 				FieldMapping:     identityProviderConfig.FieldMapping,
+				GroupBaseDN:      identityProviderConfig.GroupBaseDn,
+				GroupFilter:      identityProviderConfig.GroupFilter,
 			},
 		)
 		if err != nil {
@@ -360,6 +362,7 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 			DisplayName: v.FieldMapping.DisplayName,
 			Email:       v.FieldMapping.Email,
 			Phone:       v.FieldMapping.Phone,
+			Groups:      v.FieldMapping.Groups,
 		}
 		return &v1pb.IdentityProviderConfig{
 			Config: &v1pb.IdentityProviderConfig_Oauth2Config{
@@ -382,6 +385,7 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 			DisplayName: v.FieldMapping.DisplayName,
 			Email:       v.FieldMapping.Email,
 			Phone:       v.FieldMapping.Phone,
+			Groups:      v.FieldMapping.Groups,
 		}
 		return &v1pb.IdentityProviderConfig{
 			Config: &v1pb.IdentityProviderConfig_OidcConfig{
@@ -402,6 +406,7 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 			DisplayName: v.FieldMapping.DisplayName,
 			Email:       v.FieldMapping.Email,
 			Phone:       v.FieldMapping.Phone,
+			Groups:      v.FieldMapping.Groups,
 		}
 		return &v1pb.IdentityProviderConfig{
 			Config: &v1pb.IdentityProviderConfig_LdapConfig{
@@ -415,6 +420,8 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 					UserFilter:       v.UserFilter,
 					SecurityProtocol: v.SecurityProtocol,
 					FieldMapping:     &fieldMapping,
+					GroupBaseDn:      v.GroupBaseDn,
+					GroupFilter:      v.GroupFilter,
 				},
 			},
 		}
@@ -429,6 +436,7 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 			DisplayName: v.FieldMapping.DisplayName,
 			Email:       v.FieldMapping.Email,
 			Phone:       v.FieldMapping.Phone,
+			Groups:      v.FieldMapping.Groups,
 		}
 		return &storepb.IdentityProviderConfig{
 			Config: &storepb.IdentityProviderConfig_Oauth2Config{
@@ -451,6 +459,7 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 			DisplayName: v.FieldMapping.DisplayName,
 			Email:       v.FieldMapping.Email,
 			Phone:       v.FieldMapping.Phone,
+			Groups:      v.FieldMapping.Groups,
 		}
 		return &storepb.IdentityProviderConfig{
 			Config: &storepb.IdentityProviderConfig_OidcConfig{
@@ -470,6 +479,7 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 			DisplayName: v.FieldMapping.DisplayName,
 			Email:       v.FieldMapping.Email,
 			Phone:       v.FieldMapping.Phone,
+			Groups:      v.FieldMapping.Groups,
 		}
 		return &storepb.IdentityProviderConfig{
 			Config: &storepb.IdentityProviderConfig_LdapConfig{
@@ -483,6 +493,8 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 					UserFilter:       v.UserFilter,
 					SecurityProtocol: v.SecurityProtocol,
 					FieldMapping:     &fieldMapping,
+					GroupBaseDn:      v.GroupBaseDn,
+					GroupFilter:      v.GroupFilter,
 				},
 			},
 		}
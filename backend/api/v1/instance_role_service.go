@@ -282,6 +282,9 @@ func (s *InstanceRoleService) getInstanceMessage(ctx context.Context, instanceID
 	if instance == nil {
 		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
 	}
+	if err := db.CheckCapability(instance.Engine, db.CapabilityRole); err != nil {
+		return nil, status.Errorf(codes.Unimplemented, err.Error())
+	}
 	return instance, nil
 }
 
@@ -238,6 +238,18 @@ func (s *InstanceService) UpdateInstance(ctx context.Context, request *v1pb.Upda
 			} else {
 				patch.OptionsUpsert.SyncInterval = request.Instance.Options.GetSyncInterval()
 			}
+		case "options.prior_backup":
+			priorBackup := request.Instance.Options.GetPriorBackup()
+			if err := validatePriorBackup(instance.Engine, priorBackup); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = &storepb.InstanceOptions{
+					PriorBackup: convertInstanceOptionsPriorBackup(priorBackup),
+				}
+			} else {
+				patch.OptionsUpsert.PriorBackup = convertInstanceOptionsPriorBackup(priorBackup)
+			}
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupported update_mask "%s"`, path)
 		}
@@ -894,6 +906,9 @@ func (s *InstanceService) convertToInstanceMessage(instanceID string, instance *
 	if err != nil {
 		return nil, err
 	}
+	if err := validatePriorBackup(convertEngine(instance.Engine), instance.Options.GetPriorBackup()); err != nil {
+		return nil, err
+	}
 
 	return &store.InstanceMessage{
 		ResourceID:    instanceID,
@@ -984,6 +999,27 @@ func convertToInstanceOptions(options *storepb.InstanceOptions) *v1pb.InstanceOp
 	return &v1pb.InstanceOptions{
 		SchemaTenantMode: options.SchemaTenantMode,
 		SyncInterval:     options.SyncInterval,
+		PriorBackup:      convertToInstanceOptionsPriorBackup(options.PriorBackup),
+	}
+}
+
+func validatePriorBackup(engine db.Type, priorBackup *v1pb.InstanceOptions_PriorBackup) error {
+	if priorBackup.GetEnabled() && engine != db.MySQL {
+		return errors.Errorf("prior backup is only supported for MySQL instances")
+	}
+	if priorBackup.GetMaxRows() < 0 {
+		return errors.Errorf("max rows of prior backup must not be negative")
+	}
+	return nil
+}
+
+func convertToInstanceOptionsPriorBackup(priorBackup *storepb.InstanceOptions_PriorBackup) *v1pb.InstanceOptions_PriorBackup {
+	if priorBackup == nil {
+		return nil
+	}
+	return &v1pb.InstanceOptions_PriorBackup{
+		Enabled: priorBackup.Enabled,
+		MaxRows: priorBackup.MaxRows,
 	}
 }
 
@@ -995,5 +1031,16 @@ func convertInstanceOptions(options *v1pb.InstanceOptions) *storepb.InstanceOpti
 	return &storepb.InstanceOptions{
 		SchemaTenantMode: options.SchemaTenantMode,
 		SyncInterval:     options.SyncInterval,
+		PriorBackup:      convertInstanceOptionsPriorBackup(options.PriorBackup),
+	}
+}
+
+func convertInstanceOptionsPriorBackup(priorBackup *v1pb.InstanceOptions_PriorBackup) *storepb.InstanceOptions_PriorBackup {
+	if priorBackup == nil {
+		return nil
+	}
+	return &storepb.InstanceOptions_PriorBackup{
+		Enabled: priorBackup.Enabled,
+		MaxRows: priorBackup.MaxRows,
 	}
 }
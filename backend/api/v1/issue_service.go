@@ -12,6 +12,7 @@ import (
 	"github.com/google/cel-go/cel"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -354,6 +355,8 @@ func (s *IssueService) SearchIssues(ctx context.Context, request *v1pb.SearchIss
 					api.TaskDatabaseSchemaUpdateSDL,
 					api.TaskDatabaseSchemaUpdateGhostSync,
 					api.TaskDatabaseSchemaUpdateGhostCutover,
+					api.TaskDatabaseSchemaUpdatePGOnlineSync,
+					api.TaskDatabaseSchemaUpdatePGOnlineCutover,
 				}
 			case "DML":
 				issueFind.TaskTypes = &[]api.TaskType{
@@ -763,29 +766,45 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 		return nil, status.Errorf(codes.Internal, "failed to get project policy, error: %v", err)
 	}
 
-	canApprove, err := isUserReviewer(step, user, policy)
+	userGroups, err := listUserGroupNames(ctx, s.store, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user groups, error: %v", err)
+	}
+
+	canApprove, err := isUserReviewer(step, user, userGroups, policy)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to check if principal can approve step, error: %v", err)
 	}
 	if !canApprove {
 		return nil, status.Errorf(codes.PermissionDenied, "cannot approve because the user does not have the required permission")
 	}
+	if step.Nodes[0].DisallowSelfApproval && issue.Creator.ID == principalID {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot approve because the issue creator cannot approve the step")
+	}
+	if utils.HasApprovedStep(payload.Approval, int32(principalID)) {
+		return nil, status.Errorf(codes.InvalidArgument, "the user has approved the step")
+	}
 
-	payload.Approval.Approvers = append(payload.Approval.Approvers, &storepb.IssuePayloadApproval_Approver{
-		Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
-		PrincipalId: int32(principalID),
-	})
+	stepApproved, err := utils.ApproveStep(payload.Approval, int32(principalID))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to approve the step, error: %v", err)
+	}
 
 	approved, err := utils.CheckApprovalApproved(payload.Approval)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to check if the approval is approved, error: %v", err)
 	}
 
-	newApprovers, activityCreates, err := utils.HandleIncomingApprovalSteps(ctx, s.store, s.relayRunner.Client, issue, payload.Approval)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to handle incoming approval steps, error: %v", err)
+	var activityCreates []*store.ActivityMessage
+	// Only handle the incoming step once the current step is approved by enough approvers.
+	if stepApproved {
+		newApprovers, creates, err := utils.HandleIncomingApprovalSteps(ctx, s.store, s.relayRunner.Client, issue, payload.Approval)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to handle incoming approval steps, error: %v", err)
+		}
+		payload.Approval.Approvers = append(payload.Approval.Approvers, newApprovers...)
+		activityCreates = creates
 	}
-	payload.Approval.Approvers = append(payload.Approval.Approvers, newApprovers...)
 
 	issue, err = s.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 		PayloadUpsert: &storepb.IssuePayload{
@@ -858,7 +877,8 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 	}
 
 	if err := func() error {
-		if len(payload.Approval.ApprovalTemplates) != 1 {
+		// The pending step is notified already if it still needs more approvers.
+		if !stepApproved || len(payload.Approval.ApprovalTemplates) != 1 {
 			return nil
 		}
 		approvalStep := utils.FindNextPendingStep(payload.Approval.ApprovalTemplates[0], payload.Approval.Approvers)
@@ -948,17 +968,19 @@ func (s *IssueService) RejectIssue(ctx context.Context, request *v1pb.RejectIssu
 		return nil, status.Errorf(codes.Internal, "failed to get project policy, error: %v", err)
 	}
 
-	canApprove, err := isUserReviewer(step, user, policy)
+	userGroups, err := listUserGroupNames(ctx, s.store, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user groups, error: %v", err)
+	}
+
+	canApprove, err := isUserReviewer(step, user, userGroups, policy)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to check if principal can reject step, error: %v", err)
 	}
 	if !canApprove {
 		return nil, status.Errorf(codes.PermissionDenied, "cannot reject because the user does not have the required permission")
 	}
-	payload.Approval.Approvers = append(payload.Approval.Approvers, &storepb.IssuePayloadApproval_Approver{
-		Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
-		PrincipalId: int32(principalID),
-	})
+	utils.RejectStep(payload.Approval, int32(principalID))
 
 	issue, err = s.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 		PayloadUpsert: &storepb.IssuePayload{
@@ -1052,6 +1074,15 @@ func (s *IssueService) RequestIssue(ctx context.Context, request *v1pb.RequestIs
 		newApprovers = append(newApprovers, approver)
 	}
 	payload.Approval.Approvers = newApprovers
+	// Drop the rejections on the re-requested step, and keep the approvals collected so far.
+	var newStepApprovers []*storepb.IssuePayloadApproval_StepApprover
+	for _, approver := range payload.Approval.StepApprovers {
+		if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
+			continue
+		}
+		newStepApprovers = append(newStepApprovers, approver)
+	}
+	payload.Approval.StepApprovers = newStepApprovers
 
 	newApprovers, activityCreates, err := utils.HandleIncomingApprovalSteps(ctx, s.store, s.relayRunner.Client, issue, payload.Approval)
 	if err != nil {
@@ -1426,7 +1457,20 @@ func canRequestIssue(issueCreator *store.UserMessage, user *store.UserMessage) b
 	return issueCreator.ID == user.ID
 }
 
-func isUserReviewer(step *storepb.ApprovalStep, user *store.UserMessage, policy *store.IAMPolicyMessage) (bool, error) {
+// listUserGroupNames returns the names of the groups that the user belongs to.
+func listUserGroupNames(ctx context.Context, s *store.Store, userID int) ([]string, error) {
+	groups, err := s.ListUserGroups(ctx, &store.FindUserGroupMessage{MemberID: &userID})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, group := range groups {
+		names = append(names, fmt.Sprintf("%s%s", common.UserGroupNamePrefix, group.ResourceID))
+	}
+	return names, nil
+}
+
+func isUserReviewer(step *storepb.ApprovalStep, user *store.UserMessage, userGroups []string, policy *store.IAMPolicyMessage) (bool, error) {
 	if len(step.Nodes) != 1 {
 		return false, errors.Errorf("expecting one node but got %v", len(step.Nodes))
 	}
@@ -1440,7 +1484,7 @@ func isUserReviewer(step *storepb.ApprovalStep, user *store.UserMessage, policy
 
 	userHasProjectRole := map[string]bool{}
 	for _, binding := range policy.Bindings {
-		for _, member := range binding.Members {
+		for _, member := range binding.GetAllMembers() {
 			if member.ID == user.ID {
 				userHasProjectRole[convertToRoleName(string(binding.Role))] = true
 				break
@@ -1469,6 +1513,20 @@ func isUserReviewer(step *storepb.ApprovalStep, user *store.UserMessage, policy
 		}
 	case *storepb.ApprovalNode_ExternalNodeId:
 		return true, nil
+	case *storepb.ApprovalNode_UserList_:
+		for _, name := range val.UserList.Users {
+			userID, err := common.GetUserID(name)
+			if err != nil {
+				return false, err
+			}
+			if userID == user.ID {
+				return true, nil
+			}
+		}
+	case *storepb.ApprovalNode_UserGroup:
+		if slices.Contains(userGroups, val.UserGroup) {
+			return true, nil
+		}
 	default:
 		return false, errors.Errorf("invalid node payload type")
 	}
@@ -1535,7 +1593,11 @@ func convertToIssue(ctx context.Context, s *store.Store, issue *store.IssueMessa
 		issueV1.ApprovalFindingDone = issuePayload.Approval.ApprovalFindingDone
 		issueV1.ApprovalFindingError = issuePayload.Approval.ApprovalFindingError
 		for _, template := range issuePayload.Approval.ApprovalTemplates {
-			issueV1.ApprovalTemplates = append(issueV1.ApprovalTemplates, convertToApprovalTemplate(template))
+			convertedTemplate := convertToApprovalTemplate(template)
+			if err := convertToV1ApprovalFlowUsers(ctx, s, convertedTemplate.Flow); err != nil {
+				return nil, err
+			}
+			issueV1.ApprovalTemplates = append(issueV1.ApprovalTemplates, convertedTemplate)
 		}
 		for _, approver := range issuePayload.Approval.Approvers {
 			convertedApprover := &v1pb.Issue_Approver{Status: v1pb.Issue_Approver_Status(approver.Status)}
@@ -1546,6 +1608,17 @@ func convertToIssue(ctx context.Context, s *store.Store, issue *store.IssueMessa
 			convertedApprover.Principal = fmt.Sprintf("users/%s", user.Email)
 			issueV1.Approvers = append(issueV1.Approvers, convertedApprover)
 		}
+		for _, approver := range issuePayload.Approval.StepApprovers {
+			user, err := s.GetUserByID(ctx, int(approver.PrincipalId))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to find user by id %v", approver.PrincipalId)
+			}
+			issueV1.StepApprovers = append(issueV1.StepApprovers, &v1pb.Issue_StepApprover{
+				Step:      approver.Step,
+				Status:    v1pb.Issue_Approver_Status(approver.Status),
+				Principal: fmt.Sprintf("users/%s", user.Email),
+			})
+		}
 	}
 
 	return issueV1, nil
@@ -1630,10 +1703,53 @@ func convertToApprovalNode(node *storepb.ApprovalNode) *v1pb.ApprovalNode {
 		v1node.Payload = &v1pb.ApprovalNode_ExternalNodeId{
 			ExternalNodeId: payload.ExternalNodeId,
 		}
+	case *storepb.ApprovalNode_UserList_:
+		v1node.Payload = &v1pb.ApprovalNode_UserList_{
+			UserList: &v1pb.ApprovalNode_UserList{
+				Users: payload.UserList.Users,
+			},
+		}
+	case *storepb.ApprovalNode_UserGroup:
+		v1node.Payload = &v1pb.ApprovalNode_UserGroup{
+			UserGroup: payload.UserGroup,
+		}
 	}
+	v1node.RequiredApproverCount = node.RequiredApproverCount
+	v1node.DisallowSelfApproval = node.DisallowSelfApproval
 	return v1node
 }
 
+// convertToV1ApprovalFlowUsers converts the users of the approval nodes from users/{uid} to users/{email}.
+func convertToV1ApprovalFlowUsers(ctx context.Context, s *store.Store, flow *v1pb.ApprovalFlow) error {
+	for _, step := range flow.Steps {
+		for _, node := range step.Nodes {
+			userList := node.GetUserList()
+			if userList == nil {
+				continue
+			}
+			var users []string
+			for _, name := range userList.Users {
+				userID, err := common.GetUserID(name)
+				if err != nil {
+					return errors.Wrapf(err, "invalid user %q in approval node", name)
+				}
+				user, err := s.GetUserByID(ctx, userID)
+				if err != nil {
+					return errors.Wrapf(err, "failed to find user by id %v", userID)
+				}
+				if user == nil {
+					continue
+				}
+				users = append(users, fmt.Sprintf("%s%s", common.UserNamePrefix, user.Email))
+			}
+			node.Payload = &v1pb.ApprovalNode_UserList_{
+				UserList: &v1pb.ApprovalNode_UserList{Users: users},
+			}
+		}
+	}
+	return nil
+}
+
 func convertToApprovalNodeGroupValue(v storepb.ApprovalNode_GroupValue) v1pb.ApprovalNode_GroupValue {
 	switch v {
 	case storepb.ApprovalNode_GROUP_VALUE_UNSPECIFILED:
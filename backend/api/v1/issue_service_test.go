@@ -41,10 +41,11 @@ func TestConvertToApprovalNode(t *testing.T) {
 
 func TestCanUserApproveStep(t *testing.T) {
 	tests := []struct {
-		step   *storepb.ApprovalStep
-		user   *store.UserMessage
-		policy *store.IAMPolicyMessage
-		want   bool
+		step       *storepb.ApprovalStep
+		user       *store.UserMessage
+		userGroups []string
+		policy     *store.IAMPolicyMessage
+		want       bool
 	}{
 		{
 			step: &storepb.ApprovalStep{
@@ -179,11 +180,86 @@ func TestCanUserApproveStep(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			step: &storepb.ApprovalStep{
+				Type: storepb.ApprovalStep_ANY,
+				Nodes: []*storepb.ApprovalNode{
+					{
+						Type: storepb.ApprovalNode_ANY_IN_GROUP,
+						Payload: &storepb.ApprovalNode_UserList_{
+							UserList: &storepb.ApprovalNode_UserList{Users: []string{"users/101", "users/102"}},
+						},
+						RequiredApproverCount: 2,
+					},
+				},
+			},
+			user: &store.UserMessage{
+				ID:   102,
+				Role: api.Developer,
+			},
+			policy: &store.IAMPolicyMessage{},
+			want:   true,
+		},
+		{
+			step: &storepb.ApprovalStep{
+				Type: storepb.ApprovalStep_ANY,
+				Nodes: []*storepb.ApprovalNode{
+					{
+						Type: storepb.ApprovalNode_ANY_IN_GROUP,
+						Payload: &storepb.ApprovalNode_UserList_{
+							UserList: &storepb.ApprovalNode_UserList{Users: []string{"users/101", "users/102"}},
+						},
+					},
+				},
+			},
+			user: &store.UserMessage{
+				ID:   1,
+				Role: api.Owner,
+			},
+			policy: &store.IAMPolicyMessage{},
+			want:   false,
+		},
+		{
+			step: &storepb.ApprovalStep{
+				Type: storepb.ApprovalStep_ANY,
+				Nodes: []*storepb.ApprovalNode{
+					{
+						Type:    storepb.ApprovalNode_ANY_IN_GROUP,
+						Payload: &storepb.ApprovalNode_UserGroup{UserGroup: "groups/dba-team"},
+					},
+				},
+			},
+			user: &store.UserMessage{
+				ID:   102,
+				Role: api.Developer,
+			},
+			userGroups: []string{"groups/qa-team", "groups/dba-team"},
+			policy:     &store.IAMPolicyMessage{},
+			want:       true,
+		},
+		{
+			step: &storepb.ApprovalStep{
+				Type: storepb.ApprovalStep_ANY,
+				Nodes: []*storepb.ApprovalNode{
+					{
+						Type:    storepb.ApprovalNode_ANY_IN_GROUP,
+						Payload: &storepb.ApprovalNode_UserGroup{UserGroup: "groups/dba-team"},
+					},
+				},
+			},
+			user: &store.UserMessage{
+				ID:   1,
+				Role: api.Owner,
+			},
+			userGroups: []string{"groups/qa-team"},
+			policy:     &store.IAMPolicyMessage{},
+			want:       false,
+		},
 	}
 
 	a := require.New(t)
 	for _, test := range tests {
-		got, err := isUserReviewer(test.step, test.user, test.policy)
+		got, err := isUserReviewer(test.step, test.user, test.userGroups, test.policy)
 		a.NoError(err)
 		a.Equal(test.want, got)
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/pkg/errors"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
@@ -30,17 +32,22 @@ import (
 // defaultWorkspaceResourceID is a placeholder for resource id in workspace level IAM policy.
 var defaultWorkspaceResourceID = 1
 
+// maxMaintenanceWindowDuration is the max duration of a maintenance window.
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
 // OrgPolicyService implements the workspace policy service.
 type OrgPolicyService struct {
 	v1pb.UnimplementedOrgPolicyServiceServer
 	store          *store.Store
+	profile        *config.Profile
 	licenseService enterpriseAPI.LicenseService
 }
 
 // NewOrgPolicyService creates a new OrgPolicyService.
-func NewOrgPolicyService(store *store.Store, licenseService enterpriseAPI.LicenseService) *OrgPolicyService {
+func NewOrgPolicyService(store *store.Store, profile *config.Profile, licenseService enterpriseAPI.LicenseService) *OrgPolicyService {
 	return &OrgPolicyService{
 		store:          store,
+		profile:        profile,
 		licenseService: licenseService,
 	}
 }
@@ -523,8 +530,66 @@ func validatePolicyPayload(policyType api.PolicyType, policy *v1pb.Policy) error
 			if _, err := common.ValidateMaskingExceptionCELExpr(exception.Condition.Expression); err != nil {
 				return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid masking exception expression: %v", err))
 			}
-			if !strings.HasPrefix(exception.Member, "user:") {
-				return status.Errorf(codes.InvalidArgument, "masking exception member must start with user:")
+			if !strings.HasPrefix(exception.Member, "user:") && !strings.HasPrefix(exception.Member, "group:") {
+				return status.Errorf(codes.InvalidArgument, "masking exception member must start with user: or group:")
+			}
+		}
+	case api.PolicyTypeRolloutConcurrency:
+		rolloutConcurrencyPolicy, ok := policy.Policy.(*v1pb.Policy_RolloutConcurrencyPolicy)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unmatched policy type %v and policy %v", policyType, policy.Policy)
+		}
+		if rolloutConcurrencyPolicy.RolloutConcurrencyPolicy == nil {
+			return status.Errorf(codes.InvalidArgument, "rollout concurrency policy must be set")
+		}
+		if rolloutConcurrencyPolicy.RolloutConcurrencyPolicy.MaxRunningTaskRuns < 0 {
+			return status.Errorf(codes.InvalidArgument, "max running task runs must not be negative")
+		}
+	case api.PolicyTypeMaintenanceWindow:
+		maintenanceWindowPolicy, ok := policy.Policy.(*v1pb.Policy_MaintenanceWindowPolicy)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unmatched policy type %v and policy %v", policyType, policy.Policy)
+		}
+		if maintenanceWindowPolicy.MaintenanceWindowPolicy == nil {
+			return status.Errorf(codes.InvalidArgument, "maintenance window policy must be set")
+		}
+		for _, window := range maintenanceWindowPolicy.MaintenanceWindowPolicy.Windows {
+			if _, err := common.ParseCron(window.Cron); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid maintenance window: %v", err)
+			}
+			if window.Duration == nil || window.Duration.AsDuration() < time.Minute {
+				return status.Errorf(codes.InvalidArgument, "maintenance window duration must be at least one minute")
+			}
+			if window.Duration.AsDuration() > maxMaintenanceWindowDuration {
+				return status.Errorf(codes.InvalidArgument, "maintenance window duration must not exceed %v", maxMaintenanceWindowDuration)
+			}
+			if _, err := time.LoadLocation(window.TimeZone); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid maintenance window time zone %q", window.TimeZone)
+			}
+		}
+	case api.PolicyTypeRowFilter:
+		rowFilterPolicy, ok := policy.Policy.(*v1pb.Policy_RowFilterPolicy)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unmatched policy type %v and policy %v", policyType, policy.Policy)
+		}
+		if rowFilterPolicy.RowFilterPolicy == nil {
+			return status.Errorf(codes.InvalidArgument, "row filter policy must be set")
+		}
+		for _, rule := range rowFilterPolicy.RowFilterPolicy.Rules {
+			if rule.Id == "" {
+				return status.Errorf(codes.InvalidArgument, "row filter rule must have ID set")
+			}
+			if rule.Condition == nil {
+				return status.Errorf(codes.InvalidArgument, "row filter rule must have condition set")
+			}
+			if _, err := common.ValidateRowFilterCELExpr(rule.Condition.Expression); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid row filter expression: %v", err)
+			}
+			if strings.TrimSpace(rule.Predicate) == "" {
+				return status.Errorf(codes.InvalidArgument, "row filter rule must have predicate set")
+			}
+			if err := common.ValidateRowFilterPredicate(rule.Predicate); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid row filter predicate: %v", err)
 			}
 		}
 	default:
@@ -576,6 +641,9 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(policy *v1pb.Policy) (st
 		if payload.Schedule != api.BackupPlanPolicyScheduleUnset && payload.Schedule != api.BackupPlanPolicyScheduleDaily && payload.Schedule != api.BackupPlanPolicyScheduleWeekly {
 			return "", status.Errorf(codes.InvalidArgument, "invalid backup plan policy schedule: %q", payload.Schedule)
 		}
+		if payload.StorageBackend != "" && !s.profile.IsBackupStorageBackendAvailable(payload.StorageBackend) {
+			return "", status.Errorf(codes.FailedPrecondition, "backup storage backend %s is not configured", payload.StorageBackend)
+		}
 		if err := s.licenseService.IsFeatureEnabled(api.FeatureBackupPolicy); err != nil {
 			if payload.Schedule != api.BackupPlanPolicyScheduleUnset {
 				return "", status.Errorf(codes.PermissionDenied, err.Error())
@@ -648,6 +716,30 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(policy *v1pb.Policy) (st
 			return "", errors.Wrap(err, "failed to marshal masking exception policy")
 		}
 		return string(payloadBytes), nil
+	case v1pb.PolicyType_ROLLOUT_CONCURRENCY:
+		payload := convertToStorePBRolloutConcurrencyPolicy(policy.GetRolloutConcurrencyPolicy())
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal rollout concurrency policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_MAINTENANCE_WINDOW:
+		payload := convertToStorePBMaintenanceWindowPolicy(policy.GetMaintenanceWindowPolicy())
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal maintenance window policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_ROW_FILTER:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureAccessControl); err != nil {
+			return "", status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		payload := convertToStorePBRowFilterPolicy(policy.GetRowFilterPolicy())
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal row filter policy")
+		}
+		return string(payloadBytes), nil
 	}
 
 	return "", status.Errorf(codes.InvalidArgument, "invalid policy %v", policy.Type)
@@ -757,6 +849,33 @@ func convertToPolicy(parentPath string, policyMessage *store.PolicyMessage) (*v1
 		policy.Policy = &v1pb.Policy_MaskingExceptionPolicy{
 			MaskingExceptionPolicy: payload,
 		}
+	case api.PolicyTypeRolloutConcurrency:
+		pType = v1pb.PolicyType_ROLLOUT_CONCURRENCY
+		rolloutConcurrencyPolicy := &storepb.RolloutConcurrencyPolicy{}
+		if err := protojson.Unmarshal([]byte(policyMessage.Payload), rolloutConcurrencyPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal rollout concurrency policy")
+		}
+		policy.Policy = &v1pb.Policy_RolloutConcurrencyPolicy{
+			RolloutConcurrencyPolicy: convertToV1PBRolloutConcurrencyPolicy(rolloutConcurrencyPolicy),
+		}
+	case api.PolicyTypeMaintenanceWindow:
+		pType = v1pb.PolicyType_MAINTENANCE_WINDOW
+		maintenanceWindowPolicy := &storepb.MaintenanceWindowPolicy{}
+		if err := protojson.Unmarshal([]byte(policyMessage.Payload), maintenanceWindowPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal maintenance window policy")
+		}
+		policy.Policy = &v1pb.Policy_MaintenanceWindowPolicy{
+			MaintenanceWindowPolicy: convertToV1PBMaintenanceWindowPolicy(maintenanceWindowPolicy),
+		}
+	case api.PolicyTypeRowFilter:
+		pType = v1pb.PolicyType_ROW_FILTER
+		rowFilterPolicy := &storepb.RowFilterPolicy{}
+		if err := protojson.Unmarshal([]byte(policyMessage.Payload), rowFilterPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal row filter policy")
+		}
+		policy.Policy = &v1pb.Policy_RowFilterPolicy{
+			RowFilterPolicy: convertToV1PBRowFilterPolicy(rowFilterPolicy),
+		}
 	}
 
 	policy.Type = pType
@@ -995,6 +1114,7 @@ func convertToV1PBBackupPlanPolicy(payloadStr string) (*v1pb.Policy_BackupPlanPo
 		BackupPlanPolicy: &v1pb.BackupPlanPolicy{
 			Schedule:          schedule,
 			RetentionDuration: &durationpb.Duration{Seconds: int64(payload.RetentionPeriodTs)},
+			StorageBackend:    convertToV1PBBackupStorageBackend(payload.StorageBackend),
 		},
 	}, nil
 }
@@ -1016,10 +1136,15 @@ func convertToBackupPlanPolicyPayload(policy *v1pb.BackupPlanPolicy) (*api.Backu
 	if policy.RetentionDuration != nil {
 		retentionPeriodTs = int(policy.RetentionDuration.Seconds)
 	}
+	storageBackend, err := convertToAPIBackupStorageBackend(policy.StorageBackend)
+	if err != nil {
+		return nil, err
+	}
 
 	return &api.BackupPlanPolicy{
 		Schedule:          schedule,
 		RetentionPeriodTs: retentionPeriodTs,
+		StorageBackend:    storageBackend,
 	}, nil
 }
 
@@ -1172,6 +1297,74 @@ func convertToDisableCopyDataPolicyPayload(policy *v1pb.DisableCopyDataPolicy) (
 	}, nil
 }
 
+func convertToV1PBRolloutConcurrencyPolicy(policy *storepb.RolloutConcurrencyPolicy) *v1pb.RolloutConcurrencyPolicy {
+	return &v1pb.RolloutConcurrencyPolicy{
+		MaxRunningTaskRuns: policy.MaxRunningTaskRuns,
+	}
+}
+
+func convertToStorePBRolloutConcurrencyPolicy(policy *v1pb.RolloutConcurrencyPolicy) *storepb.RolloutConcurrencyPolicy {
+	return &storepb.RolloutConcurrencyPolicy{
+		MaxRunningTaskRuns: policy.MaxRunningTaskRuns,
+	}
+}
+
+func convertToV1PBMaintenanceWindowPolicy(policy *storepb.MaintenanceWindowPolicy) *v1pb.MaintenanceWindowPolicy {
+	var windows []*v1pb.MaintenanceWindowPolicy_Window
+	for _, window := range policy.Windows {
+		windows = append(windows, &v1pb.MaintenanceWindowPolicy_Window{
+			Cron:     window.Cron,
+			Duration: window.Duration,
+			TimeZone: window.TimeZone,
+		})
+	}
+	return &v1pb.MaintenanceWindowPolicy{
+		Windows: windows,
+	}
+}
+
+func convertToStorePBMaintenanceWindowPolicy(policy *v1pb.MaintenanceWindowPolicy) *storepb.MaintenanceWindowPolicy {
+	var windows []*storepb.MaintenanceWindowPolicy_Window
+	for _, window := range policy.Windows {
+		windows = append(windows, &storepb.MaintenanceWindowPolicy_Window{
+			Cron:     window.Cron,
+			Duration: window.Duration,
+			TimeZone: window.TimeZone,
+		})
+	}
+	return &storepb.MaintenanceWindowPolicy{
+		Windows: windows,
+	}
+}
+
+func convertToV1PBRowFilterPolicy(policy *storepb.RowFilterPolicy) *v1pb.RowFilterPolicy {
+	var rules []*v1pb.RowFilterPolicy_RowFilterRule
+	for _, rule := range policy.Rules {
+		rules = append(rules, &v1pb.RowFilterPolicy_RowFilterRule{
+			Id:        rule.Id,
+			Condition: rule.Condition,
+			Predicate: rule.Predicate,
+		})
+	}
+	return &v1pb.RowFilterPolicy{
+		Rules: rules,
+	}
+}
+
+func convertToStorePBRowFilterPolicy(policy *v1pb.RowFilterPolicy) *storepb.RowFilterPolicy {
+	var rules []*storepb.RowFilterPolicy_RowFilterRule
+	for _, rule := range policy.Rules {
+		rules = append(rules, &storepb.RowFilterPolicy_RowFilterRule{
+			Id:        rule.Id,
+			Condition: rule.Condition,
+			Predicate: rule.Predicate,
+		})
+	}
+	return &storepb.RowFilterPolicy{
+		Rules: rules,
+	}
+}
+
 func convertToStorePBMskingRulePolicy(policy *v1pb.MaskingRulePolicy) (*storepb.MaskingRulePolicy, error) {
 	var rules []*storepb.MaskingRulePolicy_MaskingRule
 	for _, rule := range policy.Rules {
@@ -1183,7 +1376,8 @@ func convertToStorePBMskingRulePolicy(policy *v1pb.MaskingRulePolicy) (*storepb.
 				Description: rule.Condition.Description,
 				Location:    rule.Condition.Location,
 			},
-			MaskingLevel: convertToStorePBMaskingLevel(rule.MaskingLevel),
+			MaskingLevel:       convertToStorePBMaskingLevel(rule.MaskingLevel),
+			MaskingAlgorithmId: rule.MaskingAlgorithmId,
 		})
 	}
 
@@ -1203,7 +1397,8 @@ func convertToV1PBMaskingRulePolicy(policy *storepb.MaskingRulePolicy) (*v1pb.Ma
 				Description: rule.Condition.Description,
 				Location:    rule.Condition.Location,
 			},
-			MaskingLevel: convertToV1PBMaskingLevel(rule.MaskingLevel),
+			MaskingLevel:       convertToV1PBMaskingLevel(rule.MaskingLevel),
+			MaskingAlgorithmId: rule.MaskingAlgorithmId,
 		})
 	}
 
@@ -1233,13 +1428,21 @@ func convertToStorePBMaskingExceptionPolicyPayload(policy *v1pb.MaskingException
 	}, nil
 }
 
+// convertToV1PBMaskingExceptionMember converts the stored member, which is either the user email or group:{group}.
+func convertToV1PBMaskingExceptionMember(member string) string {
+	if strings.HasPrefix(member, "group:") {
+		return member
+	}
+	return fmt.Sprintf("user:%s", member)
+}
+
 func convertToV1PBMaskingExceptionPolicyPayload(policy *storepb.MaskingExceptionPolicy) (*v1pb.MaskingExceptionPolicy, error) {
 	var exceptions []*v1pb.MaskingExceptionPolicy_MaskingException
 	for _, exception := range policy.MaskingExceptions {
 		exceptions = append(exceptions, &v1pb.MaskingExceptionPolicy_MaskingException{
 			Action:       convertToV1PBAction(exception.Action),
 			MaskingLevel: convertToV1PBMaskingLevel(exception.MaskingLevel),
-			Member:       fmt.Sprintf("user:%s", exception.Member),
+			Member:       convertToV1PBMaskingExceptionMember(exception.Member),
 			Condition: &expr.Expr{
 				Title:       exception.Condition.Title,
 				Expression:  exception.Condition.Expression,
@@ -1308,6 +1511,12 @@ func convertPolicyType(pType string) (api.PolicyType, error) {
 		return api.PolicyTypeSlowQuery, nil
 	case v1pb.PolicyType_DISABLE_COPY_DATA.String():
 		return api.PolicyTypeDisableCopyData, nil
+	case v1pb.PolicyType_ROLLOUT_CONCURRENCY.String():
+		return api.PolicyTypeRolloutConcurrency, nil
+	case v1pb.PolicyType_MAINTENANCE_WINDOW.String():
+		return api.PolicyTypeMaintenanceWindow, nil
+	case v1pb.PolicyType_ROW_FILTER.String():
+		return api.PolicyTypeRowFilter, nil
 	}
 	return policyType, errors.Errorf("invalid policy type %v", pType)
 }
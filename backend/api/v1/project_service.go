@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/yaml.v3"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -27,6 +28,7 @@ import (
 	vcsPlugin "github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	webhookPlugin "github.com/bytebase/bytebase/backend/plugin/webhook"
@@ -39,6 +41,8 @@ import (
 const (
 	// sqlReviewInVCSPRTitle is the pull request title for SQL review CI setup.
 	sqlReviewInVCSPRTitle = "chore: setup SQL review CI for Bytebase"
+	// webhookSecretLength is the length of the generated webhook secrets.
+	webhookSecretLength = 32
 )
 
 // ProjectService implements the project service.
@@ -773,8 +777,16 @@ func (s *ProjectService) AddWebhook(ctx context.Context, request *v1pb.AddWebhoo
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	if create.Secret == "" {
+		secret, err := common.RandomString(webhookSecretLength)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+		}
+		create.Secret = secret
+	}
 
-	if _, err := s.store.CreateProjectWebhookV2(ctx, ctx.Value(common.PrincipalIDContextKey).(int), project.UID, project.ResourceID, create); err != nil {
+	webhook, err := s.store.CreateProjectWebhookV2(ctx, ctx.Value(common.PrincipalIDContextKey).(int), project.UID, project.ResourceID, create)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
@@ -784,7 +796,15 @@ func (s *ProjectService) AddWebhook(ctx context.Context, request *v1pb.AddWebhoo
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	return convertToProject(project), nil
+	v1Project := convertToProject(project)
+	// The secret is only returned once on creation so that the caller can configure the receiver.
+	webhookName := fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, project.ResourceID, common.WebhookIDPrefix, webhook.ID)
+	for _, v1Webhook := range v1Project.Webhooks {
+		if v1Webhook.Name == webhookName {
+			v1Webhook.Secret = webhook.Secret
+		}
+	}
+	return v1Project, nil
 }
 
 // UpdateWebhook updates a webhook.
@@ -840,6 +860,18 @@ func (s *ProjectService) UpdateWebhook(ctx context.Context, request *v1pb.Update
 				return nil, status.Errorf(codes.InvalidArgument, "notification types should not be empty")
 			}
 			update.ActivityList = types
+		case "secret":
+			// The secret is never returned, so an empty secret keeps the existing one.
+			if request.Webhook.Secret != "" {
+				update.Secret = &request.Webhook.Secret
+			}
+		case "event_filter":
+			if request.Webhook.EventFilter != "" {
+				if _, err := common.ValidateWebhookEventFilterCELExpr(request.Webhook.EventFilter); err != nil {
+					return nil, err
+				}
+			}
+			update.EventFilter = &request.Webhook.EventFilter
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid field %q", path)
 		}
@@ -962,6 +994,144 @@ func (s *ProjectService) TestWebhook(ctx context.Context, request *v1pb.TestWebh
 	return resp, nil
 }
 
+// ListWebhookDeliveries lists the deliveries of a webhook.
+func (s *ProjectService) ListWebhookDeliveries(ctx context.Context, request *v1pb.ListWebhookDeliveriesRequest) (*v1pb.ListWebhookDeliveriesResponse, error) {
+	project, webhook, err := s.getProjectWebhook(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pageToken storepb.PageToken
+	if request.PageToken != "" {
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.Limit != request.PageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "request page size does not match the page token")
+		}
+	} else {
+		pageToken.Limit = request.PageSize
+	}
+	limit := int(pageToken.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	limitPlusOne := limit + 1
+	offset := int(pageToken.Offset)
+
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, &store.FindWebhookDeliveryMessage{
+		WebhookID: &webhook.ID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	response := &v1pb.ListWebhookDeliveriesResponse{}
+	if len(deliveries) == limitPlusOne {
+		deliveries = deliveries[:limit]
+		if response.NextPageToken, err = getPageToken(limit, offset+limit); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, error: %v", err)
+		}
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertToWebhookDelivery(project, delivery))
+	}
+	return response, nil
+}
+
+// RedeliverWebhook delivers the payload of a delivery again as a new delivery.
+func (s *ProjectService) RedeliverWebhook(ctx context.Context, request *v1pb.RedeliverWebhookRequest) (*v1pb.WebhookDelivery, error) {
+	projectID, webhookID, deliveryID, err := common.GetProjectIDWebhookIDDeliveryID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, webhook, err := s.getProjectWebhook(ctx, fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, projectID, common.WebhookIDPrefix, webhookID))
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := s.store.GetWebhookDelivery(ctx, &store.FindWebhookDeliveryMessage{
+		ID:        &deliveryID,
+		WebhookID: &webhook.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery %q not found", request.Name)
+	}
+
+	deliveries, err := s.store.CreateWebhookDeliveries(ctx, &store.WebhookDeliveryMessage{
+		WebhookID: webhook.ID,
+		Payload:   delivery.Payload,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create webhook delivery: %v", err)
+	}
+	return convertToWebhookDelivery(project, deliveries[0]), nil
+}
+
+// getProjectWebhook gets the project and the webhook by the webhook name.
+func (s *ProjectService) getProjectWebhook(ctx context.Context, name string) (*store.ProjectMessage, *store.ProjectWebhookMessage, error) {
+	projectID, webhookID, err := common.GetProjectIDWebhookID(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	webhookIDInt, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid webhook id %q", webhookID)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	if project.Deleted {
+		return nil, nil, status.Errorf(codes.NotFound, "project %q has been deleted", projectID)
+	}
+	webhook, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID: &project.UID,
+		ID:        &webhookIDInt,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if webhook == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "webhook %q not found", name)
+	}
+	return project, webhook, nil
+}
+
+func convertToWebhookDelivery(project *store.ProjectMessage, delivery *store.WebhookDeliveryMessage) *v1pb.WebhookDelivery {
+	result := &v1pb.WebhookDelivery{
+		Name:       fmt.Sprintf("%s%s/%s%d/%s%d", common.ProjectNamePrefix, project.ResourceID, common.WebhookIDPrefix, delivery.WebhookID, common.WebhookDeliveryIDPrefix, delivery.ID),
+		Status:     v1pb.WebhookDelivery_STATUS_UNSPECIFIED,
+		Payload:    delivery.Payload,
+		Attempt:    int32(delivery.Attempt),
+		LastError:  delivery.LastError,
+		CreateTime: timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+		UpdateTime: timestamppb.New(time.Unix(delivery.UpdatedTs, 0)),
+	}
+	switch delivery.Status {
+	case store.WebhookDeliveryPending:
+		result.Status = v1pb.WebhookDelivery_PENDING
+		result.NextAttemptTime = timestamppb.New(time.Unix(delivery.NextAttemptTs, 0))
+	case store.WebhookDeliveryDone:
+		result.Status = v1pb.WebhookDelivery_DONE
+	case store.WebhookDeliveryDead:
+		result.Status = v1pb.WebhookDelivery_DEAD
+	}
+	return result
+}
+
 func (s *ProjectService) findProjectRepository(ctx context.Context, projectName string) (*store.RepositoryMessage, error) {
 	project, err := s.getProjectMessage(ctx, projectName)
 	if err != nil {
@@ -1188,6 +1358,10 @@ func (s *ProjectService) setupVCSSQLReviewCI(ctx context.Context, repository *st
 		if err := s.setupVCSSQLReviewCIForAzureDevOps(ctx, repository, vcs, branch, sqlReviewEndpoint); err != nil {
 			return nil, err
 		}
+	case vcsPlugin.Gitea:
+		if err := s.setupVCSSQLReviewCIForGitea(ctx, repository, vcs, branch, sqlReviewEndpoint); err != nil {
+			return nil, err
+		}
 	}
 
 	return vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).CreatePullRequest(
@@ -1338,6 +1512,81 @@ func (s *ProjectService) setupVCSSQLReviewCIForGitHub(
 	)
 }
 
+// setupVCSSQLReviewCIForGitea will create the pull request in Gitea to setup SQL review action.
+func (s *ProjectService) setupVCSSQLReviewCIForGitea(
+	ctx context.Context,
+	repository *store.RepositoryMessage,
+	vcs *store.ExternalVersionControlMessage,
+	branch *vcsPlugin.BranchInfo,
+	sqlReviewEndpoint string,
+) error {
+	sqlReviewConfig := gitea.SetupSQLReviewCI(sqlReviewEndpoint)
+	fileLastCommitID := ""
+	fileSHA := ""
+
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to find workspace setting with error: %v", err.Error())
+	}
+	if setting.ExternalUrl == "" {
+		return status.Errorf(codes.FailedPrecondition, "external url is required")
+	}
+
+	fileMeta, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).ReadFileMeta(
+		ctx,
+		common.OauthContext{
+			ClientID:     vcs.ApplicationID,
+			ClientSecret: vcs.Secret,
+			AccessToken:  repository.AccessToken,
+			RefreshToken: repository.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, s.store, repository.WebURL),
+			RedirectURL:  fmt.Sprintf("%s/oauth/callback", setting.ExternalUrl),
+		},
+		vcs.InstanceURL,
+		repository.ExternalID,
+		gitea.SQLReviewActionFilePath,
+		vcsPlugin.RefInfo{
+			RefType: vcsPlugin.RefTypeBranch,
+			RefName: branch.Name,
+		},
+	)
+	if err != nil {
+		slog.Debug(
+			"Failed to get file meta",
+			slog.String("file", gitea.SQLReviewActionFilePath),
+			slog.String("last_commit", branch.LastCommitID),
+			slog.Int("code", common.ErrorCode(err).Int()),
+			log.BBError(err),
+		)
+	} else if fileMeta != nil {
+		fileLastCommitID = fileMeta.LastCommitID
+		fileSHA = fileMeta.SHA
+	}
+
+	// The file is updated with the blob SHA if it exists, otherwise created.
+	return vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).OverwriteFile(
+		ctx,
+		common.OauthContext{
+			ClientID:     vcs.ApplicationID,
+			ClientSecret: vcs.Secret,
+			AccessToken:  repository.AccessToken,
+			RefreshToken: repository.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, s.store, repository.WebURL),
+			RedirectURL:  fmt.Sprintf("%s/oauth/callback", setting.ExternalUrl),
+		},
+		vcs.InstanceURL,
+		repository.ExternalID,
+		gitea.SQLReviewActionFilePath,
+		vcsPlugin.FileCommitCreate{
+			Branch:        branch.Name,
+			CommitMessage: sqlReviewInVCSPRTitle,
+			Content:       sqlReviewConfig,
+			LastCommitID:  fileLastCommitID,
+			SHA:           fileSHA,
+		},
+	)
+}
+
 // setupVCSSQLReviewCIForGitLab will create or update SQL review related files in GitLab to setup SQL review CI.
 func (s *ProjectService) setupVCSSQLReviewCIForGitLab(
 	ctx context.Context,
@@ -2304,11 +2553,18 @@ func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectW
 	if err != nil {
 		return nil, err
 	}
+	if webhook.EventFilter != "" {
+		if _, err := common.ValidateWebhookEventFilterCELExpr(webhook.EventFilter); err != nil {
+			return nil, common.Errorf(common.Invalid, "invalid event filter %q: %s", webhook.EventFilter, status.Convert(err).Message())
+		}
+	}
 	return &store.ProjectWebhookMessage{
 		Type:         tp,
 		URL:          webhook.Url,
 		Title:        webhook.Title,
 		ActivityList: activityTypes,
+		Secret:       webhook.Secret,
+		EventFilter:  webhook.EventFilter,
 	}, nil
 }
 
@@ -2543,6 +2799,9 @@ func convertToIamPolicy(iamPolicy *store.IAMPolicyMessage) (*v1pb.IamPolicy, err
 		for _, member := range binding.Members {
 			members = append(members, fmt.Sprintf("user:%s", member.Email))
 		}
+		for _, group := range binding.Groups {
+			members = append(members, fmt.Sprintf("group:%s", group.ResourceID))
+		}
 		v1pbBinding := &v1pb.Binding{
 			Role:      convertToProjectRole(binding.Role),
 			Members:   members,
@@ -2575,11 +2834,23 @@ func (s *ProjectService) convertToIAMPolicyMessage(ctx context.Context, iamPolic
 	var bindings []*store.PolicyBinding
 	for _, binding := range iamPolicy.Bindings {
 		var users []*store.UserMessage
+		var groups []*store.UserGroupMessage
 		role, err := convertProjectRole(binding.Role)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, err.Error())
 		}
 		for _, member := range binding.Members {
+			if groupID, ok := strings.CutPrefix(member, "group:"); ok {
+				group, err := s.store.GetUserGroup(ctx, &store.FindUserGroupMessage{ResourceID: &groupID})
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, err.Error())
+				}
+				if group == nil {
+					return nil, status.Errorf(codes.NotFound, "user group %q not found", member)
+				}
+				groups = append(groups, group)
+				continue
+			}
 			email := strings.TrimPrefix(member, "user:")
 			user, err := s.store.GetUser(ctx, &store.FindUserMessage{
 				Email:       &email,
@@ -2597,6 +2868,7 @@ func (s *ProjectService) convertToIAMPolicyMessage(ctx context.Context, iamPolic
 		bindings = append(bindings, &store.PolicyBinding{
 			Role:      role,
 			Members:   users,
+			Groups:    groups,
 			Condition: binding.Condition,
 		})
 	}
@@ -2658,6 +2930,7 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 			Title:             webhook.Title,
 			Url:               webhook.URL,
 			NotificationTypes: convertNotificationTypeStrings(webhook.ActivityList),
+			EventFilter:       webhook.EventFilter,
 		})
 	}
 
@@ -2940,7 +3213,8 @@ func validateBindings(bindings []*v1pb.Binding, roles []*v1pb.Role) error {
 
 func validateMember(member string) error {
 	userIdentifierMap := map[string]bool{
-		"user:": true,
+		"user:":  true,
+		"group:": true,
 	}
 	for prefix := range userIdentifierMap {
 		if strings.HasPrefix(member, prefix) && len(member[len(prefix):]) > 0 {
@@ -2952,7 +3226,7 @@ func validateMember(member string) error {
 
 func isProjectMember(policy *store.IAMPolicyMessage, userID int) bool {
 	for _, binding := range policy.Bindings {
-		for _, member := range binding.Members {
+		for _, member := range binding.GetAllMembers() {
 			if member.ID == userID {
 				return true
 			}
@@ -3070,6 +3344,23 @@ func createVCSWebhook(ctx context.Context, vcsType vcsPlugin.Type, webhookEndpoi
 		if err != nil {
 			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
+	case vcsPlugin.Gitea:
+		webhookPost := gitea.WebhookCreateOrUpdate{
+			Type: "gitea",
+			Config: gitea.WebhookConfig{
+				URL:         fmt.Sprintf("%s/hook/gitea/%s", gitopsWebhookURL, webhookEndpointID),
+				ContentType: "json",
+				Secret:      secretToken,
+			},
+			Events: []string{"push"},
+			Active: true,
+		}
+		webhookCreatePayload, err = json.Marshal(webhookPost)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
+	case vcsPlugin.Git:
+		// The post-receive hook on the Git server calls /hook/git/{webhookEndpointID} by itself, there is nothing to create.
 	}
 	webhookID, err := vcsPlugin.Get(vcsType, vcsPlugin.ProviderConfig{}).CreateWebhook(
 		ctx,
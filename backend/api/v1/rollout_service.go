@@ -17,6 +17,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/cluster"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
@@ -717,6 +718,9 @@ func (s *RolloutService) BatchCancelTaskRuns(ctx context.Context, request *v1pb.
 		if taskRun.Status == api.TaskRunRunning {
 			if cancelFunc, ok := s.stateCfg.RunningTaskRunsCancelFunc.Load(taskRun.ID); ok {
 				cancelFunc.(context.CancelFunc)()
+			} else if err := cluster.PublishTaskRunCancel(ctx, s.store, taskRun.ID); err != nil {
+				// The task run may be executing on another replica.
+				return nil, status.Errorf(codes.Internal, "failed to cancel task run %v, error: %v", taskRun.Name, err)
 			}
 		}
 	}
@@ -837,7 +841,7 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 			// Sheet
 			if err := func() error {
 				switch task.Type {
-				case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync, api.TaskDatabaseDataUpdate:
+				case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync, api.TaskDatabaseSchemaUpdatePGOnlineSync, api.TaskDatabaseDataUpdate:
 					var taskPayload struct {
 						SpecID  string `json:"specId"`
 						SheetID int    `json:"sheetId"`
@@ -1034,6 +1038,9 @@ func validateSteps(steps []*v1pb.Plan_Step) error {
 				} else {
 					return errors.Errorf("unknown target %q", config.Target)
 				}
+				if err := validateBatchConfig(config); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -1046,6 +1053,23 @@ func validateSteps(steps []*v1pb.Plan_Step) error {
 	return nil
 }
 
+func validateBatchConfig(config *v1pb.Plan_ChangeDatabaseConfig) error {
+	c := config.BatchConfig
+	if c == nil {
+		return nil
+	}
+	if config.Type != v1pb.Plan_ChangeDatabaseConfig_DATA {
+		return errors.Errorf("batch config is only supported for the DML change")
+	}
+	if config.RollbackEnabled {
+		return errors.Errorf("rollback is not supported for the batched DML change")
+	}
+	if c.BatchSize < 0 || c.MaxRowsPerSecond < 0 || c.MaxReplicationLagSeconds < 0 {
+		return errors.Errorf("batch config cannot be negative")
+	}
+	return nil
+}
+
 // GetPipelineCreate gets a pipeline create message from a plan.
 func GetPipelineCreate(ctx context.Context, s *store.Store, licenseService enterpriseAPI.LicenseService, dbFactory *dbfactory.DBFactory, steps []*storepb.PlanConfig_Step, project *store.ProjectMessage) (*store.PipelineMessage, error) {
 	pipelineCreate := &store.PipelineMessage{
@@ -1227,7 +1251,7 @@ func canUserRunStageTasks(ctx context.Context, s *store.Store, user *store.UserM
 			if binding.Role != api.Owner {
 				continue
 			}
-			for _, member := range binding.Members {
+			for _, member := range binding.GetAllMembers() {
 				if member.ID == user.ID {
 					return true, nil
 				}
@@ -1261,7 +1285,7 @@ func canUserCancelStageTaskRun(ctx context.Context, s *store.Store, user *store.
 			if binding.Role != api.Owner {
 				continue
 			}
-			for _, member := range binding.Members {
+			for _, member := range binding.GetAllMembers() {
 				if member.ID == user.ID {
 					return true, nil
 				}
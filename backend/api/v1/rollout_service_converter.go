@@ -125,10 +125,22 @@ func convertToPlanSpecChangeDatabaseConfig(config *storepb.PlanConfig_Spec_Chang
 			SchemaVersion:   c.SchemaVersion,
 			RollbackEnabled: c.RollbackEnabled,
 			RollbackDetail:  convertToPlanSpecChangeDatabaseConfigRollbackDetail(c.RollbackDetail),
+			BatchConfig:     convertToPlanSpecChangeDatabaseConfigBatchConfig(c.BatchConfig),
 		},
 	}
 }
 
+func convertToPlanSpecChangeDatabaseConfigBatchConfig(c *storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig) *v1pb.Plan_ChangeDatabaseConfig_BatchConfig {
+	if c == nil {
+		return nil
+	}
+	return &v1pb.Plan_ChangeDatabaseConfig_BatchConfig{
+		BatchSize:                c.BatchSize,
+		MaxRowsPerSecond:         c.MaxRowsPerSecond,
+		MaxReplicationLagSeconds: c.MaxReplicationLagSeconds,
+	}
+}
+
 func convertToPlanSpecChangeDatabaseConfigRollbackDetail(d *storepb.PlanConfig_ChangeDatabaseConfig_RollbackDetail) *v1pb.Plan_ChangeDatabaseConfig_RollbackDetail {
 	if d == nil {
 		return nil
@@ -155,6 +167,8 @@ func convertToPlanSpecChangeDatabaseConfigType(t storepb.PlanConfig_ChangeDataba
 		return v1pb.Plan_ChangeDatabaseConfig_BRANCH
 	case storepb.PlanConfig_ChangeDatabaseConfig_DATA:
 		return v1pb.Plan_ChangeDatabaseConfig_DATA
+	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_PG_ONLINE:
+		return v1pb.Plan_ChangeDatabaseConfig_MIGRATE_PG_ONLINE
 	default:
 		return v1pb.Plan_ChangeDatabaseConfig_TYPE_UNSPECIFIED
 	}
@@ -252,10 +266,22 @@ func convertPlanSpecChangeDatabaseConfig(config *v1pb.Plan_Spec_ChangeDatabaseCo
 			Type:            storepb.PlanConfig_ChangeDatabaseConfig_Type(c.Type),
 			SchemaVersion:   c.SchemaVersion,
 			RollbackEnabled: c.RollbackEnabled,
+			BatchConfig:     convertPlanSpecChangeDatabaseConfigBatchConfig(c.BatchConfig),
 		},
 	}
 }
 
+func convertPlanSpecChangeDatabaseConfigBatchConfig(c *v1pb.Plan_ChangeDatabaseConfig_BatchConfig) *storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig {
+	if c == nil {
+		return nil
+	}
+	return &storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig{
+		BatchSize:                c.BatchSize,
+		MaxRowsPerSecond:         c.MaxRowsPerSecond,
+		MaxReplicationLagSeconds: c.MaxReplicationLagSeconds,
+	}
+}
+
 func convertPlanSpecRestoreDatabaseConfig(config *v1pb.Plan_Spec_RestoreDatabaseConfig) *storepb.PlanConfig_Spec_RestoreDatabaseConfig {
 	c := config.RestoreDatabaseConfig
 	storeConfig := &storepb.PlanConfig_Spec_RestoreDatabaseConfig{
@@ -355,6 +381,8 @@ func convertToPlanCheckRunType(t store.PlanCheckRunType) v1pb.PlanCheckRun_Type
 		return v1pb.PlanCheckRun_DATABASE_CONNECT
 	case store.PlanCheckDatabaseGhostSync:
 		return v1pb.PlanCheckRun_DATABASE_GHOST_SYNC
+	case store.PlanCheckDatabasePGOnlineSync:
+		return v1pb.PlanCheckRun_DATABASE_PG_ONLINE_SYNC
 	case store.PlanCheckDatabasePITRMySQL:
 		return v1pb.PlanCheckRun_DATABASE_PITR_MYSQL
 	}
@@ -468,6 +496,30 @@ func convertToTaskRun(taskRun *store.TaskRunMessage) *v1pb.TaskRun {
 		Detail:        taskRun.ResultProto.Detail,
 		ChangeHistory: taskRun.ResultProto.ChangeHistory,
 		SchemaVersion: taskRun.ResultProto.Version,
+		SchedulerInfo: convertToTaskRunSchedulerInfo(taskRun.SchedulerInfo),
+	}
+}
+
+func convertToTaskRunSchedulerInfo(info *storepb.SchedulerInfo) *v1pb.TaskRun_SchedulerInfo {
+	if info.GetWaitingCause() == nil {
+		return nil
+	}
+	waitingCause := &v1pb.TaskRun_SchedulerInfo_WaitingCause{}
+	switch cause := info.WaitingCause.Cause.(type) {
+	case *storepb.SchedulerInfo_WaitingCause_ConcurrencyLimit:
+		waitingCause.Cause = &v1pb.TaskRun_SchedulerInfo_WaitingCause_ConcurrencyLimit{
+			ConcurrencyLimit: cause.ConcurrencyLimit,
+		}
+	case *storepb.SchedulerInfo_WaitingCause_MaintenanceWindow_:
+		waitingCause.Cause = &v1pb.TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow_{
+			MaintenanceWindow: &v1pb.TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow{
+				NextWindowTime: cause.MaintenanceWindow.GetNextWindowTime(),
+			},
+		}
+	}
+	return &v1pb.TaskRun_SchedulerInfo{
+		ReportTime:   info.ReportTime,
+		WaitingCause: waitingCause,
 	}
 }
 
@@ -527,9 +579,9 @@ func convertToTask(ctx context.Context, s *store.Store, project *store.ProjectMe
 		return convertToTaskFromDatabaseCreate(ctx, s, project, task)
 	case api.TaskDatabaseSchemaBaseline:
 		return convertToTaskFromSchemaBaseline(ctx, s, project, task)
-	case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync:
+	case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync, api.TaskDatabaseSchemaUpdatePGOnlineSync:
 		return convertToTaskFromSchemaUpdate(ctx, s, project, task)
-	case api.TaskDatabaseSchemaUpdateGhostCutover:
+	case api.TaskDatabaseSchemaUpdateGhostCutover, api.TaskDatabaseSchemaUpdatePGOnlineCutover:
 		return convertToTaskFromSchemaUpdateGhostCutover(ctx, s, project, task)
 	case api.TaskDatabaseDataUpdate:
 		return convertToTaskFromDataUpdate(ctx, s, project, task)
@@ -982,6 +1034,10 @@ func convertToTaskType(taskType api.TaskType) v1pb.Task_Type {
 		return v1pb.Task_DATABASE_SCHEMA_UPDATE_GHOST_SYNC
 	case api.TaskDatabaseSchemaUpdateGhostCutover:
 		return v1pb.Task_DATABASE_SCHEMA_UPDATE_GHOST_CUTOVER
+	case api.TaskDatabaseSchemaUpdatePGOnlineSync:
+		return v1pb.Task_DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC
+	case api.TaskDatabaseSchemaUpdatePGOnlineCutover:
+		return v1pb.Task_DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER
 	case api.TaskDatabaseDataUpdate:
 		return v1pb.Task_DATABASE_DATA_UPDATE
 	case api.TaskDatabaseBackup:
@@ -7,6 +7,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -42,6 +43,9 @@ func getPlanCheckRunsFromSpec(ctx context.Context, s *store.Store, plan *store.P
 		}
 
 	case *storepb.PlanConfig_Spec_RestoreDatabaseConfig:
+		if err := checkRestoreDatabaseConfigCapability(ctx, s, config.RestoreDatabaseConfig); err != nil {
+			return nil, err
+		}
 		var planCheckRuns []*store.PlanCheckRunMessage
 		// mysql PITR check
 		if _, ok := config.RestoreDatabaseConfig.Source.(*storepb.PlanConfig_RestoreDatabaseConfig_PointInTime); ok {
@@ -125,6 +129,35 @@ func getPlanCheckRunsFromSpec(ctx context.Context, s *store.Store, plan *store.P
 	return nil, nil
 }
 
+// checkRestoreDatabaseConfigCapability returns an error if restoring from a backup is not supported by the engine of the restore target.
+func checkRestoreDatabaseConfigCapability(ctx context.Context, s *store.Store, config *storepb.PlanConfig_RestoreDatabaseConfig) error {
+	if _, ok := config.Source.(*storepb.PlanConfig_RestoreDatabaseConfig_Backup); !ok {
+		return nil
+	}
+	var instanceID string
+	if config.CreateDatabaseConfig != nil {
+		id, err := common.GetInstanceID(config.CreateDatabaseConfig.Target)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get instance id from %q", config.CreateDatabaseConfig.Target)
+		}
+		instanceID = id
+	} else {
+		id, _, err := common.GetInstanceDatabaseID(config.Target)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get instance database id from target %q", config.Target)
+		}
+		instanceID = id
+	}
+	instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get instance %q", instanceID)
+	}
+	if instance == nil {
+		return errors.Errorf("instance %q not found", instanceID)
+	}
+	return db.CheckCapability(instance.Engine, db.CapabilityRestore)
+}
+
 func getPlanCheckRunsFromChangeDatabaseConfigDatabaseGroupTarget(ctx context.Context, s *store.Store, plan *store.PlanMessage, config *storepb.PlanConfig_ChangeDatabaseConfig) ([]*store.PlanCheckRunMessage, error) {
 	switch config.Type {
 	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE:
@@ -223,6 +256,7 @@ func getPlanCheckRunsFromChangeDatabaseConfigDeploymentConfigTarget(ctx context.
 	case storepb.PlanConfig_ChangeDatabaseConfig_BASELINE:
 	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE:
 	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_GHOST:
+	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_PG_ONLINE:
 	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_SDL:
 	case storepb.PlanConfig_ChangeDatabaseConfig_DATA:
 	default:
@@ -370,6 +404,22 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 			},
 		})
 	}
+	if databaseGroupUID == nil && config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_PG_ONLINE {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			CreatorUID: api.SystemBotID,
+			UpdaterUID: api.SystemBotID,
+			PlanUID:    plan.UID,
+			Status:     store.PlanCheckRunStatusRunning,
+			Type:       store.PlanCheckDatabasePGOnlineSync,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:           int32(sheetUID),
+				ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+				InstanceUid:        int32(instance.UID),
+				DatabaseName:       database.DatabaseName,
+				DatabaseGroupUid:   databaseGroupUID,
+			},
+		})
+	}
 
 	return planCheckRuns, nil
 }
@@ -378,7 +428,8 @@ func convertToChangeDatabaseType(t storepb.PlanConfig_ChangeDatabaseConfig_Type)
 	switch t {
 	case
 		storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE,
-		storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_GHOST:
+		storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_GHOST,
+		storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_PG_ONLINE:
 		return storepb.PlanCheckRunConfig_DDL
 	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_SDL:
 		return storepb.PlanCheckRunConfig_SDL
@@ -34,6 +34,7 @@ func transformDeploymentConfigTargetToSteps(ctx context.Context, s *store.Store,
 	case storepb.PlanConfig_ChangeDatabaseConfig_BASELINE:
 	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE:
 	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_GHOST:
+	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_PG_ONLINE:
 	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_SDL:
 	case storepb.PlanConfig_ChangeDatabaseConfig_DATA:
 	default:
@@ -80,6 +81,7 @@ func transformDeploymentConfigTargetToSteps(ctx context.Context, s *store.Store,
 						SchemaVersion:   c.SchemaVersion,
 						RollbackEnabled: c.RollbackEnabled,
 						RollbackDetail:  c.RollbackDetail,
+						BatchConfig:     c.BatchConfig,
 					},
 				},
 			})
@@ -428,6 +430,59 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 		}
 		return taskCreateList, taskIndexDAGList, nil
 
+	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_PG_ONLINE:
+		if instance.Engine != db.Postgres {
+			return nil, nil, errors.Errorf("online migration is only supported for PostgreSQL, but database %q is on %s", database.DatabaseName, instance.Engine)
+		}
+		_, sheetUID, err := common.GetProjectResourceIDSheetUID(c.Sheet)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get sheet id from sheet %q", c.Sheet)
+		}
+		var taskCreateList []*store.TaskMessage
+		// task "sync"
+		payloadSync := api.TaskDatabaseSchemaUpdatePGOnlineSyncPayload{
+			SpecID:        spec.Id,
+			SheetID:       sheetUID,
+			SchemaVersion: getOrDefaultSchemaVersion(c.SchemaVersion),
+		}
+		bytesSync, err := json.Marshal(payloadSync)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to marshal database schema update PostgreSQL online sync payload")
+		}
+		taskCreateList = append(taskCreateList, &store.TaskMessage{
+			Name:              fmt.Sprintf("Update schema online sync for database %q", database.DatabaseName),
+			InstanceID:        instance.UID,
+			DatabaseID:        &database.UID,
+			Status:            api.TaskPendingApproval,
+			Type:              api.TaskDatabaseSchemaUpdatePGOnlineSync,
+			EarliestAllowedTs: spec.EarliestAllowedTime.GetSeconds(),
+			Payload:           string(bytesSync),
+		})
+
+		// task "cutover"
+		payloadCutover := api.TaskDatabaseSchemaUpdatePGOnlineCutoverPayload{
+			SpecID: spec.Id,
+		}
+		bytesCutover, err := json.Marshal(payloadCutover)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to marshal database schema update PostgreSQL online cutover payload")
+		}
+		taskCreateList = append(taskCreateList, &store.TaskMessage{
+			Name:              fmt.Sprintf("Update schema online cutover for database %q", database.DatabaseName),
+			InstanceID:        instance.UID,
+			DatabaseID:        &database.UID,
+			Status:            api.TaskPendingApproval,
+			Type:              api.TaskDatabaseSchemaUpdatePGOnlineCutover,
+			EarliestAllowedTs: spec.EarliestAllowedTime.GetSeconds(),
+			Payload:           string(bytesCutover),
+		})
+
+		// task "sync" blocks task "cutover".
+		taskIndexDAGList := []store.TaskIndexDAG{
+			{FromIndex: 0, ToIndex: 1},
+		}
+		return taskCreateList, taskIndexDAGList, nil
+
 	case storepb.PlanConfig_ChangeDatabaseConfig_DATA:
 		_, sheetUID, err := common.GetProjectResourceIDSheetUID(c.Sheet)
 		if err != nil {
@@ -439,6 +494,7 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 			SchemaVersion:     getOrDefaultSchemaVersion(c.SchemaVersion),
 			RollbackEnabled:   c.RollbackEnabled,
 			RollbackSQLStatus: api.RollbackSQLStatusPending,
+			BatchConfig:       convertToTaskBatchConfig(c.BatchConfig),
 		}
 		if c.RollbackDetail != nil {
 			issueID, err := common.GetIssueID(c.RollbackDetail.RollbackFromIssue)
@@ -629,6 +685,7 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseGroupStatements(db *store.Dat
 				RollbackEnabled:   c.RollbackEnabled,
 				RollbackSQLStatus: api.RollbackSQLStatusPending,
 				SchemaGroupName:   schemaGroupName,
+				BatchConfig:       convertToTaskBatchConfig(c.BatchConfig),
 			}
 
 			bytes, err := json.Marshal(payload)
@@ -657,6 +714,9 @@ func getTaskCreatesFromRestoreDatabaseConfig(ctx context.Context, s *store.Store
 	if c.Source == nil {
 		return nil, nil, errors.Errorf("missing source in restore database config")
 	}
+	if err := checkRestoreDatabaseConfigCapability(ctx, s, c); err != nil {
+		return nil, nil, err
+	}
 	instanceID, databaseName, err := common.GetInstanceDatabaseID(c.Target)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "failed to get instance and database id from target %q", c.Target)
@@ -1003,3 +1063,14 @@ func getOrDefaultSchemaVersionWithSuffix(v string, suffix string) string {
 	}
 	return common.DefaultMigrationVersion() + suffix
 }
+
+func convertToTaskBatchConfig(c *storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig) *api.BatchConfig {
+	if c == nil {
+		return nil
+	}
+	return &api.BatchConfig{
+		BatchSize:                int(c.BatchSize),
+		MaxRowsPerSecond:         int(c.MaxRowsPerSecond),
+		MaxReplicationLagSeconds: int(c.MaxReplicationLagSeconds),
+	}
+}
@@ -255,7 +255,7 @@ func (s *SchemaDesignService) checkProtectionRules(ctx context.Context, project
 		pass := false
 		for _, binding := range policy.Bindings {
 			matchUser := false
-			for _, member := range binding.Members {
+			for _, member := range binding.GetAllMembers() {
 				if member.Email == user.Email {
 					matchUser = true
 					break
@@ -22,7 +22,9 @@ import (
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/discovery"
 	"github.com/bytebase/bytebase/backend/plugin/mail"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/edit"
 	"github.com/bytebase/bytebase/backend/store"
@@ -70,8 +72,12 @@ var whitelistSettings = []api.SettingName{
 	api.SettingDataClassification,
 	api.SettingSemanticTypes,
 	api.SettingMaskingAlgorithms,
+	api.SettingSensitiveDataDiscovery,
 }
 
+// minSCIMTokenLength is the minimum length of the SCIM bearer token.
+const minSCIMTokenLength = 32
+
 //go:embed mail_templates/testmail/template.html
 //go:embed mail_templates/testmail/statics/logo-full.png
 //go:embed mail_templates/testmail/statics/banner.png
@@ -142,11 +148,6 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 		return nil, status.Errorf(codes.InvalidArgument, "feature %s is unavailable in current mode", settingName)
 	}
 	apiSettingName := api.SettingName(settingName)
-	// TODO(zp): remove the following hard code when we persist the algorithm setting.
-	if apiSettingName == api.SettingMaskingAlgorithms {
-		return nil, status.Errorf(codes.InvalidArgument, "setting masking algorithm is not available")
-	}
-
 	var storeSettingValue string
 	switch apiSettingName {
 	case api.SettingWorkspaceProfile:
@@ -215,6 +216,9 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 			if err := convertV1PbToStorePb(rule.Template.Flow, flow); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to unmarshal approval flow with error: %v", err)
 			}
+			if err := s.convertToStoreApprovalFlowUsers(ctx, flow); err != nil {
+				return nil, err
+			}
 			payload.Rules = append(payload.Rules, &storepb.WorkspaceApprovalSetting_Rule{
 				Condition: rule.Condition,
 				Template: &storepb.ApprovalTemplate{
@@ -439,6 +443,80 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
 		}
 		storeSettingValue = string(bytes)
+	case api.SettingMaskingAlgorithms:
+		storeMaskingAlgorithmSetting := new(storepb.MaskingAlgorithmSetting)
+		if err := convertV1PbToStorePb(request.Setting.Value.GetMaskingAlgorithmSettingValue(), storeMaskingAlgorithmSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		// The salts are write-only, so the unchanged ones are sent back empty and kept as is.
+		if err := s.fillHashMaskSalts(ctx, storeMaskingAlgorithmSetting); err != nil {
+			return nil, err
+		}
+		idMap := make(map[string]any)
+		for _, algorithm := range storeMaskingAlgorithmSetting.Algorithms {
+			if !isValidUUID(algorithm.Id) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid masking algorithm id format: %s", algorithm.Id)
+			}
+			if algorithm.Title == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "masking algorithm title cannot be empty: %s", algorithm.Id)
+			}
+			if _, ok := idMap[algorithm.Id]; ok {
+				return nil, status.Errorf(codes.InvalidArgument, "duplicate masking algorithm id: %s", algorithm.Id)
+			}
+			idMap[algorithm.Id] = any(nil)
+			if _, err := masker.New(algorithm, storepb.MaskingLevel_FULL); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid masking algorithm: %v", err)
+			}
+		}
+		bytes, err := protojson.Marshal(storeMaskingAlgorithmSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingSensitiveDataDiscovery:
+		storeSensitiveDataDiscoverySetting := new(storepb.SensitiveDataDiscoverySetting)
+		if err := convertV1PbToStorePb(request.Setting.Value.GetSensitiveDataDiscoverySettingValue(), storeSensitiveDataDiscoverySetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		if storeSensitiveDataDiscoverySetting.SampleSize < 0 || storeSensitiveDataDiscoverySetting.SampleSize > 10000 {
+			return nil, status.Errorf(codes.InvalidArgument, "sample size must be in [0, 10000]")
+		}
+		if storeSensitiveDataDiscoverySetting.MinConfidence < 0 || storeSensitiveDataDiscoverySetting.MinConfidence > 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "min confidence must be in [0, 1]")
+		}
+		idMap := make(map[string]any)
+		for _, detector := range storeSensitiveDataDiscoverySetting.Detectors {
+			if !isValidUUID(detector.Id) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid detector id format: %s", detector.Id)
+			}
+			if detector.Title == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "detector title cannot be empty: %s", detector.Id)
+			}
+			if _, ok := idMap[detector.Id]; ok {
+				return nil, status.Errorf(codes.InvalidArgument, "duplicate detector id: %s", detector.Id)
+			}
+			idMap[detector.Id] = any(nil)
+			if _, err := discovery.New(detector); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid detector: %v", err)
+			}
+		}
+		bytes, err := protojson.Marshal(storeSensitiveDataDiscoverySetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingSCIMToken:
+		if role := ctx.Value(common.RoleContextKey).(api.Role); role != api.Owner {
+			return nil, status.Errorf(codes.PermissionDenied, "only workspace owner can set the SCIM token")
+		}
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureSSO); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		token := request.Setting.Value.GetStringValue()
+		if token != "" && len(token) < minSCIMTokenLength {
+			return nil, status.Errorf(codes.InvalidArgument, "SCIM token must be at least %d characters", minSCIMTokenLength)
+		}
+		storeSettingValue = token
 	default:
 		storeSettingValue = request.Setting.Value.GetStringValue()
 	}
@@ -574,6 +652,9 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 		v1Value := &v1pb.WorkspaceApprovalSetting{}
 		for _, rule := range storeValue.Rules {
 			template := convertToApprovalTemplate(rule.Template)
+			if err := convertToV1ApprovalFlowUsers(ctx, s.store, template.Flow); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert approval flow: %v", err)
+			}
 			creator, err := s.store.GetUserByID(ctx, int(rule.Template.CreatorId))
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get creator: %v", err))
@@ -653,6 +734,12 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
 		}
+		// The salt is write-only, otherwise the hash masked values can be reversed by hashing the guessed values.
+		for _, algorithm := range v1Value.Algorithms {
+			if hashMask := algorithm.GetHashMask(); hashMask != nil {
+				hashMask.Salt = ""
+			}
+		}
 		return &v1pb.Setting{
 			Name: settingName,
 			Value: &v1pb.Value{
@@ -661,6 +748,19 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 				},
 			},
 		}, nil
+	case api.SettingSensitiveDataDiscovery:
+		v1Value := new(v1pb.SensitiveDataDiscoverySetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_SensitiveDataDiscoverySettingValue{
+					SensitiveDataDiscoverySettingValue: v1Value,
+				},
+			},
+		}, nil
 
 	default:
 		return &v1pb.Setting{
@@ -807,6 +907,49 @@ func settingInWhitelist(name api.SettingName) bool {
 	return false
 }
 
+// convertToStoreApprovalFlowUsers converts the users of the approval nodes from users/{email} to users/{uid}.
+func (s *SettingService) convertToStoreApprovalFlowUsers(ctx context.Context, flow *storepb.ApprovalFlow) error {
+	for _, step := range flow.Steps {
+		for _, node := range step.Nodes {
+			if name := node.GetUserGroup(); name != "" {
+				groupID, err := common.GetUserGroupID(name)
+				if err != nil {
+					return status.Errorf(codes.InvalidArgument, "invalid user group %q in approval node: %v", name, err)
+				}
+				group, err := s.store.GetUserGroup(ctx, &store.FindUserGroupMessage{ResourceID: &groupID})
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to get user group %q: %v", name, err)
+				}
+				if group == nil {
+					return status.Errorf(codes.InvalidArgument, "user group %q not found", name)
+				}
+				continue
+			}
+			userList := node.GetUserList()
+			if userList == nil {
+				continue
+			}
+			var users []string
+			for _, name := range userList.Users {
+				email, err := common.GetUserEmail(name)
+				if err != nil {
+					return status.Errorf(codes.InvalidArgument, "invalid user %q in approval node: %v", name, err)
+				}
+				user, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email})
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to get user %q: %v", email, err)
+				}
+				if user == nil {
+					return status.Errorf(codes.InvalidArgument, "user %q not found", email)
+				}
+				users = append(users, fmt.Sprintf("%s%d", common.UserNamePrefix, user.ID))
+			}
+			userList.Users = users
+		}
+	}
+	return nil
+}
+
 func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
 	if template.Flow == nil {
 		return errors.Errorf("approval template cannot be nil")
@@ -821,6 +964,21 @@ func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
 		if len(step.Nodes) != 1 {
 			return errors.Errorf("expect 1 node in approval step, got: %v", len(step.Nodes))
 		}
+		node := step.Nodes[0]
+		if node.RequiredApproverCount < 0 {
+			return errors.Errorf("invalid required approver count: %v", node.RequiredApproverCount)
+		}
+		if userList := node.GetUserList(); userList != nil {
+			if len(userList.Users) == 0 {
+				return errors.Errorf("approval node user list cannot be empty")
+			}
+			if int(node.RequiredApproverCount) > len(userList.Users) {
+				return errors.Errorf("required approver count %v is greater than the user count %v", node.RequiredApproverCount, len(userList.Users))
+			}
+		}
+		if node.GetExternalNodeId() != "" && node.RequiredApproverCount > 1 {
+			return errors.Errorf("external approval node cannot require multiple approvers")
+		}
 	}
 	return nil
 }
@@ -1041,3 +1199,31 @@ func stripSensitiveData(setting *v1pb.Setting) (*v1pb.Setting, error) {
 	}
 	return setting, nil
 }
+
+// fillHashMaskSalts fills the empty salts of the hash mask algorithms with the stored ones of the same algorithms.
+func (s *SettingService) fillHashMaskSalts(ctx context.Context, setting *storepb.MaskingAlgorithmSetting) error {
+	settingName := api.SettingMaskingAlgorithms
+	storedSetting, err := s.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &settingName})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get setting %s: %v", settingName, err)
+	}
+	if storedSetting == nil {
+		return nil
+	}
+	storedValue := new(storepb.MaskingAlgorithmSetting)
+	if err := protojson.Unmarshal([]byte(storedSetting.Value), storedValue); err != nil {
+		return status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", settingName, err)
+	}
+	salts := make(map[string]string)
+	for _, algorithm := range storedValue.Algorithms {
+		if hashMask := algorithm.GetHashMask(); hashMask != nil {
+			salts[algorithm.Id] = hashMask.Salt
+		}
+	}
+	for _, algorithm := range setting.Algorithms {
+		if hashMask := algorithm.GetHashMask(); hashMask != nil && hashMask.Salt == "" {
+			hashMask.Salt = salts[algorithm.Id]
+		}
+	}
+	return nil
+}
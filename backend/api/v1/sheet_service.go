@@ -801,7 +801,7 @@ func (s *SheetService) findProjectRoles(ctx context.Context, projectUID int, pri
 	}
 	projectRoles := make(map[common.ProjectRole]bool)
 	for _, binding := range policy.Bindings {
-		for _, member := range binding.Members {
+		for _, member := range binding.GetAllMembers() {
 			if member.ID == principalUID {
 				projectRoles[common.ProjectRole(binding.Role)] = true
 				break
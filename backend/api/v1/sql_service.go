@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -38,6 +39,8 @@ import (
 	advisorDB "github.com/bytebase/bytebase/backend/plugin/advisor/db"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
@@ -174,6 +177,13 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 			}
 		} else {
 			response.Results = result
+			if err := s.maskAdminExecuteResults(ctx, instance, request, result); err != nil {
+				response.Results = []*v1pb.QueryResult{
+					{
+						Error: err.Error(),
+					},
+				}
+			}
 		}
 
 		if proto.Size(response) > maximumSQLResultSize {
@@ -247,6 +257,35 @@ func (*SQLService) doAdminExecute(ctx context.Context, driver db.Driver, conn *s
 	return result, time.Now().UnixNano() - start, err
 }
 
+// maskAdminExecuteResults masks the sensitive data in the results of the admin execute.
+func (s *SQLService) maskAdminExecuteResults(ctx context.Context, instance *store.InstanceMessage, request *v1pb.AdminExecuteRequest, results []*v1pb.QueryResult) error {
+	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) != nil {
+		return nil
+	}
+	hasColumns := false
+	for _, result := range results {
+		if len(result.ColumnNames) > 0 {
+			hasColumns = true
+			break
+		}
+	}
+	if !hasColumns {
+		return nil
+	}
+	sensitiveSchemaInfo, err := s.getSensitiveSchemaInfoForStatement(ctx, instance, request.ConnectionDatabase, request.Statement)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if err := util.MaskQueryResult(instance.Engine, result, request.ConnectionDatabase, sensitiveSchemaInfo); err != nil {
+			// Never return the unmasked data if it cannot be masked.
+			result.Rows = nil
+			result.Error = err.Error()
+		}
+	}
+	return nil
+}
+
 func (s *SQLService) preAdminExecute(ctx context.Context, request *v1pb.AdminExecuteRequest) (*store.InstanceMessage, *store.DatabaseMessage, *store.ActivityMessage, error) {
 	user, _, instance, database, err := s.prepareRelatedMessage(ctx, request.Name, request.ConnectionDatabase)
 	if err != nil {
@@ -396,6 +435,19 @@ func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest,
 		if content, err = exportXLSX(result[0]); err != nil {
 			return nil, durationNs, err
 		}
+	case v1pb.ExportFormat_PARQUET, v1pb.ExportFormat_NDJSON:
+		var buf bytes.Buffer
+		w, err := newExportWriter(request.Format, instance.Engine, nil, &buf)
+		if err != nil {
+			return nil, durationNs, err
+		}
+		if err := writeExportResult(w, result[0]); err != nil {
+			return nil, durationNs, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, durationNs, err
+		}
+		content = buf.Bytes()
 	default:
 		return nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
 	}
@@ -801,8 +853,13 @@ func (s *SQLService) preExport(ctx context.Context, request *v1pb.ExportRequest)
 			return nil, nil, nil, nil, err
 		}
 		if !result {
+			// A non-positive limit exports all rows, so the row limit in the export grant is checked against the largest limit.
+			limit := request.Limit
+			if limit <= 0 {
+				limit = math.MaxInt32
+			}
 			// Check if the user has permission to execute the export.
-			if err := s.checkQueryRights(ctx, request.ConnectionDatabase, dataShare, request.Statement, request.Limit, user, instance, true); err != nil {
+			if err := s.checkQueryRights(ctx, request.ConnectionDatabase, dataShare, request.Statement, limit, user, instance, true); err != nil {
 				return nil, nil, nil, nil, err
 			}
 		}
@@ -889,6 +946,16 @@ func (s *SQLService) preExport(ctx context.Context, request *v1pb.ExportRequest)
 		return nil, nil, nil, nil, err
 	}
 
+	// Apply the row filters after the activity, which uses the statement of the user.
+	// Workspace owners and DBAs export data without row filters using admin mode.
+	if s.licenseService.IsFeatureEnabled(api.FeatureAccessControl) == nil && !request.Admin {
+		statement, err := s.applyRowFilters(ctx, user, instance, request.ConnectionDatabase, request.Statement)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		request.Statement = statement
+	}
+
 	return instance, database, sensitiveSchemaInfo, activity, nil
 }
 
@@ -1224,76 +1291,9 @@ func (s *SQLService) preQuery(ctx context.Context, request *v1pb.QueryRequest) (
 	// Get sensitive schema info.
 	var sensitiveSchemaInfo *db.SensitiveSchemaInfo
 	if adviceStatus != advisor.Error {
-		switch instance.Engine {
-		case db.MySQL, db.TiDB, db.MariaDB, db.OceanBase:
-			databaseList, err := parser.ExtractDatabaseList(parser.MySQL, request.Statement, "")
-			if err != nil {
-				return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get database list: %s with error %v", request.Statement, err)
-			}
-
-			sensitiveSchemaInfo, err = s.getSensitiveSchemaInfo(ctx, instance, databaseList, request.ConnectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
-			if err != nil {
-				return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", request.Statement, err.Error())
-			}
-		case db.Redshift, db.RisingWave:
-			sensitiveSchemaInfo, err = s.getSensitiveSchemaInfo(ctx, instance, []string{request.ConnectionDatabase}, request.ConnectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
-			if err != nil {
-				return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", request.Statement, err.Error())
-			}
-		case db.Postgres:
-			if allPostgresSystemObjects(request.Statement) {
-				sensitiveSchemaInfo = nil
-			} else {
-				sensitiveSchemaInfo, err = s.getSensitiveSchemaInfo(ctx, instance, []string{request.ConnectionDatabase}, request.ConnectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
-				if err != nil {
-					return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", request.Statement, err.Error())
-				}
-			}
-		case db.Oracle, db.DM:
-			if instance.Options == nil || !instance.Options.SchemaTenantMode {
-				sensitiveSchemaInfo, err = s.getSensitiveSchemaInfo(ctx, instance, []string{request.ConnectionDatabase}, request.ConnectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
-				if err != nil {
-					return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", request.Statement, err.Error())
-				}
-			} else {
-				list, err := parser.ExtractResourceList(parser.Oracle, request.ConnectionDatabase, request.ConnectionDatabase, request.Statement)
-				if err != nil {
-					return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get resource list: %s", request.Statement)
-				}
-				databaseMap := make(map[string]bool)
-				for _, resource := range list {
-					databaseMap[resource.Database] = true
-				}
-				var databaseList []string
-				databaseList = append(databaseList, request.ConnectionDatabase)
-				for database := range databaseMap {
-					databaseList = append(databaseList, database)
-				}
-				sensitiveSchemaInfo, err = s.getSensitiveSchemaInfo(ctx, instance, databaseList, request.ConnectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
-				if err != nil {
-					return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", request.Statement, err.Error())
-				}
-			}
-		case db.Snowflake:
-			databaseList, err := parser.ExtractDatabaseList(parser.Snowflake, request.Statement, request.ConnectionDatabase)
-			if err != nil {
-				return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get database list: %s with error %v", request.Statement, err)
-			}
-
-			sensitiveSchemaInfo, err = s.getSensitiveSchemaInfo(ctx, instance, databaseList, request.ConnectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
-			if err != nil {
-				return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", request.Statement, err.Error())
-			}
-		case db.MSSQL:
-			databaseList, err := parser.ExtractDatabaseList(parser.MSSQL, request.Statement, request.ConnectionDatabase)
-			if err != nil {
-				return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get database list: %s with error %v", request.Statement, err)
-			}
-
-			sensitiveSchemaInfo, err = s.getSensitiveSchemaInfo(ctx, instance, databaseList, request.ConnectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
-			if err != nil {
-				return nil, nil, nil, advisor.Success, nil, nil, nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info: %s", request.Statement)
-			}
+		sensitiveSchemaInfo, err = s.getSensitiveSchemaInfoForStatement(ctx, instance, request.ConnectionDatabase, request.Statement)
+		if err != nil {
+			return nil, nil, nil, advisor.Success, nil, nil, nil, err
 		}
 	}
 
@@ -1323,9 +1323,101 @@ func (s *SQLService) preQuery(ctx context.Context, request *v1pb.QueryRequest) (
 		return nil, nil, nil, advisor.Success, nil, nil, nil, err
 	}
 
+	// Apply the row filters after the SQL review and the activity, which use the statement of the user.
+	if s.licenseService.IsFeatureEnabled(api.FeatureAccessControl) == nil {
+		statement, err := s.applyRowFilters(ctx, user, instance, request.ConnectionDatabase, request.Statement)
+		if err != nil {
+			return nil, nil, nil, advisor.Success, nil, nil, nil, err
+		}
+		request.Statement = statement
+	}
+
 	return user, instance, maybeDatabase, adviceStatus, adviceList, sensitiveSchemaInfo, activity, nil
 }
 
+// getSensitiveSchemaInfoForStatement gets the sensitive schema info of the databases accessed by the statement for querying.
+func (s *SQLService) getSensitiveSchemaInfoForStatement(ctx context.Context, instance *store.InstanceMessage, connectionDatabase string, statement string) (*db.SensitiveSchemaInfo, error) {
+	switch instance.Engine {
+	case db.MySQL, db.TiDB, db.MariaDB, db.OceanBase:
+		databaseList, err := parser.ExtractDatabaseList(parser.MySQL, statement, "")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get database list: %s with error %v", statement, err)
+		}
+
+		sensitiveSchemaInfo, err := s.getSensitiveSchemaInfo(ctx, instance, databaseList, connectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", statement, err.Error())
+		}
+		return sensitiveSchemaInfo, nil
+	case db.Redshift, db.RisingWave:
+		sensitiveSchemaInfo, err := s.getSensitiveSchemaInfo(ctx, instance, []string{connectionDatabase}, connectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", statement, err.Error())
+		}
+		return sensitiveSchemaInfo, nil
+	case db.Postgres:
+		if allPostgresSystemObjects(statement) {
+			return nil, nil
+		}
+		sensitiveSchemaInfo, err := s.getSensitiveSchemaInfo(ctx, instance, []string{connectionDatabase}, connectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", statement, err.Error())
+		}
+		return sensitiveSchemaInfo, nil
+	case db.Oracle, db.DM:
+		if instance.Options == nil || !instance.Options.SchemaTenantMode {
+			sensitiveSchemaInfo, err := s.getSensitiveSchemaInfo(ctx, instance, []string{connectionDatabase}, connectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", statement, err.Error())
+			}
+			return sensitiveSchemaInfo, nil
+		}
+		{
+			list, err := parser.ExtractResourceList(parser.Oracle, connectionDatabase, connectionDatabase, statement)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Failed to get resource list: %s", statement)
+			}
+			databaseMap := make(map[string]bool)
+			for _, resource := range list {
+				databaseMap[resource.Database] = true
+			}
+			var databaseList []string
+			databaseList = append(databaseList, connectionDatabase)
+			for database := range databaseMap {
+				databaseList = append(databaseList, database)
+			}
+			sensitiveSchemaInfo, err := s.getSensitiveSchemaInfo(ctx, instance, databaseList, connectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", statement, err.Error())
+			}
+			return sensitiveSchemaInfo, nil
+		}
+	case db.Snowflake:
+		databaseList, err := parser.ExtractDatabaseList(parser.Snowflake, statement, connectionDatabase)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get database list: %s with error %v", statement, err)
+		}
+
+		sensitiveSchemaInfo, err := s.getSensitiveSchemaInfo(ctx, instance, databaseList, connectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info for statement: %s, error: %v", statement, err.Error())
+		}
+		return sensitiveSchemaInfo, nil
+	case db.MSSQL:
+		databaseList, err := parser.ExtractDatabaseList(parser.MSSQL, statement, connectionDatabase)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get database list: %s with error %v", statement, err)
+		}
+
+		sensitiveSchemaInfo, err := s.getSensitiveSchemaInfo(ctx, instance, databaseList, connectionDatabase, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get sensitive schema info: %s", statement)
+		}
+		return sensitiveSchemaInfo, nil
+	}
+	return nil, nil
+}
+
 func allPostgresSystemObjects(statement string) bool {
 	// We need to distinguish between specified public schema and by default.
 	resources, err := parser.ExtractResourceList(parser.Postgres, "", "", statement)
@@ -1384,6 +1476,15 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to find current principal")
 	}
+	// The masking exception members are either the user email or group:{group}.
+	exceptionMembers := map[string]bool{currentPrincipal.Email: true}
+	userGroups, err := s.store.ListUserGroups(ctx, &store.FindUserGroupMessage{MemberID: &currentPrincipalUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find user groups of current principal")
+	}
+	for _, group := range userGroups {
+		exceptionMembers[fmt.Sprintf("group:%s", group.ResourceID)] = true
+	}
 
 	type sensitiveDataMap map[api.SensitiveData]api.SensitiveDataMaskType
 	isEmpty := true
@@ -1410,6 +1511,16 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 		withMaskingRulePolicy(maskingRulePolicy).
 		withDataClassificationSetting(classificationSetting)
 
+	maskingAlgorithmSetting, err := s.store.GetMaskingAlgorithmSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking algorithm setting")
+	}
+	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find semantic types setting")
+	}
+	resolver := newMaskerResolver(maskingAlgorithmSetting, semanticTypesSetting)
+
 	for _, name := range databaseList {
 		databaseName := name
 		if name == "" {
@@ -1464,7 +1575,7 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 				if maskingException.Action != action {
 					continue
 				}
-				if maskingException.Member == currentPrincipal.Email {
+				if exceptionMembers[maskingException.Member] {
 					slog.Debug("hit masking exception for current principal", slog.String("database", databaseName), slog.String("project", database.ProjectID), slog.Any("masking exception", maskingException))
 					maskingExceptionContainsCurrentPrincipal = append(maskingExceptionContainsCurrentPrincipal, maskingException)
 					break
@@ -1514,6 +1625,7 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to find schema for database %q in instance %q: %v", databaseName, instance.Title, err)
 		}
+		columnSemanticTypes := getColumnSemanticTypes(dbSchema.Config)
 
 		if instance.Engine == db.Oracle || instance.Engine == db.DM {
 			for _, schema := range dbSchema.Metadata.Schemas {
@@ -1531,17 +1643,26 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 					}
 					for _, column := range table.Columns {
 						slog.Debug("processing sensitive schema info", slog.String("schema", schema.Name), slog.String("table", table.Name))
-						maskingLevel, err := m.evaluateMaskingLevelOfColumn(database, schema.Name, table.Name, column.Name, column.Classification, project.DataClassificationConfigID, maskingPolicyMap, maskingExceptionContainsCurrentPrincipal)
+						maskingLevel, ruleAlgorithmID, err := m.evaluateMaskingOfColumn(database, schema.Name, table.Name, column.Name, column.Classification, project.DataClassificationConfigID, maskingPolicyMap, maskingExceptionContainsCurrentPrincipal)
 						if err != nil {
 							return nil, errors.Wrapf(err, "failed to evaluate masking level of database %q, schema %q, table %q, column %q", databaseName, schema.Name, table.Name, column.Name)
 						}
 						sensitive := maskingLevel == storepb.MaskingLevel_FULL || maskingLevel == storepb.MaskingLevel_PARTIAL
+						var columnMasker masker.Masker
 						if sensitive {
 							isEmpty = false
+							semanticTypeIDs := []string{
+								columnSemanticTypes[maskingPolicyKey{schema: schema.Name, table: table.Name, column: column.Name}],
+								getClassificationSemanticTypeOfColumn(column.Classification, dataClassificationConfig),
+							}
+							if columnMasker, err = resolver.getMasker(maskingLevel, ruleAlgorithmID, semanticTypeIDs); err != nil {
+								return nil, errors.Wrapf(err, "failed to get masker of database %q, schema %q, table %q, column %q", databaseName, schema.Name, table.Name, column.Name)
+							}
 						}
 						tableSchema.ColumnList = append(tableSchema.ColumnList, db.ColumnInfo{
 							Name:         column.Name,
 							MaskingLevel: maskingLevel,
+							Masker:       columnMasker,
 						})
 					}
 					schemaSchema.TableList = append(schemaSchema.TableList, tableSchema)
@@ -1568,17 +1689,26 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 				}
 				for _, column := range table.Columns {
 					slog.Debug("processing sensitive schema info", slog.String("database", database.DatabaseName), slog.String("schema", schema.Name), slog.String("table", table.Name), slog.String("column", column.Name))
-					maskingLevel, err := m.evaluateMaskingLevelOfColumn(database, schema.Name, table.Name, column.Name, column.Classification, project.DataClassificationConfigID, maskingPolicyMap, maskingExceptionContainsCurrentPrincipal)
+					maskingLevel, ruleAlgorithmID, err := m.evaluateMaskingOfColumn(database, schema.Name, table.Name, column.Name, column.Classification, project.DataClassificationConfigID, maskingPolicyMap, maskingExceptionContainsCurrentPrincipal)
 					if err != nil {
 						return nil, errors.Wrapf(err, "failed to evaluate masking level of database %q, schema %q, table %q, column %q", databaseName, schema.Name, table.Name, column.Name)
 					}
 					sensitive := maskingLevel == storepb.MaskingLevel_FULL || maskingLevel == storepb.MaskingLevel_PARTIAL
+					var columnMasker masker.Masker
 					if sensitive {
 						isEmpty = false
+						semanticTypeIDs := []string{
+							columnSemanticTypes[maskingPolicyKey{schema: schema.Name, table: table.Name, column: column.Name}],
+							getClassificationSemanticTypeOfColumn(column.Classification, dataClassificationConfig),
+						}
+						if columnMasker, err = resolver.getMasker(maskingLevel, ruleAlgorithmID, semanticTypeIDs); err != nil {
+							return nil, errors.Wrapf(err, "failed to get masker of database %q, schema %q, table %q, column %q", databaseName, schema.Name, table.Name, column.Name)
+						}
 					}
 					tableSchema.ColumnList = append(tableSchema.ColumnList, db.ColumnInfo{
 						Name:         column.Name,
 						MaskingLevel: maskingLevel,
+						Masker:       columnMasker,
 					})
 				}
 				schemaSchema.TableList = append(schemaSchema.TableList, tableSchema)
@@ -1603,6 +1733,86 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 	return result, nil
 }
 
+// maskerResolver resolves the maskers of the sensitive columns from the masking algorithm setting and the semantic types setting.
+type maskerResolver struct {
+	algorithms    map[string]*storepb.MaskingAlgorithmSetting_MaskingAlgorithm
+	semanticTypes map[string]*storepb.SemanticTypesSetting_SemanticType
+	// maskers caches the maskers by the algorithm and the masking level, so the columns using the same algorithm share the same masker.
+	maskers map[maskerKey]masker.Masker
+}
+
+type maskerKey struct {
+	algorithmID string
+	level       storepb.MaskingLevel
+}
+
+func newMaskerResolver(maskingAlgorithmSetting *storepb.MaskingAlgorithmSetting, semanticTypesSetting *storepb.SemanticTypesSetting) *maskerResolver {
+	r := &maskerResolver{
+		algorithms:    make(map[string]*storepb.MaskingAlgorithmSetting_MaskingAlgorithm),
+		semanticTypes: make(map[string]*storepb.SemanticTypesSetting_SemanticType),
+		maskers:       make(map[maskerKey]masker.Masker),
+	}
+	for _, algorithm := range maskingAlgorithmSetting.GetAlgorithms() {
+		r.algorithms[algorithm.Id] = algorithm
+	}
+	for _, semanticType := range semanticTypesSetting.GetTypes() {
+		r.semanticTypes[semanticType.Id] = semanticType
+	}
+	return r
+}
+
+// getMasker returns the masker of the column in the masking level.
+// The algorithm of the masking rule takes precedence, then the algorithms of the semantic types in order for the masking level.
+// It returns nil if no algorithm is found, and the default masker of the masking level is used.
+func (r *maskerResolver) getMasker(level storepb.MaskingLevel, ruleAlgorithmID string, semanticTypeIDs []string) (masker.Masker, error) {
+	algorithmIDs := []string{ruleAlgorithmID}
+	for _, semanticTypeID := range semanticTypeIDs {
+		semanticType, ok := r.semanticTypes[semanticTypeID]
+		if !ok {
+			continue
+		}
+		switch level {
+		case storepb.MaskingLevel_FULL:
+			algorithmIDs = append(algorithmIDs, semanticType.FullMaskAlgorithmId)
+		case storepb.MaskingLevel_PARTIAL:
+			algorithmIDs = append(algorithmIDs, semanticType.PartialMaskAlgorithmId)
+		}
+	}
+	for _, algorithmID := range algorithmIDs {
+		algorithm, ok := r.algorithms[algorithmID]
+		if !ok {
+			continue
+		}
+		key := maskerKey{algorithmID: algorithmID, level: level}
+		if m, ok := r.maskers[key]; ok {
+			return m, nil
+		}
+		m, err := masker.New(algorithm, level)
+		if err != nil {
+			return nil, err
+		}
+		r.maskers[key] = m
+		return m, nil
+	}
+	return nil, nil
+}
+
+// getColumnSemanticTypes returns the semantic types of the columns in the database config.
+func getColumnSemanticTypes(config *storepb.DatabaseConfig) map[maskingPolicyKey]string {
+	semanticTypes := make(map[maskingPolicyKey]string)
+	for _, schemaConfig := range config.GetSchemaConfigs() {
+		for _, tableConfig := range schemaConfig.TableConfigs {
+			for _, columnConfig := range tableConfig.ColumnConfigs {
+				if columnConfig.SemanticTypeId == "" {
+					continue
+				}
+				semanticTypes[maskingPolicyKey{schema: schemaConfig.Name, table: tableConfig.Name, column: columnConfig.Name}] = columnConfig.SemanticTypeId
+			}
+		}
+	}
+	return semanticTypes
+}
+
 type maskingLevelEvaluator struct {
 	maskingRules            []*storepb.MaskingRulePolicy_MaskingRule
 	dataClassificationIDMap map[string]*storepb.DataClassificationSetting_DataClassificationConfig
@@ -1655,7 +1865,15 @@ func (m *maskingLevelEvaluator) getDataClassificationConfig(classificationID str
 //
 // - filteredMaskingExceptions: the exceptions should apply for current principal.
 func (m *maskingLevelEvaluator) evaluateMaskingLevelOfColumn(databaseMessage *store.DatabaseMessage, schemaName, tableName, columnName, columnClassification string, databaseProjectDataClassificationID string, maskingPolicyMap map[maskingPolicyKey]*storepb.MaskData, filteredMaskingExceptions []*storepb.MaskingExceptionPolicy_MaskingException) (storepb.MaskingLevel, error) {
+	level, _, err := m.evaluateMaskingOfColumn(databaseMessage, schemaName, tableName, columnName, columnClassification, databaseProjectDataClassificationID, maskingPolicyMap, filteredMaskingExceptions)
+	return level, err
+}
+
+// evaluateMaskingOfColumn evaluates the masking level of the given column like evaluateMaskingLevelOfColumn,
+// and also returns the masking algorithm id of the masking rule deciding the level, which is empty if the level is not decided by a masking rule.
+func (m *maskingLevelEvaluator) evaluateMaskingOfColumn(databaseMessage *store.DatabaseMessage, schemaName, tableName, columnName, columnClassification string, databaseProjectDataClassificationID string, maskingPolicyMap map[maskingPolicyKey]*storepb.MaskData, filteredMaskingExceptions []*storepb.MaskingExceptionPolicy_MaskingException) (storepb.MaskingLevel, string, error) {
 	finalLevel := storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED
+	var rule *storepb.MaskingRulePolicy_MaskingRule
 
 	key := maskingPolicyKey{
 		schema: schemaName,
@@ -1682,10 +1900,11 @@ func (m *maskingLevelEvaluator) evaluateMaskingLevelOfColumn(databaseMessage *st
 			}
 			pass, err := evaluateMaskingRulePolicyCondition(maskingRule.Condition.Expression, maskingRuleAttributes)
 			if err != nil {
-				return storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to evaluate masking rule policy condition")
+				return storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, "", errors.Wrapf(err, "failed to evaluate masking rule policy condition")
 			}
 			if pass {
 				finalLevel = maskingRule.MaskingLevel
+				rule = maskingRule
 				slog.Debug("hit masking rule", slog.String("column", columnName), slog.Any("masking rule", maskingRule), slog.Any("masking level", maskingRule.MaskingLevel.String()))
 				break
 			}
@@ -1699,7 +1918,7 @@ func (m *maskingLevelEvaluator) evaluateMaskingLevelOfColumn(databaseMessage *st
 		// After looking up the maskingPolicy and maskingRulePolicy, if the maskingLevel is still MASKING_LEVEL_UNSPECIFIED or NONE,
 		// return the MASKING_LEVEL_NONE, which means no masking and do not need eval exceptions anymore.
 		slog.Debug("After looking up maskingPolicy and maskingRulePolicy, the masking level is UNSPECIFIED or NONE", slog.Any("masking level", finalLevel.String()))
-		return storepb.MaskingLevel_NONE, nil
+		return storepb.MaskingLevel_NONE, "", nil
 	}
 
 	// If the column has PARTIAL/FULL masking level,
@@ -1720,7 +1939,7 @@ func (m *maskingLevelEvaluator) evaluateMaskingLevelOfColumn(databaseMessage *st
 		}
 		hit, err := evaluateMaskingExceptionPolicyCondition(filteredMaskingException.Condition.Expression, maskingExceptionAttributes)
 		if err != nil {
-			return storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to evaluate masking exception policy condition")
+			return storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, "", errors.Wrapf(err, "failed to evaluate masking exception policy condition")
 		}
 		if !hit {
 			continue
@@ -1733,7 +1952,11 @@ func (m *maskingLevelEvaluator) evaluateMaskingLevelOfColumn(databaseMessage *st
 		}
 	}
 	slog.Debug("final level of column", slog.String("column", columnName), slog.Any("final level", finalLevel.String()))
-	return finalLevel, nil
+	// The algorithm of the masking rule only applies to the level of the rule, not the level lowered by the exceptions.
+	if rule != nil && rule.MaskingLevel == finalLevel {
+		return finalLevel, rule.MaskingAlgorithmId, nil
+	}
+	return finalLevel, "", nil
 }
 
 func getClassificationLevelOfColumn(columnClassificationID string, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) string {
@@ -1752,6 +1975,17 @@ func getClassificationLevelOfColumn(columnClassificationID string, classificatio
 	return *classification.LevelId
 }
 
+func getClassificationSemanticTypeOfColumn(columnClassificationID string, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) string {
+	if columnClassificationID == "" || classificationConfig == nil {
+		return ""
+	}
+	classification, ok := classificationConfig.Classification[columnClassificationID]
+	if !ok {
+		return ""
+	}
+	return classification.SemanticTypeId
+}
+
 func isExcludeDatabase(dbType db.Type, database string) bool {
 	switch dbType {
 	case db.MySQL, db.MariaDB:
@@ -2545,7 +2779,7 @@ func hasDatabaseAccessRights(principalID int, projectPolicy *store.IAMPolicyMess
 	for _, binding := range projectPolicy.Bindings {
 		// Project owner has all permissions.
 		if binding.Role == api.Role(common.ProjectOwner) {
-			for _, member := range binding.Members {
+			for _, member := range binding.GetAllMembers() {
 				if member.ID == principalID {
 					pass = true
 					break
@@ -2555,7 +2789,7 @@ func hasDatabaseAccessRights(principalID int, projectPolicy *store.IAMPolicyMess
 		if !((isExport && binding.Role == api.Role(common.ProjectExporter)) || (!isExport && binding.Role == api.Role(common.ProjectQuerier))) {
 			continue
 		}
-		for _, member := range binding.Members {
+		for _, member := range binding.GetAllMembers() {
 			if member.ID != principalID {
 				continue
 			}
@@ -2673,6 +2907,224 @@ func evaluateQueryExportPolicyCondition(expression string, attributes map[string
 	return boolVal, nil
 }
 
+func evaluateRowFilterPolicyCondition(expression string, attributes map[string]any) (bool, error) {
+	env, err := cel.NewEnv(common.RowFilterPolicyCELAttributes...)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create CEL environment for row filter policy")
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return false, errors.Wrapf(issues.Err(), "failed to get the ast of CEL program for row filter")
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create CEL program for row filter")
+	}
+	out, _, err := prg.Eval(attributes)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to eval CEL program for row filter")
+	}
+	val, err := out.ConvertToNative(reflect.TypeOf(false))
+	if err != nil {
+		return false, errors.Wrap(err, "expect bool result for row filter")
+	}
+	boolVal, ok := val.(bool)
+	if !ok {
+		return false, errors.New("expect bool result for row filter")
+	}
+	return boolVal, nil
+}
+
+// applyRowFilters rewrites the statement with the row filter policies of the projects of the queried tables.
+// The rules are evaluated for every principal including the workspace owners and DBAs, because the conditions select the principals.
+// Any failure in evaluating the rules fails the query, so that the rows are never exposed unfiltered.
+func (s *SQLService) applyRowFilters(ctx context.Context, user *store.UserMessage, instance *store.InstanceMessage, databaseName string, statement string) (string, error) {
+	engine := convertToParserEngine(instance.Engine)
+	resourceList, err := s.extractRowFilterResourceList(ctx, engine, databaseName, statement, instance)
+	if err != nil {
+		return "", err
+	}
+
+	principal := &common.RowFilterPrincipal{
+		Email:      user.Email,
+		Attributes: user.Profile.GetAttributes(),
+	}
+	if principal.Attributes == nil {
+		principal.Attributes = map[string]string{}
+	}
+	policyMap := make(map[int]*storepb.RowFilterPolicy)
+	getPolicy := func(project *store.ProjectMessage) (*storepb.RowFilterPolicy, error) {
+		policy, ok := policyMap[project.UID]
+		if !ok {
+			var err error
+			policy, err = s.store.GetRowFilterPolicyByProjectUID(ctx, project.UID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get row filter policy of project %q: %v", project.ResourceID, err)
+			}
+			policyMap[project.UID] = policy
+		}
+		return policy, nil
+	}
+
+	rowFilterContext := &parser.RowFilterContext{CurrentDatabase: databaseName}
+	// The views and functions can read the filtered tables without the filters, so they are restricted in the databases with row filter rules.
+	// The connection database is always checked because the functions can be called without referencing any table.
+	checkedDatabases := make(map[string]bool)
+	for _, name := range append([]string{databaseName}, getResourceDatabaseNames(resourceList)...) {
+		if name == "" || checkedDatabases[name] {
+			continue
+		}
+		checkedDatabases[name] = true
+		project, database, err := s.getProjectAndDatabaseMessage(ctx, instance, name)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to find database %q: %v", name, err)
+		}
+		if project == nil || database == nil {
+			continue
+		}
+		policy, err := getPolicy(project)
+		if err != nil {
+			return "", err
+		}
+		if len(policy.GetRules()) == 0 {
+			continue
+		}
+		rowFilterContext.HasRules = true
+		dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to get schema of database %q: %v", name, err)
+		}
+		if dbSchema == nil {
+			continue
+		}
+		for _, schema := range dbSchema.Metadata.GetSchemas() {
+			for _, view := range schema.Views {
+				rowFilterContext.RestrictedViews = append(rowFilterContext.RestrictedViews, parser.SchemaResource{
+					Database: database.DatabaseName,
+					Schema:   schema.Name,
+					Table:    view.Name,
+				})
+			}
+			for _, function := range schema.Functions {
+				rowFilterContext.RestrictedFunctions = append(rowFilterContext.RestrictedFunctions, function.Name)
+			}
+		}
+	}
+
+	for _, resource := range resourceList {
+		project, database, err := s.getProjectAndDatabaseMessage(ctx, instance, resource.Database)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to find database %q: %v", resource.Database, err)
+		}
+		if project == nil || database == nil {
+			continue
+		}
+		policy, err := getPolicy(project)
+		if err != nil {
+			return "", err
+		}
+		for _, rule := range policy.GetRules() {
+			matched, err := evaluateRowFilterPolicyCondition(rule.Condition.GetExpression(), map[string]any{
+				"resource.environment_id": database.EffectiveEnvironmentID,
+				"resource.instance_id":    instance.ResourceID,
+				"resource.database_name":  database.DatabaseName,
+				"resource.schema_name":    resource.Schema,
+				"resource.table_name":     resource.Table,
+				"user.attributes":         principal.Attributes,
+			})
+			if err != nil {
+				return "", status.Errorf(codes.Internal, "failed to evaluate row filter rule %q: %v", rule.Id, err)
+			}
+			if !matched {
+				continue
+			}
+			predicate, err := common.RenderRowFilterPredicate(rule.Predicate, principal, getRowFilterQuoter(instance.Engine))
+			if err != nil {
+				return "", status.Errorf(codes.PermissionDenied, "failed to render row filter rule %q: %v", rule.Id, err)
+			}
+			rowFilterContext.Filters = append(rowFilterContext.Filters, parser.RowFilter{
+				Resource:  resource,
+				Predicate: predicate,
+			})
+		}
+	}
+
+	rewritten, err := parser.ApplyRowFilters(engine, statement, rowFilterContext)
+	if err != nil {
+		return "", status.Errorf(codes.PermissionDenied, "failed to apply row filters: %v", err)
+	}
+	return rewritten, nil
+}
+
+// extractRowFilterResourceList returns the tables referenced by the statement for the row filters.
+// The unqualified PostgreSQL tables are returned in every schema containing them, because the search path decides which one is referenced.
+func (s *SQLService) extractRowFilterResourceList(ctx context.Context, engine parser.EngineType, databaseName string, statement string, instance *store.InstanceMessage) ([]parser.SchemaResource, error) {
+	if engine != parser.Postgres {
+		resourceList, err := s.extractResourceList(ctx, engine, databaseName, statement, instance)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+		}
+		return resourceList, nil
+	}
+
+	list, err := parser.ExtractResourceList(engine, databaseName, "", statement)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:   &instance.ResourceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch database: %v", err)
+	}
+	if database == nil {
+		return nil, nil
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch database schema: %v", err)
+	}
+	if dbSchema == nil {
+		return nil, nil
+	}
+	var result []parser.SchemaResource
+	for _, resource := range list {
+		for _, schema := range dbSchema.Metadata.GetSchemas() {
+			if resource.Schema != "" && resource.Schema != schema.Name {
+				continue
+			}
+			if dbSchema.TableExists(schema.Name, resource.Table, false /* ignoreCaseSensitive */) {
+				result = append(result, parser.SchemaResource{Database: resource.Database, Schema: schema.Name, Table: resource.Table})
+			}
+		}
+	}
+	return result, nil
+}
+
+func getResourceDatabaseNames(resourceList []parser.SchemaResource) []string {
+	var names []string
+	for _, resource := range resourceList {
+		names = append(names, resource.Database)
+	}
+	return names
+}
+
+// getRowFilterQuoter returns the function quoting the values as string literals of the engine.
+func getRowFilterQuoter(engine db.Type) func(string) string {
+	switch engine {
+	case db.MySQL, db.MariaDB, db.OceanBase:
+		return func(s string) string {
+			s = strings.ReplaceAll(s, `\`, `\\`)
+			return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+		}
+	default:
+		return func(s string) string {
+			return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+		}
+	}
+}
+
 func (s *SQLService) getProjectAndDatabaseMessage(ctx context.Context, instance *store.InstanceMessage, database string) (*store.ProjectMessage, *store.DatabaseMessage, error) {
 	databaseMessage, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
 		InstanceID:          &instance.ResourceID,
@@ -0,0 +1,507 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/pkg/errors"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// exportStreamChunkSize is the size of the file content in each ExportStream response.
+	exportStreamChunkSize = 1024 * 1024
+	// parquetRecordBatchSize is the number of rows buffered before they are written as a parquet row group.
+	parquetRecordBatchSize = 8192
+)
+
+// ExportStream exports the SQL query result as a stream of file content chunks.
+func (s *SQLService) ExportStream(request *v1pb.ExportRequest, server v1pb.SQLService_ExportStreamServer) error {
+	ctx := server.Context()
+	instance, database, sensitiveSchemaInfo, activity, err := s.preExport(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	durationNs, exportErr := s.doExportStream(ctx, request, instance, database, sensitiveSchemaInfo, &exportStreamSender{server: server})
+
+	if err := s.postExport(ctx, activity, durationNs, exportErr); err != nil {
+		return err
+	}
+	return exportErr
+}
+
+func (s *SQLService) doExportStream(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *db.SensitiveSchemaInfo, out io.Writer) (int64, error) {
+	// Don't anonymize data for exporting data using admin mode.
+	if request.Admin {
+		sensitiveSchemaInfo = nil
+	}
+
+	var resourceList []parser.SchemaResource
+	if request.Format == v1pb.ExportFormat_SQL {
+		list, err := s.extractResourceList(ctx, convertToParserEngine(instance.Engine), request.ConnectionDatabase, request.Statement, instance)
+		if err != nil {
+			return 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+		}
+		resourceList = list
+	}
+
+	bufferedOut := bufio.NewWriterSize(out, exportStreamChunkSize)
+	w, err := newExportWriter(request.Format, instance.Engine, resourceList, bufferedOut)
+	if err != nil {
+		return 0, err
+	}
+
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return 0, err
+	}
+	defer driver.Close(ctx)
+
+	sqlDB := driver.GetDB()
+	var conn *sql.Conn
+	if sqlDB != nil {
+		conn, err = sqlDB.Conn(ctx)
+		if err != nil {
+			return 0, err
+		}
+		defer conn.Close()
+	}
+
+	queryContext := &db.QueryContext{
+		Limit:               int(request.Limit),
+		ReadOnly:            true,
+		CurrentDatabase:     request.ConnectionDatabase,
+		SensitiveSchemaInfo: sensitiveSchemaInfo,
+		EnableSensitive:     s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
+	}
+	start := time.Now().UnixNano()
+	if streamer, ok := driver.(db.QueryStreamer); ok {
+		err = streamer.QueryConnStream(ctx, conn, request.Statement, queryContext, w)
+	} else {
+		// Fall back to the buffered query for the drivers that cannot stream yet.
+		var result []*v1pb.QueryResult
+		result, err = driver.QueryConn(ctx, conn, request.Statement, queryContext)
+		if err == nil {
+			if len(result) != 1 {
+				err = errors.Errorf("expecting 1 result, but got %d", len(result))
+			} else if result[0].Error != "" {
+				err = errors.New(result[0].Error)
+			} else {
+				err = writeExportResult(w, result[0])
+			}
+		}
+	}
+	durationNs := time.Now().UnixNano() - start
+	if err != nil {
+		return durationNs, err
+	}
+
+	if err := w.Close(); err != nil {
+		return durationNs, err
+	}
+	if err := bufferedOut.Flush(); err != nil {
+		return durationNs, err
+	}
+	return durationNs, nil
+}
+
+// exportStreamSender sends each write as an ExportStream response.
+type exportStreamSender struct {
+	server v1pb.SQLService_ExportStreamServer
+}
+
+func (s *exportStreamSender) Write(p []byte) (int, error) {
+	// The gRPC stream may hold the message after Send returns, so we copy the buffer reused by the caller.
+	content := make([]byte, len(p))
+	copy(content, p)
+	if err := s.server.Send(&v1pb.ExportResponse{Content: content}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// exportWriter writes a streamed query result in an export format.
+type exportWriter interface {
+	db.QueryResultWriter
+	// Close writes the trailing content of the format. It doesn't close the underlying writer.
+	Close() error
+}
+
+func newExportWriter(format v1pb.ExportFormat, engine db.Type, resourceList []parser.SchemaResource, w io.Writer) (exportWriter, error) {
+	switch format {
+	case v1pb.ExportFormat_CSV:
+		return &csvExportWriter{w: w}, nil
+	case v1pb.ExportFormat_JSON:
+		return &jsonExportWriter{w: w}, nil
+	case v1pb.ExportFormat_SQL:
+		return &sqlExportWriter{w: w, engine: engine, resourceList: resourceList}, nil
+	case v1pb.ExportFormat_XLSX:
+		return &xlsxExportWriter{w: w}, nil
+	case v1pb.ExportFormat_PARQUET:
+		return &parquetExportWriter{w: w}, nil
+	case v1pb.ExportFormat_NDJSON:
+		return &ndjsonExportWriter{w: w}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", format.String())
+	}
+}
+
+// writeExportResult writes a buffered query result with the export writer.
+func writeExportResult(w exportWriter, result *v1pb.QueryResult) error {
+	if err := w.WriteHeader(result); err != nil {
+		return err
+	}
+	for _, row := range result.Rows {
+		if err := w.WriteRow(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type csvExportWriter struct {
+	w        io.Writer
+	rowCount int
+}
+
+func (e *csvExportWriter) WriteHeader(result *v1pb.QueryResult) error {
+	header, err := exportCSV(&v1pb.QueryResult{ColumnNames: result.ColumnNames})
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(header)
+	return err
+}
+
+func (e *csvExportWriter) WriteRow(row *v1pb.QueryRow) error {
+	if e.rowCount > 0 {
+		if _, err := e.w.Write([]byte{'\n'}); err != nil {
+			return err
+		}
+	}
+	e.rowCount++
+	for i, value := range row.Values {
+		if i != 0 {
+			if _, err := e.w.Write([]byte{','}); err != nil {
+				return err
+			}
+		}
+		if _, err := e.w.Write(convertValueToBytesInCSV(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*csvExportWriter) Close() error {
+	return nil
+}
+
+type jsonExportWriter struct {
+	w           io.Writer
+	columnNames []string
+	rowCount    int
+}
+
+func (e *jsonExportWriter) WriteHeader(result *v1pb.QueryResult) error {
+	e.columnNames = result.ColumnNames
+	_, err := e.w.Write([]byte("["))
+	return err
+}
+
+func (e *jsonExportWriter) WriteRow(row *v1pb.QueryRow) error {
+	separator := ",\n  "
+	if e.rowCount == 0 {
+		separator = "\n  "
+	}
+	e.rowCount++
+	if _, err := e.w.Write([]byte(separator)); err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(convertRowToJSONObject(e.columnNames, row), "  ", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(content)
+	return err
+}
+
+func (e *jsonExportWriter) Close() error {
+	if e.rowCount == 0 {
+		_, err := e.w.Write([]byte("]"))
+		return err
+	}
+	_, err := e.w.Write([]byte("\n]"))
+	return err
+}
+
+type ndjsonExportWriter struct {
+	w           io.Writer
+	columnNames []string
+}
+
+func (e *ndjsonExportWriter) WriteHeader(result *v1pb.QueryResult) error {
+	e.columnNames = result.ColumnNames
+	return nil
+}
+
+func (e *ndjsonExportWriter) WriteRow(row *v1pb.QueryRow) error {
+	content, err := json.Marshal(convertRowToJSONObject(e.columnNames, row))
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(append(content, '\n'))
+	return err
+}
+
+func (*ndjsonExportWriter) Close() error {
+	return nil
+}
+
+func convertRowToJSONObject(columnNames []string, row *v1pb.QueryRow) map[string]any {
+	m := make(map[string]any)
+	for i, value := range row.Values {
+		m[columnNames[i]] = convertValueToStringInJSON(value)
+	}
+	return m
+}
+
+type sqlExportWriter struct {
+	w               io.Writer
+	engine          db.Type
+	resourceList    []parser.SchemaResource
+	statementPrefix string
+	rowCount        int
+}
+
+func (e *sqlExportWriter) WriteHeader(result *v1pb.QueryResult) error {
+	statementPrefix, err := getSQLStatementPrefix(e.engine, e.resourceList, result.ColumnNames)
+	if err != nil {
+		return err
+	}
+	e.statementPrefix = statementPrefix
+	return nil
+}
+
+func (e *sqlExportWriter) WriteRow(row *v1pb.QueryRow) error {
+	if e.rowCount > 0 {
+		if _, err := e.w.Write([]byte{'\n'}); err != nil {
+			return err
+		}
+	}
+	e.rowCount++
+	content, err := exportSQL(e.engine, e.statementPrefix, &v1pb.QueryResult{Rows: []*v1pb.QueryRow{row}})
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(content)
+	return err
+}
+
+func (*sqlExportWriter) Close() error {
+	return nil
+}
+
+type xlsxExportWriter struct {
+	w        io.Writer
+	f        *excelize.File
+	sw       *excelize.StreamWriter
+	rowCount int
+}
+
+func (e *xlsxExportWriter) WriteHeader(result *v1pb.QueryResult) error {
+	if len(result.ColumnNames) > excelMaxColumn {
+		return errors.Errorf("column count cannot be greater than %v (column ZZZ)", excelMaxColumn)
+	}
+	e.f = excelize.NewFile()
+	sw, err := e.f.NewStreamWriter(sheet1Name)
+	if err != nil {
+		return err
+	}
+	e.sw = sw
+	var header []any
+	for _, columnName := range result.ColumnNames {
+		header = append(header, columnName)
+	}
+	return e.writeRow(header)
+}
+
+func (e *xlsxExportWriter) WriteRow(row *v1pb.QueryRow) error {
+	var values []any
+	for _, value := range row.Values {
+		values = append(values, convertValueToStringInXLSX(value))
+	}
+	return e.writeRow(values)
+}
+
+func (e *xlsxExportWriter) writeRow(values []any) error {
+	e.rowCount++
+	cell, err := excelize.CoordinatesToCellName(1, e.rowCount)
+	if err != nil {
+		return err
+	}
+	return e.sw.SetRow(cell, values)
+}
+
+func (e *xlsxExportWriter) Close() error {
+	if e.f == nil {
+		return nil
+	}
+	defer e.f.Close()
+	if err := e.sw.Flush(); err != nil {
+		return err
+	}
+	return e.f.Write(e.w)
+}
+
+// parquetExportWriter writes the query result as a parquet file.
+// The column types are derived from the database column types, and the masked columns are always strings.
+type parquetExportWriter struct {
+	w        io.Writer
+	fw       *pqarrow.FileWriter
+	builder  *array.RecordBuilder
+	rowCount int
+}
+
+func (e *parquetExportWriter) WriteHeader(result *v1pb.QueryResult) error {
+	var fields []arrow.Field
+	for i, columnName := range result.ColumnNames {
+		var dataType arrow.DataType = arrow.BinaryTypes.String
+		if i < len(result.ColumnTypeNames) && !(i < len(result.Masked) && result.Masked[i]) {
+			dataType = getParquetDataType(result.ColumnTypeNames[i])
+		}
+		fields = append(fields, arrow.Field{Name: columnName, Type: dataType, Nullable: true})
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	fw, err := pqarrow.NewFileWriter(schema, e.w, parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return errors.Wrapf(err, "failed to create parquet writer")
+	}
+	e.fw = fw
+	e.builder = array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	return nil
+}
+
+func (e *parquetExportWriter) WriteRow(row *v1pb.QueryRow) error {
+	for i, value := range row.Values {
+		if err := appendParquetValue(e.builder.Field(i), value); err != nil {
+			return errors.Wrapf(err, "failed to convert column %q", e.builder.Schema().Field(i).Name)
+		}
+	}
+	e.rowCount++
+	if e.rowCount%parquetRecordBatchSize == 0 {
+		return e.flush()
+	}
+	return nil
+}
+
+func (e *parquetExportWriter) flush() error {
+	record := e.builder.NewRecord()
+	defer record.Release()
+	return e.fw.Write(record)
+}
+
+func (e *parquetExportWriter) Close() error {
+	if e.fw == nil {
+		return nil
+	}
+	defer e.builder.Release()
+	if e.rowCount%parquetRecordBatchSize != 0 {
+		if err := e.flush(); err != nil {
+			return err
+		}
+	}
+	return e.fw.Close()
+}
+
+// getParquetDataType returns the parquet column type for the database column type.
+// It follows the scan types of the query result, and the other types such as decimals and timestamps are kept as strings to preserve their precision.
+func getParquetDataType(columnTypeName string) arrow.DataType {
+	switch columnTypeName {
+	case "INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT", "MEDIUMINT", "INT2", "INT4", "INT8":
+		return arrow.PrimitiveTypes.Int64
+	case "FLOAT", "DOUBLE", "REAL", "FLOAT4", "FLOAT8":
+		return arrow.PrimitiveTypes.Float64
+	case "BOOL", "BOOLEAN":
+		return arrow.FixedWidthTypes.Boolean
+	case "BIT", "VARBIT", "BYTEA", "BINARY", "VARBINARY", "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB":
+		return arrow.BinaryTypes.Binary
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+func appendParquetValue(builder array.Builder, value *v1pb.RowValue) error {
+	if _, ok := value.Kind.(*v1pb.RowValue_NullValue); ok {
+		builder.AppendNull()
+		return nil
+	}
+	switch b := builder.(type) {
+	case *array.Int64Builder:
+		switch value.Kind.(type) {
+		case *v1pb.RowValue_Int32Value:
+			b.Append(int64(value.GetInt32Value()))
+		case *v1pb.RowValue_Int64Value:
+			b.Append(value.GetInt64Value())
+		case *v1pb.RowValue_Uint32Value:
+			b.Append(int64(value.GetUint32Value()))
+		default:
+			v, err := strconv.ParseInt(convertValueToStringInJSON(value), 10, 64)
+			if err != nil {
+				return err
+			}
+			b.Append(v)
+		}
+	case *array.Float64Builder:
+		switch value.Kind.(type) {
+		case *v1pb.RowValue_FloatValue:
+			b.Append(float64(value.GetFloatValue()))
+		case *v1pb.RowValue_DoubleValue:
+			b.Append(value.GetDoubleValue())
+		default:
+			v, err := strconv.ParseFloat(convertValueToStringInJSON(value), 64)
+			if err != nil {
+				return err
+			}
+			b.Append(v)
+		}
+	case *array.BooleanBuilder:
+		v, err := strconv.ParseBool(convertValueToStringInJSON(value))
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.BinaryBuilder:
+		if _, ok := value.Kind.(*v1pb.RowValue_BytesValue); ok {
+			b.Append(value.GetBytesValue())
+		} else {
+			b.Append([]byte(convertValueToStringInXLSX(value)))
+		}
+	case *array.StringBuilder:
+		b.Append(convertValueToStringInXLSX(value))
+	default:
+		return errors.Errorf("unsupported parquet column type %T", builder)
+	}
+	return nil
+}
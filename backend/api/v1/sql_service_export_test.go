@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func newTestExportResult() *v1pb.QueryResult {
+	return &v1pb.QueryResult{
+		ColumnNames:     []string{"id", "name", "score", "email"},
+		ColumnTypeNames: []string{"INT", "VARCHAR", "DOUBLE", "VARCHAR"},
+		Masked:          []bool{false, false, false, true},
+		Rows: []*v1pb.QueryRow{
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "alice"}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "9.5"}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				},
+			},
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_Int64Value{Int64Value: 2}},
+					{Kind: &v1pb.RowValue_NullValue{}},
+					{Kind: &v1pb.RowValue_NullValue{}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				},
+			},
+		},
+	}
+}
+
+func writeTestExport(t *testing.T, format v1pb.ExportFormat, result *v1pb.QueryResult) []byte {
+	var buf bytes.Buffer
+	w, err := newExportWriter(format, db.MySQL, nil, &buf)
+	require.NoError(t, err)
+	require.NoError(t, writeExportResult(w, result))
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestExportWriterMatchesBufferedExport(t *testing.T) {
+	a := require.New(t)
+	result := newTestExportResult()
+
+	want, err := exportCSV(result)
+	a.NoError(err)
+	a.Equal(string(want), string(writeTestExport(t, v1pb.ExportFormat_CSV, result)))
+
+	want, err = exportJSON(result)
+	a.NoError(err)
+	a.Equal(string(want), string(writeTestExport(t, v1pb.ExportFormat_JSON, result)))
+
+	want, err = exportSQL(db.MySQL, "INSERT INTO `<table_name>` (`id`,`name`,`score`,`email`) VALUES (", result)
+	a.NoError(err)
+	a.Equal(string(want), string(writeTestExport(t, v1pb.ExportFormat_SQL, result)))
+}
+
+func TestExportNDJSON(t *testing.T) {
+	got := writeTestExport(t, v1pb.ExportFormat_NDJSON, newTestExportResult())
+	want := `{"email":"******","id":"1","name":"alice","score":"9.5"}
+{"email":"******","id":"2","name":"null","score":"null"}
+`
+	require.Equal(t, want, string(got))
+}
+
+func TestExportParquet(t *testing.T) {
+	a := require.New(t)
+	got := writeTestExport(t, v1pb.ExportFormat_PARQUET, newTestExportResult())
+
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(got), parquet.NewReaderProperties(memory.DefaultAllocator), pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	a.NoError(err)
+	defer table.Release()
+
+	a.EqualValues(2, table.NumRows())
+	schema := table.Schema()
+	a.Equal(arrow.PrimitiveTypes.Int64, schema.Field(0).Type)
+	a.Equal(arrow.BinaryTypes.String, schema.Field(1).Type)
+	a.Equal(arrow.PrimitiveTypes.Float64, schema.Field(2).Type)
+	// The masked column is always a string column.
+	a.Equal(arrow.BinaryTypes.String, schema.Field(3).Type)
+
+	ids := table.Column(0).Data().Chunk(0).(*array.Int64)
+	a.Equal([]int64{1, 2}, ids.Int64Values())
+	scores := table.Column(2).Data().Chunk(0).(*array.Float64)
+	a.Equal(9.5, scores.Value(0))
+	a.True(scores.IsNull(1))
+	names := table.Column(1).Data().Chunk(0).(*array.String)
+	a.Equal("alice", names.Value(0))
+	a.True(names.IsNull(1))
+}
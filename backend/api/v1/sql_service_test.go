@@ -450,3 +450,73 @@ func TestEvalMaskingLevelOfColumn(t *testing.T) {
 		a.Equal(tc.want, result, tc.description)
 	}
 }
+
+func TestMaskerResolver(t *testing.T) {
+	a := require.New(t)
+	resolver := newMaskerResolver(
+		&storepb.MaskingAlgorithmSetting{
+			Algorithms: []*storepb.MaskingAlgorithmSetting_MaskingAlgorithm{
+				{
+					Id:   "rule",
+					Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_FullMask_{FullMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_FullMask{Substitution: "rule"}},
+				},
+				{
+					Id:   "semantic",
+					Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_FullMask_{FullMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_FullMask{Substitution: "semantic"}},
+				},
+			},
+		},
+		&storepb.SemanticTypesSetting{
+			Types: []*storepb.SemanticTypesSetting_SemanticType{
+				{Id: "email", FullMaskAlgorithmId: "semantic"},
+			},
+		},
+	)
+	value := &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "value"}}
+
+	m, err := resolver.getMasker(storepb.MaskingLevel_FULL, "rule", []string{"email"})
+	a.NoError(err)
+	a.Equal("rule", m.Mask(value).GetStringValue())
+
+	m, err = resolver.getMasker(storepb.MaskingLevel_FULL, "", []string{"unknown", "email"})
+	a.NoError(err)
+	a.Equal("semantic", m.Mask(value).GetStringValue())
+
+	// The semantic type has no algorithm for the partial masking level.
+	m, err = resolver.getMasker(storepb.MaskingLevel_PARTIAL, "", []string{"email"})
+	a.NoError(err)
+	a.Nil(m)
+}
+
+func TestEvaluateRowFilterPolicyCondition(t *testing.T) {
+	a := require.New(t)
+	attributes := map[string]any{
+		"resource.environment_id": "prod",
+		"resource.instance_id":    "mysql",
+		"resource.database_name":  "db",
+		"resource.schema_name":    "",
+		"resource.table_name":     "orders",
+		"user.attributes":         map[string]string{"tenant": "acme"},
+	}
+
+	matched, err := evaluateRowFilterPolicyCondition(`resource.table_name == "orders" && "tenant" in user.attributes`, attributes)
+	a.NoError(err)
+	a.True(matched)
+
+	matched, err = evaluateRowFilterPolicyCondition(`resource.environment_id == "test"`, attributes)
+	a.NoError(err)
+	a.False(matched)
+
+	_, err = evaluateRowFilterPolicyCondition(`user.attributes["region"] == "eu"`, attributes)
+	a.Error(err)
+
+	// The users can change their own email.
+	_, err = evaluateRowFilterPolicyCondition(`user.email == "bob@example.com"`, attributes)
+	a.Error(err)
+}
+
+func TestGetRowFilterQuoter(t *testing.T) {
+	a := require.New(t)
+	a.Equal(`'O''Neil\\'`, getRowFilterQuoter(db.MySQL)(`O'Neil\`))
+	a.Equal(`'O''Neil\'`, getRowFilterQuoter(db.Postgres)(`O'Neil\`))
+}
@@ -0,0 +1,212 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// UserGroupService implements the user group service.
+type UserGroupService struct {
+	v1pb.UnimplementedUserGroupServiceServer
+	store *store.Store
+}
+
+// NewUserGroupService returns a new instance of the user group service.
+func NewUserGroupService(store *store.Store) *UserGroupService {
+	return &UserGroupService{
+		store: store,
+	}
+}
+
+// GetUserGroup gets a user group.
+func (s *UserGroupService) GetUserGroup(ctx context.Context, request *v1pb.GetUserGroupRequest) (*v1pb.UserGroup, error) {
+	group, err := s.getUserGroup(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToUserGroup(ctx, group)
+}
+
+// ListUserGroups lists user groups.
+func (s *UserGroupService) ListUserGroups(ctx context.Context, _ *v1pb.ListUserGroupsRequest) (*v1pb.ListUserGroupsResponse, error) {
+	groups, err := s.store.ListUserGroups(ctx, &store.FindUserGroupMessage{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user groups: %v", err)
+	}
+	response := &v1pb.ListUserGroupsResponse{}
+	for _, group := range groups {
+		v1Group, err := s.convertToUserGroup(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		response.Groups = append(response.Groups, v1Group)
+	}
+	return response, nil
+}
+
+// CreateUserGroup creates a user group.
+func (s *UserGroupService) CreateUserGroup(ctx context.Context, request *v1pb.CreateUserGroupRequest) (*v1pb.UserGroup, error) {
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group must be set")
+	}
+	if !isValidResourceID(request.GroupId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group ID %v", request.GroupId)
+	}
+	if request.Group.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "group title must be set")
+	}
+	existing, err := s.store.GetUserGroup(ctx, &store.FindUserGroupMessage{ResourceID: &request.GroupId})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user group: %v", err)
+	}
+	if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "user group %q already exists", request.GroupId)
+	}
+	memberIDs, err := s.convertToMemberIDs(ctx, request.Group.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	principalID := ctx.Value(common.PrincipalIDContextKey).(int)
+	group, err := s.store.CreateUserGroup(ctx, &store.UserGroupMessage{
+		ResourceID:  request.GroupId,
+		Title:       request.Group.Title,
+		Description: request.Group.Description,
+		MemberIDs:   memberIDs,
+	}, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create user group: %v", err)
+	}
+	return s.convertToUserGroup(ctx, group)
+}
+
+// UpdateUserGroup updates a user group.
+func (s *UserGroupService) UpdateUserGroup(ctx context.Context, request *v1pb.UpdateUserGroupRequest) (*v1pb.UserGroup, error) {
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group must be set")
+	}
+	if request.UpdateMask == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
+	}
+	group, err := s.getUserGroup(ctx, request.Group.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	principalID := ctx.Value(common.PrincipalIDContextKey).(int)
+	patch := &store.UpdateUserGroupMessage{
+		UID:       group.UID,
+		UpdaterID: principalID,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			if request.Group.Title == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "group title must be set")
+			}
+			patch.Title = &request.Group.Title
+		case "description":
+			patch.Description = &request.Group.Description
+		case "members":
+			if group.IdentityProviderUID != nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "cannot update the members of group %q synced from the identity provider", request.Group.Name)
+			}
+			memberIDs, err := s.convertToMemberIDs(ctx, request.Group.Members)
+			if err != nil {
+				return nil, err
+			}
+			patch.MemberIDs = &memberIDs
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update mask path: %s", path)
+		}
+	}
+
+	group, err = s.store.UpdateUserGroup(ctx, patch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user group: %v", err)
+	}
+	return s.convertToUserGroup(ctx, group)
+}
+
+// DeleteUserGroup deletes a user group.
+func (s *UserGroupService) DeleteUserGroup(ctx context.Context, request *v1pb.DeleteUserGroupRequest) (*emptypb.Empty, error) {
+	group, err := s.getUserGroup(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.DeleteUserGroup(ctx, group.UID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete user group: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserGroupService) getUserGroup(ctx context.Context, name string) (*store.UserGroupMessage, error) {
+	groupID, err := common.GetUserGroupID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	group, err := s.store.GetUserGroup(ctx, &store.FindUserGroupMessage{ResourceID: &groupID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user group: %v", err)
+	}
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "user group %q not found", name)
+	}
+	return group, nil
+}
+
+func (s *UserGroupService) convertToMemberIDs(ctx context.Context, members []string) ([]int, error) {
+	var memberIDs []int
+	for _, member := range members {
+		email, err := common.GetUserEmail(member)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid member %q: %v", member, err)
+		}
+		user, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user %q: %v", email, err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.NotFound, "user %q not found", member)
+		}
+		memberIDs = append(memberIDs, user.ID)
+	}
+	return memberIDs, nil
+}
+
+func (s *UserGroupService) convertToUserGroup(ctx context.Context, group *store.UserGroupMessage) (*v1pb.UserGroup, error) {
+	v1Group := &v1pb.UserGroup{
+		Name:        fmt.Sprintf("%s%s", common.UserGroupNamePrefix, group.ResourceID),
+		Title:       group.Title,
+		Description: group.Description,
+		ExternalId:  group.ExternalID,
+	}
+	for _, memberID := range group.MemberIDs {
+		user, err := s.store.GetUserByID(ctx, memberID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user %d: %v", memberID, err)
+		}
+		if user == nil {
+			continue
+		}
+		v1Group.Members = append(v1Group.Members, fmt.Sprintf("%s%s", common.UserNamePrefix, user.Email))
+	}
+	if group.IdentityProviderUID != nil {
+		idp, err := s.store.GetIdentityProvider(ctx, &store.FindIdentityProviderMessage{UID: group.IdentityProviderUID, ShowDeleted: true})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get identity provider: %v", err)
+		}
+		if idp != nil {
+			v1Group.IdentityProvider = fmt.Sprintf("%s%s", common.IdentityProviderNamePrefix, idp.ResourceID)
+		}
+	}
+	return v1Group, nil
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/xo/dburl"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
 )
 
 func newDumpCmd() *cobra.Command {
@@ -49,13 +51,17 @@ func newDumpCmd() *cobra.Command {
 // dumpDatabase exports the schema of a database instance.
 // When file isn't specified, the schema will be exported to stdout.
 func dumpDatabase(ctx context.Context, u *dburl.URL, out io.Writer, schemaOnly bool) error {
-	db, err := open(ctx, u)
+	capability := db.CapabilityDump
+	if schemaOnly {
+		capability = db.CapabilitySchemaDump
+	}
+	driver, err := open(ctx, u, capability)
 	if err != nil {
 		return err
 	}
-	defer db.Close(ctx)
+	defer driver.Close(ctx)
 
-	if _, err := db.Dump(ctx, out, schemaOnly); err != nil {
+	if _, err := driver.Dump(ctx, out, schemaOnly); err != nil {
 		return errors.Wrap(err, "failed to create dump")
 	}
 	return nil
@@ -8,12 +8,16 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/xo/dburl"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/storage/codec"
 )
 
 func newRestoreCmd() *cobra.Command {
 	var (
-		dsn  string
-		file string
+		dsn           string
+		file          string
+		encryptionKey string
 	)
 	restoreCmd := &cobra.Command{
 		Use:   "restore",
@@ -23,11 +27,12 @@ func newRestoreCmd() *cobra.Command {
 			if err != nil {
 				return errors.Wrap(err, "failed to parse dsn")
 			}
-			return restoreDatabase(context.Background(), u, file)
+			return restoreDatabase(context.Background(), u, file, encryptionKey)
 		},
 	}
 	restoreCmd.Flags().StringVar(&dsn, "dsn", "", dsnUsage)
 	restoreCmd.Flags().StringVar(&file, "file", "", "File to store the dump.")
+	restoreCmd.Flags().StringVar(&encryptionKey, "encryption-key", "", "Reference of the key to decrypt an encrypted backup file, e.g., env://BB_BACKUP_KEY or file:///path/to/key. Defaults to the key reference recorded in the backup file.")
 	if err := restoreCmd.MarkFlagRequired("file"); err != nil {
 		panic(err)
 	}
@@ -36,20 +41,32 @@ func newRestoreCmd() *cobra.Command {
 }
 
 // restoreDatabase restores the schema of a database instance.
-func restoreDatabase(ctx context.Context, u *dburl.URL, file string) error {
+func restoreDatabase(ctx context.Context, u *dburl.URL, file, encryptionKey string) error {
 	f, err := os.Open(file)
 	if err != nil {
 		return errors.Wrapf(err, "failed to open file %q", file)
 	}
 	defer f.Close()
+	resolveKey := codec.ResolveKey
+	if encryptionKey != "" {
+		resolveKey = func(string) ([]byte, error) {
+			return codec.ResolveKey(encryptionKey)
+		}
+	}
+	// Backup files taken by Bytebase may be compressed and encrypted.
+	r, err := codec.NewReader(f, resolveKey)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode backup file %q", file)
+	}
+	defer r.Close()
 
-	db, err := open(ctx, u)
+	driver, err := open(ctx, u, db.CapabilityRestore)
 	if err != nil {
 		return err
 	}
-	defer db.Close(ctx)
+	defer driver.Close(ctx)
 
-	if err := db.Restore(ctx, f); err != nil {
+	if err := driver.Restore(ctx, r); err != nil {
 		return errors.Wrapf(err, "failed to restore from backup file %q", file)
 	}
 	return nil
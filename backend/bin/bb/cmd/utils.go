@@ -11,6 +11,8 @@ import (
 	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
 
+	// register mssql driver.
+	_ "github.com/bytebase/bytebase/backend/plugin/db/mssql"
 	// install mysql driver.
 	_ "github.com/bytebase/bytebase/backend/plugin/db/mysql"
 	// register pg driver.
@@ -25,7 +27,8 @@ func getDatabase(u *dburl.URL) string {
 	return u.Path[1:]
 }
 
-func open(ctx context.Context, u *dburl.URL) (db.Driver, error) {
+// open opens the database driver for the URL, and returns an error if the driver does not support any of the required capabilities.
+func open(ctx context.Context, u *dburl.URL, capabilities ...db.Capability) (db.Driver, error) {
 	var dbType db.Type
 	var dbBinDir string
 	resourceDir := os.TempDir()
@@ -44,8 +47,15 @@ func open(ctx context.Context, u *dburl.URL) (db.Driver, error) {
 			return nil, errors.Wrapf(err, "cannot install postgres in directory %q", resourceDir)
 		}
 		dbBinDir = dir
+	case "sqlserver":
+		dbType = db.MSSQL
 	default:
-		return nil, errors.Errorf("database type %q not supported; supported types: mysql, pg", u.Driver)
+		return nil, errors.Errorf("database type %q not supported; supported types: mysql, pg, sqlserver", u.Driver)
+	}
+	for _, capability := range capabilities {
+		if err := db.CheckCapability(dbType, capability); err != nil {
+			return nil, err
+		}
 	}
 	passwd, _ := u.User.Password()
 	driver, err := db.Open(
@@ -1,17 +1,19 @@
 package cmd
 
 import (
+	"strings"
 	"time"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/secret"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 )
 
 func getBaseProfile(dataDir string) config.Profile {
 	backupStorageBackend := api.BackupStorageBackendLocal
-	if flags.backupBucket != "" {
-		backupStorageBackend = api.BackupStorageBackendS3
+	if len(flags.backupBucketConfigs) > 0 {
+		backupStorageBackend = flags.backupBucketConfigs[0].StorageBackend
 	}
 
 	sampleDatabasePort := 0
@@ -21,22 +23,29 @@ func getBaseProfile(dataDir string) config.Profile {
 	}
 
 	return config.Profile{
-		ExternalURL:          flags.externalURL,
-		GrpcPort:             flags.port + 1, // Using flags.port + 1 as our gRPC server port.
-		DatastorePort:        flags.port + 2, // Using flags.port + 2 as our datastore port.
-		SampleDatabasePort:   sampleDatabasePort,
-		Readonly:             flags.readonly,
-		SaaS:                 flags.saas,
-		DataDir:              dataDir,
-		ResourceDir:          common.GetResourceDir(dataDir),
-		DemoName:             flags.demoName,
-		Version:              version,
-		GitCommit:            gitcommit,
-		PgURL:                flags.pgURL,
-		BackupStorageBackend: backupStorageBackend,
-		BackupRegion:         flags.backupRegion,
-		BackupBucket:         flags.backupBucket,
-		BackupCredentialFile: flags.backupCredential,
-		LastActiveTs:         time.Now().Unix(),
+		ExternalURL:            flags.externalURL,
+		GrpcPort:               flags.port + 1, // Using flags.port + 1 as our gRPC server port.
+		DatastorePort:          flags.port + 2, // Using flags.port + 2 as our datastore port.
+		SampleDatabasePort:     sampleDatabasePort,
+		Readonly:               flags.readonly,
+		SaaS:                   flags.saas,
+		DataDir:                dataDir,
+		ResourceDir:            common.GetResourceDir(dataDir),
+		DemoName:               flags.demoName,
+		Version:                version,
+		GitCommit:              gitcommit,
+		PgURL:                  flags.pgURL,
+		BackupStorageBackend:   backupStorageBackend,
+		BackupBuckets:          flags.backupBucketConfigs,
+		BackupCompression:      api.BackupCompression(strings.ToUpper(flags.backupCompression)),
+		BackupEncryptionKeyRef: flags.backupEncryptionKey,
+		RestoreDrillInstance:   flags.restoreDrillInstance,
+		RestoreDrillInterval:   flags.restoreDrillInterval,
+		ExternalSecretAllowlist: &secret.Allowlist{
+			Envs:              flags.externalSecretEnvs,
+			FileDirs:          flags.externalSecretFileDirs,
+			VaultPathPrefixes: flags.externalSecretVaultPathPrefixes,
+		},
+		LastActiveTs: time.Now().Unix(),
 	}
 }
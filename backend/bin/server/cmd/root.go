@@ -7,17 +7,22 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/storage/codec"
 	"github.com/bytebase/bytebase/backend/server"
 )
 
@@ -83,8 +88,21 @@ var (
 
 		// Cloud backup configs.
 		backupRegion     string
-		backupBucket     string
+		backupBuckets    []string
+		backupEndpoint   string
 		backupCredential string
+		// backupBucketConfigs is parsed from the cloud backup configs.
+		backupBucketConfigs []*config.BackupBucket
+		// Backup artifact encoding configs.
+		backupCompression   string
+		backupEncryptionKey string
+		// Restore drill configs.
+		restoreDrillInstance string
+		restoreDrillInterval time.Duration
+		// External secret configs.
+		externalSecretEnvs              []string
+		externalSecretFileDirs          []string
+		externalSecretVaultPathPrefixes []string
 	}
 
 	rootCmd = &cobra.Command{
@@ -130,10 +148,18 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&flags.disableSample, "disable-sample", false, "disable the sample instance")
 
 	// Cloud backup related flags.
-	// TODO(dragonly): Add GCS usages when it's supported.
-	rootCmd.PersistentFlags().StringVar(&flags.backupBucket, "backup-bucket", "", "bucket where Bytebase stores backup data, e.g., s3://example-bucket. When provided, Bytebase will store data to the S3 bucket.")
+	rootCmd.PersistentFlags().StringArrayVar(&flags.backupBuckets, "backup-bucket", nil, "bucket where Bytebase stores backup data, e.g., s3://example-bucket, gs://example-bucket or azblob://example-container. It can be repeated to configure buckets for different storage backends, and the first one is the default backup storage. The region, endpoint and credential can be overridden per bucket with the query parameters, e.g., s3://example-bucket?region=us-west-2&credential=/path/to/credential.")
 	rootCmd.PersistentFlags().StringVar(&flags.backupRegion, "backup-region", "", "region of the backup bucket, e.g., us-west-2 for AWS S3.")
-	rootCmd.PersistentFlags().StringVar(&flags.backupCredential, "backup-credential", "", "credentials file to use for the backup bucket. It should be the same format as the AWS/GCP credential files.")
+	rootCmd.PersistentFlags().StringVar(&flags.backupEndpoint, "backup-endpoint", "", "endpoint of the S3-compatible storage for the backup bucket, e.g., http://minio:9000 for MinIO.")
+	rootCmd.PersistentFlags().StringVar(&flags.backupCompression, "backup-compression", "none", "compression algorithm of the backup artifacts, one of none, gzip and zstd.")
+	rootCmd.PersistentFlags().StringVar(&flags.backupEncryptionKey, "backup-encryption-key", "", "reference of the base64-encoded 256-bit key to encrypt the backup artifacts, e.g., env://BB_BACKUP_KEY or file:///path/to/key. The backup artifacts are not encrypted if it is empty.")
+	rootCmd.PersistentFlags().StringVar(&flags.restoreDrillInstance, "restore-drill-instance", "", "resource ID of the instance to restore the latest backups into scratch databases periodically, so that broken backups are detected before they are needed. Restore drills are disabled if it is empty.")
+	rootCmd.PersistentFlags().DurationVar(&flags.restoreDrillInterval, "restore-drill-interval", 24*time.Hour, "interval between restore drills.")
+	// External secret related flags.
+	rootCmd.PersistentFlags().StringSliceVar(&flags.externalSecretEnvs, "external-secret-env", nil, "names of the environment variables that the external secrets can read with env://<name>, e.g., DB_PASSWORD. The env:// secrets are disabled if it is empty.")
+	rootCmd.PersistentFlags().StringSliceVar(&flags.externalSecretFileDirs, "external-secret-file-dir", nil, "directories whose files the external secrets can read with file://<path>, e.g., /run/secrets. The file:// secrets are disabled if it is empty.")
+	rootCmd.PersistentFlags().StringSliceVar(&flags.externalSecretVaultPathPrefixes, "external-secret-vault-path", nil, "HashiCorp Vault API path prefixes that the external secrets can read with vault://<path>, e.g., secret/data/bytebase. The vault:// secrets are disabled if it is empty.")
+	rootCmd.PersistentFlags().StringVar(&flags.backupCredential, "backup-credential", "", "credentials file to use for the backup bucket. It should be the same format as the AWS/GCP credential files, or contain the connection string for Azure Blob Storage.")
 }
 
 // -----------------------------------Command Line Config END--------------------------------------
@@ -159,22 +185,96 @@ func checkDataDir() error {
 }
 
 func checkCloudBackupFlags() error {
-	if flags.backupBucket == "" {
-		return nil
+	flags.backupBucketConfigs = nil
+	for _, bucketURI := range flags.backupBuckets {
+		bucket, err := parseBackupBucket(bucketURI)
+		if err != nil {
+			return err
+		}
+		for _, b := range flags.backupBucketConfigs {
+			if b.StorageBackend == bucket.StorageBackend {
+				return errors.Errorf("only one --backup-bucket is allowed for storage backend %s", bucket.StorageBackend)
+			}
+		}
+		flags.backupBucketConfigs = append(flags.backupBucketConfigs, bucket)
 	}
-	if !strings.HasPrefix(flags.backupBucket, "s3://") {
-		return errors.Errorf("only support bucket URI starting with s3://")
+	return nil
+}
+
+func checkBackupEncodingFlags() error {
+	switch api.BackupCompression(strings.ToUpper(flags.backupCompression)) {
+	case api.BackupCompressionNone, api.BackupCompressionGzip, api.BackupCompressionZstd:
+	default:
+		return errors.Errorf("--backup-compression must be one of none, gzip and zstd, got %q", flags.backupCompression)
 	}
-	flags.backupBucket = strings.TrimPrefix(flags.backupBucket, "s3://")
-	if flags.backupCredential == "" {
-		return errors.Errorf("must specify --backup-credential when --backup-bucket is present")
+	if flags.backupEncryptionKey != "" {
+		// Resolve the key on startup so that a misconfigured key fails fast instead of failing every backup.
+		if _, err := codec.ResolveKey(flags.backupEncryptionKey); err != nil {
+			return errors.Wrap(err, "invalid --backup-encryption-key")
+		}
 	}
-	if flags.backupRegion == "" {
-		return errors.Errorf("must specify --backup-region for AWS S3 backup")
+	return nil
+}
+
+func checkRestoreDrillFlags() error {
+	if flags.restoreDrillInstance != "" && flags.restoreDrillInterval <= 0 {
+		return errors.Errorf("--restore-drill-interval must be positive, got %v", flags.restoreDrillInterval)
 	}
 	return nil
 }
 
+func parseBackupBucket(bucketURI string) (*config.BackupBucket, error) {
+	u, err := url.Parse(bucketURI)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid --backup-bucket %q", bucketURI)
+	}
+	bucket := &config.BackupBucket{
+		Bucket:         u.Host,
+		Region:         flags.backupRegion,
+		Endpoint:       flags.backupEndpoint,
+		CredentialFile: flags.backupCredential,
+	}
+	query := u.Query()
+	if v := query.Get("region"); v != "" {
+		bucket.Region = v
+	}
+	if v := query.Get("endpoint"); v != "" {
+		bucket.Endpoint = v
+	}
+	if v := query.Get("credential"); v != "" {
+		bucket.CredentialFile = v
+	}
+	if bucket.Bucket == "" {
+		return nil, errors.Errorf("bucket name is missing in --backup-bucket %q", bucketURI)
+	}
+
+	switch u.Scheme {
+	case "s3":
+		bucket.StorageBackend = api.BackupStorageBackendS3
+		if bucket.CredentialFile == "" {
+			return nil, errors.Errorf("must specify the credential for --backup-bucket %q", bucketURI)
+		}
+		// S3-compatible storages such as MinIO usually ignore the region, but the AWS SDK requires one.
+		if bucket.Region == "" && bucket.Endpoint == "" {
+			return nil, errors.Errorf("must specify the region for AWS S3 backup bucket %q", bucketURI)
+		}
+		if bucket.Region == "" {
+			bucket.Region = "us-east-1"
+		}
+	case "gs":
+		// GCS falls back to the application default credentials if the credential is not specified.
+		bucket.StorageBackend = api.BackupStorageBackendGCS
+	case "azblob":
+		bucket.StorageBackend = api.BackupStorageBackendAzureBlob
+		if bucket.CredentialFile == "" {
+			return nil, errors.Errorf("must specify the credential for --backup-bucket %q", bucketURI)
+		}
+	default:
+		return nil, errors.Errorf("only support bucket URI starting with s3://, gs:// or azblob://, got %q", bucketURI)
+	}
+	return bucket, nil
+}
+
 // Check the port availability by trying to bind and immediately release it.
 func checkPort(port int) error {
 	l, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", port))
@@ -207,6 +307,14 @@ func start() {
 		slog.Error("invalid flags for cloud backup", log.BBError(err))
 		return
 	}
+	if err := checkBackupEncodingFlags(); err != nil {
+		slog.Error("invalid flags for backup encoding", log.BBError(err))
+		return
+	}
+	if err := checkRestoreDrillFlags(); err != nil {
+		slog.Error("invalid flags for restore drill", log.BBError(err))
+		return
+	}
 
 	profile := activeProfile(flags.dataDir)
 
@@ -73,6 +73,33 @@ var MaskingExceptionPolicyCELAttributes = []cel.EnvOption{
 	cel.ParserExpressionSizeLimit(celLimit),
 }
 
+// RowFilterPolicyCELAttributes are the variables when evaluating row filter rules.
+// The user name and email are not available, because the users can change them by themselves.
+var RowFilterPolicyCELAttributes = []cel.EnvOption{
+	cel.Variable("resource.environment_id", cel.StringType),
+	cel.Variable("resource.instance_id", cel.StringType),
+	cel.Variable("resource.database_name", cel.StringType),
+	cel.Variable("resource.schema_name", cel.StringType),
+	cel.Variable("resource.table_name", cel.StringType),
+	cel.Variable("user.attributes", cel.MapType(cel.StringType, cel.StringType)),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
+// WebhookEventFilterCELAttributes are the variables when filtering the events sent to a webhook.
+var WebhookEventFilterCELAttributes = []cel.EnvOption{
+	// The activity type, e.g. "bb.issue.create".
+	cel.Variable("event_type", cel.StringType),
+	// One of "INFO", "SUCCESS", "WARN" and "ERROR".
+	cel.Variable("level", cel.StringType),
+	cel.Variable("issue_type", cel.StringType),
+	cel.Variable("task_status", cel.StringType),
+	// The environment resource IDs of the affected databases.
+	cel.Variable("environment_ids", cel.ListType(cel.StringType)),
+	// The affected databases in the format of "instances/{instance}/databases/{database}".
+	cel.Variable("database_names", cel.ListType(cel.StringType)),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
 var ProjectMemberCELAttributes = []cel.EnvOption{
 	cel.Variable("resource.environment_name", cel.StringType),
 	cel.Variable("resource.database", cel.StringType),
@@ -175,6 +202,26 @@ func ValidateGroupCELExpr(expr string) (cel.Program, error) {
 	return prog, nil
 }
 
+// ValidateWebhookEventFilterCELExpr validates the webhook event filter expr, which must be a boolean expression.
+func ValidateWebhookEventFilterCELExpr(expr string) (cel.Program, error) {
+	e, err := cel.NewEnv(WebhookEventFilterCELAttributes...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	ast, issues := e.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, issues.Err().Error())
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, status.Errorf(codes.InvalidArgument, "expect the webhook event filter to be a boolean expression, but got %v", ast.OutputType())
+	}
+	prog, err := e.Program(ast)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return prog, nil
+}
+
 // ValidateMaskingRuleCELExpr validates masking rule expr.
 func ValidateMaskingRuleCELExpr(expr string) (cel.Program, error) {
 	e, err := cel.NewEnv(
@@ -213,6 +260,26 @@ func ValidateMaskingExceptionCELExpr(expr string) (cel.Program, error) {
 	return prog, nil
 }
 
+// ValidateRowFilterCELExpr validates row filter rule expr, which must be a boolean expression.
+func ValidateRowFilterCELExpr(expr string) (cel.Program, error) {
+	e, err := cel.NewEnv(RowFilterPolicyCELAttributes...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	ast, issues := e.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, issues.Err().Error())
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, status.Errorf(codes.InvalidArgument, "expect the row filter condition to be a boolean expression, but got %v", ast.OutputType())
+	}
+	prog, err := e.Program(ast)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return prog, nil
+}
+
 func ValidateProjectMemberCELExpr(expression *expr.Expr) (cel.Program, error) {
 	if expression == nil || expression.Expression == "" {
 		return nil, nil
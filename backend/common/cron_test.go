@@ -0,0 +1,75 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	a := require.New(t)
+	for _, expr := range []string{"* * * * *", "0 22 * * 1-5", "*/15 0-6 1,15 * 0", "30 2 * 1-12/3 7"} {
+		_, err := ParseCron(expr)
+		a.NoError(err, expr)
+	}
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		_, err := ParseCron(expr)
+		a.Error(err, expr)
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	a := require.New(t)
+	newYork, err := time.LoadLocation("America/New_York")
+	a.NoError(err)
+
+	tests := []struct {
+		expr string
+		t    time.Time
+		want time.Time
+	}{
+		{
+			expr: "0 22 * * 1-5",
+			// Friday.
+			t:    time.Date(2023, 10, 13, 21, 59, 30, 0, time.UTC),
+			want: time.Date(2023, 10, 13, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "0 22 * * 1-5",
+			t:    time.Date(2023, 10, 13, 22, 0, 0, 0, time.UTC),
+			want: time.Date(2023, 10, 16, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "*/15 * * * *",
+			t:    time.Date(2023, 10, 13, 23, 50, 0, 0, time.UTC),
+			want: time.Date(2023, 10, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// Either the day of month or the day of week matches.
+			expr: "0 0 1 * 0",
+			t:    time.Date(2023, 10, 13, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2023, 10, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "0 0 29 2 *",
+			t:    time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "0 2 * * 7",
+			t:    time.Date(2023, 10, 13, 0, 0, 0, 0, newYork),
+			want: time.Date(2023, 10, 15, 2, 0, 0, 0, newYork),
+		},
+		{
+			expr: "0 0 31 2 *",
+			t:    time.Date(2023, 10, 13, 0, 0, 0, 0, time.UTC),
+			want: time.Time{},
+		},
+	}
+	for _, test := range tests {
+		schedule, err := ParseCron(test.expr)
+		a.NoError(err)
+		a.True(test.want.Equal(schedule.Next(test.t)), "%s: want %v, got %v", test.expr, test.want, schedule.Next(test.t))
+	}
+}
@@ -10,40 +10,43 @@ import (
 
 // nolint:revive
 const (
-	ProjectNamePrefix            = "projects/"
-	EnvironmentNamePrefix        = "environments/"
-	InstanceNamePrefix           = "instances/"
-	PolicyNamePrefix             = "policies/"
-	DatabaseIDPrefix             = "databases/"
-	InstanceRolePrefix           = "roles/"
-	UserNamePrefix               = "users/"
-	IdentityProviderNamePrefix   = "idps/"
-	SettingNamePrefix            = "settings/"
-	BackupPrefix                 = "backups/"
-	BookmarkPrefix               = "bookmarks/"
-	ExternalVersionControlPrefix = "externalVersionControls/"
-	RiskPrefix                   = "risks/"
-	IssuePrefix                  = "issues/"
-	RolloutPrefix                = "rollouts/"
-	StagePrefix                  = "stages/"
-	TaskPrefix                   = "tasks/"
-	TaskRunPrefix                = "taskRuns/"
-	PlanPrefix                   = "plans/"
-	PlanCheckRunPrefix           = "planCheckRuns/"
-	RolePrefix                   = "roles/"
-	SecretNamePrefix             = "secrets/"
-	WebhookIDPrefix              = "webhooks/"
-	SheetIDPrefix                = "sheets/"
-	DatabaseGroupNamePrefix      = "databaseGroups/"
-	SchemaGroupNamePrefix        = "schemaGroups/"
-	ChangeHistoryPrefix          = "changeHistories/"
-	IssueNamePrefix              = "issues/"
-	PipelineNamePrefix           = "pipelines/"
-	LogNamePrefix                = "logs/"
-	InboxNamePrefix              = "inbox/"
-	SchemaDesignPrefix           = "schemaDesigns/"
-	DeploymentConfigPrefix       = "deploymentConfigs/"
-	ChangelistsPrefix            = "changelists/"
+	ProjectNamePrefix              = "projects/"
+	EnvironmentNamePrefix          = "environments/"
+	InstanceNamePrefix             = "instances/"
+	PolicyNamePrefix               = "policies/"
+	DatabaseIDPrefix               = "databases/"
+	InstanceRolePrefix             = "roles/"
+	UserNamePrefix                 = "users/"
+	IdentityProviderNamePrefix     = "idps/"
+	SettingNamePrefix              = "settings/"
+	BackupPrefix                   = "backups/"
+	BookmarkPrefix                 = "bookmarks/"
+	ExternalVersionControlPrefix   = "externalVersionControls/"
+	RiskPrefix                     = "risks/"
+	IssuePrefix                    = "issues/"
+	RolloutPrefix                  = "rollouts/"
+	StagePrefix                    = "stages/"
+	TaskPrefix                     = "tasks/"
+	TaskRunPrefix                  = "taskRuns/"
+	PlanPrefix                     = "plans/"
+	PlanCheckRunPrefix             = "planCheckRuns/"
+	RolePrefix                     = "roles/"
+	SecretNamePrefix               = "secrets/"
+	WebhookIDPrefix                = "webhooks/"
+	WebhookDeliveryIDPrefix        = "deliveries/"
+	SheetIDPrefix                  = "sheets/"
+	DatabaseGroupNamePrefix        = "databaseGroups/"
+	SchemaGroupNamePrefix          = "schemaGroups/"
+	ChangeHistoryPrefix            = "changeHistories/"
+	ClassificationSuggestionPrefix = "classificationSuggestions/"
+	IssueNamePrefix                = "issues/"
+	PipelineNamePrefix             = "pipelines/"
+	LogNamePrefix                  = "logs/"
+	InboxNamePrefix                = "inbox/"
+	SchemaDesignPrefix             = "schemaDesigns/"
+	DeploymentConfigPrefix         = "deploymentConfigs/"
+	ChangelistsPrefix              = "changelists/"
+	UserGroupNamePrefix            = "groups/"
 
 	BackupSettingSuffix   = "/backupSetting"
 	SchemaSuffix          = "/schema"
@@ -88,6 +91,23 @@ func GetProjectIDWebhookID(name string) (string, string, error) {
 	return tokens[0], tokens[1], nil
 }
 
+// GetProjectIDWebhookIDDeliveryID returns the project ID, webhook ID and delivery ID from a resource name.
+func GetProjectIDWebhookIDDeliveryID(name string) (string, int, int, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, WebhookIDPrefix, WebhookDeliveryIDPrefix)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	webhookID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid webhook ID %q", tokens[1])
+	}
+	deliveryID, err := strconv.Atoi(tokens[2])
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid delivery ID %q", tokens[2])
+	}
+	return tokens[0], webhookID, deliveryID, nil
+}
+
 func GetProjectIDDeploymentConfigID(name string) (string, string, error) {
 	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, DeploymentConfigPrefix)
 	if err != nil {
@@ -184,6 +204,20 @@ func GetInstanceDatabaseIDChangeHistory(name string) (string, string, string, er
 	return tokens[0], tokens[1], tokens[2], nil
 }
 
+// GetInstanceDatabaseIDClassificationSuggestion returns the instance ID, database ID, and classification suggestion ID from a resource name.
+func GetInstanceDatabaseIDClassificationSuggestion(name string) (string, string, int, error) {
+	// the name should be instances/{instance-id}/databases/{database-id}/classificationSuggestions/{suggestion-id}
+	tokens, err := GetNameParentTokens(name, InstanceNamePrefix, DatabaseIDPrefix, ClassificationSuggestionPrefix)
+	if err != nil {
+		return "", "", 0, err
+	}
+	suggestionID, err := strconv.Atoi(tokens[2])
+	if err != nil {
+		return "", "", 0, errors.Errorf("invalid classification suggestion ID %q", tokens[2])
+	}
+	return tokens[0], tokens[1], suggestionID, nil
+}
+
 // GetInstanceDatabaseIDSecretName returns the instance ID, database ID, and secret name from a resource name.
 func GetInstanceDatabaseIDSecretName(name string) (string, string, string, error) {
 	// the instance request should be instances/{instance-id}/databases/{database-id}/secrets/{secret-name}
@@ -235,6 +269,15 @@ func GetIdentityProviderID(name string) (string, error) {
 	return tokens[0], nil
 }
 
+// GetUserGroupID returns the user group ID from a resource name.
+func GetUserGroupID(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, UserGroupNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
 // GetBookmarkID returns the bookmark ID from a resource name.
 func GetBookmarkID(name string) (int, error) {
 	return GetUIDFromName(name, BookmarkPrefix)
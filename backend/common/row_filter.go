@@ -0,0 +1,66 @@
+package common
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// rowFilterPlaceholderRegexp matches the placeholders in the row filter predicate templates, e.g. {{user.attribute.tenant}}.
+var rowFilterPlaceholderRegexp = regexp.MustCompile(`\{\{\s*([^{}\s]*)\s*\}\}`)
+
+const rowFilterAttributePrefix = "user.attribute."
+
+// RowFilterPrincipal is the principal whose values replace the placeholders in the row filter predicates.
+// Only the attributes managed by the workspace owners are used, because the users can change their own name and email.
+type RowFilterPrincipal struct {
+	// Email identifies the principal in the error messages.
+	Email      string
+	Attributes map[string]string
+}
+
+// ValidateRowFilterPredicate validates the placeholders in the row filter predicate template.
+func ValidateRowFilterPredicate(predicate string) error {
+	for _, match := range rowFilterPlaceholderRegexp.FindAllStringSubmatch(predicate, -1) {
+		if err := validateRowFilterPlaceholder(match[1]); err != nil {
+			return err
+		}
+	}
+	if strings.Contains(rowFilterPlaceholderRegexp.ReplaceAllString(predicate, ""), "{{") {
+		return errors.Errorf("unclosed placeholder in predicate %q", predicate)
+	}
+	return nil
+}
+
+func validateRowFilterPlaceholder(placeholder string) error {
+	if strings.HasPrefix(placeholder, rowFilterAttributePrefix) && len(placeholder) > len(rowFilterAttributePrefix) {
+		return nil
+	}
+	return errors.Errorf("unsupported placeholder %q, expect {{user.attribute.<key>}}", placeholder)
+}
+
+// RenderRowFilterPredicate replaces the placeholders in the row filter predicate template with the values of the principal quoted by quote.
+// It returns an error if the principal does not have a referenced attribute, so that the rows are never exposed by an incomplete predicate.
+func RenderRowFilterPredicate(predicate string, principal *RowFilterPrincipal, quote func(string) string) (string, error) {
+	if err := ValidateRowFilterPredicate(predicate); err != nil {
+		return "", err
+	}
+	var renderErr error
+	rendered := rowFilterPlaceholderRegexp.ReplaceAllStringFunc(predicate, func(s string) string {
+		placeholder := rowFilterPlaceholderRegexp.FindStringSubmatch(s)[1]
+		key := strings.TrimPrefix(placeholder, rowFilterAttributePrefix)
+		value, ok := principal.Attributes[key]
+		if !ok {
+			if renderErr == nil {
+				renderErr = errors.Errorf("user %q does not have the attribute %q", principal.Email, key)
+			}
+			return s
+		}
+		return quote(value)
+	})
+	if renderErr != nil {
+		return "", renderErr
+	}
+	return rendered, nil
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderRowFilterPredicate(t *testing.T) {
+	principal := &RowFilterPrincipal{
+		Email:      "bob@example.com",
+		Attributes: map[string]string{"tenant": "acme", "owner": "Bob O'Neil"},
+	}
+	quote := func(s string) string {
+		return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+	}
+	tests := []struct {
+		predicate string
+		want      string
+		wantErr   bool
+	}{
+		{
+			predicate: "tenant_id = {{user.attribute.tenant}}",
+			want:      "tenant_id = 'acme'",
+		},
+		{
+			predicate: "owner_name = {{ user.attribute.owner }}",
+			want:      "owner_name = 'Bob O''Neil'",
+		},
+		{
+			// The name and email can be changed by the users themselves.
+			predicate: "owner = {{user.email}}",
+			wantErr:   true,
+		},
+		{
+			predicate: "owner_name = {{user.name}}",
+			wantErr:   true,
+		},
+		{
+			predicate: "region = {{user.attribute.region}}",
+			wantErr:   true,
+		},
+		{
+			predicate: "region = {{user.phone}}",
+			wantErr:   true,
+		},
+		{
+			predicate: "region = {{user.email",
+			wantErr:   true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := RenderRowFilterPredicate(test.predicate, principal, quote)
+		if test.wantErr {
+			a.Error(err, test.predicate)
+			continue
+		}
+		a.NoError(err, test.predicate)
+		a.Equal(test.want, got)
+	}
+}
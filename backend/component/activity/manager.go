@@ -123,8 +123,10 @@ func (m *Manager) BatchCreateActivitiesForRunTasks(ctx context.Context, tasks []
 		CreatorName:  user.Name,
 		CreatorEmail: user.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
+	if err := m.setWebhookResources(ctx, &webhookCtx, issue, tasks); err != nil {
+		slog.Warn("Failed to set webhook resources", slog.String("issue_name", issue.Title), log.BBError(err))
+	}
+	m.createWebhookDeliveries(ctx, &webhookCtx, webhookList)
 
 	return nil
 }
@@ -207,8 +209,10 @@ func (m *Manager) BatchCreateActivitiesForSkipTasks(ctx context.Context, tasks [
 		CreatorName:  user.Name,
 		CreatorEmail: user.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
+	if err := m.setWebhookResources(ctx, &webhookCtx, issue, tasks); err != nil {
+		slog.Warn("Failed to set webhook resources", slog.String("issue_name", issue.Title), log.BBError(err))
+	}
+	m.createWebhookDeliveries(ctx, &webhookCtx, webhookList)
 
 	return nil
 }
@@ -292,8 +296,10 @@ func (m *Manager) BatchCreateActivitiesForCancelTaskRuns(ctx context.Context, ta
 		CreatorName:  user.Name,
 		CreatorEmail: user.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
+	if err := m.setWebhookResources(ctx, &webhookCtx, issue, tasks); err != nil {
+		slog.Warn("Failed to set webhook resources", slog.String("issue_name", issue.Title), log.BBError(err))
+	}
+	m.createWebhookDeliveries(ctx, &webhookCtx, webhookList)
 
 	return nil
 }
@@ -376,8 +382,10 @@ func (m *Manager) BatchCreateTaskStatusUpdateApprovalActivity(ctx context.Contex
 		CreatorName:  user.Name,
 		CreatorEmail: user.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
+	if err := m.setWebhookResources(ctx, &webhookCtx, issue, taskList); err != nil {
+		slog.Warn("Failed to set webhook resources", slog.String("issue_name", issue.Title), log.BBError(err))
+	}
+	m.createWebhookDeliveries(ctx, &webhookCtx, webhookList)
 
 	return nil
 }
@@ -390,6 +398,13 @@ func (m *Manager) CreateActivity(ctx context.Context, create *store.ActivityMess
 	}
 
 	if meta.Issue == nil {
+		if strings.HasPrefix(string(create.Type), "bb.project.") {
+			if err := m.createProjectWebhookDeliveries(ctx, activity); err != nil {
+				slog.Warn("Failed to create project webhook deliveries",
+					slog.String("activity type", string(create.Type)),
+					log.BBError(err))
+			}
+		}
 		return activity, nil
 	}
 	postInbox, err := shouldPostInbox(activity, create.Type)
@@ -428,31 +443,45 @@ func (m *Manager) CreateActivity(ctx context.Context, create *store.ActivityMess
 			log.BBError(err))
 		return activity, nil
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, webhookCtx, webhookList)
+	m.createWebhookDeliveries(ctx, webhookCtx, webhookList)
 
 	return activity, nil
 }
 
-func postWebhookList(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
+// createWebhookDeliveries persists the webhook event as a delivery for each webhook.
+func (m *Manager) createWebhookDeliveries(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
+	webhookCtx.CreatedTs = time.Now().Unix()
+	payload, err := json.Marshal(webhookCtx)
+	if err != nil {
+		slog.Error("Failed to marshal webhook payload", slog.String("activity type", webhookCtx.ActivityType), log.BBError(err))
+		return
+	}
+	var creates []*store.WebhookDeliveryMessage
 	for _, hook := range webhookList {
-		webhookCtx := *webhookCtx
-		webhookCtx.URL = hook.URL
-		webhookCtx.CreatedTs = time.Now().Unix()
-		go func(webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage) {
-			if err := common.Retry(ctx, func() error {
-				return webhook.Post(hook.Type, *webhookCtx)
-			}); err != nil {
-				// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
-				slog.Warn("Failed to post webhook event on activity",
-					slog.String("webhook type", hook.Type),
-					slog.String("webhook name", hook.Title),
-					slog.String("activity type", webhookCtx.ActivityType),
-					slog.String("title", webhookCtx.Title),
-					log.BBError(err))
-				return
-			}
-		}(&webhookCtx, hook)
+		matched, err := webhook.MatchEventFilter(hook.EventFilter, webhookCtx)
+		if err != nil {
+			slog.Warn("Failed to match webhook event filter",
+				slog.String("webhook name", hook.Title),
+				slog.String("event filter", hook.EventFilter),
+				log.BBError(err))
+			continue
+		}
+		if !matched {
+			continue
+		}
+		creates = append(creates, &store.WebhookDeliveryMessage{
+			WebhookID: hook.ID,
+			Payload:   string(payload),
+		})
+	}
+	if len(creates) == 0 {
+		return
+	}
+	if _, err := m.store.CreateWebhookDeliveries(ctx, creates...); err != nil {
+		slog.Error("Failed to create webhook deliveries",
+			slog.String("activity type", webhookCtx.ActivityType),
+			slog.String("title", webhookCtx.Title),
+			log.BBError(err))
 	}
 }
 
@@ -460,6 +489,7 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 	var webhookCtx webhook.Context
 	var webhookTaskResult *webhook.TaskResult
 	var webhookApproval webhook.Approval
+	var webhookTasks []*store.TaskMessage
 
 	setting, err := m.store.GetWorkspaceGeneralSetting(ctx)
 	if err != nil {
@@ -597,6 +627,7 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 			return nil, err
 		}
 
+		webhookTasks = append(webhookTasks, task)
 		webhookTaskResult = &webhook.TaskResult{
 			Name:   task.Name,
 			Status: string(task.LatestTaskRunStatus),
@@ -691,6 +722,7 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 			return nil, err
 		}
 
+		webhookTasks = append(webhookTasks, task)
 		webhookTaskResult = &webhook.TaskResult{
 			Name:   payload.TaskName,
 			Status: string(payload.NewStatus),
@@ -802,6 +834,10 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 			usersGetter = func(ctx context.Context) ([]*store.UserMessage, error) {
 				return nil, nil
 			}
+		case *storepb.ApprovalNode_UserList_:
+			usersGetter = getUsersFromUserList(m.store, val.UserList.Users)
+		case *storepb.ApprovalNode_UserGroup:
+			usersGetter = getUsersFromUserGroup(m.store, val.UserGroup)
 		default:
 			return nil, errors.Errorf("invalid node payload type")
 		}
@@ -824,6 +860,23 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 			phone := strconv.FormatInt(int64(*phoneNumber.NationalNumber), 10)
 			webhookApproval.MentionUsersByPhone = append(webhookApproval.MentionUsersByPhone, phone)
 		}
+		for _, user := range users {
+			webhookApproval.Approvers = append(webhookApproval.Approvers, &webhook.User{
+				ID:    user.ID,
+				Name:  user.Name,
+				Email: user.Email,
+			})
+		}
+	case api.ActivityPipelineTaskFileCommit, api.ActivityPipelineTaskStatementUpdate, api.ActivityPipelineTaskEarliestAllowedTimeUpdate:
+		if taskID := getActivityTaskID(activity); taskID != 0 {
+			task, err := m.store.GetTaskV2ByID(ctx, taskID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get task %d", taskID)
+			}
+			if task != nil {
+				webhookTasks = append(webhookTasks, task)
+			}
+		}
 	}
 
 	webhookCtx = webhook.Context{
@@ -841,13 +894,17 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 			ID:   meta.Issue.Project.UID,
 			Name: meta.Issue.Project.Title,
 		},
-		TaskResult:   webhookTaskResult,
-		Description:  activity.Comment,
-		Link:         link,
-		CreatorID:    updater.ID,
-		CreatorName:  updater.Name,
-		CreatorEmail: updater.Email,
-		Approval:     &webhookApproval,
+		TaskResult:      webhookTaskResult,
+		Description:     activity.Comment,
+		Link:            link,
+		CreatorID:       updater.ID,
+		CreatorName:     updater.Name,
+		CreatorEmail:    updater.Email,
+		Approval:        &webhookApproval,
+		ActivityPayload: getWebhookActivityPayload(activity),
+	}
+	if err := m.setWebhookResources(ctx, &webhookCtx, meta.Issue, webhookTasks); err != nil {
+		slog.Warn("Failed to set webhook resources", slog.String("issue_name", meta.Issue.Title), log.BBError(err))
 	}
 	return &webhookCtx, nil
 }
@@ -922,6 +979,53 @@ func getUsersFromWorkspaceRole(s *store.Store, role api.Role) func(context.Conte
 	}
 }
 
+func getUsersFromUserList(s *store.Store, names []string) func(context.Context) ([]*store.UserMessage, error) {
+	return func(ctx context.Context) ([]*store.UserMessage, error) {
+		var users []*store.UserMessage
+		for _, name := range names {
+			userID, err := common.GetUserID(name)
+			if err != nil {
+				return nil, err
+			}
+			user, err := s.GetUserByID(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			if user != nil {
+				users = append(users, user)
+			}
+		}
+		return users, nil
+	}
+}
+
+func getUsersFromUserGroup(s *store.Store, name string) func(context.Context) ([]*store.UserMessage, error) {
+	return func(ctx context.Context) ([]*store.UserMessage, error) {
+		groupID, err := common.GetUserGroupID(name)
+		if err != nil {
+			return nil, err
+		}
+		group, err := s.GetUserGroup(ctx, &store.FindUserGroupMessage{ResourceID: &groupID})
+		if err != nil {
+			return nil, err
+		}
+		if group == nil {
+			return nil, nil
+		}
+		var users []*store.UserMessage
+		for _, memberID := range group.MemberIDs {
+			user, err := s.GetUserByID(ctx, memberID)
+			if err != nil {
+				return nil, err
+			}
+			if user != nil {
+				users = append(users, user)
+			}
+		}
+		return users, nil
+	}
+}
+
 func getUsersFromProjectRole(s *store.Store, role api.Role, projectID string) func(context.Context) ([]*store.UserMessage, error) {
 	return func(ctx context.Context) ([]*store.UserMessage, error) {
 		projectIAM, err := s.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{
@@ -933,7 +1037,7 @@ func getUsersFromProjectRole(s *store.Store, role api.Role, projectID string) fu
 		var users []*store.UserMessage
 		for _, binding := range projectIAM.Bindings {
 			if binding.Role == role {
-				users = append(users, binding.Members...)
+				users = append(users, binding.GetAllMembers()...)
 			}
 		}
 		return users, nil
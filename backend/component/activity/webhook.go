@@ -0,0 +1,232 @@
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// setWebhookResources sets the plan, rollout and affected databases of the issue on the webhook context.
+// The task and its stage are set if the event is about a single task.
+func (m *Manager) setWebhookResources(ctx context.Context, webhookCtx *webhook.Context, issue *store.IssueMessage, tasks []*store.TaskMessage) error {
+	if webhookCtx.Project != nil {
+		webhookCtx.Project.ResourceID = issue.Project.ResourceID
+	}
+	if issue.PlanUID != nil {
+		plan, err := m.store.GetPlan(ctx, &store.FindPlanMessage{UID: issue.PlanUID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get plan %d", *issue.PlanUID)
+		}
+		if plan != nil {
+			webhookCtx.Plan = &webhook.Plan{ID: plan.UID, Name: plan.Name}
+		}
+	}
+	if issue.PipelineUID == nil {
+		return nil
+	}
+	pipeline, err := m.store.GetPipelineV2ByID(ctx, *issue.PipelineUID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get pipeline %d", *issue.PipelineUID)
+	}
+	if pipeline == nil {
+		return nil
+	}
+	webhookCtx.Rollout = &webhook.Rollout{ID: pipeline.ID, Name: pipeline.Name}
+
+	pipelineTasks, err := m.store.ListTasks(ctx, &api.TaskFind{PipelineID: &pipeline.ID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list tasks of pipeline %d", pipeline.ID)
+	}
+	databases := make(map[int]*webhook.Database)
+	for _, task := range pipelineTasks {
+		if task.DatabaseID == nil {
+			continue
+		}
+		if _, ok := databases[*task.DatabaseID]; ok {
+			continue
+		}
+		database, err := m.getWebhookDatabase(ctx, *task.DatabaseID)
+		if err != nil {
+			return err
+		}
+		if database != nil {
+			databases[*task.DatabaseID] = database
+			webhookCtx.Databases = append(webhookCtx.Databases, database)
+		}
+	}
+
+	if len(tasks) != 1 {
+		return nil
+	}
+	task := tasks[0]
+	webhookCtx.Task = &webhook.Task{
+		ID:     task.ID,
+		Name:   task.Name,
+		Type:   string(task.Type),
+		Status: string(task.LatestTaskRunStatus),
+	}
+	if task.DatabaseID != nil {
+		if database, ok := databases[*task.DatabaseID]; ok {
+			webhookCtx.Task.Database = database.Name
+		}
+	}
+	var payload struct {
+		SheetID int `json:"sheetId"`
+	}
+	if err := json.Unmarshal([]byte(task.Payload), &payload); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal payload of task %d", task.ID)
+	}
+	if payload.SheetID != 0 {
+		webhookCtx.Task.Sheet = fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, issue.Project.ResourceID, common.SheetIDPrefix, payload.SheetID)
+	}
+	stage, err := m.getWebhookStage(ctx, task.PipelineID, task.StageID)
+	if err != nil {
+		return err
+	}
+	webhookCtx.Stage = stage
+	return nil
+}
+
+func (m *Manager) getWebhookDatabase(ctx context.Context, databaseUID int) (*webhook.Database, error) {
+	database, err := m.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &databaseUID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %d", databaseUID)
+	}
+	if database == nil {
+		return nil, nil
+	}
+	return &webhook.Database{
+		Name:        fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
+		Environment: fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, database.EffectiveEnvironmentID),
+	}, nil
+}
+
+func (m *Manager) getWebhookStage(ctx context.Context, pipelineUID, stageUID int) (*webhook.Stage, error) {
+	stages, err := m.store.ListStageV2(ctx, pipelineUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list stages of pipeline %d", pipelineUID)
+	}
+	for _, stage := range stages {
+		if stage.ID != stageUID {
+			continue
+		}
+		environment, err := m.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{UID: &stage.EnvironmentID, ShowDeleted: true})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get environment %d", stage.EnvironmentID)
+		}
+		result := &webhook.Stage{ID: stage.ID, Name: stage.Name}
+		if environment != nil {
+			result.Environment = fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, environment.ResourceID)
+		}
+		return result, nil
+	}
+	return nil, nil
+}
+
+// createProjectWebhookDeliveries creates the webhook deliveries for the project activities which don't belong to an issue.
+func (m *Manager) createProjectWebhookDeliveries(ctx context.Context, activity *store.ActivityMessage) error {
+	title, ok := projectActivityWebhookTitles[activity.Type]
+	if !ok {
+		return nil
+	}
+	// The container of the project activities is the project.
+	project, err := m.store.GetProjectV2(ctx, &store.FindProjectMessage{UID: &activity.ContainerUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project %d", activity.ContainerUID)
+	}
+	if project == nil {
+		return nil
+	}
+	webhookList, err := m.store.FindProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID:    &project.UID,
+		ActivityType: &activity.Type,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to find project webhook for activity %d", activity.UID)
+	}
+	if len(webhookList) == 0 {
+		return nil
+	}
+	setting, err := m.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace setting")
+	}
+	creator, err := m.store.GetUserByID(ctx, activity.CreatorUID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get principal %d", activity.CreatorUID)
+	}
+	if creator == nil {
+		return errors.Errorf("creator user not found for ID %v", activity.CreatorUID)
+	}
+
+	webhookCtx := webhook.Context{
+		Level:        getWebhookLevel(activity.Level),
+		ActivityType: string(activity.Type),
+		Title:        fmt.Sprintf("%s - %s", title, project.Title),
+		Description:  activity.Comment,
+		Link:         fmt.Sprintf("%s/project/%s-%d", setting.ExternalUrl, slug.Make(project.Title), project.UID),
+		CreatorID:    creator.ID,
+		CreatorName:  creator.Name,
+		CreatorEmail: creator.Email,
+		Project: &webhook.Project{
+			ID:         project.UID,
+			Name:       project.Title,
+			ResourceID: project.ResourceID,
+		},
+		ActivityPayload: getWebhookActivityPayload(activity),
+	}
+	m.createWebhookDeliveries(ctx, &webhookCtx, webhookList)
+	return nil
+}
+
+// projectActivityWebhookTitles maps all the "bb.project." activity types to the webhook titles.
+// The other activities without an issue are not sent to the project webhooks because their container is not a project:
+// the member activities belong to the workspace, and the SQL editor query and SQL export activities belong to the instance.
+// The database PITR done activity carries its issue, so it is sent along with the issue activities.
+var projectActivityWebhookTitles = map[api.ActivityType]string{
+	api.ActivityProjectRepositoryPush:   "Repository pushed",
+	api.ActivityProjectDatabaseTransfer: "Database transferred",
+	api.ActivityProjectMemberCreate:     "Project member added",
+	api.ActivityProjectMemberDelete:     "Project member removed",
+}
+
+func getWebhookLevel(level api.ActivityLevel) webhook.Level {
+	switch level {
+	case api.ActivityWarn:
+		return webhook.WebhookWarn
+	case api.ActivityError:
+		return webhook.WebhookError
+	default:
+		return webhook.WebhookInfo
+	}
+}
+
+// getWebhookActivityPayload returns the activity payload if it is a JSON object.
+func getWebhookActivityPayload(activity *store.ActivityMessage) json.RawMessage {
+	if activity.Payload == "" || !json.Valid([]byte(activity.Payload)) {
+		return nil
+	}
+	return json.RawMessage(activity.Payload)
+}
+
+// getActivityTaskID returns the task ID in the payload of the pipeline task activities.
+func getActivityTaskID(activity *store.ActivityMessage) int {
+	var payload struct {
+		TaskID int `json:"taskId"`
+	}
+	if err := json.Unmarshal([]byte(activity.Payload), &payload); err != nil {
+		slog.Debug("Failed to unmarshal activity payload", slog.Int("activity", activity.UID), log.BBError(err))
+		return 0
+	}
+	return payload.TaskID
+}
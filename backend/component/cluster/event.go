@@ -0,0 +1,255 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// eventChannel is the Postgres notification channel for the replica events.
+	eventChannel = "bb_replica_event"
+	// forwardInterval is the interval of forwarding the pending work in the state maps.
+	forwardInterval = 1 * time.Second
+	// listenRetryInterval is the interval of re-listening after the listening connection fails.
+	listenRetryInterval = 5 * time.Second
+)
+
+type eventType string
+
+const (
+	// The events handled by the leader.
+	eventTaskRunTickle     eventType = "task-run-tickle"
+	eventPlanCheckTickle   eventType = "plan-check-tickle"
+	eventInstanceSync      eventType = "instance-sync"
+	eventSlowQuerySync     eventType = "slow-query-sync"
+	eventTaskSkippedOrDone eventType = "task-skipped-or-done"
+	eventApprovalFinding   eventType = "approval-finding"
+	eventRollbackGenerate  eventType = "rollback-generate"
+	// The events handled by every replica.
+	eventTaskRunCancel eventType = "task-run-cancel"
+	eventReplicaJoin   eventType = "replica-join"
+	// The events handled by the followers.
+	eventTaskProgress eventType = "task-progress"
+)
+
+// event is the notification payload between the replicas.
+// The notifications are best-effort, the runners on the leader still poll the metadata database periodically.
+type event struct {
+	Type eventType `json:"type"`
+	// ID is the UID of the instance, task, issue or task run depending on the event type.
+	ID            int                                 `json:"id,omitempty"`
+	SlowQuerySync *state.InstanceSlowQuerySyncMessage `json:"slowQuerySync,omitempty"`
+	TaskProgress  *api.Progress                       `json:"taskProgress,omitempty"`
+	// ReplicaID is the ID of the replica publishing the event.
+	ReplicaID string `json:"replicaId,omitempty"`
+}
+
+// PublishTaskRunCancel notifies every replica to cancel the task run if it's executing on the replica.
+func PublishTaskRunCancel(ctx context.Context, s *store.Store, taskRunID int) error {
+	return notify(ctx, s, &event{Type: eventTaskRunCancel, ID: taskRunID})
+}
+
+func notify(ctx context.Context, s *store.Store, e *event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal replica event %q", e.Type)
+	}
+	return s.Notify(ctx, eventChannel, string(payload))
+}
+
+// forwardEvents publishes the events sent to the local channels and state maps.
+// The leader-bound events are published only when the replica is a follower,
+// otherwise the local runners consume them directly.
+func (r *Replica) forwardEvents(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(forwardInterval)
+	defer ticker.Stop()
+	forwardedTaskProgress := make(map[int]api.Progress)
+	for {
+		// Nil channels block forever, so that the leader doesn't consume the events for its local runners.
+		var taskRunTickleChan, planCheckTickleChan, taskSkippedOrDoneChan chan int
+		var instanceSyncChan chan *store.InstanceMessage
+		var slowQuerySyncChan chan *state.InstanceSlowQuerySyncMessage
+		if !r.IsLeader() {
+			taskRunTickleChan = r.stateCfg.TaskRunTickleChan
+			planCheckTickleChan = r.stateCfg.PlanCheckTickleChan
+			taskSkippedOrDoneChan = r.stateCfg.TaskSkippedOrDoneChan
+			instanceSyncChan = r.stateCfg.InstanceDatabaseSyncChan
+			slowQuerySyncChan = r.stateCfg.InstanceSlowQuerySyncChan
+		}
+
+		select {
+		case <-taskRunTickleChan:
+			r.publish(ctx, &event{Type: eventTaskRunTickle})
+		case <-planCheckTickleChan:
+			r.publish(ctx, &event{Type: eventPlanCheckTickle})
+		case taskUID := <-taskSkippedOrDoneChan:
+			r.publish(ctx, &event{Type: eventTaskSkippedOrDone, ID: taskUID})
+		case instance := <-instanceSyncChan:
+			r.publish(ctx, &event{Type: eventInstanceSync, ID: instance.UID})
+		case message := <-slowQuerySyncChan:
+			r.publish(ctx, &event{Type: eventSlowQuerySync, SlowQuerySync: message})
+		case <-ticker.C:
+			if r.IsLeader() {
+				r.forwardTaskProgress(ctx, forwardedTaskProgress)
+			} else {
+				r.forwardStateMaps(ctx)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// forwardStateMaps publishes the pending approval finding and rollback SQL generation to the leader.
+func (r *Replica) forwardStateMaps(ctx context.Context) {
+	r.stateCfg.ApprovalFinding.Range(func(key, _ any) bool {
+		if r.publish(ctx, &event{Type: eventApprovalFinding, ID: key.(int)}) {
+			r.stateCfg.ApprovalFinding.Delete(key)
+		}
+		return true
+	})
+	r.stateCfg.RollbackGenerate.Range(func(key, _ any) bool {
+		if r.publish(ctx, &event{Type: eventRollbackGenerate, ID: key.(int)}) {
+			r.stateCfg.RollbackGenerate.Delete(key)
+		}
+		return true
+	})
+}
+
+// forwardTaskProgress publishes the task progress changed since the last forwarding to the followers,
+// because the tasks only run on the leader while every replica serves the API.
+func (r *Replica) forwardTaskProgress(ctx context.Context, forwarded map[int]api.Progress) {
+	r.stateCfg.TaskProgress.Range(func(key, value any) bool {
+		taskID, progress := key.(int), value.(api.Progress)
+		if last, ok := forwarded[taskID]; ok && last == progress {
+			return true
+		}
+		if r.publish(ctx, &event{Type: eventTaskProgress, ID: taskID, TaskProgress: &progress}) {
+			forwarded[taskID] = progress
+		}
+		return true
+	})
+}
+
+func (r *Replica) publish(ctx context.Context, e *event) bool {
+	e.ReplicaID = r.id
+	if err := notify(ctx, r.store, e); err != nil {
+		slog.Error("Failed to publish replica event", slog.String("type", string(e.Type)), log.BBError(err))
+		return false
+	}
+	return true
+}
+
+// listenEvents listens to the events from all replicas including itself, and re-listens if the connection fails.
+func (r *Replica) listenEvents(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		if err := r.store.Listen(ctx, eventChannel, func(payload string) {
+			r.handleEvent(ctx, payload)
+		}); err != nil {
+			slog.Error("Failed to listen replica events", log.BBError(err))
+		}
+		select {
+		case <-time.After(listenRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Replica) handleEvent(ctx context.Context, payload string) {
+	e := &event{}
+	if err := json.Unmarshal([]byte(payload), e); err != nil {
+		slog.Error("Failed to unmarshal replica event", slog.String("payload", payload), log.BBError(err))
+		return
+	}
+	switch e.Type {
+	case eventTaskRunCancel:
+		if cancelFunc, ok := r.stateCfg.RunningTaskRunsCancelFunc.Load(e.ID); ok {
+			cancelFunc.(context.CancelFunc)()
+		}
+		return
+	case eventReplicaJoin:
+		// Stop serving from the caches at once, before the new replica is counted on the next lease renewal.
+		if e.ReplicaID != r.id {
+			r.store.SetCacheEnabled(false)
+		}
+		return
+	case eventTaskProgress:
+		if !r.IsLeader() && e.TaskProgress != nil {
+			r.stateCfg.TaskProgress.Store(e.ID, *e.TaskProgress)
+		}
+		return
+	}
+	if !r.IsLeader() {
+		return
+	}
+
+	switch e.Type {
+	case eventTaskRunTickle:
+		tickle(r.stateCfg.TaskRunTickleChan)
+	case eventPlanCheckTickle:
+		tickle(r.stateCfg.PlanCheckTickleChan)
+	case eventTaskSkippedOrDone:
+		send(ctx, r.stateCfg.TaskSkippedOrDoneChan, e.ID)
+	case eventInstanceSync:
+		instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &e.ID})
+		if err != nil {
+			slog.Error("Failed to get instance", slog.Int("instance", e.ID), log.BBError(err))
+			return
+		}
+		if instance != nil {
+			send(ctx, r.stateCfg.InstanceDatabaseSyncChan, instance)
+		}
+	case eventSlowQuerySync:
+		if e.SlowQuerySync != nil {
+			send(ctx, r.stateCfg.InstanceSlowQuerySyncChan, e.SlowQuerySync)
+		}
+	case eventApprovalFinding:
+		issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &e.ID})
+		if err != nil {
+			slog.Error("Failed to get issue", slog.Int("issue", e.ID), log.BBError(err))
+			return
+		}
+		if issue != nil {
+			r.stateCfg.ApprovalFinding.Store(issue.UID, issue)
+		}
+	case eventRollbackGenerate:
+		task, err := r.store.GetTaskV2ByID(ctx, e.ID)
+		if err != nil {
+			slog.Error("Failed to get task", slog.Int("task", e.ID), log.BBError(err))
+			return
+		}
+		if task != nil {
+			r.stateCfg.RollbackGenerate.Store(task.ID, task)
+		}
+	default:
+		slog.Warn("Unknown replica event", slog.String("type", string(e.Type)))
+	}
+}
+
+// tickle wakes up the runner without blocking, the pending tickle is enough if the channel is full.
+func tickle(c chan int) {
+	select {
+	case c <- 0:
+	default:
+	}
+}
+
+func send[T any](ctx context.Context, c chan T, v T) {
+	select {
+	case c <- v:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,52 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+func TestHandleEvent(t *testing.T) {
+	stateCfg, err := state.New()
+	require.NoError(t, err)
+	r := &Replica{stateCfg: stateCfg, id: "test"}
+	ctx := context.Background()
+
+	marshal := func(e *event) string {
+		payload, err := json.Marshal(e)
+		require.NoError(t, err)
+		return string(payload)
+	}
+
+	// The followers ignore the leader-bound events.
+	r.handleEvent(ctx, marshal(&event{Type: eventTaskRunTickle}))
+	require.Len(t, stateCfg.TaskRunTickleChan, 0)
+
+	// Every replica cancels the task run executing on it.
+	canceled := false
+	stateCfg.RunningTaskRunsCancelFunc.Store(101, context.CancelFunc(func() { canceled = true }))
+	r.handleEvent(ctx, marshal(&event{Type: eventTaskRunCancel, ID: 101}))
+	require.True(t, canceled)
+
+	// The followers store the task progress forwarded by the leader.
+	r.handleEvent(ctx, marshal(&event{Type: eventTaskProgress, ID: 103, TaskProgress: &api.Progress{TotalUnit: 10, CompletedUnit: 3}}))
+	progress, ok := stateCfg.TaskProgress.Load(103)
+	require.True(t, ok)
+	require.Equal(t, api.Progress{TotalUnit: 10, CompletedUnit: 3}, progress)
+
+	r.isLeader.Store(true)
+	r.handleEvent(ctx, marshal(&event{Type: eventTaskRunTickle}))
+	r.handleEvent(ctx, marshal(&event{Type: eventTaskSkippedOrDone, ID: 102}))
+	r.handleEvent(ctx, marshal(&event{Type: eventSlowQuerySync, SlowQuerySync: &state.InstanceSlowQuerySyncMessage{InstanceID: "prod"}}))
+	require.Len(t, stateCfg.TaskRunTickleChan, 1)
+	require.Equal(t, 102, <-stateCfg.TaskSkippedOrDoneChan)
+	require.Equal(t, "prod", (<-stateCfg.InstanceSlowQuerySyncChan).InstanceID)
+
+	// Malformed payloads are ignored.
+	r.handleEvent(ctx, "{")
+}
@@ -0,0 +1,173 @@
+// Package cluster coordinates the Bytebase replicas sharing the same metadata database.
+//
+// Every replica serves the API, while only the leader runs the background runners.
+// The leader is elected with the leases in the metadata database, and the replicas notify each other
+// with Postgres LISTEN/NOTIFY in place of the in-memory channels.
+// The store caches are disabled while more than one replica is active, as they are not invalidated across the replicas.
+package cluster
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// leaseTTL is the duration that a lease stays valid without renewal.
+	leaseTTL = 30 * time.Second
+	// leaseRenewInterval is the interval of renewing the leases.
+	leaseRenewInterval = 10 * time.Second
+)
+
+// Replica is the local Bytebase replica.
+type Replica struct {
+	store    *store.Store
+	stateCfg *state.State
+	id       string
+
+	isLeader atomic.Bool
+}
+
+// NewReplica creates the local replica with a unique replica ID.
+func NewReplica(store *store.Store, stateCfg *state.State) (*Replica, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "bytebase"
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, errors.Wrap(err, "failed to generate replica ID")
+	}
+	return &Replica{
+		store:    store,
+		stateCfg: stateCfg,
+		id:       fmt.Sprintf("%s-%s", hostname, hex.EncodeToString(suffix)),
+	}, nil
+}
+
+// ID returns the replica ID.
+func (r *Replica) ID() string {
+	return r.id
+}
+
+// IsLeader returns whether the replica is the leader.
+func (r *Replica) IsLeader() bool {
+	return r.isLeader.Load()
+}
+
+// Run keeps the replica lease alive and campaigns for the leader lease.
+// The replica lease is renewed in its own goroutine, so that it doesn't expire while waiting for the leader-only runners to stop.
+// Once the replica becomes the leader, runLeader is called to start the leader-only runners with a context
+// which is canceled when the replica loses the leadership, and the runners must call wg.Done() on exit.
+func (r *Replica) Run(ctx context.Context, wg *sync.WaitGroup, runLeader func(ctx context.Context, wg *sync.WaitGroup)) {
+	defer wg.Done()
+	slog.Info(fmt.Sprintf("Replica %s started and will renew leases every %v", r.id, leaseRenewInterval))
+
+	wg.Add(3)
+	go r.listenEvents(ctx, wg)
+	go r.forwardEvents(ctx, wg)
+	go r.keepReplicaLease(ctx, wg)
+	r.publish(ctx, &event{Type: eventReplicaJoin})
+
+	var leaderWG sync.WaitGroup
+	var leaderCancel context.CancelFunc
+	stepDown := func() {
+		if leaderCancel == nil {
+			return
+		}
+		r.isLeader.Store(false)
+		leaderCancel()
+		leaderWG.Wait()
+		leaderCancel = nil
+		slog.Info(fmt.Sprintf("Replica %s stepped down from leader", r.id))
+	}
+
+	ticker := time.NewTicker(leaseRenewInterval)
+	defer ticker.Stop()
+	var renewedTs time.Time
+	for {
+		leader, err := r.store.TryAcquireLease(ctx, store.LeaderLeaseName, r.id, leaseTTL)
+		if err != nil {
+			slog.Error("Failed to renew leader lease", slog.String("replica", r.id), log.BBError(err))
+			// Keep the leadership only if the leader lease is still valid.
+			leader = r.IsLeader() && time.Since(renewedTs) < leaseTTL-leaseRenewInterval
+		} else if leader {
+			renewedTs = time.Now()
+		}
+
+		if leader && leaderCancel == nil {
+			leaderCancel = r.becomeLeader(ctx, &leaderWG, runLeader)
+		} else if !leader {
+			stepDown()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			stepDown()
+			r.releaseLease(store.LeaderLeaseName)
+			return
+		}
+	}
+}
+
+// keepReplicaLease renews the replica lease and updates the cache mode until the context is canceled.
+func (r *Replica) keepReplicaLease(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(leaseRenewInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := r.store.TryAcquireLease(ctx, store.GetReplicaLeaseName(r.id), r.id, leaseTTL); err != nil {
+			slog.Error("Failed to renew replica lease", slog.String("replica", r.id), log.BBError(err))
+		}
+		r.updateCacheMode(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			r.releaseLease(store.GetReplicaLeaseName(r.id))
+			return
+		}
+	}
+}
+
+// updateCacheMode enables the store caches only if the replica is the only active one.
+func (r *Replica) updateCacheMode(ctx context.Context) {
+	count, err := r.store.CountActiveReplicas(ctx)
+	if err != nil {
+		slog.Error("Failed to count active replicas", log.BBError(err))
+		r.store.SetCacheEnabled(false)
+		return
+	}
+	r.store.SetCacheEnabled(count <= 1)
+}
+
+// becomeLeader starts the leader-only runners and returns the function to stop them.
+func (r *Replica) becomeLeader(ctx context.Context, wg *sync.WaitGroup, runLeader func(ctx context.Context, wg *sync.WaitGroup)) context.CancelFunc {
+	slog.Info(fmt.Sprintf("Replica %s became leader", r.id))
+	leaderCtx, cancel := context.WithCancel(ctx)
+	r.isLeader.Store(true)
+	runLeader(leaderCtx, wg)
+	return cancel
+}
+
+// releaseLease releases the lease on shutdown so that another replica can take over immediately.
+func (r *Replica) releaseLease(name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := r.store.ReleaseLease(ctx, name, r.id); err != nil {
+		slog.Warn("Failed to release lease", slog.String("lease", name), log.BBError(err))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/secret"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 )
 
@@ -42,13 +43,23 @@ type Profile struct {
 	AppRunnerInterval time.Duration
 	// BackupRunnerInterval is the interval for backup runner.
 	BackupRunnerInterval time.Duration
-	// BackupStorageBackend is the backup storage backend.
+	// BackupStorageBackend is the default backup storage backend.
+	// It is used when neither the database backup setting nor the environment backup policy specifies one.
 	BackupStorageBackend api.BackupStorageBackend
-
-	// Cloud backup related fields
-	BackupRegion         string
-	BackupBucket         string
-	BackupCredentialFile string
+	// BackupBuckets are the cloud buckets storing the backups, at most one for each storage backend.
+	BackupBuckets []*BackupBucket
+	// BackupCompression is the compression algorithm of the backup artifacts.
+	BackupCompression api.BackupCompression
+	// BackupEncryptionKeyRef is the reference of the key encryption key of the backup artifacts, e.g., env://BB_BACKUP_KEY.
+	// Empty means the backup artifacts are not encrypted.
+	BackupEncryptionKeyRef string
+	// RestoreDrillInstance is the resource ID of the instance hosting the scratch databases of restore drills.
+	// Restore drills are disabled if it is empty.
+	RestoreDrillInstance string
+	// RestoreDrillInterval is the interval for restore drill runner.
+	RestoreDrillInterval time.Duration
+	// ExternalSecretAllowlist restricts the environment variables, files and Vault paths readable by the external secrets.
+	ExternalSecretAllowlist *secret.Allowlist
 
 	// Version is the bytebase's server version
 	Version string
@@ -68,6 +79,35 @@ type Profile struct {
 	LastActiveTs int64
 }
 
+// BackupBucket is the cloud bucket storing the backups.
+type BackupBucket struct {
+	StorageBackend api.BackupStorageBackend
+	// Bucket is the bucket name, or the container name for Azure Blob Storage.
+	Bucket string
+	// Region is the region of the AWS S3 bucket.
+	Region string
+	// Endpoint is the endpoint of the S3-compatible storage, e.g., MinIO. Empty for AWS S3.
+	Endpoint string
+	// CredentialFile is the path of the credential file.
+	// It is the AWS shared credentials file for S3, the service account key file for GCS,
+	// and the file containing the connection string for Azure Blob Storage.
+	CredentialFile string
+}
+
+// IsBackupStorageBackendAvailable returns whether the backup storage backend is configured.
+// The local storage backend is always available.
+func (prof *Profile) IsBackupStorageBackendAvailable(backend api.BackupStorageBackend) bool {
+	if backend == api.BackupStorageBackendLocal {
+		return true
+	}
+	for _, bucket := range prof.BackupBuckets {
+		if bucket.StorageBackend == backend {
+			return true
+		}
+	}
+	return false
+}
+
 // UseEmbedDB returns whether to use embedDB.
 func (prof *Profile) UseEmbedDB() bool {
 	return len(prof.PgURL) == 0
@@ -0,0 +1,74 @@
+package dbfactory
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var connectionsDesc = prometheus.NewDesc(
+	"bytebase_db_connections",
+	"The number of connections in the connection pools of the opened database drivers by instance and state.",
+	[]string{"instance", "state"},
+	nil,
+)
+
+// poolCollector collects the connection pool usage of the database drivers opened by the factory.
+type poolCollector struct {
+	sync.Mutex
+	// pools maps the connection pools to their instance IDs.
+	pools map[*sql.DB]string
+}
+
+func newPoolCollector() *poolCollector {
+	return &poolCollector{pools: make(map[*sql.DB]string)}
+}
+
+func (c *poolCollector) track(pool *sql.DB, instanceID string) {
+	c.Lock()
+	defer c.Unlock()
+	c.pools[pool] = instanceID
+}
+
+// Describe implements prometheus.Collector.
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- connectionsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	type usage struct {
+		inUse int
+		idle  int
+	}
+	usages := make(map[string]*usage)
+
+	c.Lock()
+	for pool, instanceID := range c.pools {
+		stats := pool.Stats()
+		// The drivers connect on opening, so the pool without connections is closed by the caller.
+		if stats.OpenConnections == 0 {
+			delete(c.pools, pool)
+			continue
+		}
+		u, ok := usages[instanceID]
+		if !ok {
+			u = &usage{}
+			usages[instanceID] = u
+		}
+		u.inUse += stats.InUse
+		u.idle += stats.Idle
+	}
+	c.Unlock()
+
+	for instanceID, u := range usages {
+		ch <- prometheus.MustNewConstMetric(connectionsDesc, prometheus.GaugeValue, float64(u.inUse), instanceID, "in_use")
+		ch <- prometheus.MustNewConstMetric(connectionsDesc, prometheus.GaugeValue, float64(u.idle), instanceID, "idle")
+	}
+}
+
+// Collector returns the Prometheus collector of the connection pool usage of the opened database drivers.
+func (d *DBFactory) Collector() prometheus.Collector {
+	return d.poolCollector
+}
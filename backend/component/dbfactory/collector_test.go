@@ -0,0 +1,78 @@
+package dbfactory
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	sql.Register("collector_test", fakeDriver{})
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (fakeConn) Close() error {
+	return nil
+}
+
+func (fakeConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+func TestPoolCollector(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	openPool := func() *sql.DB {
+		pool, err := sql.Open("collector_test", "")
+		a.NoError(err)
+		t.Cleanup(func() { pool.Close() })
+		return pool
+	}
+	collector := newPoolCollector()
+
+	// Two pools of instance "i1" with an in-use and an idle connection.
+	inUsePool := openPool()
+	conn, err := inUsePool.Conn(ctx)
+	a.NoError(err)
+	defer conn.Close()
+	collector.track(inUsePool, "i1")
+	idlePool := openPool()
+	a.NoError(idlePool.PingContext(ctx))
+	collector.track(idlePool, "i1")
+	// A pool of instance "i2" with an idle connection.
+	otherPool := openPool()
+	a.NoError(otherPool.PingContext(ctx))
+	collector.track(otherPool, "i2")
+	// The closed pool is not collected and untracked.
+	closedPool := openPool()
+	a.NoError(closedPool.PingContext(ctx))
+	collector.track(closedPool, "i3")
+	a.NoError(closedPool.Close())
+
+	expected := `
+# HELP bytebase_db_connections The number of connections in the connection pools of the opened database drivers by instance and state.
+# TYPE bytebase_db_connections gauge
+bytebase_db_connections{instance="i1",state="idle"} 1
+bytebase_db_connections{instance="i1",state="in_use"} 1
+bytebase_db_connections{instance="i2",state="idle"} 1
+bytebase_db_connections{instance="i2",state="in_use"} 0
+`
+	a.NoError(testutil.CollectAndCompare(collector, strings.NewReader(expected), "bytebase_db_connections"))
+	a.Len(collector.pools, 3)
+}
@@ -19,16 +19,22 @@ type DBFactory struct {
 	mongoBinDir string
 	dataDir     string
 	secret      string
+	// secretManager resolves the data source passwords stored in the external secret managers.
+	secretManager *secret.Manager
+	// poolCollector collects the connection pool usage of the opened drivers.
+	poolCollector *poolCollector
 }
 
 // New creates a new database driver factory.
-func New(mysqlBinDir, mongoBinDir, pgBinDir, dataDir, secret string) *DBFactory {
+func New(mysqlBinDir, mongoBinDir, pgBinDir, dataDir, secret string, secretManager *secret.Manager) *DBFactory {
 	return &DBFactory{
-		mysqlBinDir: mysqlBinDir,
-		mongoBinDir: mongoBinDir,
-		pgBinDir:    pgBinDir,
-		dataDir:     dataDir,
-		secret:      secret,
+		mysqlBinDir:   mysqlBinDir,
+		mongoBinDir:   mongoBinDir,
+		pgBinDir:      pgBinDir,
+		dataDir:       dataDir,
+		secret:        secret,
+		secretManager: secretManager,
+		poolCollector: newPoolCollector(),
 	}
 }
 
@@ -147,11 +153,6 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, engine db.Type, dat
 	if err != nil {
 		return nil, err
 	}
-	updatedPassword, err := secret.ReplaceExternalSecret(password)
-	if err != nil {
-		return nil, err
-	}
-	password = updatedPassword
 	sshConfig := db.SSHConfig{
 		Host:       dataSource.SSHHost,
 		Port:       dataSource.SSHPort,
@@ -159,39 +160,56 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, engine db.Type, dat
 		Password:   sshPassword,
 		PrivateKey: sshPrivateKey,
 	}
-	driver, err := db.Open(
-		ctx,
-		engine,
-		db.DriverConfig{
-			DbBinDir:  dbBinDir,
-			BinlogDir: common.GetBinlogAbsDir(d.dataDir, instanceUID),
-		},
-		db.ConnectionConfig{
-			Username: dataSource.Username,
-			Password: password,
-			TLSConfig: db.TLSConfig{
-				SslCA:   sslCA,
-				SslCert: sslCert,
-				SslKey:  sslKey,
+	openDriver := func() (db.Driver, error) {
+		externalPassword, err := d.secretManager.ReplaceExternalSecret(ctx, password)
+		if err != nil {
+			return nil, err
+		}
+		return db.Open(
+			ctx,
+			engine,
+			db.DriverConfig{
+				DbBinDir:  dbBinDir,
+				BinlogDir: common.GetBinlogAbsDir(d.dataDir, instanceUID),
+			},
+			db.ConnectionConfig{
+				Username: dataSource.Username,
+				Password: externalPassword,
+				TLSConfig: db.TLSConfig{
+					SslCA:   sslCA,
+					SslCert: sslCert,
+					SslKey:  sslKey,
+				},
+				Host:                   dataSource.Host,
+				Port:                   dataSource.Port,
+				Database:               databaseName,
+				ConnectionDatabase:     connectionDatabase,
+				SRV:                    dataSource.SRV,
+				AuthenticationDatabase: dataSource.AuthenticationDatabase,
+				SID:                    dataSource.SID,
+				ServiceName:            dataSource.ServiceName,
+				SSHConfig:              sshConfig,
+				ReadOnly:               readOnly,
+				SchemaTenantMode:       schemaTenantMode,
 			},
-			Host:                   dataSource.Host,
-			Port:                   dataSource.Port,
-			Database:               databaseName,
-			ConnectionDatabase:     connectionDatabase,
-			SRV:                    dataSource.SRV,
-			AuthenticationDatabase: dataSource.AuthenticationDatabase,
-			SID:                    dataSource.SID,
-			ServiceName:            dataSource.ServiceName,
-			SSHConfig:              sshConfig,
-			ReadOnly:               readOnly,
-			SchemaTenantMode:       schemaTenantMode,
-		},
-		db.ConnectionContext{
-			InstanceID: instanceID,
-		},
-	)
+			db.ConnectionContext{
+				InstanceID: instanceID,
+			},
+		)
+	}
+	driver, err := openDriver()
 	if err != nil {
-		return nil, err
+		if ok, _ := secret.GetExternalSecretURL(password); !ok {
+			return nil, err
+		}
+		// The cached external secret may be stale after rotation, so fetch it again and retry once.
+		d.secretManager.Invalidate(password)
+		if driver, err = openDriver(); err != nil {
+			return nil, err
+		}
+	}
+	if pool := driver.GetDB(); pool != nil {
+		d.poolCollector.track(pool, instanceID)
 	}
 
 	return driver, nil
@@ -0,0 +1,105 @@
+// Package metrics defines the Prometheus metrics of the server, which are exposed on the /metrics endpoint.
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "bytebase"
+
+var (
+	// TaskRunQueueDepth is the number of the pending and running task runs.
+	TaskRunQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "task_run_queue_depth",
+		Help:      "The number of pending and running task runs by task type and status.",
+	}, []string{"task_type", "status"})
+	// TaskRunDuration is the execution duration of the finished task runs.
+	TaskRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "task_run_duration_seconds",
+		Help:      "The execution duration of finished task runs by task type and status.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 4, 10),
+	}, []string{"task_type", "status"})
+	// PlanCheckRunDuration is the duration of the finished plan check runs.
+	PlanCheckRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "plan_check_run_duration_seconds",
+		Help:      "The duration of finished plan check runs by check type and status.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	}, []string{"type", "status"})
+	// SchemaSyncDuration is the duration of syncing the instance metadata and the database schemas.
+	SchemaSyncDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "schema_sync_duration_seconds",
+		Help:      "The duration of syncing the instance metadata or a database schema by instance and object type.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	}, []string{"instance", "type"})
+	// BackupDuration is the duration of the database backups.
+	BackupDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "backup_duration_seconds",
+		Help:      "The duration of database backups by engine and status.",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+	}, []string{"engine", "status"})
+	// BackupSize is the size of the backup files.
+	BackupSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "backup_size_bytes",
+		Help:      "The size of database backup files by engine.",
+		// From 1KB to 256GB.
+		Buckets: prometheus.ExponentialBuckets(1024, 4, 15),
+	}, []string{"engine"})
+	// WebhookDeliveryFailures is the number of the failed webhook delivery attempts.
+	WebhookDeliveryFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_delivery_failures_total",
+		Help:      "The number of failed webhook delivery attempts by webhook type and the delivery status after the attempt.",
+	}, []string{"webhook_type", "status"})
+	// GRPCRequestDuration is the latency of the gRPC requests.
+	GRPCRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "The latency of gRPC requests by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		TaskRunQueueDepth,
+		TaskRunDuration,
+		PlanCheckRunDuration,
+		SchemaSyncDuration,
+		BackupDuration,
+		BackupSize,
+		WebhookDeliveryFailures,
+		GRPCRequestDuration,
+	)
+}
+
+// ObserveDuration observes the duration since start in seconds.
+func ObserveDuration(observer prometheus.Observer, start time.Time) {
+	observer.Observe(time.Since(start).Seconds())
+}
+
+// UnaryInterceptor records the latency of the unary gRPC requests.
+func UnaryInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, request)
+	ObserveDuration(GRPCRequestDuration.WithLabelValues(serverInfo.FullMethod, status.Code(err).String()), start)
+	return resp, err
+}
+
+// StreamInterceptor records the latency of the streaming gRPC requests.
+func StreamInterceptor(request any, ss grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(request, ss)
+	ObserveDuration(GRPCRequestDuration.WithLabelValues(serverInfo.FullMethod, status.Code(err).String()), start)
+	return err
+}
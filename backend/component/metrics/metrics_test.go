@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	a := require.New(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/bytebase.v1.TestService/Get"}
+
+	_, err := UnaryInterceptor(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return nil, nil
+	})
+	a.NoError(err)
+	_, err = UnaryInterceptor(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	a.Error(err)
+
+	a.Equal(2, testutil.CollectAndCount(GRPCRequestDuration, "bytebase_grpc_request_duration_seconds"))
+}
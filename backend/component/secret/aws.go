@@ -0,0 +1,64 @@
+package secret
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/pkg/errors"
+)
+
+// awsProvider gets the secret from AWS Secrets Manager, e.g., aws-sm://prod/db?region=us-east-1#password.
+// The path is the secret name or ARN, and the optional version query is the version stage, AWSCURRENT by default.
+// The credentials are loaded from the default credential chain.
+type awsProvider struct {
+	mu sync.Mutex
+	// clients are the clients by region.
+	clients map[string]*secretsmanager.Client
+}
+
+func newAWSProvider() *awsProvider {
+	return &awsProvider{clients: make(map[string]*secretsmanager.Client)}
+}
+
+func (p *awsProvider) GetSecret(ctx context.Context, ref *Reference) (string, error) {
+	client, err := p.getClient(ctx, ref.Query.Get("region"))
+	if err != nil {
+		return "", err
+	}
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(ref.Path),
+	}
+	if version := ref.Query.Get("version"); version != "" {
+		input.VersionStage = aws.String(version)
+	}
+	output, err := client.GetSecretValue(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	if output.SecretString != nil {
+		return *output.SecretString, nil
+	}
+	return string(output.SecretBinary), nil
+}
+
+func (p *awsProvider) getClient(ctx context.Context, region string) (*secretsmanager.Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if client, ok := p.clients[region]; ok {
+		return client, nil
+	}
+	var opts []func(*awsconfig.LoadOptions) error
+	if region != "" {
+		opts = append(opts, awsconfig.WithRegion(region))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load AWS config")
+	}
+	client := secretsmanager.NewFromConfig(cfg)
+	p.clients[region] = client
+	return client, nil
+}
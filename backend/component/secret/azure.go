@@ -0,0 +1,101 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+	"github.com/pkg/errors"
+)
+
+// azureProvider gets the secret from Azure Key Vault, e.g., azure-kv://my-vault/db-password[/version].
+// The vault URL is https://<vault>.vault.azure.net, the vault can also be the full host name in the sovereign clouds,
+// e.g., my-vault.vault.azure.cn. The credentials are loaded from the default Azure credential chain.
+type azureProvider struct {
+	mu         sync.Mutex
+	credential azcore.TokenCredential
+	// clients are the clients by vault.
+	clients map[string]*azsecrets.Client
+}
+
+func newAzureProvider() *azureProvider {
+	return &azureProvider{clients: make(map[string]*azsecrets.Client)}
+}
+
+func (p *azureProvider) GetSecret(ctx context.Context, ref *Reference) (string, error) {
+	parts := strings.Split(strings.Trim(ref.Path, "/"), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return "", errors.Errorf("invalid Azure Key Vault secret %q, should be azure-kv://<vault>/<secret>[/<version>]", ref.Path)
+	}
+	vault, name, version := parts[0], parts[1], ""
+	if len(parts) == 3 {
+		version = parts[2]
+	}
+	client, err := p.getClient(vault)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.GetSecret(ctx, name, version, nil)
+	if err != nil {
+		return "", err
+	}
+	if resp.Value == nil {
+		return "", errors.Errorf("secret %q has no value", name)
+	}
+	return *resp.Value, nil
+}
+
+func (p *azureProvider) getClient(vault string) (*azsecrets.Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if client, ok := p.clients[vault]; ok {
+		return client, nil
+	}
+	if p.credential == nil {
+		credential, err := azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create Azure credential")
+		}
+		p.credential = credential
+	}
+	vaultHost, err := getAzureVaultHost(vault)
+	if err != nil {
+		return nil, err
+	}
+	client, err := azsecrets.NewClient(fmt.Sprintf("https://%s", vaultHost), p.credential, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create Azure Key Vault client")
+	}
+	p.clients[vault] = client
+	return client, nil
+}
+
+// azureVaultDomains are the DNS suffixes of Azure Key Vault in the public and sovereign clouds.
+var azureVaultDomains = []string{
+	"vault.azure.net",
+	"vault.azure.cn",
+	"vault.usgovcloudapi.net",
+	"vault.microsoftazure.de",
+}
+
+// azureVaultNameRegex matches the Key Vault names, which are 3-24 alphanumerics and hyphens.
+var azureVaultNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$`)
+
+// getAzureVaultHost returns the host of the vault, which is either a vault name or a host in the Key Vault domains.
+// Other hosts are rejected so that the Azure credential is never sent to an arbitrary server.
+func getAzureVaultHost(vault string) (string, error) {
+	name, domain, ok := strings.Cut(strings.ToLower(vault), ".")
+	if !ok {
+		domain = azureVaultDomains[0]
+	}
+	if !azureVaultNameRegex.MatchString(name) || !slices.Contains(azureVaultDomains, domain) {
+		return "", errors.Errorf("invalid Azure Key Vault %q, should be a vault name or a host in %s", vault, strings.Join(azureVaultDomains, ", "))
+	}
+	return fmt.Sprintf("%s.%s", name, domain), nil
+}
@@ -0,0 +1,50 @@
+package secret
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	"github.com/pkg/errors"
+)
+
+// gcpProvider gets the secret from GCP Secret Manager, e.g., gcp-sm://projects/my-project/secrets/db-password.
+// The latest version is used unless the path specifies one, e.g., gcp-sm://projects/my-project/secrets/db-password/versions/2.
+// The credentials are loaded from the application default credentials.
+type gcpProvider struct {
+	mu     sync.Mutex
+	client *secretmanager.Client
+}
+
+func (p *gcpProvider) GetSecret(ctx context.Context, ref *Reference) (string, error) {
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	name := strings.TrimPrefix(ref.Path, "/")
+	if !strings.Contains(name, "/versions/") {
+		name += "/versions/latest"
+	}
+	resp, err := client.AccessSecretVersion(ctx, &secretmanagerpb.AccessSecretVersionRequest{Name: name})
+	if err != nil {
+		return "", err
+	}
+	return string(resp.GetPayload().GetData()), nil
+}
+
+func (p *gcpProvider) getClient(ctx context.Context) (*secretmanager.Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.client != nil {
+		return p.client, nil
+	}
+	// Use a background context because the client outlives the request.
+	client, err := secretmanager.NewClient(context.WithoutCancel(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create GCP Secret Manager client")
+	}
+	p.client = client
+	return client, nil
+}
@@ -0,0 +1,209 @@
+package secret
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// httpProvider gets the secret from the URL returning the payload in the shape of GCP Secret Manager access response,
+// e.g., {"payload": {"data": "<base64-encoded secret>"}}.
+type httpProvider struct{}
+
+type payload struct {
+	Data string `json:"data"`
+}
+
+type accessResponse struct {
+	Payload payload `json:"payload"`
+}
+
+func (*httpProvider) GetSecret(ctx context.Context, ref *Reference) (string, error) {
+	// The key is part of the URL for the HTTP provider.
+	secretURL := ref.URI
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create request for %q", secretURL)
+	}
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get secret from %q", secretURL)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to get secret from %q status %v", secretURL, response.StatusCode)
+	}
+
+	var r accessResponse
+	decoder := json.NewDecoder(response.Body)
+	if err := decoder.Decode(&r); err != nil {
+		return "", errors.Wrapf(err, "failed to decode JSON response")
+	}
+	secret, err := base64.StdEncoding.DecodeString(r.Payload.Data)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decode base64 secret payload")
+	}
+	return string(secret), nil
+}
+
+// envProvider gets the secret from the environment variable, e.g., env://DB_PASSWORD.
+// Only the allowed environment variables can be read.
+type envProvider struct {
+	allowedEnvs []string
+}
+
+func (p *envProvider) GetSecret(_ context.Context, ref *Reference) (string, error) {
+	if !slices.Contains(p.allowedEnvs, ref.Path) {
+		return "", errors.Errorf("environment variable %q is not allowed by --external-secret-env", ref.Path)
+	}
+	v, ok := os.LookupEnv(ref.Path)
+	if !ok {
+		return "", errors.Errorf("environment variable %q is not set", ref.Path)
+	}
+	return v, nil
+}
+
+// fileProvider gets the secret from the file, e.g., file:///run/secrets/db-password.
+// It is useful for the secrets mounted by Kubernetes or Docker, which are re-read after rotation.
+// Only the files under the allowed directories can be read.
+type fileProvider struct {
+	allowedDirs []string
+}
+
+func (p *fileProvider) GetSecret(_ context.Context, ref *Reference) (string, error) {
+	if !filepath.IsAbs(ref.Path) {
+		return "", errors.Errorf("secret file %q must be an absolute path", ref.Path)
+	}
+	// Resolve the symbolic links so that they cannot point outside the allowed directories.
+	path, err := filepath.EvalSymlinks(ref.Path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve secret file %q", ref.Path)
+	}
+	if !slices.ContainsFunc(p.allowedDirs, func(dir string) bool {
+		return isUnderDir(path, dir)
+	}) {
+		return "", errors.Errorf("secret file %q is not under the directories allowed by --external-secret-file-dir", ref.Path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read secret file %q", ref.Path)
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// isUnderDir returns whether the resolved path is under the directory.
+func isUnderDir(path, dir string) bool {
+	dir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel)
+}
+
+// vaultProvider gets the secret from HashiCorp Vault, e.g., vault://secret/data/db#password.
+// The path is the Vault API path without the /v1 prefix. The Vault address and token are read from
+// the VAULT_ADDR and VAULT_TOKEN environment variables, the same as the Vault CLI.
+// Only the paths under the allowed prefixes can be read, since the requests carry the server's Vault token.
+type vaultProvider struct {
+	allowedPathPrefixes []string
+}
+
+func (p *vaultProvider) GetSecret(ctx context.Context, ref *Reference) (string, error) {
+	path := strings.TrimPrefix(ref.Path, "/")
+	if err := validateVaultPath(path); err != nil {
+		return "", err
+	}
+	if !slices.ContainsFunc(p.allowedPathPrefixes, func(prefix string) bool {
+		prefix = strings.Trim(prefix, "/")
+		return prefix != "" && (path == prefix || strings.HasPrefix(path, prefix+"/"))
+	}) {
+		return "", errors.Errorf("Vault path %q is not allowed by --external-secret-vault-path", path)
+	}
+	addr := os.Getenv("VAULT_ADDR")
+	if addr == "" {
+		return "", errors.New("VAULT_ADDR is not set")
+	}
+	token := os.Getenv("VAULT_TOKEN")
+	if token == "" {
+		return "", errors.New("VAULT_TOKEN is not set")
+	}
+	secretURL := fmt.Sprintf("%s/v1/%s", strings.TrimSuffix(addr, "/"), path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create request for %q", secretURL)
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if namespace := os.Getenv("VAULT_NAMESPACE"); namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get secret from %q", secretURL)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return "", errors.Errorf("failed to get secret from %q status %v: %s", secretURL, response.StatusCode, body)
+	}
+
+	var r struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&r); err != nil {
+		return "", errors.Wrapf(err, "failed to decode JSON response")
+	}
+	data := r.Data
+	// KV secrets engine version 2 nests the secret in data.data.
+	if nested, ok := data["data"]; ok {
+		if _, hasMetadata := data["metadata"]; hasMetadata {
+			data = nil
+			if err := json.Unmarshal(nested, &data); err != nil {
+				return "", errors.Wrapf(err, "failed to decode KV v2 secret")
+			}
+		}
+	}
+	if ref.Key == "" {
+		if len(data) != 1 {
+			return "", errors.Errorf("secret has %d fields, the key must be specified", len(data))
+		}
+		for _, v := range data {
+			var s string
+			if err := json.Unmarshal(v, &s); err != nil {
+				return "", errors.Wrapf(err, "secret value is not a string")
+			}
+			return s, nil
+		}
+	}
+	secret, err := json.Marshal(data)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal secret")
+	}
+	return string(secret), nil
+}
+
+// validateVaultPath rejects the Vault paths escaping the allowed prefixes with the dot segments or escaped characters.
+func validateVaultPath(path string) error {
+	if strings.Contains(path, "%") {
+		return errors.Errorf("invalid Vault path %q, escaped characters are not allowed", path)
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return errors.Errorf("invalid Vault path %q, empty and dot segments are not allowed", path)
+		}
+	}
+	return nil
+}
@@ -2,32 +2,62 @@
 package secret
 
 import (
-	"encoding/base64"
+	"context"
 	"encoding/json"
-	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
-var secretCache = make(map[string]string)
+// DefaultCacheTTL is the default duration to cache the secrets, after which rotated secrets are picked up.
+const DefaultCacheTTL = 5 * time.Minute
 
-// ReplaceExternalSecret replaces the secret with external secret.
-func ReplaceExternalSecret(secret string) (string, error) {
-	ok, secretURL := GetExternalSecretURL(secret)
-	if !ok {
-		return secret, nil
+// SecretProvider gets secrets from an external secret manager.
+type SecretProvider interface {
+	// GetSecret returns the secret referenced by ref.
+	GetSecret(ctx context.Context, ref *Reference) (string, error)
+}
+
+// Reference is the reference of an external secret in the form of scheme://path[?query][#key].
+// For example, aws-sm://prod/db?region=us-east-1#password refers to the "password" field of the JSON secret "prod/db".
+type Reference struct {
+	// Scheme is the URI scheme selecting the secret provider.
+	Scheme string
+	// Path is the location of the secret in the secret manager, i.e., everything between "://" and the query.
+	Path string
+	// Query is the provider specific options.
+	Query url.Values
+	// Key is the optional field to extract if the secret is a JSON object.
+	Key string
+	// URI is the original reference.
+	URI string
+}
+
+// ParseReference parses the reference of an external secret.
+func ParseReference(uri string) (*Reference, error) {
+	scheme, rest, ok := strings.Cut(uri, "://")
+	if !ok || scheme == "" {
+		return nil, errors.Errorf("invalid secret reference %q, should be in the form of scheme://path", uri)
 	}
-	if v, ok := secretCache[secretURL]; ok {
-		return v, nil
+	ref := &Reference{
+		Scheme: strings.ToLower(scheme),
+		URI:    uri,
 	}
-	secret, err := getSecretFromURL(secretURL)
+	rest, ref.Key, _ = strings.Cut(rest, "#")
+	rest, rawQuery, _ := strings.Cut(rest, "?")
+	query, err := url.ParseQuery(rawQuery)
 	if err != nil {
-		return "", err
+		return nil, errors.Wrapf(err, "invalid query in secret reference %q", uri)
+	}
+	ref.Path = rest
+	ref.Query = query
+	if ref.Path == "" {
+		return nil, errors.Errorf("invalid secret reference %q, path is empty", uri)
 	}
-	secretCache[secretURL] = secret
-	return secret, err
+	return ref, nil
 }
 
 // GetExternalSecretURL gets external secret URL from secret.
@@ -39,39 +69,145 @@ func GetExternalSecretURL(secret string) (bool, string) {
 		return false, ""
 	}
 	s := secret[2 : len(secret)-2]
-	if _, err := url.ParseRequestURI(s); err != nil {
+	if _, err := ParseReference(s); err != nil {
 		return false, ""
 	}
 	return true, s
 }
 
-type payload struct {
-	Data string `json:"data"`
+type cacheEntry struct {
+	value    string
+	expireAt time.Time
+}
+
+// Allowlist restricts what the external secrets can read from the Bytebase server itself.
+// Since the secret references are set by the users, the env://, file:// and vault:// secrets are disabled
+// unless the environment variables, files and Vault paths are explicitly allowed by the server flags.
+type Allowlist struct {
+	// Envs are the names of the environment variables readable by env://.
+	Envs []string
+	// FileDirs are the directories whose files are readable by file://.
+	FileDirs []string
+	// VaultPathPrefixes are the Vault API path prefixes readable by vault://, e.g., secret/data/bytebase.
+	VaultPathPrefixes []string
 }
 
-type accessResponse struct {
-	Payload payload `json:"payload"`
+// Manager resolves the external secrets with the providers registered by URI scheme.
+// The resolved secrets are cached for the TTL. It is safe for concurrent use.
+type Manager struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	providers map[string]SecretProvider
+	cache     map[string]*cacheEntry
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// NewManager creates a secret manager with the built-in providers registered.
+// The env://, file:// and vault:// secrets are restricted to the allowlist, and disabled if it is nil.
+func NewManager(ttl time.Duration, allowlist *Allowlist) *Manager {
+	if allowlist == nil {
+		allowlist = &Allowlist{}
+	}
+	m := &Manager{
+		ttl:       ttl,
+		providers: make(map[string]SecretProvider),
+		cache:     make(map[string]*cacheEntry),
+		now:       time.Now,
+	}
+	httpProvider := &httpProvider{}
+	m.RegisterProvider("http", httpProvider)
+	m.RegisterProvider("https", httpProvider)
+	m.RegisterProvider("env", &envProvider{allowedEnvs: allowlist.Envs})
+	m.RegisterProvider("file", &fileProvider{allowedDirs: allowlist.FileDirs})
+	m.RegisterProvider("vault", &vaultProvider{allowedPathPrefixes: allowlist.VaultPathPrefixes})
+	m.RegisterProvider("aws-sm", newAWSProvider())
+	m.RegisterProvider("gcp-sm", &gcpProvider{})
+	m.RegisterProvider("azure-kv", newAzureProvider())
+	return m
 }
 
-func getSecretFromURL(secretURL string) (string, error) {
-	response, err := http.Get(secretURL)
+// RegisterProvider registers the provider for the URI scheme, replacing the existing one.
+func (m *Manager) RegisterProvider(scheme string, provider SecretProvider) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.providers[strings.ToLower(scheme)] = provider
+}
+
+// ReplaceExternalSecret replaces the secret with external secret.
+// The secret is returned as is if it is not an external secret reference like {{vault://secret/data/db#password}}.
+func (m *Manager) ReplaceExternalSecret(ctx context.Context, secret string) (string, error) {
+	ok, uri := GetExternalSecretURL(secret)
+	if !ok {
+		return secret, nil
+	}
+
+	m.mu.Lock()
+	if entry, ok := m.cache[uri]; ok && m.now().Before(entry.expireAt) {
+		m.mu.Unlock()
+		return entry.value, nil
+	}
+	m.mu.Unlock()
+
+	// Fetch the secret without holding the lock, since it may take a while.
+	value, err := m.getSecret(ctx, uri)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to get secret from %q", secretURL)
+		return "", err
 	}
-	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusOK {
-		return "", errors.Wrapf(err, "failed to get secret from %q status %v", secretURL, response.StatusCode)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cache[uri] = &cacheEntry{value: value, expireAt: m.now().Add(m.ttl)}
+	return value, nil
+}
+
+// Invalidate evicts the cached external secret so that it is fetched again on the next use,
+// e.g., after the secret is rotated and the cached one is rejected.
+func (m *Manager) Invalidate(secret string) {
+	ok, uri := GetExternalSecretURL(secret)
+	if !ok {
+		return
 	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.cache, uri)
+}
 
-	var r accessResponse
-	decoder := json.NewDecoder(response.Body)
-	if err := decoder.Decode(&r); err != nil {
-		return "", errors.Wrapf(err, "failed to decode JSON response")
+func (m *Manager) getSecret(ctx context.Context, uri string) (string, error) {
+	ref, err := ParseReference(uri)
+	if err != nil {
+		return "", err
 	}
-	secret, err := base64.StdEncoding.DecodeString(r.Payload.Data)
+	m.mu.Lock()
+	provider, ok := m.providers[ref.Scheme]
+	m.mu.Unlock()
+	if !ok {
+		return "", errors.Errorf("unsupported secret provider %q in %q", ref.Scheme, uri)
+	}
+	value, err := provider.GetSecret(ctx, ref)
 	if err != nil {
-		panic(err)
+		return "", errors.Wrapf(err, "failed to get secret %q", uri)
+	}
+	return extractKey(value, ref.Key)
+}
+
+// extractKey extracts the field from the JSON secret, the secret is returned as is if the key is empty.
+func extractKey(secret, key string) (string, error) {
+	if key == "" {
+		return secret, nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(secret), &fields); err != nil {
+		return "", errors.Wrapf(err, "secret is not a JSON object to extract key %q", key)
+	}
+	v, ok := fields[key]
+	if !ok {
+		return "", errors.Errorf("key %q not found in secret", key)
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", errors.Errorf("value of key %q is not a string", key)
 	}
-	return string(secret), nil
+	return s, nil
 }
@@ -0,0 +1,227 @@
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		uri     string
+		want    *Reference
+		wantErr bool
+	}{
+		{
+			uri:  "env://DB_PASSWORD",
+			want: &Reference{Scheme: "env", Path: "DB_PASSWORD"},
+		},
+		{
+			uri:  "file:///run/secrets/db",
+			want: &Reference{Scheme: "file", Path: "/run/secrets/db"},
+		},
+		{
+			uri:  "aws-sm://arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db?region=us-east-1#password",
+			want: &Reference{Scheme: "aws-sm", Path: "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db", Key: "password"},
+		},
+		{
+			uri:     "DB_PASSWORD",
+			wantErr: true,
+		},
+		{
+			uri:     "env://",
+			wantErr: true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		ref, err := ParseReference(test.uri)
+		if test.wantErr {
+			a.Error(err, test.uri)
+			continue
+		}
+		a.NoError(err, test.uri)
+		a.Equal(test.want.Scheme, ref.Scheme)
+		a.Equal(test.want.Path, ref.Path)
+		a.Equal(test.want.Key, ref.Key)
+	}
+}
+
+func TestReplaceExternalSecret(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	secretDir := t.TempDir()
+	m := NewManager(time.Minute, &Allowlist{Envs: []string{"BB_TEST_DB_PASSWORD"}, FileDirs: []string{secretDir}})
+
+	got, err := m.ReplaceExternalSecret(ctx, "plain-password")
+	a.NoError(err)
+	a.Equal("plain-password", got)
+
+	t.Setenv("BB_TEST_DB_PASSWORD", "env-password")
+	got, err = m.ReplaceExternalSecret(ctx, "{{env://BB_TEST_DB_PASSWORD}}")
+	a.NoError(err)
+	a.Equal("env-password", got)
+
+	secretFile := filepath.Join(secretDir, "db.json")
+	a.NoError(os.WriteFile(secretFile, []byte(`{"username": "bb", "password": "file-password"}`+"\n"), 0600))
+	got, err = m.ReplaceExternalSecret(ctx, "{{file://"+secretFile+"#password}}")
+	a.NoError(err)
+	a.Equal("file-password", got)
+
+	_, err = m.ReplaceExternalSecret(ctx, "{{file://"+secretFile+"#token}}")
+	a.Error(err)
+	_, err = m.ReplaceExternalSecret(ctx, "{{unknown://secret}}")
+	a.Error(err)
+
+	// The environment variables and files not in the allowlist cannot be read.
+	t.Setenv("BB_TEST_OTHER", "other")
+	_, err = m.ReplaceExternalSecret(ctx, "{{env://BB_TEST_OTHER}}")
+	a.Error(err)
+	otherFile := filepath.Join(t.TempDir(), "other")
+	a.NoError(os.WriteFile(otherFile, []byte("other"), 0600))
+	_, err = m.ReplaceExternalSecret(ctx, "{{file://"+otherFile+"}}")
+	a.Error(err)
+	_, err = m.ReplaceExternalSecret(ctx, "{{file://"+secretDir+"/../"+filepath.Base(filepath.Dir(otherFile))+"/other}}")
+	a.Error(err)
+	a.NoError(os.Symlink(otherFile, filepath.Join(secretDir, "link")))
+	_, err = m.ReplaceExternalSecret(ctx, "{{file://"+secretDir+"/link}}")
+	a.Error(err)
+
+	// The env:// and file:// secrets are disabled without the allowlist.
+	_, err = NewManager(time.Minute, nil).ReplaceExternalSecret(ctx, "{{env://BB_TEST_DB_PASSWORD}}")
+	a.Error(err)
+}
+
+func TestReplaceExternalSecretCache(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	m := NewManager(time.Minute, &Allowlist{Envs: []string{"BB_TEST_DB_PASSWORD"}})
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	t.Setenv("BB_TEST_DB_PASSWORD", "old-password")
+	got, err := m.ReplaceExternalSecret(ctx, "{{env://BB_TEST_DB_PASSWORD}}")
+	a.NoError(err)
+	a.Equal("old-password", got)
+
+	// The rotated secret is not picked up before the cache expires.
+	t.Setenv("BB_TEST_DB_PASSWORD", "new-password")
+	got, err = m.ReplaceExternalSecret(ctx, "{{env://BB_TEST_DB_PASSWORD}}")
+	a.NoError(err)
+	a.Equal("old-password", got)
+
+	now = now.Add(2 * time.Minute)
+	got, err = m.ReplaceExternalSecret(ctx, "{{env://BB_TEST_DB_PASSWORD}}")
+	a.NoError(err)
+	a.Equal("new-password", got)
+
+	// Invalidate evicts the cached secret immediately.
+	t.Setenv("BB_TEST_DB_PASSWORD", "newer-password")
+	m.Invalidate("{{env://BB_TEST_DB_PASSWORD}}")
+	got, err = m.ReplaceExternalSecret(ctx, "{{env://BB_TEST_DB_PASSWORD}}")
+	a.NoError(err)
+	a.Equal("newer-password", got)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Invalidate("{{env://BB_TEST_DB_PASSWORD}}")
+			got, err := m.ReplaceExternalSecret(ctx, "{{env://BB_TEST_DB_PASSWORD}}")
+			a.NoError(err)
+			a.Equal("newer-password", got)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestHTTPProvider(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := "cGFzc3dvcmQ="
+		if r.URL.Path == "/invalid" {
+			data = "not base64"
+		}
+		a.NoError(json.NewEncoder(w).Encode(map[string]any{"payload": map[string]string{"data": data}}))
+	}))
+	defer server.Close()
+
+	m := NewManager(time.Minute, nil)
+	got, err := m.ReplaceExternalSecret(context.Background(), "{{"+server.URL+"/data}}")
+	a.NoError(err)
+	a.Equal("password", got)
+
+	_, err = m.ReplaceExternalSecret(context.Background(), "{{"+server.URL+"/invalid}}")
+	a.Error(err)
+}
+
+func TestVaultProvider(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("test-token", r.Header.Get("X-Vault-Token"))
+		a.Equal("/v1/secret/data/db", r.URL.Path)
+		a.NoError(json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"data":     map[string]string{"username": "bb", "password": "vault-password"},
+				"metadata": map[string]any{"version": 1},
+			},
+		}))
+	}))
+	defer server.Close()
+	t.Setenv("VAULT_ADDR", server.URL)
+	t.Setenv("VAULT_TOKEN", "test-token")
+
+	m := NewManager(time.Minute, &Allowlist{VaultPathPrefixes: []string{"secret/data/"}})
+	got, err := m.ReplaceExternalSecret(context.Background(), "{{vault://secret/data/db#password}}")
+	a.NoError(err)
+	a.Equal("vault-password", got)
+
+	// The key is required if the secret has multiple fields.
+	_, err = m.ReplaceExternalSecret(context.Background(), "{{vault://secret/data/db}}")
+	a.Error(err)
+
+	// The paths outside the allowed prefixes are rejected before sending the token.
+	for _, uri := range []string{
+		"{{vault://sys/policies/acl/default}}",
+		"{{vault://secret/data/../../sys/policies/acl/default}}",
+		"{{vault://secret/data/%2e%2e/%2e%2e/sys/policies/acl/default}}",
+		"{{vault://secret/database}}",
+	} {
+		_, err = m.ReplaceExternalSecret(context.Background(), uri)
+		a.Error(err, uri)
+	}
+}
+
+func TestGetAzureVaultHost(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		vault   string
+		want    string
+		wantErr bool
+	}{
+		{vault: "my-vault", want: "my-vault.vault.azure.net"},
+		{vault: "my-vault.vault.azure.cn", want: "my-vault.vault.azure.cn"},
+		{vault: "attacker.example.com", wantErr: true},
+		{vault: "my-vault.vault.azure.net.example.com", wantErr: true},
+		{vault: "localhost:8080", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := getAzureVaultHost(test.vault)
+		if test.wantErr {
+			a.Error(err, test.vault)
+			continue
+		}
+		a.NoError(err, test.vault)
+		a.Equal(test.want, got)
+	}
+}
@@ -29,6 +29,7 @@ type State struct {
 	ApprovalFinding sync.Map // map[issue.ID]*store.IssueMessage
 
 	// TaskProgress is the map from task ID to task progress.
+	// The tasks run on the leader replica, which forwards the progress to the followers.
 	TaskProgress sync.Map // map[taskID]api.Progress
 	// GhostTaskState is the map from task ID to gh-ost state.
 	GhostTaskState sync.Map // map[taskID]sharedGhostState
@@ -49,6 +50,7 @@ type State struct {
 	// RunningTasksCancel is the cancel's of running tasks.
 	RunningTasksCancel sync.Map // map[taskID]context.CancelFunc
 	// InstanceOutstandingConnections is the maximum number of connections per instance.
+	// It's only used by the schedulers, which run on the leader replica.
 	InstanceOutstandingConnections map[int]int
 
 	// IssueExternalApprovalRelayCancelChan cancels the external approval from relay for issue issueUID.
@@ -35,12 +35,26 @@ type BackupStorageBackend string
 const (
 	// BackupStorageBackendLocal is the local storage backend for a backup.
 	BackupStorageBackendLocal BackupStorageBackend = "LOCAL"
-	// BackupStorageBackendS3 is the AWS S3 storage backend for a backup. Not used yet.
+	// BackupStorageBackendS3 is the AWS S3 or S3-compatible (e.g., MinIO) storage backend for a backup.
 	BackupStorageBackendS3 BackupStorageBackend = "S3"
-	// BackupStorageBackendGCS is the Google Cloud Storage (GCS) storage backend for a backup. Not used yet.
+	// BackupStorageBackendGCS is the Google Cloud Storage (GCS) storage backend for a backup.
 	BackupStorageBackendGCS BackupStorageBackend = "GCS"
 	// BackupStorageBackendOSS is the AliCloud Object Storage Service (OSS) storage backend for a backup. Not used yet.
 	BackupStorageBackendOSS BackupStorageBackend = "OSS"
+	// BackupStorageBackendAzureBlob is the Azure Blob Storage storage backend for a backup.
+	BackupStorageBackendAzureBlob BackupStorageBackend = "AZURE_BLOB"
+)
+
+// BackupCompression is the compression algorithm of a backup artifact.
+type BackupCompression string
+
+const (
+	// BackupCompressionNone is the compression for uncompressed backup artifacts.
+	BackupCompressionNone BackupCompression = "NONE"
+	// BackupCompressionGzip is the gzip compression for backup artifacts.
+	BackupCompressionGzip BackupCompression = "GZIP"
+	// BackupCompressionZstd is the zstd compression for backup artifacts.
+	BackupCompressionZstd BackupCompression = "ZSTD"
 )
 
 // BinlogInfo is the binlog coordination for MySQL.
@@ -62,4 +76,30 @@ type BackupPayload struct {
 	// It is recorded within the same transaction as the dump so that the binlog position is consistent with the dump.
 	// Please refer to https://github.com/bytebase/bytebase/blob/main/docs/design/pitr-mysql.md#full-backup for details.
 	BinlogInfo BinlogInfo `json:"binlogInfo"`
+
+	// Compression is the compression algorithm of the backup artifact. Empty means uncompressed.
+	Compression BackupCompression `json:"compression,omitempty"`
+	// EncryptionKeyRef is the reference of the key encryption key wrapping the data key of the backup artifact,
+	// e.g., env://BB_BACKUP_KEY. Empty means unencrypted.
+	EncryptionKeyRef string `json:"encryptionKeyRef,omitempty"`
+	// Checksum is the hex-encoded SHA-256 checksum of the backup artifact as stored in the storage backend.
+	// It is verified before restoring the backup.
+	Checksum string `json:"checksum,omitempty"`
+	// TableRowCounts is the row counts of the tables keyed by the schema-qualified table name when the backup is taken.
+	// Restore drills compare the restored tables against them.
+	TableRowCounts map[string]int64 `json:"tableRowCounts,omitempty"`
+	// RestoreDrill is the result of the latest restore drill of the backup.
+	RestoreDrill *BackupRestoreDrill `json:"restoreDrill,omitempty"`
+}
+
+// BackupRestoreDrill is the result of restoring a backup into a scratch database to verify that it is restorable.
+type BackupRestoreDrill struct {
+	// Ts is the timestamp when the drill finished.
+	Ts int64 `json:"ts"`
+	// InstanceID is the resource ID of the instance hosting the scratch database.
+	InstanceID string `json:"instanceId"`
+	// Passed is true if the backup is restored and the restored tables and row counts match the source database.
+	Passed bool `json:"passed"`
+	// Detail is the reason of the failure or the summary of the comparison.
+	Detail string `json:"detail,omitempty"`
 }
@@ -50,6 +50,12 @@ const (
 	PolicyTypeDisableCopyData PolicyType = "bb.policy.disable-copy-data"
 	// PolicyTypeMaskingRule is the masking rule policy type.
 	PolicyTypeMaskingRule PolicyType = "bb.policy.masking-rule"
+	// PolicyTypeRolloutConcurrency is the rollout concurrency policy type.
+	PolicyTypeRolloutConcurrency PolicyType = "bb.policy.rollout-concurrency"
+	// PolicyTypeMaintenanceWindow is the maintenance window policy type.
+	PolicyTypeMaintenanceWindow PolicyType = "bb.policy.maintenance-window"
+	// PolicyTypeRowFilter is the row filter policy type.
+	PolicyTypeRowFilter PolicyType = "bb.policy.row-filter"
 
 	// PipelineApprovalValueManualNever means the pipeline will automatically be approved without user intervention.
 	PipelineApprovalValueManualNever PipelineApprovalValue = "MANUAL_APPROVAL_NEVER"
@@ -90,16 +96,19 @@ const (
 var (
 	// AllowedResourceTypes includes allowed resource types for each policy type.
 	AllowedResourceTypes = map[PolicyType][]PolicyResourceType{
-		PolicyTypeWorkspaceIAM:     {PolicyResourceTypeWorkspace},
-		PolicyTypePipelineApproval: {PolicyResourceTypeEnvironment},
-		PolicyTypeBackupPlan:       {PolicyResourceTypeEnvironment},
-		PolicyTypeSQLReview:        {PolicyResourceTypeEnvironment},
-		PolicyTypeEnvironmentTier:  {PolicyResourceTypeEnvironment},
-		PolicyTypeMasking:          {PolicyResourceTypeDatabase},
-		PolicyTypeSlowQuery:        {PolicyResourceTypeInstance},
-		PolicyTypeDisableCopyData:  {PolicyResourceTypeEnvironment},
-		PolicyTypeMaskingRule:      {PolicyResourceTypeWorkspace},
-		PolicyTypeMaskingException: {PolicyResourceTypeProject},
+		PolicyTypeWorkspaceIAM:       {PolicyResourceTypeWorkspace},
+		PolicyTypePipelineApproval:   {PolicyResourceTypeEnvironment},
+		PolicyTypeBackupPlan:         {PolicyResourceTypeEnvironment},
+		PolicyTypeSQLReview:          {PolicyResourceTypeEnvironment},
+		PolicyTypeEnvironmentTier:    {PolicyResourceTypeEnvironment},
+		PolicyTypeMasking:            {PolicyResourceTypeDatabase},
+		PolicyTypeSlowQuery:          {PolicyResourceTypeInstance},
+		PolicyTypeDisableCopyData:    {PolicyResourceTypeEnvironment},
+		PolicyTypeMaskingRule:        {PolicyResourceTypeWorkspace},
+		PolicyTypeMaskingException:   {PolicyResourceTypeProject},
+		PolicyTypeRolloutConcurrency: {PolicyResourceTypeEnvironment, PolicyResourceTypeProject, PolicyResourceTypeInstance},
+		PolicyTypeMaintenanceWindow:  {PolicyResourceTypeEnvironment},
+		PolicyTypeRowFilter:          {PolicyResourceTypeProject},
 	}
 )
 
@@ -148,6 +157,9 @@ type BackupPlanPolicy struct {
 	Schedule BackupPlanPolicySchedule `json:"schedule"`
 	// RetentionPeriodTs is the minimum allowed period that backup data is kept for databases in an environment.
 	RetentionPeriodTs int `json:"retentionPeriodTs"`
+	// StorageBackend is the storage backend for the backups of databases in an environment.
+	// Empty means using the default storage backend of the server.
+	StorageBackend BackupStorageBackend `json:"storageBackend,omitempty"`
 }
 
 func (bp *BackupPlanPolicy) String() (string, error) {
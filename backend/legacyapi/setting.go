@@ -46,6 +46,11 @@ const (
 	SettingSemanticTypes SettingName = "bb.workspace.semantic-types"
 	// SettingMaskingAlgorithms is the setting name for masking algorithms.
 	SettingMaskingAlgorithms SettingName = "bb.workspace.masking-algorithms"
+	// SettingSensitiveDataDiscovery is the setting name for sensitive data discovery.
+	SettingSensitiveDataDiscovery SettingName = "bb.workspace.sensitive-data-discovery"
+	// SettingSCIMToken is the setting name for the bearer token of the SCIM provisioning APIs.
+	// SCIM provisioning is disabled if the token is empty.
+	SettingSCIMToken SettingName = "bb.workspace.scim-token"
 )
 
 // IMType is the type of IM.
@@ -42,6 +42,10 @@ const (
 	TaskDatabaseSchemaUpdateGhostSync TaskType = "bb.task.database.schema.update.ghost.sync"
 	// TaskDatabaseSchemaUpdateGhostCutover is the task type for gh-ost switching the original table and the ghost table.
 	TaskDatabaseSchemaUpdateGhostCutover TaskType = "bb.task.database.schema.update.ghost.cutover"
+	// TaskDatabaseSchemaUpdatePGOnlineSync is the task type for syncing the PostgreSQL shadow table.
+	TaskDatabaseSchemaUpdatePGOnlineSync TaskType = "bb.task.database.schema.update.pg-online.sync"
+	// TaskDatabaseSchemaUpdatePGOnlineCutover is the task type for switching the original table and the PostgreSQL shadow table.
+	TaskDatabaseSchemaUpdatePGOnlineCutover TaskType = "bb.task.database.schema.update.pg-online.cutover"
 	// TaskDatabaseDataUpdate is the task type for updating database data.
 	TaskDatabaseDataUpdate TaskType = "bb.task.database.data.update"
 	// TaskDatabaseBackup is the task type for creating database backups.
@@ -167,6 +171,25 @@ type TaskDatabaseSchemaUpdateGhostCutoverPayload struct {
 	SpecID        string `json:"specId,omitempty"`
 }
 
+// TaskDatabaseSchemaUpdatePGOnlineSyncPayload is the task payload for syncing the PostgreSQL shadow table.
+type TaskDatabaseSchemaUpdatePGOnlineSyncPayload struct {
+	// Common fields
+	Skipped       bool   `json:"skipped,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+	SpecID        string `json:"specId,omitempty"`
+
+	SheetID       int    `json:"sheetId,omitempty"`
+	SchemaVersion string `json:"schemaVersion,omitempty"`
+}
+
+// TaskDatabaseSchemaUpdatePGOnlineCutoverPayload is the task payload for switching the original table and the PostgreSQL shadow table.
+type TaskDatabaseSchemaUpdatePGOnlineCutoverPayload struct {
+	// Common fields
+	Skipped       bool   `json:"skipped,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+	SpecID        string `json:"specId,omitempty"`
+}
+
 // RollbackSQLStatus is the status of a rollback SQL generation task.
 type RollbackSQLStatus string
 
@@ -179,6 +202,44 @@ const (
 	RollbackSQLStatusFailed RollbackSQLStatus = "FAILED"
 )
 
+// RollbackBackupTable is the table backing up the rows changed by an UPDATE or DELETE statement.
+type RollbackBackupTable struct {
+	// Schema and Table are the table changed by the statement.
+	// Schema is the database for MySQL.
+	Schema string `json:"schema,omitempty"`
+	Table  string `json:"table,omitempty"`
+	// BackupTable is the name of the backup table in the "bbdataarchive" schema for PostgreSQL, or database for MySQL.
+	BackupTable string `json:"backupTable,omitempty"`
+	// IsDelete is true for the DELETE statement, and false for the UPDATE statement.
+	IsDelete bool `json:"isDelete,omitempty"`
+	// SkippedReason is the reason why the rows are not backed up, e.g. the changed rows cannot be determined statically.
+	SkippedReason string `json:"skippedReason,omitempty"`
+}
+
+// BatchConfig is the config executing a single-table UPDATE or DELETE statement in batches of the primary key ranges.
+type BatchConfig struct {
+	// BatchSize is the number of rows in the primary key range of a batch.
+	BatchSize int `json:"batchSize,omitempty"`
+	// MaxRowsPerSecond is the maximum number of rows changed per second. There is no limit if it's zero.
+	MaxRowsPerSecond int `json:"maxRowsPerSecond,omitempty"`
+	// MaxReplicationLagSeconds pauses the execution while the replication lag exceeds it. There is no limit if it's zero.
+	MaxReplicationLagSeconds int `json:"maxReplicationLagSeconds,omitempty"`
+}
+
+// BatchProgress is the progress of the batched execution, from which the execution resumes after being canceled or interrupted.
+type BatchProgress struct {
+	// SheetID is the sheet executed. The progress is discarded if the sheet of the task changes.
+	SheetID int `json:"sheetId,omitempty"`
+	// LastKey is the primary key of the last row of the last committed batch.
+	LastKey []string `json:"lastKey,omitempty"`
+	// ScannedRows is the number of rows in the primary key ranges of the committed batches.
+	ScannedRows int64 `json:"scannedRows,omitempty"`
+	// AffectedRows is the number of rows changed by the committed batches.
+	AffectedRows int64 `json:"affectedRows,omitempty"`
+	// Completed is true if all batches are committed.
+	Completed bool `json:"completed,omitempty"`
+}
+
 // TaskDatabaseDataUpdatePayload is the task payload for database data update (DML).
 type TaskDatabaseDataUpdatePayload struct {
 	// Common fields
@@ -217,6 +278,14 @@ type TaskDatabaseDataUpdatePayload struct {
 	RollbackFromIssueID int `json:"rollbackFromIssueId,omitempty"`
 	// RollbackFromTaskID is the task ID from which the rollback SQL statement is generated for this task.
 	RollbackFromTaskID int `json:"rollbackFromTaskId,omitempty"`
+	// RollbackBackupTables are the tables backing up the rows changed by the UPDATE and DELETE statements in the order of the statements.
+	// It is used for PostgreSQL, and MySQL with the prior backup enabled.
+	RollbackBackupTables []*RollbackBackupTable `json:"rollbackBackupTables,omitempty"`
+
+	// BatchConfig executes the statement in batches if set.
+	BatchConfig *BatchConfig `json:"batchConfig,omitempty"`
+	// BatchProgress is the progress of the batched execution.
+	BatchProgress *BatchProgress `json:"batchProgress,omitempty"`
 
 	SchemaGroupName string `json:"schemaGroupName,omitempty"`
 }
@@ -0,0 +1,5 @@
+ALTER TABLE backup DROP CONSTRAINT IF EXISTS backup_storage_backend_check;
+ALTER TABLE backup ADD CONSTRAINT backup_storage_backend_check CHECK (storage_backend IN ('LOCAL', 'S3', 'GCS', 'OSS', 'AZURE_BLOB'));
+
+-- storage_backend is the storage backend for the backups of the database. Empty means inheriting from the environment backup plan policy.
+ALTER TABLE backup_setting ADD storage_backend TEXT NOT NULL DEFAULT '' CHECK (storage_backend IN ('', 'LOCAL', 'S3', 'GCS', 'OSS', 'AZURE_BLOB'));
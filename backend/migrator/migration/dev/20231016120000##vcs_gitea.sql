@@ -0,0 +1,2 @@
+ALTER TABLE vcs DROP CONSTRAINT IF EXISTS vcs_type_check;
+ALTER TABLE vcs ADD CONSTRAINT vcs_type_check CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS', 'GIT', 'GITEA'));
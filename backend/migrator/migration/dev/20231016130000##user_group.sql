@@ -0,0 +1,63 @@
+-- user_group stores the user groups, which can be granted project roles as a whole.
+CREATE TABLE user_group (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    resource_id TEXT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    -- idp_id is the identity provider that the group is synced from, NULL for groups managed in Bytebase.
+    idp_id INTEGER REFERENCES idp (id),
+    -- external_id is the group identifier in the identity provider, e.g. the DN of an LDAP group.
+    external_id TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX idx_user_group_unique_resource_id ON user_group(resource_id);
+
+CREATE UNIQUE INDEX idx_user_group_unique_idp_id_external_id ON user_group(idp_id, external_id) WHERE idp_id IS NOT NULL;
+
+ALTER SEQUENCE user_group_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_user_group_updated_ts
+BEFORE
+UPDATE
+    ON user_group FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+CREATE TABLE user_group_member (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    group_id INTEGER NOT NULL REFERENCES user_group (id),
+    principal_id INTEGER NOT NULL REFERENCES principal (id)
+);
+
+CREATE UNIQUE INDEX idx_user_group_member_unique_group_id_principal_id ON user_group_member(group_id, principal_id);
+
+CREATE INDEX idx_user_group_member_principal_id ON user_group_member(principal_id);
+
+ALTER SEQUENCE user_group_member_id_seq RESTART WITH 101;
+
+-- project_group_member stores the project roles granted to user groups.
+CREATE TABLE project_group_member (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    project_id INTEGER NOT NULL REFERENCES project (id),
+    role TEXT NOT NULL,
+    group_id INTEGER NOT NULL REFERENCES user_group (id),
+    condition JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_project_group_member_project_id ON project_group_member(project_id);
+
+ALTER SEQUENCE project_group_member_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_project_group_member_updated_ts
+BEFORE
+UPDATE
+    ON project_group_member FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
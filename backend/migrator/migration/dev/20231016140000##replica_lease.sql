@@ -0,0 +1,10 @@
+-- replica_lease stores the leases held by the Bytebase replicas.
+-- Each replica renews the lease "replica/{replica_id}" while it is alive, and the leader renews the lease "leader".
+CREATE TABLE replica_lease (
+    name TEXT PRIMARY KEY,
+    holder TEXT NOT NULL,
+    expire_ts BIGINT NOT NULL
+);
+
+-- replica_id is the replica that claimed the task run for execution, empty if the task run is not claimed.
+ALTER TABLE task_run ADD COLUMN replica_id TEXT NOT NULL DEFAULT '';
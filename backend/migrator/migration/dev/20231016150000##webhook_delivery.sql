@@ -0,0 +1,29 @@
+-- secret is the key to sign the webhook payloads with HMAC-SHA256.
+ALTER TABLE project_webhook ADD COLUMN secret TEXT NOT NULL DEFAULT '';
+
+-- webhook_delivery stores the outgoing project webhook events.
+-- The deliveries are sent by the webhook delivery runner with exponential backoff,
+-- and marked DEAD after the max attempts.
+CREATE TABLE webhook_delivery (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    webhook_id INTEGER NOT NULL REFERENCES project_webhook (id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'DONE', 'DEAD')),
+    payload JSONB NOT NULL DEFAULT '{}',
+    attempt INTEGER NOT NULL DEFAULT 0,
+    next_attempt_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery(webhook_id);
+
+CREATE INDEX idx_webhook_delivery_pending_next_attempt_ts ON webhook_delivery(next_attempt_ts) WHERE status = 'PENDING';
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_webhook_delivery_updated_ts
+BEFORE
+UPDATE
+    ON webhook_delivery FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
@@ -0,0 +1,2 @@
+-- event_filter is the CEL expression to filter the events sent to the webhook, empty means all events.
+ALTER TABLE project_webhook ADD COLUMN event_filter TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- scheduler_info saves why the task run is waiting to execute in json format, e.g. waiting for the maintenance window.
+ALTER TABLE task_run ADD COLUMN scheduler_info JSONB NOT NULL DEFAULT '{}';
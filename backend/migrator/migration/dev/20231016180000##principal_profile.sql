@@ -0,0 +1,2 @@
+-- profile saves the attributes of the user referenced by the row filter policies in json format.
+ALTER TABLE principal ADD COLUMN profile JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,25 @@
+-- classification_suggestion stores the data classification of the columns proposed by the sensitive data discovery.
+-- The suggestions are reviewed by the workspace owner or DBA, and the accepted classification is set in the database config.
+CREATE TABLE classification_suggestion (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id) ON DELETE CASCADE,
+    schema TEXT NOT NULL,
+    "table" TEXT NOT NULL,
+    "column" TEXT NOT NULL,
+    classification_id TEXT NOT NULL,
+    detector_id TEXT NOT NULL,
+    confidence DOUBLE PRECISION NOT NULL,
+    state TEXT NOT NULL CHECK (state IN ('PENDING', 'ACCEPTED', 'REJECTED'))
+);
+
+CREATE UNIQUE INDEX idx_classification_suggestion_unique_database_id_schema_table_column ON classification_suggestion(database_id, schema, "table", "column");
+
+ALTER SEQUENCE classification_suggestion_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_classification_suggestion_updated_ts
+BEFORE
+UPDATE
+    ON classification_suggestion FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
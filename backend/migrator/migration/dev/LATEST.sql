@@ -47,7 +47,8 @@ CREATE TABLE principal (
     email TEXT NOT NULL,
     password_hash TEXT NOT NULL,
     phone TEXT NOT NULL DEFAULT '',
-    mfa_config JSONB NOT NULL DEFAULT '{}'
+    mfa_config JSONB NOT NULL DEFAULT '{}',
+    profile JSONB NOT NULL DEFAULT '{}'
 );
 
 CREATE TRIGGER update_principal_updated_ts
@@ -292,6 +293,71 @@ UPDATE
     ON project_member FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- User Group
+-- user_group stores the user groups, which can be granted project roles as a whole.
+CREATE TABLE user_group (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    resource_id TEXT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    -- idp_id is the identity provider that the group is synced from, NULL for groups managed in Bytebase.
+    idp_id INTEGER REFERENCES idp (id),
+    -- external_id is the group identifier in the identity provider, e.g. the DN of an LDAP group.
+    external_id TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX idx_user_group_unique_resource_id ON user_group(resource_id);
+
+CREATE UNIQUE INDEX idx_user_group_unique_idp_id_external_id ON user_group(idp_id, external_id) WHERE idp_id IS NOT NULL;
+
+ALTER SEQUENCE user_group_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_user_group_updated_ts
+BEFORE
+UPDATE
+    ON user_group FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+CREATE TABLE user_group_member (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    group_id INTEGER NOT NULL REFERENCES user_group (id),
+    principal_id INTEGER NOT NULL REFERENCES principal (id)
+);
+
+CREATE UNIQUE INDEX idx_user_group_member_unique_group_id_principal_id ON user_group_member(group_id, principal_id);
+
+CREATE INDEX idx_user_group_member_principal_id ON user_group_member(principal_id);
+
+ALTER SEQUENCE user_group_member_id_seq RESTART WITH 101;
+
+-- project_group_member stores the project roles granted to user groups.
+CREATE TABLE project_group_member (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    project_id INTEGER NOT NULL REFERENCES project (id),
+    role TEXT NOT NULL,
+    group_id INTEGER NOT NULL REFERENCES user_group (id),
+    condition JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_project_group_member_project_id ON project_group_member(project_id);
+
+ALTER SEQUENCE project_group_member_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_project_group_member_updated_ts
+BEFORE
+UPDATE
+    ON project_group_member FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
 -- Project Hook
 CREATE TABLE project_webhook (
     id SERIAL PRIMARY KEY,
@@ -304,7 +370,11 @@ CREATE TABLE project_webhook (
     type TEXT NOT NULL CHECK (type LIKE 'bb.plugin.webhook.%'),
     name TEXT NOT NULL,
     url TEXT NOT NULL,
-    activity_list TEXT ARRAY NOT NULL
+    activity_list TEXT ARRAY NOT NULL,
+    -- secret is the key to sign the webhook payloads with HMAC-SHA256.
+    secret TEXT NOT NULL DEFAULT '',
+    -- event_filter is the CEL expression to filter the events sent to the webhook, empty means all events.
+    event_filter TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_project_webhook_project_id ON project_webhook(project_id);
@@ -479,7 +549,7 @@ CREATE TABLE backup (
     name TEXT NOT NULL,
     status TEXT NOT NULL CHECK (status IN ('PENDING_CREATE', 'DONE', 'FAILED')),
     type TEXT NOT NULL CHECK (type IN ('MANUAL', 'AUTOMATIC', 'PITR')),
-    storage_backend TEXT NOT NULL CHECK (storage_backend IN ('LOCAL', 'S3', 'GCS', 'OSS')),
+    storage_backend TEXT NOT NULL CHECK (storage_backend IN ('LOCAL', 'S3', 'GCS', 'OSS', 'AZURE_BLOB')),
     migration_history_version TEXT NOT NULL,
     path TEXT NOT NULL,
     comment TEXT NOT NULL DEFAULT '',
@@ -516,7 +586,9 @@ CREATE TABLE backup_setting (
     -- retention_period_ts == 0 means unset retention period and we do not delete any data.
     retention_period_ts INTEGER NOT NULL DEFAULT 0 CHECK (retention_period_ts >= 0),
     -- hook_url is the callback url to be requested after a successful backup.
-    hook_url TEXT NOT NULL
+    hook_url TEXT NOT NULL,
+    -- storage_backend is the storage backend for the backups of the database. Empty means inheriting from the environment backup plan policy.
+    storage_backend TEXT NOT NULL DEFAULT '' CHECK (storage_backend IN ('', 'LOCAL', 'S3', 'GCS', 'OSS', 'AZURE_BLOB'))
 );
 
 CREATE UNIQUE INDEX idx_backup_setting_unique_database_id ON backup_setting(database_id);
@@ -644,7 +716,11 @@ CREATE TABLE task_run (
     status TEXT NOT NULL CHECK (status IN ('PENDING', 'RUNNING', 'DONE', 'FAILED', 'CANCELED')),
     code INTEGER NOT NULL DEFAULT 0,
     -- result saves the task run result in json format
-    result  JSONB NOT NULL DEFAULT '{}'
+    result  JSONB NOT NULL DEFAULT '{}',
+    -- replica_id is the replica that claimed the task run for execution, empty if the task run is not claimed.
+    replica_id TEXT NOT NULL DEFAULT '',
+    -- scheduler_info saves why the task run is waiting to execute in json format, e.g. waiting for the maintenance window.
+    scheduler_info JSONB NOT NULL DEFAULT '{}'
 );
 
 CREATE INDEX idx_task_run_task_id ON task_run(task_id);
@@ -901,7 +977,7 @@ CREATE TABLE vcs (
     updater_id INTEGER NOT NULL REFERENCES principal (id),
     updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
     name TEXT NOT NULL,
-    type TEXT NOT NULL CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS')),
+    type TEXT NOT NULL CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS', 'GIT', 'GITEA')),
     instance_url TEXT NOT NULL CHECK ((instance_url LIKE 'http://%' OR instance_url LIKE 'https://%') AND instance_url = rtrim(instance_url, '/')),
     api_url TEXT NOT NULL CHECK ((api_url LIKE 'http://%' OR api_url LIKE 'https://%') AND api_url = rtrim(api_url, '/')),
     application_id TEXT NOT NULL,
@@ -1301,4 +1377,65 @@ CREATE TRIGGER update_changelist_updated_ts
 BEFORE
 UPDATE
     ON changelist FOR EACH ROW
-EXECUTE FUNCTION trigger_update_updated_ts();
\ No newline at end of file
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+-- replica_lease stores the leases held by the Bytebase replicas.
+-- Each replica renews the lease "replica/{replica_id}" while it is alive, and the leader renews the lease "leader".
+CREATE TABLE replica_lease (
+    name TEXT PRIMARY KEY,
+    holder TEXT NOT NULL,
+    expire_ts BIGINT NOT NULL
+);
+
+-- webhook_delivery stores the outgoing project webhook events.
+-- The deliveries are sent by the webhook delivery runner with exponential backoff,
+-- and marked DEAD after the max attempts.
+CREATE TABLE webhook_delivery (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    webhook_id INTEGER NOT NULL REFERENCES project_webhook (id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'DONE', 'DEAD')),
+    payload JSONB NOT NULL DEFAULT '{}',
+    attempt INTEGER NOT NULL DEFAULT 0,
+    next_attempt_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery(webhook_id);
+
+CREATE INDEX idx_webhook_delivery_pending_next_attempt_ts ON webhook_delivery(next_attempt_ts) WHERE status = 'PENDING';
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_webhook_delivery_updated_ts
+BEFORE
+UPDATE
+    ON webhook_delivery FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+-- classification_suggestion stores the data classification of the columns proposed by the sensitive data discovery.
+-- The suggestions are reviewed by the workspace owner or DBA, and the accepted classification is set in the database config.
+CREATE TABLE classification_suggestion (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id) ON DELETE CASCADE,
+    schema TEXT NOT NULL,
+    "table" TEXT NOT NULL,
+    "column" TEXT NOT NULL,
+    classification_id TEXT NOT NULL,
+    detector_id TEXT NOT NULL,
+    confidence DOUBLE PRECISION NOT NULL,
+    state TEXT NOT NULL CHECK (state IN ('PENDING', 'ACCEPTED', 'REJECTED'))
+);
+
+CREATE UNIQUE INDEX idx_classification_suggestion_unique_database_id_schema_table_column ON classification_suggestion(database_id, schema, "table", "column");
+
+ALTER SEQUENCE classification_suggestion_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_classification_suggestion_updated_ts
+BEFORE
+UPDATE
+    ON classification_suggestion FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
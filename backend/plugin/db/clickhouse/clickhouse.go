@@ -38,7 +38,7 @@ var (
 )
 
 func init() {
-	db.Register(db.ClickHouse, newDriver)
+	db.Register(db.ClickHouse, newDriver, db.CapabilitySchemaDump, db.CapabilityRestore)
 }
 
 // Driver is the ClickHouse driver.
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
 )
 
@@ -32,8 +33,11 @@ const (
 		"%s;\n"
 )
 
-// Dump dumps the database.
-func (driver *Driver) Dump(ctx context.Context, out io.Writer, _ bool) (string, error) {
+// Dump dumps the database schema. Dump with data is not supported.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	if !schemaOnly {
+		return "", common.Errorf(common.NotImplemented, "dump is not supported for %s", db.ClickHouse)
+	}
 	txn, err := driver.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		return "", err
@@ -27,7 +27,7 @@ var (
 )
 
 func init() {
-	db.Register(db.DM, newDriver)
+	db.Register(db.DM, newDriver, db.CapabilitySchemaDump)
 }
 
 // Driver is the DM driver.
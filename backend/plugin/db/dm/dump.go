@@ -7,11 +7,16 @@ import (
 	"io"
 	"strings"
 
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
 )
 
-// Dump dumps the database.
-func (driver *Driver) Dump(ctx context.Context, out io.Writer, _ bool) (string, error) {
+// Dump dumps the database schema. Dump with data is not supported.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	if !schemaOnly {
+		return "", common.Errorf(common.NotImplemented, "dump is not supported for %s", db.DM)
+	}
 	txn, err := driver.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		return "", err
@@ -84,7 +89,7 @@ func dumpTxn(ctx context.Context, txn *sql.Tx, schemas []string, out io.Writer)
 }
 
 // Restore restores a database.
-func (*Driver) Restore(_ context.Context, _ io.Reader) (err error) {
+func (*Driver) Restore(_ context.Context, _ io.Reader) error {
 	// TODO(d): implement it.
-	return nil
+	return common.Errorf(common.NotImplemented, "restore is not supported for %s", db.DM)
 }
@@ -15,6 +15,8 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -96,8 +98,25 @@ type IndexKey struct {
 }
 
 var (
-	driversMu sync.RWMutex
-	drivers   = make(map[Type]driverFunc)
+	driversMu    sync.RWMutex
+	drivers      = make(map[Type]driverFunc)
+	capabilities = make(map[Type]map[Capability]bool)
+)
+
+// Capability is an optional feature that a database driver may support.
+type Capability string
+
+const (
+	// CapabilitySchemaDump is the capability to dump the database schema without data.
+	CapabilitySchemaDump Capability = "SCHEMA_DUMP"
+	// CapabilityDump is the capability to dump the database schema and data, which is required by backups.
+	CapabilityDump Capability = "DUMP"
+	// CapabilityRestore is the capability to restore a database from a dump.
+	CapabilityRestore Capability = "RESTORE"
+	// CapabilityRole is the capability to manage instance roles.
+	CapabilityRole Capability = "ROLE"
+	// CapabilitySlowQuery is the capability to sync slow queries.
+	CapabilitySlowQuery Capability = "SLOW_QUERY"
 )
 
 // DriverConfig is the driver configuration.
@@ -419,6 +438,21 @@ type QueryContext struct {
 	ShareDB bool
 }
 
+// QueryResultWriter receives a query result streamed row by row.
+type QueryResultWriter interface {
+	// WriteHeader is called once with the column names, types and masking info before any row is written.
+	WriteHeader(result *v1pb.QueryResult) error
+	// WriteRow is called for each row as the driver produces it. The row is already masked.
+	WriteRow(row *v1pb.QueryRow) error
+}
+
+// QueryStreamer is implemented by drivers that can stream the result of a readonly SELECT statement
+// instead of loading the whole result into memory.
+type QueryStreamer interface {
+	// QueryConnStream executes a single readonly SELECT statement and writes the result to w.
+	QueryConnStream(ctx context.Context, conn *sql.Conn, statement string, queryContext *QueryContext, w QueryResultWriter) error
+}
+
 // DatabaseRoleMessage is the API message for database role.
 type DatabaseRoleMessage struct {
 	// The role unique name.
@@ -498,10 +532,10 @@ type Driver interface {
 	Restore(ctx context.Context, src io.Reader) error
 }
 
-// Register makes a database driver available by the provided type.
+// Register makes a database driver available by the provided type together with the optional capabilities it supports.
 // If Register is called twice with the same name or if driver is nil,
 // it panics.
-func Register(dbType Type, f driverFunc) {
+func Register(dbType Type, f driverFunc, driverCapabilities ...Capability) {
 	driversMu.Lock()
 	defer driversMu.Unlock()
 	if f == nil {
@@ -511,6 +545,42 @@ func Register(dbType Type, f driverFunc) {
 		panic("db: Register called twice for driver " + dbType)
 	}
 	drivers[dbType] = f
+	capabilitySet := make(map[Capability]bool)
+	for _, capability := range driverCapabilities {
+		capabilitySet[capability] = true
+	}
+	capabilities[dbType] = capabilitySet
+}
+
+// HasCapability returns true if the driver registered for the database type supports the capability.
+func HasCapability(dbType Type, capability Capability) bool {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+	return capabilities[dbType][capability]
+}
+
+// CheckCapability returns a NotImplemented error if the driver registered for the database type does not support the capability.
+func CheckCapability(dbType Type, capability Capability) error {
+	if !HasCapability(dbType, capability) {
+		return common.Errorf(common.NotImplemented, "%s is not supported for %s", capability.description(), dbType)
+	}
+	return nil
+}
+
+func (c Capability) description() string {
+	switch c {
+	case CapabilitySchemaDump:
+		return "schema dump"
+	case CapabilityDump:
+		return "dump"
+	case CapabilityRestore:
+		return "restore"
+	case CapabilityRole:
+		return "role management"
+	case CapabilitySlowQuery:
+		return "slow query"
+	}
+	return string(c)
 }
 
 // Open opens a database specified by its database driver type and connection config without verifying the connection.
@@ -534,6 +604,9 @@ func Open(ctx context.Context, dbType Type, driverConfig DriverConfig, connectio
 type ExecuteOptions struct {
 	BeginFunc          func(ctx context.Context, conn *sql.Conn) error
 	EndTransactionFunc func(tx *sql.Tx) error
+	// BeforeStatementFunc is called in the transaction before executing each non-empty statement with the index of the statement.
+	// The statements are executed one by one if it's set. Only MySQL supports it.
+	BeforeStatementFunc func(ctx context.Context, tx *sql.Tx, index int) error
 }
 
 // FormatParamNameInQuestionMark formats the param name in question mark.
@@ -601,6 +674,8 @@ type TableSchema struct {
 type ColumnInfo struct {
 	Name         string
 	MaskingLevel storepb.MaskingLevel
+	// Masker is the masker of the masking algorithm configured for the column, nil for the default masker of the masking level.
+	Masker masker.Masker
 }
 
 // SensitiveField is the struct about SELECT fields.
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
 )
 
 func TestParseMigrationInfo(t *testing.T) {
@@ -318,3 +320,17 @@ func TestParseSchemaFileInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckCapability(t *testing.T) {
+	a := require.New(t)
+	const dbType Type = "CAPABILITY_TEST"
+	Register(dbType, func(DriverConfig) Driver { return nil }, CapabilitySchemaDump, CapabilityDump)
+
+	a.True(HasCapability(dbType, CapabilityDump))
+	a.NoError(CheckCapability(dbType, CapabilitySchemaDump))
+	a.False(HasCapability(dbType, CapabilityRestore))
+	err := CheckCapability(dbType, CapabilityRestore)
+	a.Error(err)
+	a.Equal(common.NotImplemented, common.ErrorCode(err))
+	a.False(HasCapability("UNKNOWN", CapabilityDump))
+}
@@ -0,0 +1,260 @@
+package mongodb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/resources/mongoutil"
+)
+
+const (
+	// archiveMagicNumber is the magic number at the beginning of the archive produced by mongodump --archive.
+	// https://github.com/mongodb/mongo-tools/blob/master/common/archive/archive.go
+	archiveMagicNumber uint32 = 0x8199e26d
+	schemaHeaderFmt           = "" +
+		"//\n" +
+		"// MongoDB database structure for %s\n" +
+		"//\n"
+	collectionHeaderFmt = "" +
+		"//\n" +
+		"// %s structure for %s\n" +
+		"//\n"
+)
+
+// collectionSpec is the collection specification returned by listCollections.
+type collectionSpec struct {
+	Name    string   `bson:"name"`
+	Type    string   `bson:"type"`
+	Options bson.Raw `bson:"options"`
+}
+
+// Dump dumps the database.
+// The full dump is a BSON archive in the mongodump --archive format, and the schema-only dump is a mongosh script
+// creating the collections with their options and validators, the views and the indexes.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	if driver.databaseName == "" {
+		return "", errors.Errorf("MongoDB can dump one database only at a time")
+	}
+	if schemaOnly {
+		return "", driver.dumpSchema(ctx, out)
+	}
+
+	connCfg := driver.connCfg
+	// The database is specified by --db, mongodump disallows the database in the connection string together with --db.
+	connCfg.Database = ""
+	args := []string{
+		fmt.Sprintf("--uri=%s", getMongoDBConnectionURI(connCfg)),
+		fmt.Sprintf("--db=%s", driver.databaseName),
+		"--archive",
+		"--quiet",
+	}
+	cmd := exec.CommandContext(ctx, mongoutil.GetMongodumpPath(driver.dbBinDir), args...)
+	var errContent bytes.Buffer
+	cmd.Stdout = out
+	cmd.Stderr = &errContent
+	if err := cmd.Run(); err != nil {
+		return "", errors.Wrapf(err, "failed to run mongodump: %s", errContent.String())
+	}
+	return "", nil
+}
+
+func (driver *Driver) dumpSchema(ctx context.Context, out io.Writer) error {
+	database := driver.client.Database(driver.databaseName)
+	cursor, err := database.ListCollections(ctx, bson.D{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list collections in database %q", driver.databaseName)
+	}
+	var collections []*collectionSpec
+	if err := cursor.All(ctx, &collections); err != nil {
+		return errors.Wrapf(err, "failed to decode collections in database %q", driver.databaseName)
+	}
+	collections = sortCollections(collections)
+
+	if _, err := io.WriteString(out, fmt.Sprintf(schemaHeaderFmt, driver.databaseName)); err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		var indexes []bson.D
+		if collection.Type != "view" {
+			cursor, err := database.Collection(collection.Name).Indexes().List(ctx)
+			if err != nil {
+				return errors.Wrapf(err, "failed to list indexes of collection %q", collection.Name)
+			}
+			if err := cursor.All(ctx, &indexes); err != nil {
+				return errors.Wrapf(err, "failed to decode indexes of collection %q", collection.Name)
+			}
+		}
+		stmt, err := getCollectionStmt(collection, indexes)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(out, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortCollections skips the system collections and sorts the collections by name, with views after collections
+// because views are defined on top of collections.
+func sortCollections(collections []*collectionSpec) []*collectionSpec {
+	var result []*collectionSpec
+	for _, collection := range collections {
+		if strings.HasPrefix(collection.Name, "system.") {
+			continue
+		}
+		result = append(result, collection)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		iView, jView := result[i].Type == "view", result[j].Type == "view"
+		if iView != jView {
+			return jView
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func getCollectionStmt(collection *collectionSpec, indexes []bson.D) (string, error) {
+	var buf strings.Builder
+	objectType := "Collection"
+	if collection.Type == "view" {
+		objectType = "View"
+	}
+	name, err := quoteString(collection.Name)
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprintf(&buf, collectionHeaderFmt, objectType, collection.Name)
+	if len(collection.Options) > 0 && !isEmptyDocument(collection.Options) {
+		options, err := getEJSONParseExpression(collection.Options)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to marshal options of collection %q", collection.Name)
+		}
+		fmt.Fprintf(&buf, "db.createCollection(%s, %s);\n", name, options)
+	} else {
+		fmt.Fprintf(&buf, "db.createCollection(%s);\n", name)
+	}
+
+	for _, index := range indexes {
+		var key any
+		var indexName string
+		var options bson.D
+		for _, e := range index {
+			switch e.Key {
+			case "key":
+				key = e.Value
+			case "v", "ns":
+				// The index version and namespace are determined by the server.
+			default:
+				if e.Key == "name" {
+					indexName, _ = e.Value.(string)
+				}
+				options = append(options, e)
+			}
+		}
+		// The _id index is created implicitly with the collection.
+		if indexName == "_id_" || key == nil {
+			continue
+		}
+		keyExpression, err := getEJSONParseExpression(key)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to marshal key of index %q on collection %q", indexName, collection.Name)
+		}
+		optionsExpression, err := getEJSONParseExpression(options)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to marshal options of index %q on collection %q", indexName, collection.Name)
+		}
+		fmt.Fprintf(&buf, "db.getCollection(%s).createIndex(%s, %s);\n", name, keyExpression, optionsExpression)
+	}
+	buf.WriteString("\n")
+	return buf.String(), nil
+}
+
+// getEJSONParseExpression returns the mongosh expression building the value from its canonical extended JSON,
+// which keeps the BSON types such as regular expressions and decimals in validators and options.
+func getEJSONParseExpression(value any) (string, error) {
+	content, err := bson.MarshalExtJSON(value, true /* canonical */, false /* escapeHTML */)
+	if err != nil {
+		return "", err
+	}
+	quoted, err := quoteString(string(content))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("EJSON.parse(%s)", quoted), nil
+}
+
+// quoteString quotes the string as a JavaScript string literal.
+func quoteString(s string) (string, error) {
+	content, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func isEmptyDocument(raw bson.Raw) bool {
+	elements, err := raw.Elements()
+	return err == nil && len(elements) == 0
+}
+
+// Restore restores the backup read from src.
+// The backup is either a BSON archive produced by Dump, which is replayed by mongorestore into the current database,
+// or a schema-only mongosh script, which is executed by mongosh.
+func (driver *Driver) Restore(ctx context.Context, src io.Reader) error {
+	if driver.databaseName == "" {
+		return errors.Errorf("MongoDB can restore one database only at a time")
+	}
+	reader := bufio.NewReader(src)
+	if !isArchive(reader) {
+		script, err := io.ReadAll(reader)
+		if err != nil {
+			return errors.Wrap(err, "failed to read the backup")
+		}
+		if _, err := driver.Execute(ctx, string(script), false /* createDatabase */, db.ExecuteOptions{}); err != nil {
+			return errors.Wrap(err, "failed to restore the schema")
+		}
+		return nil
+	}
+
+	connCfg := driver.connCfg
+	connCfg.Database = ""
+	args := []string{
+		fmt.Sprintf("--uri=%s", getMongoDBConnectionURI(connCfg)),
+		"--archive",
+		// The archive may be taken from another database, so we rename the namespaces to the current database.
+		"--nsFrom=$database$.$collection$",
+		fmt.Sprintf("--nsTo=%s.$collection$", driver.databaseName),
+		"--quiet",
+	}
+	cmd := exec.CommandContext(ctx, mongoutil.GetMongorestorePath(driver.dbBinDir), args...)
+	var errContent bytes.Buffer
+	cmd.Stdin = reader
+	cmd.Stderr = &errContent
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to run mongorestore: %s", errContent.String())
+	}
+	return nil
+}
+
+// isArchive returns true if the reader starts with the magic number of the mongodump archive format.
+func isArchive(reader *bufio.Reader) bool {
+	header, err := reader.Peek(4)
+	if err != nil {
+		return false
+	}
+	return binary.LittleEndian.Uint32(header) == archiveMagicNumber
+}
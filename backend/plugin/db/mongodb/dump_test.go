@@ -0,0 +1,57 @@
+package mongodb
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestGetCollectionStmt(t *testing.T) {
+	a := require.New(t)
+	options, err := bson.Marshal(bson.D{{Key: "validator", Value: bson.D{{Key: "age", Value: bson.D{{Key: "$gte", Value: int32(0)}}}}}})
+	a.NoError(err)
+	collection := &collectionSpec{Name: "users", Type: "collection", Options: options}
+	indexes := []bson.D{
+		{{Key: "v", Value: int32(2)}, {Key: "key", Value: bson.D{{Key: "_id", Value: int32(1)}}}, {Key: "name", Value: "_id_"}},
+		{{Key: "v", Value: int32(2)}, {Key: "key", Value: bson.D{{Key: "email", Value: int32(1)}}}, {Key: "name", Value: "email_1"}, {Key: "unique", Value: true}},
+	}
+	want := "//\n" +
+		"// Collection structure for users\n" +
+		"//\n" +
+		`db.createCollection("users", EJSON.parse("{\"validator\":{\"age\":{\"$gte\":{\"$numberInt\":\"0\"}}}}"));` + "\n" +
+		`db.getCollection("users").createIndex(EJSON.parse("{\"email\":{\"$numberInt\":\"1\"}}"), EJSON.parse("{\"name\":\"email_1\",\"unique\":true}"));` + "\n" +
+		"\n"
+	got, err := getCollectionStmt(collection, indexes)
+	a.NoError(err)
+	a.Equal(want, got)
+
+	emptyOptions, err := bson.Marshal(bson.D{})
+	a.NoError(err)
+	got, err = getCollectionStmt(&collectionSpec{Name: "logs", Options: emptyOptions}, nil)
+	a.NoError(err)
+	a.Equal("//\n// Collection structure for logs\n//\ndb.createCollection(\"logs\");\n\n", got)
+}
+
+func TestSortCollections(t *testing.T) {
+	collections := sortCollections([]*collectionSpec{
+		{Name: "v_users", Type: "view"},
+		{Name: "users", Type: "collection"},
+		{Name: "system.views", Type: "collection"},
+		{Name: "accounts", Type: "collection"},
+	})
+	var names []string
+	for _, collection := range collections {
+		names = append(names, collection.Name)
+	}
+	require.Equal(t, []string{"accounts", "users", "v_users"}, names)
+}
+
+func TestIsArchive(t *testing.T) {
+	a := require.New(t)
+	a.True(isArchive(bufio.NewReader(bytes.NewReader([]byte{0x6d, 0xe2, 0x99, 0x81, 0x00}))))
+	a.False(isArchive(bufio.NewReader(bytes.NewReader([]byte("db.createCollection(\"users\");")))))
+	a.False(isArchive(bufio.NewReader(bytes.NewReader(nil))))
+}
@@ -7,7 +7,6 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log/slog"
 	"net/url"
 	"os"
@@ -31,7 +30,7 @@ import (
 var _ db.Driver = (*Driver)(nil)
 
 func init() {
-	db.Register(db.MongoDB, newDriver)
+	db.Register(db.MongoDB, newDriver, db.CapabilitySchemaDump, db.CapabilityDump, db.CapabilityRestore)
 }
 
 // Driver is the MongoDB driver.
@@ -129,16 +128,6 @@ func (driver *Driver) Execute(ctx context.Context, statement string, _ bool, _ d
 	return 0, nil
 }
 
-// Dump dumps the database.
-func (*Driver) Dump(_ context.Context, _ io.Writer, _ bool) (string, error) {
-	return "", nil
-}
-
-// Restore restores the backup read from src.
-func (*Driver) Restore(_ context.Context, _ io.Reader) error {
-	panic("not implemented")
-}
-
 // getMongoDBConnectionURI returns the MongoDB connection URI.
 // https://www.mongodb.com/docs/manual/reference/connection-string/
 func getMongoDBConnectionURI(connConfig db.ConnectionConfig) string {
@@ -1,18 +1,1430 @@
 package mssql
 
 import (
+	"bufio"
 	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
 	"io"
+	"log/slog"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
+// Dump and restore.
+const (
+	databaseHeaderFmt = "" +
+		"--\n" +
+		"-- SQL Server database structure for %s\n" +
+		"--\n\n"
+	objectHeaderFmt = "" +
+		"--\n" +
+		"-- %s structure for %s\n" +
+		"--\n"
+	encryptedObjectHeaderFmt = "" +
+		"--\n" +
+		"-- %s structure for %s\n" +
+		"-- NULL statement because the definition is encrypted.\n" +
+		"--\n\n"
+	dataHeaderFmt = "" +
+		"--\n" +
+		"-- Data for %s\n" +
+		"--\n"
+
+	// batchSeparator separates the T-SQL batches in the dump, the same as sqlcmd and SSMS.
+	batchSeparator = "GO"
+	// insertBatchSize is the maximum row count of one INSERT statement.
+	// SQL Server limits the table value constructor to 1000 rows.
+	insertBatchSize = 1000
+)
+
+// valueKind is the literal kind of a dumped column value.
+type valueKind int
+
+const (
+	valueKindNumeric valueKind = iota
+	valueKindString
+	valueKindBinary
+)
+
+type columnSchema struct {
+	name string
+	// typeName is the name of the user type, e.g. nvarchar or an alias type.
+	typeName     string
+	typeSchema   string
+	baseTypeName sql.NullString
+	userDefined  bool
+	assemblyType bool
+	maxLength    int
+	precision    int
+	scale        int
+	nullable     bool
+	collation    sql.NullString
+
+	identity          bool
+	identitySeed      sql.NullString
+	identityIncrement sql.NullString
+
+	computedDefinition sql.NullString
+	persisted          sql.NullBool
+
+	defaultName       sql.NullString
+	defaultDefinition sql.NullString
+}
+
+type keyConstraint struct {
+	name       string
+	primary    bool
+	indexType  string
+	columns    []string
+	descending []bool
+}
+
+type checkConstraint struct {
+	name       string
+	definition string
+	disabled   bool
+}
+
+type indexSchema struct {
+	name            string
+	indexType       int
+	unique          bool
+	filter          sql.NullString
+	columns         []string
+	descending      []bool
+	includedColumns []string
+}
+
+type foreignKeySchema struct {
+	name              string
+	columns           []string
+	referencedSchema  string
+	referencedTable   string
+	referencedColumns []string
+	onDelete          string
+	onUpdate          string
+	disabled          bool
+	notForReplication bool
+}
+
+type tableSchema struct {
+	objectID    int64
+	schema      string
+	name        string
+	columns     []*columnSchema
+	keys        []*keyConstraint
+	checks      []*checkConstraint
+	indexes     []*indexSchema
+	foreignKeys []*foreignKeySchema
+}
+
+// moduleSchema is a programmable object defined by T-SQL text, i.e. a view, function, procedure or trigger.
+type moduleSchema struct {
+	objectID         int64
+	schema           string
+	name             string
+	objectType       string
+	definition       sql.NullString
+	ansiNulls        bool
+	quotedIdentifier bool
+
+	// Trigger only.
+	parentSchema string
+	parentName   string
+	disabled     bool
+}
+
+type sequenceSchema struct {
+	schema       string
+	name         string
+	typeName     string
+	precision    int
+	startValue   string
+	increment    string
+	minimumValue string
+	maximumValue string
+	cycling      bool
+	cached       bool
+	cacheSize    sql.NullInt64
+	currentValue sql.NullString
+}
+
+type synonymSchema struct {
+	schema         string
+	name           string
+	baseObjectName string
+}
+
+// dumpObject is a table or a module which takes part in the dependency ordering.
+type dumpObject struct {
+	objectID int64
+	table    *tableSchema
+	module   *moduleSchema
+}
+
 // Dump dumps the database.
-func (*Driver) Dump(_ context.Context, _ io.Writer, _ bool) (string, error) {
-	// TODO(d): implement it.
+// The dump reads a consistent snapshot if the database allows snapshot isolation, otherwise it falls back to read committed.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	isolation := sql.LevelReadCommitted
+	snapshotAllowed, err := driver.isSnapshotIsolationAllowed(ctx)
+	if err != nil {
+		return "", err
+	}
+	if snapshotAllowed {
+		isolation = sql.LevelSnapshot
+	} else {
+		slog.Warn("snapshot isolation is not allowed, the dump may be inconsistent under concurrent writes", slog.String("database", driver.databaseName))
+	}
+	txn, err := driver.db.BeginTx(ctx, &sql.TxOptions{Isolation: isolation})
+	if err != nil {
+		return "", err
+	}
+	defer txn.Rollback()
+
+	if err := dumpTxn(ctx, txn, out, schemaOnly); err != nil {
+		return "", err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return "", err
+	}
 	return "", nil
 }
 
-// Restore restores a database.
-func (*Driver) Restore(_ context.Context, _ io.Reader) (err error) {
-	// TODO(d): implement it.
+// isSnapshotIsolationAllowed returns true if ALLOW_SNAPSHOT_ISOLATION is on for the current database.
+func (driver *Driver) isSnapshotIsolationAllowed(ctx context.Context) (bool, error) {
+	var state int
+	if err := driver.db.QueryRowContext(ctx, "SELECT snapshot_isolation_state FROM sys.databases WHERE name = DB_NAME()").Scan(&state); err != nil {
+		return false, errors.Wrap(err, "failed to get the snapshot isolation state")
+	}
+	// 1 is ON.
+	return state == 1, nil
+}
+
+// dumpTxn dumps the current database of the transaction.
+// Objects are written in dependency order so that the dump can be replayed by Restore:
+// schemas, sequences, tables and modules, table data, indexes, foreign keys, triggers and synonyms.
+func dumpTxn(ctx context.Context, txn *sql.Tx, out io.Writer, schemaOnly bool) error {
+	var databaseName, databaseCollation string
+	if err := txn.QueryRowContext(ctx, "SELECT DB_NAME(), CONVERT(NVARCHAR(128), DATABASEPROPERTYEX(DB_NAME(), 'Collation'))").Scan(&databaseName, &databaseCollation); err != nil {
+		return err
+	}
+
+	schemas, err := getSchemaNames(ctx, txn)
+	if err != nil {
+		return errors.Wrap(err, "failed to get schemas")
+	}
+	sequences, err := getSequences(ctx, txn)
+	if err != nil {
+		return errors.Wrap(err, "failed to get sequences")
+	}
+	tables, err := getTableSchemas(ctx, txn)
+	if err != nil {
+		return errors.Wrap(err, "failed to get tables")
+	}
+	modules, err := getModules(ctx, txn)
+	if err != nil {
+		return errors.Wrap(err, "failed to get views, functions and procedures")
+	}
+	triggers, err := getTriggers(ctx, txn)
+	if err != nil {
+		return errors.Wrap(err, "failed to get triggers")
+	}
+	synonyms, err := getSynonyms(ctx, txn)
+	if err != nil {
+		return errors.Wrap(err, "failed to get synonyms")
+	}
+	dependencies, err := getDependencies(ctx, txn)
+	if err != nil {
+		return errors.Wrap(err, "failed to get object dependencies")
+	}
+
+	if _, err := io.WriteString(out, fmt.Sprintf(databaseHeaderFmt, databaseName)); err != nil {
+		return err
+	}
+	for _, schema := range schemas {
+		if err := writeBatch(out, "Schema", quoteIdentifier(schema), fmt.Sprintf("CREATE SCHEMA %s;", quoteIdentifier(schema))); err != nil {
+			return err
+		}
+	}
+	for _, sequence := range sequences {
+		if err := writeBatch(out, "Sequence", quoteName(sequence.schema, sequence.name), getSequenceStmt(sequence)); err != nil {
+			return err
+		}
+	}
+
+	var objects []*dumpObject
+	for _, table := range tables {
+		objects = append(objects, &dumpObject{objectID: table.objectID, table: table})
+	}
+	for _, module := range modules {
+		objects = append(objects, &dumpObject{objectID: module.objectID, module: module})
+	}
+	for _, object := range sortObjects(objects, dependencies) {
+		if object.table != nil {
+			if err := writeBatch(out, "Table", quoteName(object.table.schema, object.table.name), getTableStmt(object.table, databaseCollation)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := writeModule(out, object.module); err != nil {
+			return err
+		}
+	}
+
+	if !schemaOnly {
+		for _, table := range tables {
+			if err := exportTableData(ctx, txn, table, out); err != nil {
+				return errors.Wrapf(err, "failed to export data of table %s", quoteName(table.schema, table.name))
+			}
+		}
+	}
+
+	for _, table := range tables {
+		for _, index := range table.indexes {
+			if err := writeBatch(out, "Index", quoteIdentifier(index.name), getIndexStmt(table, index)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, table := range tables {
+		for _, foreignKey := range table.foreignKeys {
+			if err := writeBatch(out, "Foreign key", quoteIdentifier(foreignKey.name), getForeignKeyStmt(table, foreignKey)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, trigger := range triggers {
+		if err := writeModule(out, trigger); err != nil {
+			return err
+		}
+	}
+	for _, synonym := range synonyms {
+		stmt := fmt.Sprintf("CREATE SYNONYM %s FOR %s;", quoteName(synonym.schema, synonym.name), synonym.baseObjectName)
+		if err := writeBatch(out, "Synonym", quoteName(synonym.schema, synonym.name), stmt); err != nil {
+			return err
+		}
+	}
+
+	if !schemaOnly {
+		// Sequences are not advanced by the dumped data, so we restart them after their current value.
+		for _, sequence := range sequences {
+			restartValue, ok := getSequenceRestartValue(sequence)
+			if !ok {
+				continue
+			}
+			stmt := fmt.Sprintf("ALTER SEQUENCE %s RESTART WITH %s;", quoteName(sequence.schema, sequence.name), restartValue)
+			if err := writeBatch(out, "Sequence value", quoteName(sequence.schema, sequence.name), stmt); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeBatch(out io.Writer, objectType, name, stmt string) error {
+	if _, err := io.WriteString(out, fmt.Sprintf(objectHeaderFmt, objectType, name)); err != nil {
+		return err
+	}
+	_, err := io.WriteString(out, fmt.Sprintf("%s\n%s\n\n", stmt, batchSeparator))
+	return err
+}
+
+func writeModule(out io.Writer, module *moduleSchema) error {
+	name := quoteName(module.schema, module.name)
+	objectType := getReadableModuleType(module.objectType)
+	if !module.definition.Valid {
+		_, err := io.WriteString(out, fmt.Sprintf(encryptedObjectHeaderFmt, objectType, name))
+		return err
+	}
+	if _, err := io.WriteString(out, fmt.Sprintf(objectHeaderFmt, objectType, name)); err != nil {
+		return err
+	}
+	// The options are stored with the module and must be set in a separate batch before creating it.
+	settings := fmt.Sprintf("SET ANSI_NULLS %s;\n%s\nSET QUOTED_IDENTIFIER %s;\n%s\n", onOff(module.ansiNulls), batchSeparator, onOff(module.quotedIdentifier), batchSeparator)
+	if _, err := io.WriteString(out, settings); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(out, fmt.Sprintf("%s\n%s\n\n", strings.TrimSpace(module.definition.String), batchSeparator)); err != nil {
+		return err
+	}
+	if module.objectType == "TR" && module.disabled {
+		stmt := fmt.Sprintf("DISABLE TRIGGER %s ON %s;\n%s\n\n", name, quoteName(module.parentSchema, module.parentName), batchSeparator)
+		if _, err := io.WriteString(out, stmt); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+func getReadableModuleType(objectType string) string {
+	switch objectType {
+	case "V":
+		return "View"
+	case "FN", "IF", "TF":
+		return "Function"
+	case "P":
+		return "Procedure"
+	case "TR":
+		return "Trigger"
+	}
+	return objectType
+}
+
+func onOff(b bool) string {
+	if b {
+		return "ON"
+	}
+	return "OFF"
+}
+
+func quoteIdentifier(s string) string {
+	return fmt.Sprintf("[%s]", strings.ReplaceAll(s, "]", "]]"))
+}
+
+func quoteName(schema, name string) string {
+	return fmt.Sprintf("%s.%s", quoteIdentifier(schema), quoteIdentifier(name))
+}
+
+func quoteIdentifierList(names []string) string {
+	var list []string
+	for _, name := range names {
+		list = append(list, quoteIdentifier(name))
+	}
+	return strings.Join(list, ", ")
+}
+
+func getSchemaNames(ctx context.Context, txn *sql.Tx) ([]string, error) {
+	// Schema ids of dbo, guest, INFORMATION_SCHEMA and sys are below 5, and fixed database roles start from 16384.
+	query := `SELECT name FROM sys.schemas WHERE schema_id BETWEEN 5 AND 16383 ORDER BY name;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		result = append(result, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func getSequences(ctx context.Context, txn *sql.Tx) ([]*sequenceSchema, error) {
+	query := `
+		SELECT
+			SCHEMA_NAME(s.schema_id),
+			s.name,
+			TYPE_NAME(s.user_type_id),
+			s.precision,
+			CONVERT(NVARCHAR(64), s.start_value),
+			CONVERT(NVARCHAR(64), s.increment),
+			CONVERT(NVARCHAR(64), s.minimum_value),
+			CONVERT(NVARCHAR(64), s.maximum_value),
+			s.is_cycling,
+			s.is_cached,
+			s.cache_size,
+			CONVERT(NVARCHAR(64), s.current_value)
+		FROM sys.sequences s
+		WHERE s.is_ms_shipped = 0
+		ORDER BY 1, 2;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*sequenceSchema
+	for rows.Next() {
+		sequence := &sequenceSchema{}
+		if err := rows.Scan(
+			&sequence.schema,
+			&sequence.name,
+			&sequence.typeName,
+			&sequence.precision,
+			&sequence.startValue,
+			&sequence.increment,
+			&sequence.minimumValue,
+			&sequence.maximumValue,
+			&sequence.cycling,
+			&sequence.cached,
+			&sequence.cacheSize,
+			&sequence.currentValue,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, sequence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func getSequenceStmt(sequence *sequenceSchema) string {
+	typeName := sequence.typeName
+	if typeName == "decimal" || typeName == "numeric" {
+		typeName = fmt.Sprintf("%s(%d, 0)", typeName, sequence.precision)
+	}
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "CREATE SEQUENCE %s AS %s START WITH %s INCREMENT BY %s MINVALUE %s MAXVALUE %s",
+		quoteName(sequence.schema, sequence.name), typeName, sequence.startValue, sequence.increment, sequence.minimumValue, sequence.maximumValue)
+	if sequence.cycling {
+		_, _ = buf.WriteString(" CYCLE")
+	} else {
+		_, _ = buf.WriteString(" NO CYCLE")
+	}
+	switch {
+	case !sequence.cached:
+		_, _ = buf.WriteString(" NO CACHE")
+	case sequence.cacheSize.Valid:
+		_, _ = fmt.Fprintf(&buf, " CACHE %d", sequence.cacheSize.Int64)
+	default:
+		_, _ = buf.WriteString(" CACHE")
+	}
+	_, _ = buf.WriteString(";")
+	return buf.String()
+}
+
+// getSequenceRestartValue returns the value following the current value of the sequence.
+// It returns false if the sequence would be exhausted.
+func getSequenceRestartValue(sequence *sequenceSchema) (string, bool) {
+	if !sequence.currentValue.Valid {
+		return "", false
+	}
+	current, ok := new(big.Int).SetString(sequence.currentValue.String, 10)
+	if !ok {
+		return "", false
+	}
+	increment, ok := new(big.Int).SetString(sequence.increment, 10)
+	if !ok {
+		return "", false
+	}
+	minimum, ok := new(big.Int).SetString(sequence.minimumValue, 10)
+	if !ok {
+		return "", false
+	}
+	maximum, ok := new(big.Int).SetString(sequence.maximumValue, 10)
+	if !ok {
+		return "", false
+	}
+	next := new(big.Int).Add(current, increment)
+	if next.Cmp(minimum) < 0 || next.Cmp(maximum) > 0 {
+		return "", false
+	}
+	return next.String(), true
+}
+
+func getTableSchemas(ctx context.Context, txn *sql.Tx) ([]*tableSchema, error) {
+	query := `
+		SELECT
+			t.object_id,
+			SCHEMA_NAME(t.schema_id),
+			t.name
+		FROM sys.tables t
+		WHERE t.is_ms_shipped = 0
+		ORDER BY 2, 3;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []*tableSchema
+	tableMap := make(map[int64]*tableSchema)
+	for rows.Next() {
+		table := &tableSchema{}
+		if err := rows.Scan(&table.objectID, &table.schema, &table.name); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+		tableMap[table.objectID] = table
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := getTableColumnSchemas(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to get columns")
+	}
+	if err := getKeyConstraints(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to get primary key and unique constraints")
+	}
+	if err := getCheckConstraints(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to get check constraints")
+	}
+	if err := getIndexSchemas(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to get indexes")
+	}
+	if err := getForeignKeys(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to get foreign keys")
+	}
+	return tables, nil
+}
+
+func getTableColumnSchemas(ctx context.Context, txn *sql.Tx, tableMap map[int64]*tableSchema) error {
+	query := `
+		SELECT
+			c.object_id,
+			c.name,
+			TYPE_NAME(c.user_type_id),
+			SCHEMA_NAME(ty.schema_id),
+			TYPE_NAME(c.system_type_id),
+			ty.is_user_defined,
+			ty.is_assembly_type,
+			c.max_length,
+			c.precision,
+			c.scale,
+			c.is_nullable,
+			c.collation_name,
+			c.is_identity,
+			CONVERT(NVARCHAR(64), ic.seed_value),
+			CONVERT(NVARCHAR(64), ic.increment_value),
+			cc.definition,
+			cc.is_persisted,
+			dc.name,
+			dc.definition
+		FROM sys.columns c
+		INNER JOIN sys.tables t ON t.object_id = c.object_id
+		INNER JOIN sys.types ty ON ty.user_type_id = c.user_type_id
+		LEFT JOIN sys.identity_columns ic ON ic.object_id = c.object_id AND ic.column_id = c.column_id
+		LEFT JOIN sys.computed_columns cc ON cc.object_id = c.object_id AND cc.column_id = c.column_id
+		LEFT JOIN sys.default_constraints dc ON dc.parent_object_id = c.object_id AND dc.parent_column_id = c.column_id
+		WHERE t.is_ms_shipped = 0
+		ORDER BY c.object_id, c.column_id;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var objectID int64
+		column := &columnSchema{}
+		if err := rows.Scan(
+			&objectID,
+			&column.name,
+			&column.typeName,
+			&column.typeSchema,
+			&column.baseTypeName,
+			&column.userDefined,
+			&column.assemblyType,
+			&column.maxLength,
+			&column.precision,
+			&column.scale,
+			&column.nullable,
+			&column.collation,
+			&column.identity,
+			&column.identitySeed,
+			&column.identityIncrement,
+			&column.computedDefinition,
+			&column.persisted,
+			&column.defaultName,
+			&column.defaultDefinition,
+		); err != nil {
+			return err
+		}
+		if table, ok := tableMap[objectID]; ok {
+			table.columns = append(table.columns, column)
+		}
+	}
+	return rows.Err()
+}
+
+func getKeyConstraints(ctx context.Context, txn *sql.Tx, tableMap map[int64]*tableSchema) error {
+	query := `
+		SELECT
+			kc.parent_object_id,
+			kc.name,
+			kc.type,
+			i.type_desc,
+			c.name,
+			ic.is_descending_key
+		FROM sys.key_constraints kc
+		INNER JOIN sys.indexes i ON i.object_id = kc.parent_object_id AND i.index_id = kc.unique_index_id
+		INNER JOIN sys.index_columns ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id
+		INNER JOIN sys.columns c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+		WHERE kc.is_ms_shipped = 0
+		ORDER BY kc.parent_object_id, kc.name, ic.key_ordinal;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var objectID int64
+		var name, constraintType, indexType, columnName string
+		var descending bool
+		if err := rows.Scan(&objectID, &name, &constraintType, &indexType, &columnName, &descending); err != nil {
+			return err
+		}
+		table, ok := tableMap[objectID]
+		if !ok {
+			continue
+		}
+		if len(table.keys) == 0 || table.keys[len(table.keys)-1].name != name {
+			table.keys = append(table.keys, &keyConstraint{
+				name:      name,
+				primary:   strings.TrimSpace(constraintType) == "PK",
+				indexType: indexType,
+			})
+		}
+		key := table.keys[len(table.keys)-1]
+		key.columns = append(key.columns, columnName)
+		key.descending = append(key.descending, descending)
+	}
+	return rows.Err()
+}
+
+func getCheckConstraints(ctx context.Context, txn *sql.Tx, tableMap map[int64]*tableSchema) error {
+	query := `
+		SELECT
+			cc.parent_object_id,
+			cc.name,
+			cc.definition,
+			cc.is_disabled
+		FROM sys.check_constraints cc
+		WHERE cc.is_ms_shipped = 0
+		ORDER BY cc.parent_object_id, cc.name;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var objectID int64
+		check := &checkConstraint{}
+		if err := rows.Scan(&objectID, &check.name, &check.definition, &check.disabled); err != nil {
+			return err
+		}
+		if table, ok := tableMap[objectID]; ok {
+			table.checks = append(table.checks, check)
+		}
+	}
+	return rows.Err()
+}
+
+func getIndexSchemas(ctx context.Context, txn *sql.Tx, tableMap map[int64]*tableSchema) error {
+	// Index type 1 and 2 are clustered and nonclustered rowstore indexes, 5 and 6 are columnstore indexes.
+	query := `
+		SELECT
+			i.object_id,
+			i.name,
+			i.type,
+			i.is_unique,
+			i.filter_definition,
+			c.name,
+			ic.is_descending_key,
+			ic.is_included_column
+		FROM sys.indexes i
+		INNER JOIN sys.tables t ON t.object_id = i.object_id
+		INNER JOIN sys.index_columns ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id
+		INNER JOIN sys.columns c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+		WHERE t.is_ms_shipped = 0
+			AND i.is_primary_key = 0
+			AND i.is_unique_constraint = 0
+			AND i.is_hypothetical = 0
+			AND i.type IN (1, 2, 5, 6)
+		ORDER BY i.object_id, i.name, ic.key_ordinal, ic.index_column_id;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var objectID int64
+		var name, columnName string
+		var indexType int
+		var unique, descending, included bool
+		var filter sql.NullString
+		if err := rows.Scan(&objectID, &name, &indexType, &unique, &filter, &columnName, &descending, &included); err != nil {
+			return err
+		}
+		table, ok := tableMap[objectID]
+		if !ok {
+			continue
+		}
+		if len(table.indexes) == 0 || table.indexes[len(table.indexes)-1].name != name {
+			table.indexes = append(table.indexes, &indexSchema{
+				name:      name,
+				indexType: indexType,
+				unique:    unique,
+				filter:    filter,
+			})
+		}
+		index := table.indexes[len(table.indexes)-1]
+		if included {
+			index.includedColumns = append(index.includedColumns, columnName)
+		} else {
+			index.columns = append(index.columns, columnName)
+			index.descending = append(index.descending, descending)
+		}
+	}
+	return rows.Err()
+}
+
+func getForeignKeys(ctx context.Context, txn *sql.Tx, tableMap map[int64]*tableSchema) error {
+	query := `
+		SELECT
+			fk.parent_object_id,
+			fk.name,
+			SCHEMA_NAME(rt.schema_id),
+			rt.name,
+			pc.name,
+			rc.name,
+			fk.delete_referential_action_desc,
+			fk.update_referential_action_desc,
+			fk.is_disabled,
+			fk.is_not_for_replication
+		FROM sys.foreign_keys fk
+		INNER JOIN sys.foreign_key_columns fkc ON fkc.constraint_object_id = fk.object_id
+		INNER JOIN sys.tables rt ON rt.object_id = fk.referenced_object_id
+		INNER JOIN sys.columns pc ON pc.object_id = fkc.parent_object_id AND pc.column_id = fkc.parent_column_id
+		INNER JOIN sys.columns rc ON rc.object_id = fkc.referenced_object_id AND rc.column_id = fkc.referenced_column_id
+		WHERE fk.is_ms_shipped = 0
+		ORDER BY fk.parent_object_id, fk.name, fkc.constraint_column_id;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var objectID int64
+		var name, referencedSchema, referencedTable, columnName, referencedColumnName, onDelete, onUpdate string
+		var disabled, notForReplication bool
+		if err := rows.Scan(&objectID, &name, &referencedSchema, &referencedTable, &columnName, &referencedColumnName, &onDelete, &onUpdate, &disabled, &notForReplication); err != nil {
+			return err
+		}
+		table, ok := tableMap[objectID]
+		if !ok {
+			continue
+		}
+		if len(table.foreignKeys) == 0 || table.foreignKeys[len(table.foreignKeys)-1].name != name {
+			table.foreignKeys = append(table.foreignKeys, &foreignKeySchema{
+				name:              name,
+				referencedSchema:  referencedSchema,
+				referencedTable:   referencedTable,
+				onDelete:          strings.ReplaceAll(onDelete, "_", " "),
+				onUpdate:          strings.ReplaceAll(onUpdate, "_", " "),
+				disabled:          disabled,
+				notForReplication: notForReplication,
+			})
+		}
+		foreignKey := table.foreignKeys[len(table.foreignKeys)-1]
+		foreignKey.columns = append(foreignKey.columns, columnName)
+		foreignKey.referencedColumns = append(foreignKey.referencedColumns, referencedColumnName)
+	}
+	return rows.Err()
+}
+
+func getModules(ctx context.Context, txn *sql.Tx) ([]*moduleSchema, error) {
+	query := `
+		SELECT
+			o.object_id,
+			SCHEMA_NAME(o.schema_id),
+			o.name,
+			o.type,
+			m.definition,
+			m.uses_ansi_nulls,
+			m.uses_quoted_identifier
+		FROM sys.sql_modules m
+		INNER JOIN sys.objects o ON o.object_id = m.object_id
+		WHERE o.is_ms_shipped = 0 AND o.type IN ('V', 'FN', 'IF', 'TF', 'P')
+		ORDER BY o.object_id;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*moduleSchema
+	for rows.Next() {
+		module := &moduleSchema{}
+		if err := rows.Scan(&module.objectID, &module.schema, &module.name, &module.objectType, &module.definition, &module.ansiNulls, &module.quotedIdentifier); err != nil {
+			return nil, err
+		}
+		module.objectType = strings.TrimSpace(module.objectType)
+		result = append(result, module)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func getTriggers(ctx context.Context, txn *sql.Tx) ([]*moduleSchema, error) {
+	// Parent class 1 is the object or column triggers, the database DDL triggers are not dumped.
+	query := `
+		SELECT
+			tr.object_id,
+			SCHEMA_NAME(o.schema_id),
+			tr.name,
+			SCHEMA_NAME(p.schema_id),
+			p.name,
+			tr.is_disabled,
+			m.definition,
+			m.uses_ansi_nulls,
+			m.uses_quoted_identifier
+		FROM sys.triggers tr
+		INNER JOIN sys.objects o ON o.object_id = tr.object_id
+		INNER JOIN sys.objects p ON p.object_id = tr.parent_id
+		INNER JOIN sys.sql_modules m ON m.object_id = tr.object_id
+		WHERE tr.parent_class = 1 AND tr.is_ms_shipped = 0
+		ORDER BY tr.object_id;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*moduleSchema
+	for rows.Next() {
+		trigger := &moduleSchema{objectType: "TR"}
+		if err := rows.Scan(&trigger.objectID, &trigger.schema, &trigger.name, &trigger.parentSchema, &trigger.parentName, &trigger.disabled, &trigger.definition, &trigger.ansiNulls, &trigger.quotedIdentifier); err != nil {
+			return nil, err
+		}
+		result = append(result, trigger)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func getSynonyms(ctx context.Context, txn *sql.Tx) ([]*synonymSchema, error) {
+	query := `
+		SELECT
+			SCHEMA_NAME(s.schema_id),
+			s.name,
+			s.base_object_name
+		FROM sys.synonyms s
+		ORDER BY 1, 2;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*synonymSchema
+	for rows.Next() {
+		synonym := &synonymSchema{}
+		if err := rows.Scan(&synonym.schema, &synonym.name, &synonym.baseObjectName); err != nil {
+			return nil, err
+		}
+		result = append(result, synonym)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// getDependencies returns the referenced object ids keyed by the referencing object id.
+func getDependencies(ctx context.Context, txn *sql.Tx) (map[int64][]int64, error) {
+	query := `
+		SELECT DISTINCT
+			d.referencing_id,
+			d.referenced_id
+		FROM sys.sql_expression_dependencies d
+		WHERE d.referenced_id IS NOT NULL AND d.referencing_id <> d.referenced_id
+		ORDER BY 1, 2;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64][]int64)
+	for rows.Next() {
+		var referencingID, referencedID int64
+		if err := rows.Scan(&referencingID, &referencedID); err != nil {
+			return nil, err
+		}
+		result[referencingID] = append(result[referencingID], referencedID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// sortObjects orders the objects so that every object comes after the objects it references.
+// Objects in a dependency cycle, e.g. procedures calling each other, keep their original order.
+func sortObjects(objects []*dumpObject, dependencies map[int64][]int64) []*dumpObject {
+	objectMap := make(map[int64]*dumpObject)
+	for _, object := range objects {
+		objectMap[object.objectID] = object
+	}
+
+	var result []*dumpObject
+	visited := make(map[int64]bool)
+	visiting := make(map[int64]bool)
+	var visit func(object *dumpObject)
+	visit = func(object *dumpObject) {
+		if visited[object.objectID] || visiting[object.objectID] {
+			return
+		}
+		visiting[object.objectID] = true
+		for _, referencedID := range dependencies[object.objectID] {
+			if referenced, ok := objectMap[referencedID]; ok {
+				visit(referenced)
+			}
+		}
+		visiting[object.objectID] = false
+		visited[object.objectID] = true
+		result = append(result, object)
+	}
+	for _, object := range objects {
+		visit(object)
+	}
+	return result
+}
+
+func getTableStmt(table *tableSchema, databaseCollation string) string {
+	var lines []string
+	for _, column := range table.columns {
+		lines = append(lines, getColumnDefinition(column, databaseCollation))
+	}
+	for _, key := range table.keys {
+		constraintType := "UNIQUE"
+		if key.primary {
+			constraintType = "PRIMARY KEY"
+		}
+		lines = append(lines, fmt.Sprintf("CONSTRAINT %s %s %s (%s)", quoteIdentifier(key.name), constraintType, key.indexType, getIndexColumnList(key.columns, key.descending)))
+	}
+	for _, check := range table.checks {
+		lines = append(lines, fmt.Sprintf("CONSTRAINT %s CHECK %s", quoteIdentifier(check.name), check.definition))
+	}
+
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "CREATE TABLE %s (\n    %s\n);", quoteName(table.schema, table.name), strings.Join(lines, ",\n    "))
+	for _, check := range table.checks {
+		if check.disabled {
+			_, _ = fmt.Fprintf(&buf, "\nALTER TABLE %s NOCHECK CONSTRAINT %s;", quoteName(table.schema, table.name), quoteIdentifier(check.name))
+		}
+	}
+	return buf.String()
+}
+
+func getColumnDefinition(column *columnSchema, databaseCollation string) string {
+	if column.computedDefinition.Valid {
+		definition := fmt.Sprintf("%s AS %s", quoteIdentifier(column.name), column.computedDefinition.String)
+		if column.persisted.Valid && column.persisted.Bool {
+			definition += " PERSISTED"
+		}
+		return definition
+	}
+
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "%s %s", quoteIdentifier(column.name), getColumnType(column))
+	if column.collation.Valid && column.collation.String != databaseCollation {
+		_, _ = fmt.Fprintf(&buf, " COLLATE %s", column.collation.String)
+	}
+	if column.identity {
+		seed, increment := "1", "1"
+		if column.identitySeed.Valid {
+			seed = column.identitySeed.String
+		}
+		if column.identityIncrement.Valid {
+			increment = column.identityIncrement.String
+		}
+		_, _ = fmt.Fprintf(&buf, " IDENTITY(%s, %s)", seed, increment)
+	}
+	if column.nullable {
+		_, _ = buf.WriteString(" NULL")
+	} else {
+		_, _ = buf.WriteString(" NOT NULL")
+	}
+	if column.defaultDefinition.Valid {
+		_, _ = fmt.Fprintf(&buf, " CONSTRAINT %s DEFAULT %s", quoteIdentifier(column.defaultName.String), column.defaultDefinition.String)
+	}
+	return buf.String()
+}
+
+// getColumnType returns the column type with its length, precision or scale.
+func getColumnType(column *columnSchema) string {
+	if column.userDefined {
+		return quoteName(column.typeSchema, column.typeName)
+	}
+	switch column.typeName {
+	case "char", "varchar", "binary", "varbinary":
+		if column.maxLength == -1 {
+			return fmt.Sprintf("%s(MAX)", column.typeName)
+		}
+		return fmt.Sprintf("%s(%d)", column.typeName, column.maxLength)
+	case "nchar", "nvarchar":
+		// The max length of the Unicode types is in bytes.
+		if column.maxLength == -1 {
+			return fmt.Sprintf("%s(MAX)", column.typeName)
+		}
+		return fmt.Sprintf("%s(%d)", column.typeName, column.maxLength/2)
+	case "decimal", "numeric":
+		return fmt.Sprintf("%s(%d, %d)", column.typeName, column.precision, column.scale)
+	case "datetime2", "datetimeoffset", "time":
+		return fmt.Sprintf("%s(%d)", column.typeName, column.scale)
+	case "float":
+		if column.precision != 53 {
+			return fmt.Sprintf("%s(%d)", column.typeName, column.precision)
+		}
+	}
+	return column.typeName
+}
+
+func getIndexColumnList(columns []string, descending []bool) string {
+	var list []string
+	for i, column := range columns {
+		order := "ASC"
+		if i < len(descending) && descending[i] {
+			order = "DESC"
+		}
+		list = append(list, fmt.Sprintf("%s %s", quoteIdentifier(column), order))
+	}
+	return strings.Join(list, ", ")
+}
+
+func getIndexStmt(table *tableSchema, index *indexSchema) string {
+	tableName := quoteName(table.schema, table.name)
+	switch index.indexType {
+	case 5:
+		return fmt.Sprintf("CREATE CLUSTERED COLUMNSTORE INDEX %s ON %s;", quoteIdentifier(index.name), tableName)
+	case 6:
+		// The columns of a nonclustered columnstore index are all included columns.
+		columns := append(append([]string{}, index.columns...), index.includedColumns...)
+		stmt := fmt.Sprintf("CREATE NONCLUSTERED COLUMNSTORE INDEX %s ON %s (%s)", quoteIdentifier(index.name), tableName, quoteIdentifierList(columns))
+		if index.filter.Valid {
+			stmt += fmt.Sprintf(" WHERE %s", index.filter.String)
+		}
+		return stmt + ";"
+	}
+
+	var buf strings.Builder
+	_, _ = buf.WriteString("CREATE ")
+	if index.unique {
+		_, _ = buf.WriteString("UNIQUE ")
+	}
+	if index.indexType == 1 {
+		_, _ = buf.WriteString("CLUSTERED ")
+	} else {
+		_, _ = buf.WriteString("NONCLUSTERED ")
+	}
+	_, _ = fmt.Fprintf(&buf, "INDEX %s ON %s (%s)", quoteIdentifier(index.name), tableName, getIndexColumnList(index.columns, index.descending))
+	if len(index.includedColumns) > 0 {
+		_, _ = fmt.Fprintf(&buf, " INCLUDE (%s)", quoteIdentifierList(index.includedColumns))
+	}
+	if index.filter.Valid {
+		_, _ = fmt.Fprintf(&buf, " WHERE %s", index.filter.String)
+	}
+	_, _ = buf.WriteString(";")
+	return buf.String()
+}
+
+func getForeignKeyStmt(table *tableSchema, foreignKey *foreignKeySchema) string {
+	tableName := quoteName(table.schema, table.name)
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s) ON DELETE %s ON UPDATE %s",
+		tableName,
+		quoteIdentifier(foreignKey.name),
+		quoteIdentifierList(foreignKey.columns),
+		quoteName(foreignKey.referencedSchema, foreignKey.referencedTable),
+		quoteIdentifierList(foreignKey.referencedColumns),
+		foreignKey.onDelete,
+		foreignKey.onUpdate,
+	)
+	if foreignKey.notForReplication {
+		_, _ = buf.WriteString(" NOT FOR REPLICATION")
+	}
+	_, _ = buf.WriteString(";")
+	if foreignKey.disabled {
+		_, _ = fmt.Fprintf(&buf, "\nALTER TABLE %s NOCHECK CONSTRAINT %s;", tableName, quoteIdentifier(foreignKey.name))
+	}
+	return buf.String()
+}
+
+// getColumnSelectExpression returns the expression to select the column value for the dump and the literal kind of the value.
+// The values are converted to types that survive the round trip through the driver unchanged.
+func getColumnSelectExpression(column *columnSchema) (string, valueKind) {
+	name := quoteIdentifier(column.name)
+	if column.assemblyType {
+		// CLR types such as hierarchyid, geometry and geography are restored from their binary serialization.
+		return fmt.Sprintf("CONVERT(VARBINARY(MAX), %s)", name), valueKindBinary
+	}
+	switch column.baseTypeName.String {
+	case "binary", "varbinary", "image":
+		return name, valueKindBinary
+	case "char", "varchar", "text", "nchar", "nvarchar", "ntext":
+		return name, valueKindString
+	case "date", "datetime", "datetime2", "smalldatetime":
+		return fmt.Sprintf("CONVERT(NVARCHAR(64), %s, 126)", name), valueKindString
+	case "datetimeoffset":
+		return fmt.Sprintf("CONVERT(NVARCHAR(64), %s, 127)", name), valueKindString
+	case "time":
+		return fmt.Sprintf("CONVERT(NVARCHAR(64), %s)", name), valueKindString
+	case "uniqueidentifier":
+		return fmt.Sprintf("CONVERT(NVARCHAR(36), %s)", name), valueKindString
+	case "xml", "sql_variant":
+		return fmt.Sprintf("CONVERT(NVARCHAR(MAX), %s)", name), valueKindString
+	}
+	return name, valueKindNumeric
+}
+
+// isRowVersion returns true if the column is a rowversion column, which cannot be inserted explicitly.
+func (column *columnSchema) isRowVersion() bool {
+	return column.baseTypeName.Valid && column.baseTypeName.String == "timestamp"
+}
+
+// exportTableData writes the table data as batched INSERT statements.
+func exportTableData(ctx context.Context, txn *sql.Tx, table *tableSchema, out io.Writer) error {
+	var columnNames, expressions []string
+	var kinds []valueKind
+	hasIdentity := false
+	for _, column := range table.columns {
+		if column.computedDefinition.Valid || column.isRowVersion() {
+			continue
+		}
+		expression, kind := getColumnSelectExpression(column)
+		columnNames = append(columnNames, quoteIdentifier(column.name))
+		expressions = append(expressions, expression)
+		kinds = append(kinds, kind)
+		if column.identity {
+			hasIdentity = true
+		}
+	}
+	if len(columnNames) == 0 {
+		return nil
+	}
+
+	tableName := quoteName(table.schema, table.name)
+	query := fmt.Sprintf("SELECT %s FROM %s;", strings.Join(expressions, ", "), tableName)
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	values := make([]any, len(columnNames))
+	refs := make([]any, len(columnNames))
+	for i := range values {
+		refs[i] = &values[i]
+	}
+	insertHeader := fmt.Sprintf("INSERT INTO %s (%s) VALUES\n", tableName, strings.Join(columnNames, ", "))
+	rowCount := 0
+	for rows.Next() {
+		if err := rows.Scan(refs...); err != nil {
+			return err
+		}
+		if rowCount == 0 {
+			if _, err := io.WriteString(out, fmt.Sprintf(dataHeaderFmt, tableName)); err != nil {
+				return err
+			}
+			if hasIdentity {
+				if _, err := io.WriteString(out, fmt.Sprintf("SET IDENTITY_INSERT %s ON;\n%s\n", tableName, batchSeparator)); err != nil {
+					return err
+				}
+			}
+		}
+		var prefix string
+		switch {
+		case rowCount == 0:
+			prefix = insertHeader
+		case rowCount%insertBatchSize == 0:
+			prefix = fmt.Sprintf(";\n%s\n%s", batchSeparator, insertHeader)
+		default:
+			prefix = ",\n"
+		}
+		tokens := make([]string, len(values))
+		for i, value := range values {
+			tokens[i] = formatValue(value, kinds[i])
+		}
+		if _, err := io.WriteString(out, fmt.Sprintf("%s(%s)", prefix, strings.Join(tokens, ", "))); err != nil {
+			return err
+		}
+		rowCount++
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if rowCount == 0 {
+		return nil
+	}
+	if _, err := io.WriteString(out, fmt.Sprintf(";\n%s\n", batchSeparator)); err != nil {
+		return err
+	}
+	if hasIdentity {
+		if _, err := io.WriteString(out, fmt.Sprintf("SET IDENTITY_INSERT %s OFF;\n%s\n", tableName, batchSeparator)); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(out, "\n")
+	return err
+}
+
+// formatValue formats the scanned value as a T-SQL literal.
+func formatValue(value any, kind valueKind) string {
+	if value == nil {
+		return "NULL"
+	}
+	switch kind {
+	case valueKindBinary:
+		if v, ok := value.([]byte); ok {
+			return "0x" + strings.ToUpper(hex.EncodeToString(v))
+		}
+	case valueKindString:
+		switch v := value.(type) {
+		case string:
+			return quoteString(v)
+		case []byte:
+			return quoteString(string(v))
+		}
+	}
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case []byte:
+		// Decimal and money values are returned as their textual representation.
+		return string(v)
+	case string:
+		return quoteString(v)
+	case time.Time:
+		return quoteString(v.Format("2006-01-02T15:04:05.9999999Z07:00"))
+	}
+	return fmt.Sprint(value)
+}
+
+func quoteString(s string) string {
+	return fmt.Sprintf("N'%s'", strings.ReplaceAll(s, "'", "''"))
+}
+
+// Restore restores a database.
+// Each batch is committed separately, so that restoring a large backup doesn't hold the locks and the log of a single transaction.
+// The batches run on the same connection because SET IDENTITY_INSERT applies to the session.
+func (driver *Driver) Restore(ctx context.Context, src io.Reader) (err error) {
+	conn, err := driver.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	f := func(batch string) error {
+		if _, err := conn.ExecContext(ctx, batch); err != nil {
+			return err
+		}
+		return nil
+	}
+	return splitBatches(src, f)
+}
+
+// splitBatches splits the T-SQL script into batches separated by GO lines and applies f to each batch.
+// GO lines inside string literals, quoted identifiers and block comments are not separators.
+func splitBatches(src io.Reader, f func(string) error) error {
+	reader := bufio.NewReader(src)
+	var sb strings.Builder
+	s := &batchScanState{}
+	startLine, lineNumber := 1, 0
+	apply := func() error {
+		batch := strings.TrimSpace(sb.String())
+		sb.Reset()
+		if batch == "" {
+			return nil
+		}
+		if err := f(batch); err != nil {
+			return errors.Wrapf(err, "failed to execute the batch starting at line %d", startLine)
+		}
+		return nil
+	}
+
+	for done := false; !done; {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if err != io.EOF {
+				return err
+			}
+			done = true
+		}
+		lineNumber++
+		if s.isIdle() && strings.EqualFold(strings.TrimSpace(line), batchSeparator) {
+			if err := apply(); err != nil {
+				return err
+			}
+			startLine = lineNumber + 1
+			continue
+		}
+		s.scan(line)
+		_, _ = sb.WriteString(line)
+	}
+	return apply()
+}
+
+// batchScanState tracks whether the scanner is inside a string literal, a quoted identifier or a block comment.
+type batchScanState struct {
+	// quote is the closing character of the current string literal or quoted identifier, or 0 if there is none.
+	quote byte
+	// blockCommentDepth is the depth of the nested block comments.
+	blockCommentDepth int
+}
+
+func (s *batchScanState) isIdle() bool {
+	return s.quote == 0 && s.blockCommentDepth == 0
+}
+
+func (s *batchScanState) scan(line string) {
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case s.blockCommentDepth > 0:
+			// T-SQL block comments can be nested.
+			if c == '*' && i+1 < len(line) && line[i+1] == '/' {
+				s.blockCommentDepth--
+				i++
+			} else if c == '/' && i+1 < len(line) && line[i+1] == '*' {
+				s.blockCommentDepth++
+				i++
+			}
+		case s.quote != 0:
+			if c == s.quote {
+				// The closing character is escaped by doubling it.
+				if i+1 < len(line) && line[i+1] == s.quote {
+					i++
+					continue
+				}
+				s.quote = 0
+			}
+		case c == '-' && i+1 < len(line) && line[i+1] == '-':
+			return
+		case c == '/' && i+1 < len(line) && line[i+1] == '*':
+			s.blockCommentDepth++
+			i++
+		case c == '\'':
+			s.quote = '\''
+		case c == '"':
+			s.quote = '"'
+		case c == '[':
+			s.quote = ']'
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package mssql
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitBatches(t *testing.T) {
+	tests := []struct {
+		script string
+		want   []string
+	}{
+		{
+			script: "CREATE SCHEMA [s];\nGO\nCREATE TABLE [s].[t] ([a] int NULL);\ngo\n",
+			want:   []string{"CREATE SCHEMA [s];", "CREATE TABLE [s].[t] ([a] int NULL);"},
+		},
+		{
+			// GO inside a string literal is not a separator.
+			script: "INSERT INTO [t] ([a]) VALUES\n(N'it''s\nGO\n');\nGO\n",
+			want:   []string{"INSERT INTO [t] ([a]) VALUES\n(N'it''s\nGO\n');"},
+		},
+		{
+			// GO inside a block comment is not a separator.
+			script: "/*\nGO\n*/\nSELECT 1;\n  GO  \nSELECT 2;",
+			want:   []string{"/*\nGO\n*/\nSELECT 1;", "SELECT 2;"},
+		},
+		{
+			// GO inside a nested block comment is not a separator.
+			script: "/* outer /* inner */\nGO\n*/\nSELECT 1;\nGO\n",
+			want:   []string{"/* outer /* inner */\nGO\n*/\nSELECT 1;"},
+		},
+		{
+			// Quotes in line comments and escaped brackets are ignored.
+			script: "-- it's a comment\nSELECT [a]]'b] FROM [t];\nGO\nSELECT 3;\n",
+			want:   []string{"-- it's a comment\nSELECT [a]]'b] FROM [t];", "SELECT 3;"},
+		},
+	}
+
+	for _, test := range tests {
+		var got []string
+		err := splitBatches(strings.NewReader(test.script), func(batch string) error {
+			got = append(got, batch)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.script)
+	}
+}
+
+func TestGetColumnType(t *testing.T) {
+	tests := []struct {
+		column *columnSchema
+		want   string
+	}{
+		{column: &columnSchema{typeName: "nvarchar", maxLength: 100}, want: "nvarchar(50)"},
+		{column: &columnSchema{typeName: "varchar", maxLength: -1}, want: "varchar(MAX)"},
+		{column: &columnSchema{typeName: "decimal", precision: 10, scale: 2}, want: "decimal(10, 2)"},
+		{column: &columnSchema{typeName: "datetime2", scale: 7}, want: "datetime2(7)"},
+		{column: &columnSchema{typeName: "float", precision: 53}, want: "float"},
+		{column: &columnSchema{typeName: "int"}, want: "int"},
+		{column: &columnSchema{typeName: "phone", typeSchema: "dbo", userDefined: true}, want: "[dbo].[phone]"},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.want, getColumnType(test.column))
+	}
+}
+
+func TestGetTableStmt(t *testing.T) {
+	table := &tableSchema{
+		schema: "dbo",
+		name:   "user",
+		columns: []*columnSchema{
+			{name: "id", typeName: "int", identity: true, identitySeed: sql.NullString{String: "1", Valid: true}, identityIncrement: sql.NullString{String: "1", Valid: true}},
+			{name: "name", typeName: "nvarchar", maxLength: 200, nullable: true, collation: sql.NullString{String: "Latin1_General_BIN", Valid: true}, defaultName: sql.NullString{String: "DF_user_name", Valid: true}, defaultDefinition: sql.NullString{String: "(N'')", Valid: true}},
+			{name: "full_name", computedDefinition: sql.NullString{String: "([name]+N'!')", Valid: true}, persisted: sql.NullBool{Bool: true, Valid: true}},
+		},
+		keys: []*keyConstraint{
+			{name: "PK_user", primary: true, indexType: "CLUSTERED", columns: []string{"id"}, descending: []bool{false}},
+		},
+		checks: []*checkConstraint{
+			{name: "CK_user_id", definition: "([id]>(0))", disabled: true},
+		},
+	}
+	want := "CREATE TABLE [dbo].[user] (\n" +
+		"    [id] int IDENTITY(1, 1) NOT NULL,\n" +
+		"    [name] nvarchar(100) COLLATE Latin1_General_BIN NULL CONSTRAINT [DF_user_name] DEFAULT (N''),\n" +
+		"    [full_name] AS ([name]+N'!') PERSISTED,\n" +
+		"    CONSTRAINT [PK_user] PRIMARY KEY CLUSTERED ([id] ASC),\n" +
+		"    CONSTRAINT [CK_user_id] CHECK ([id]>(0))\n" +
+		");\n" +
+		"ALTER TABLE [dbo].[user] NOCHECK CONSTRAINT [CK_user_id];"
+	require.Equal(t, want, getTableStmt(table, "SQL_Latin1_General_CP1_CI_AS"))
+}
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		value any
+		kind  valueKind
+		want  string
+	}{
+		{value: nil, kind: valueKindString, want: "NULL"},
+		{value: "it's", kind: valueKindString, want: "N'it''s'"},
+		{value: []byte{0xde, 0xad}, kind: valueKindBinary, want: "0xDEAD"},
+		{value: []byte{}, kind: valueKindBinary, want: "0x"},
+		{value: int64(42), kind: valueKindNumeric, want: "42"},
+		{value: 1.5, kind: valueKindNumeric, want: "1.5"},
+		{value: true, kind: valueKindNumeric, want: "1"},
+		{value: []byte("123.45"), kind: valueKindNumeric, want: "123.45"},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.want, formatValue(test.value, test.kind))
+	}
+}
+
+func TestSortObjects(t *testing.T) {
+	a := require.New(t)
+	objects := []*dumpObject{
+		{objectID: 1, module: &moduleSchema{name: "v_user"}},
+		{objectID: 2, table: &tableSchema{name: "user"}},
+		{objectID: 3, module: &moduleSchema{name: "p_a"}},
+		{objectID: 4, module: &moduleSchema{name: "p_b"}},
+	}
+	dependencies := map[int64][]int64{
+		// v_user reads user.
+		1: {2},
+		// p_a and p_b call each other.
+		3: {4},
+		4: {3},
+	}
+	var got []int64
+	for _, object := range sortObjects(objects, dependencies) {
+		got = append(got, object.objectID)
+	}
+	a.Equal([]int64{2, 1, 4, 3}, got)
+}
+
+func TestGetSequenceRestartValue(t *testing.T) {
+	a := require.New(t)
+	sequence := &sequenceSchema{
+		increment:    "1",
+		minimumValue: "1",
+		maximumValue: "9223372036854775807",
+		currentValue: sql.NullString{String: "41", Valid: true},
+	}
+	got, ok := getSequenceRestartValue(sequence)
+	a.True(ok)
+	a.Equal("42", got)
+
+	sequence.currentValue = sql.NullString{String: "9223372036854775807", Valid: true}
+	_, ok = getSequenceRestartValue(sequence)
+	a.False(ok)
+}
@@ -27,7 +27,7 @@ var (
 )
 
 func init() {
-	db.Register(db.MSSQL, newDriver)
+	db.Register(db.MSSQL, newDriver, db.CapabilitySchemaDump, db.CapabilityDump, db.CapabilityRestore)
 }
 
 // Driver is the MSSQL driver.
@@ -0,0 +1,260 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/opcode"
+	"github.com/pkg/errors"
+
+	bbparser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+)
+
+// BatchStatement is a single-table UPDATE or DELETE statement executed in batches of the primary key ranges.
+type BatchStatement struct {
+	// Database and Table are the table changed by the statement.
+	// Database is empty if the table is not qualified by the database in the statement.
+	Database string
+	Table    string
+	// UpdatedColumns are the columns set by the UPDATE statement.
+	UpdatedColumns []string
+
+	node  ast.StmtNode
+	where ast.ExprNode
+}
+
+// GetBatchStatement parses the statement executed in batches.
+// The statement must be a single UPDATE or DELETE statement on a single table without ORDER BY and LIMIT clauses.
+func GetBatchStatement(statement string) (*BatchStatement, error) {
+	list, err := bbparser.SplitMultiSQL(bbparser.MySQL, statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to split statement")
+	}
+	var text string
+	for _, sql := range list {
+		if sql.Empty {
+			continue
+		}
+		if text != "" {
+			return nil, errors.Errorf("batched execution only supports a single statement")
+		}
+		text = sql.Text
+	}
+	if text == "" {
+		return nil, errors.Errorf("empty statement")
+	}
+	node, err := parser.New().ParseOneStmt(text, "", "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+
+	batch := &BatchStatement{node: node}
+	var tableRefs *ast.TableRefsClause
+	switch node := node.(type) {
+	case *ast.UpdateStmt:
+		if node.With != nil || node.MultipleTable || node.Order != nil || node.Limit != nil {
+			return nil, errors.Errorf("batched execution does not support UPDATE statement with WITH, ORDER BY or LIMIT clause, or on multiple tables")
+		}
+		tableRefs, batch.where = node.TableRefs, node.Where
+		for _, assignment := range node.List {
+			batch.UpdatedColumns = append(batch.UpdatedColumns, assignment.Column.Name.O)
+		}
+		if err := checkIdempotentAssignments(node.List, batch.UpdatedColumns); err != nil {
+			return nil, err
+		}
+	case *ast.DeleteStmt:
+		if node.With != nil || node.IsMultiTable || node.Order != nil || node.Limit != nil {
+			return nil, errors.Errorf("batched execution does not support DELETE statement with WITH, ORDER BY or LIMIT clause, or on multiple tables")
+		}
+		tableRefs, batch.where = node.TableRefs, node.Where
+	default:
+		return nil, errors.Errorf("batched execution only supports UPDATE and DELETE statements")
+	}
+
+	var tableName *ast.TableName
+	if tableRefs != nil && tableRefs.TableRefs != nil && tableRefs.TableRefs.Right == nil {
+		if source, ok := tableRefs.TableRefs.Left.(*ast.TableSource); ok {
+			tableName, _ = source.Source.(*ast.TableName)
+		}
+	}
+	if tableName == nil {
+		return nil, errors.Errorf("batched execution does not support statement on multiple tables")
+	}
+	batch.Database, batch.Table = tableName.Schema.O, tableName.Name.O
+	return batch, nil
+}
+
+// checkIdempotentAssignments checks that the assignments don't depend on the updated columns, because a batch may be executed
+// again on resuming, e.g. "SET c = c + 1" increases the column twice.
+func checkIdempotentAssignments(assignments []*ast.Assignment, updatedColumns []string) error {
+	for _, assignment := range assignments {
+		collector := &columnRefCollector{}
+		assignment.Expr.Accept(collector)
+		if collector.hasSubquery {
+			return errors.Errorf("batched execution does not support the assignment to column %q with a subquery", assignment.Column.Name.O)
+		}
+		for _, column := range collector.columns {
+			for _, updatedColumn := range updatedColumns {
+				// The column names are case-insensitive in MySQL.
+				if strings.EqualFold(column, updatedColumn) {
+					return errors.Errorf("batched execution does not support the assignment to column %q referencing the updated column %q, because it's not idempotent", assignment.Column.Name.O, updatedColumn)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// columnRefCollector collects the columns and the subqueries referenced by an expression.
+type columnRefCollector struct {
+	columns     []string
+	hasSubquery bool
+}
+
+func (c *columnRefCollector) Enter(in ast.Node) (ast.Node, bool) {
+	switch node := in.(type) {
+	case *ast.ColumnNameExpr:
+		c.columns = append(c.columns, node.Name.Name.O)
+	case *ast.SubqueryExpr:
+		c.hasSubquery = true
+		return in, true
+	}
+	return in, false
+}
+
+func (*columnRefCollector) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// GetChunkSQL returns the statement changing the rows in the primary key range (lower, upper].
+// The bounds are the placeholders of the primary key values, the lower bound first. The range is unbounded on the side without the bound.
+func (b *BatchStatement) GetChunkSQL(primaryKey []string, hasLower, hasUpper bool) (string, error) {
+	var conditions []string
+	if hasLower {
+		conditions = append(conditions, getKeyCondition(primaryKey, ">"))
+	}
+	if hasUpper {
+		conditions = append(conditions, getKeyCondition(primaryKey, "<="))
+	}
+	where := b.where
+	if len(conditions) > 0 {
+		stmt, err := parser.New().ParseOneStmt(fmt.Sprintf("SELECT 1 FROM DUAL WHERE %s", strings.Join(conditions, " AND ")), "", "")
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to parse the primary key range")
+		}
+		keyRange := stmt.(*ast.SelectStmt).Where
+		if where == nil {
+			where = keyRange
+		} else {
+			where = &ast.BinaryOperationExpr{
+				Op: opcode.LogicAnd,
+				L:  &ast.ParenthesesExpr{Expr: where},
+				R:  keyRange,
+			}
+		}
+	}
+
+	switch node := b.node.(type) {
+	case *ast.UpdateStmt:
+		node.Where = where
+	case *ast.DeleteStmt:
+		node.Where = where
+	}
+	var buf strings.Builder
+	err := b.node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &buf))
+	switch node := b.node.(type) {
+	case *ast.UpdateStmt:
+		node.Where = b.where
+	case *ast.DeleteStmt:
+		node.Where = b.where
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to restore the batch statement on table %q", b.Table)
+	}
+	return buf.String(), nil
+}
+
+// GetChunkKeysSQL returns the query selecting the primary keys of the rows in the next primary key range of at most batchSize rows.
+// The lower bound of the range is the placeholders of the primary key values if hasLower.
+func (b *BatchStatement) GetChunkKeysSQL(primaryKey []string, hasLower bool, batchSize int) string {
+	var columns []string
+	for _, column := range primaryKey {
+		columns = append(columns, quoteIdentifier(column))
+	}
+	table := quoteIdentifier(b.Table)
+	if b.Database != "" {
+		table = fmt.Sprintf("%s.%s", quoteIdentifier(b.Database), table)
+	}
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "SELECT %s FROM %s", strings.Join(columns, ", "), table)
+	if hasLower {
+		_, _ = fmt.Fprintf(&buf, " WHERE %s", getKeyCondition(primaryKey, ">"))
+	}
+	_, _ = fmt.Fprintf(&buf, " ORDER BY %s LIMIT %d", strings.Join(columns, ", "), batchSize)
+	return buf.String()
+}
+
+// GetReplicationLagSeconds returns the replication lag in seconds of the replica.
+func GetReplicationLagSeconds(ctx context.Context, conn *sql.Conn) (float64, error) {
+	rows, err := conn.QueryContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		// MariaDB and MySQL before 8.0.22 do not support SHOW REPLICA STATUS.
+		rows, err = conn.QueryContext(ctx, "SHOW SLAVE STATUS")
+		if err != nil {
+			return 0, err
+		}
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+		return 0, errors.Errorf("the server is not a replica")
+	}
+	values := make([]sql.NullString, len(columns))
+	dests := make([]any, len(columns))
+	for i := range values {
+		dests[i] = &values[i]
+	}
+	if err := rows.Scan(dests...); err != nil {
+		return 0, err
+	}
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		if !values[i].Valid {
+			return 0, errors.Errorf("the replication is not running")
+		}
+		return strconv.ParseFloat(values[i].String, 64)
+	}
+	return 0, errors.Errorf("replication lag not found in the replica status")
+}
+
+// getKeyCondition returns the comparison of the primary key with the placeholders.
+func getKeyCondition(primaryKey []string, operator string) string {
+	var columns, placeholders []string
+	for _, column := range primaryKey {
+		columns = append(columns, quoteIdentifier(column))
+		placeholders = append(placeholders, "?")
+	}
+	if len(primaryKey) == 1 {
+		return fmt.Sprintf("%s %s ?", columns[0], operator)
+	}
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(columns, ", "), operator, strings.Join(placeholders, ", "))
+}
+
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
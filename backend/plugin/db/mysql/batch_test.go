@@ -0,0 +1,46 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBatchStatement(t *testing.T) {
+	a := require.New(t)
+	batch, err := GetBatchStatement("UPDATE t SET b = 1, c = d + 1 WHERE a > 1 OR d IS NULL;")
+	a.NoError(err)
+	a.Equal("", batch.Database)
+	a.Equal("t", batch.Table)
+	a.Equal([]string{"b", "c"}, batch.UpdatedColumns)
+
+	chunk, err := batch.GetChunkSQL([]string{"id"}, false, true)
+	a.NoError(err)
+	a.Equal("UPDATE `t` SET `b`=1, `c`=`d`+1 WHERE (`a`>1 OR `d` IS NULL) AND `id`<=?", chunk)
+	chunk, err = batch.GetChunkSQL([]string{"id"}, true, false)
+	a.NoError(err)
+	a.Equal("UPDATE `t` SET `b`=1, `c`=`d`+1 WHERE (`a`>1 OR `d` IS NULL) AND `id`>?", chunk)
+	a.Equal("SELECT `id` FROM `t` WHERE `id` > ? ORDER BY `id` LIMIT 1000", batch.GetChunkKeysSQL([]string{"id"}, true, 1000))
+
+	batch, err = GetBatchStatement("DELETE FROM db.t")
+	a.NoError(err)
+	a.Equal("db", batch.Database)
+	chunk, err = batch.GetChunkSQL([]string{"a", "b"}, true, true)
+	a.NoError(err)
+	a.Equal("DELETE FROM `db`.`t` WHERE ROW(`a`,`b`)>ROW(?,?) AND ROW(`a`,`b`)<=ROW(?,?)", chunk)
+	a.Equal("SELECT `a`, `b` FROM `db`.`t` ORDER BY `a`, `b` LIMIT 100", batch.GetChunkKeysSQL([]string{"a", "b"}, false, 100))
+
+	for _, statement := range []string{
+		"UPDATE t SET a = 1; DELETE FROM t;",
+		"DELETE FROM t WHERE a > 1 ORDER BY a LIMIT 10",
+		"UPDATE t JOIN t2 ON t.a = t2.a SET t.b = 1",
+		"INSERT INTO t VALUES (1)",
+		"UPDATE t SET c = c + 1",
+		"UPDATE t SET b = 1, c = t.B",
+		"UPDATE t SET c = (SELECT MAX(a) FROM t2)",
+		"",
+	} {
+		_, err := GetBatchStatement(statement)
+		a.Error(err, statement)
+	}
+}
@@ -35,10 +35,10 @@ var (
 )
 
 func init() {
-	db.Register(db.MySQL, newDriver)
-	db.Register(db.TiDB, newDriver)
-	db.Register(db.MariaDB, newDriver)
-	db.Register(db.OceanBase, newDriver)
+	db.Register(db.MySQL, newDriver, db.CapabilitySchemaDump, db.CapabilityDump, db.CapabilityRestore, db.CapabilityRole, db.CapabilitySlowQuery)
+	db.Register(db.TiDB, newDriver, db.CapabilitySchemaDump, db.CapabilityDump, db.CapabilityRestore, db.CapabilityRole)
+	db.Register(db.MariaDB, newDriver, db.CapabilitySchemaDump, db.CapabilityDump, db.CapabilityRestore, db.CapabilityRole)
+	db.Register(db.OceanBase, newDriver, db.CapabilitySchemaDump, db.CapabilityDump, db.CapabilityRestore, db.CapabilityRole)
 }
 
 // Driver is the MySQL driver.
@@ -157,9 +157,23 @@ func (driver *Driver) getVersion(ctx context.Context) (string, error) {
 
 // Execute executes a SQL statement.
 func (driver *Driver) Execute(ctx context.Context, statement string, _ bool, opts db.ExecuteOptions) (int64, error) {
-	statement, err := parser.DealWithDelimiter(statement)
-	if err != nil {
-		return 0, errors.Wrapf(err, "failed to deal with delimiter")
+	var statements []string
+	if opts.BeforeStatementFunc != nil {
+		list, err := parser.SplitMultiSQL(parser.MySQL, statement)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to split statement")
+		}
+		for _, sql := range list {
+			if !sql.Empty {
+				statements = append(statements, sql.Text)
+			}
+		}
+	} else {
+		statement, err := parser.DealWithDelimiter(statement)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to deal with delimiter")
+		}
+		statements = []string{statement}
 	}
 	conn, err := driver.db.Conn(ctx)
 	if err != nil {
@@ -179,16 +193,23 @@ func (driver *Driver) Execute(ctx context.Context, statement string, _ bool, opt
 	defer tx.Rollback()
 
 	var totalRowsAffected int64
-	sqlResult, err := tx.ExecContext(ctx, statement)
-	if err != nil {
-		return 0, errors.Wrapf(err, "failed to execute context in a transaction")
-	}
-	rowsAffected, err := sqlResult.RowsAffected()
-	if err != nil {
-		// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
-		slog.Debug("rowsAffected returns error", log.BBError(err))
+	for i, statement := range statements {
+		if opts.BeforeStatementFunc != nil {
+			if err := opts.BeforeStatementFunc(ctx, tx, i); err != nil {
+				return 0, err
+			}
+		}
+		sqlResult, err := tx.ExecContext(ctx, statement)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to execute context in a transaction")
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
+			slog.Debug("rowsAffected returns error", log.BBError(err))
+		}
+		totalRowsAffected += rowsAffected
 	}
-	totalRowsAffected += rowsAffected
 
 	if err := tx.Commit(); err != nil {
 		return 0, errors.Wrapf(err, "failed to commit execute transaction")
@@ -259,6 +280,39 @@ func (driver *Driver) querySingleSQL(ctx context.Context, conn *sql.Conn, single
 	return result, nil
 }
 
+// QueryConnStream queries a single SQL statement in a given connection and streams the result to w.
+func (driver *Driver) QueryConnStream(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext, w db.QueryResultWriter) error {
+	singleSQLs, err := parser.SplitMultiSQL(parser.MySQL, statement)
+	if err != nil {
+		return err
+	}
+	var nonEmptySQLs []parser.SingleSQL
+	for _, singleSQL := range singleSQLs {
+		if !singleSQL.Empty {
+			nonEmptySQLs = append(nonEmptySQLs, singleSQL)
+		}
+	}
+	if len(nonEmptySQLs) != 1 {
+		return errors.Errorf("expecting 1 statement, but got %d", len(nonEmptySQLs))
+	}
+
+	stmt := strings.TrimLeft(strings.TrimRight(nonEmptySQLs[0].Text, " \n\t;"), " \n\t")
+	if !strings.HasPrefix(stmt, "EXPLAIN") && queryContext.Limit > 0 {
+		stmt, err = driver.getStatementWithResultLimit(stmt, queryContext.Limit)
+		if err != nil {
+			return err
+		}
+	}
+
+	if driver.dbType == db.TiDB && queryContext.ReadOnly {
+		// TiDB doesn't support READ ONLY transactions. We have to skip the flag for it.
+		// https://github.com/pingcap/tidb/issues/34626
+		queryContext.ReadOnly = false
+	}
+
+	return util.QueryStream(ctx, driver.dbType, conn, stmt, queryContext, w)
+}
+
 func updateTiDBExplainResult(result *v1pb.QueryResult) error {
 	for _, row := range result.Rows {
 		if len(row.Values) > 0 {
@@ -0,0 +1,246 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	bbparser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+)
+
+const (
+	// BackupDatabaseName is the database storing the rows changed by the data change tasks for rolling back.
+	BackupDatabaseName = "bbdataarchive"
+	// maxIdentifierLength is the max length in characters of MySQL identifiers.
+	maxIdentifierLength = 64
+)
+
+// PriorBackup is the backup of the rows to be changed by a statement.
+type PriorBackup struct {
+	// Database and Table are the table changed by the statement.
+	Database string
+	Table    string
+	// BackupTable is the table in the backup database storing the rows before the change.
+	// It's empty if the statement is skipped before executing the statements.
+	BackupTable string
+	// IsDelete is true for the DELETE statement, and false for the UPDATE statement.
+	IsDelete bool
+	// SkippedReason is the reason why the rows cannot be backed up, e.g. the changed rows cannot be determined statically.
+	SkippedReason string
+	// Statement is the SELECT statement selecting the rows to be changed.
+	Statement string
+}
+
+// GetPriorBackups returns the backups of the non-empty statements in order, and each of them is backed up right before executing
+// the statement in the same transaction. Only the UPDATE and DELETE statements on a single table are backed up, and the others are skipped.
+// The tables without the database are in the given database. The backup tables are named with the prefix and the index of the statement.
+func GetPriorBackups(statement string, database string, prefix string) ([]*PriorBackup, error) {
+	list, err := bbparser.SplitMultiSQL(bbparser.MySQL, statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to split statement")
+	}
+	var statements []bbparser.SingleSQL
+	for _, sql := range list {
+		if !sql.Empty {
+			statements = append(statements, sql)
+		}
+	}
+	var backups []*PriorBackup
+	for _, sql := range statements {
+		nodes, _, err := parser.New().Parse(sql.Text, "", "")
+		if err != nil || len(nodes) != 1 {
+			backups = append(backups, &PriorBackup{SkippedReason: fmt.Sprintf("failed to parse statement at line %d", sql.LastLine)})
+			continue
+		}
+		backup := &PriorBackup{}
+		var tableRefs *ast.TableRefsClause
+		var where ast.ExprNode
+		var order *ast.OrderByClause
+		var limit *ast.Limit
+		switch node := nodes[0].(type) {
+		case *ast.UpdateStmt:
+			tableRefs, where, order, limit = node.TableRefs, node.Where, node.Order, node.Limit
+			if node.With != nil {
+				backup.SkippedReason = "UPDATE statement with WITH clause is not supported"
+			} else if node.MultipleTable {
+				backup.SkippedReason = "UPDATE statement on multiple tables is not supported"
+			}
+		case *ast.DeleteStmt:
+			tableRefs, where, order, limit = node.TableRefs, node.Where, node.Order, node.Limit
+			backup.IsDelete = true
+			if node.With != nil {
+				backup.SkippedReason = "DELETE statement with WITH clause is not supported"
+			} else if node.IsMultiTable {
+				backup.SkippedReason = "DELETE statement on multiple tables is not supported"
+			}
+		default:
+			// The other statements, e.g. SELECT and SET, don't change the rows.
+			switch nodes[0].(type) {
+			case *ast.InsertStmt, *ast.LoadDataStmt, ast.DDLNode:
+				text, _ := common.TruncateString(strings.TrimSpace(sql.Text), 100)
+				backup.SkippedReason = fmt.Sprintf("only UPDATE and DELETE statements are backed up, but got %q", text)
+			}
+			backups = append(backups, backup)
+			continue
+		}
+
+		var tableName *ast.TableName
+		if tableRefs != nil && tableRefs.TableRefs != nil && tableRefs.TableRefs.Right == nil {
+			if source, ok := tableRefs.TableRefs.Left.(*ast.TableSource); ok {
+				tableName, _ = source.Source.(*ast.TableName)
+			}
+		}
+		if backup.SkippedReason == "" && tableName == nil {
+			backup.SkippedReason = "statement on multiple tables is not supported"
+		}
+		if backup.SkippedReason == "" && limit != nil && order == nil {
+			// The changed rows are nondeterministic.
+			backup.SkippedReason = "statement with LIMIT clause but without ORDER BY clause is not supported"
+		}
+		if tableName != nil {
+			backup.Database = tableName.Schema.O
+			if backup.Database == "" {
+				backup.Database = database
+			}
+			backup.Table = tableName.Name.O
+		}
+		if backup.SkippedReason == "" {
+			backup.BackupTable = truncateIdentifier(fmt.Sprintf("%s_%d_%s", prefix, len(backups), backup.Table))
+			selectStmt := &ast.SelectStmt{
+				SelectStmtOpts: &ast.SelectStmtOpts{SQLCache: true},
+				Kind:           ast.SelectStmtKindSelect,
+				Fields:         &ast.FieldList{Fields: []*ast.SelectField{{WildCard: &ast.WildCardField{}}}},
+				From:           tableRefs,
+				Where:          where,
+				OrderBy:        order,
+				Limit:          limit,
+			}
+			var buf strings.Builder
+			if err := selectStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &buf)); err != nil {
+				return nil, errors.Wrapf(err, "failed to restore the backup statement for table %q", backup.Table)
+			}
+			backup.Statement = buf.String()
+		}
+		backups = append(backups, backup)
+	}
+	return backups, nil
+}
+
+// IsBackedUp returns true if the rows changed by the statement are backed up.
+func (b *PriorBackup) IsBackedUp() bool {
+	return b.SkippedReason == "" && b.BackupTable != ""
+}
+
+// GetCountSQL returns the statement counting the rows to be changed.
+func (b *PriorBackup) GetCountSQL() string {
+	return fmt.Sprintf("SELECT COUNT(*) FROM (%s) AS `rows`", b.Statement)
+}
+
+// GetCreateSQL returns the statements creating the backup table.
+// They must be executed before the transaction of the statements, because DDL statements commit the transaction implicitly.
+func (b *PriorBackup) GetCreateSQL() []string {
+	table := fmt.Sprintf("%s.%s", quoteIdentifier(b.Database), quoteIdentifier(b.Table))
+	backupTable := fmt.Sprintf("%s.%s", quoteIdentifier(BackupDatabaseName), quoteIdentifier(b.BackupTable))
+	return []string{
+		fmt.Sprintf("DROP TABLE IF EXISTS %s", backupTable),
+		fmt.Sprintf("CREATE TABLE %s LIKE %s", backupTable, table),
+	}
+}
+
+// GetBackupSQL returns the statement copying the insertable columns of the rows to be changed into the backup table.
+func (b *PriorBackup) GetBackupSQL(columns []string) string {
+	backupTable := fmt.Sprintf("%s.%s", quoteIdentifier(BackupDatabaseName), quoteIdentifier(b.BackupTable))
+	var quotedColumns []string
+	for _, column := range columns {
+		quotedColumns = append(quotedColumns, quoteIdentifier(column))
+	}
+	columnList := strings.Join(quotedColumns, ", ")
+	return fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM (%s) AS `rows`", backupTable, columnList, columnList, b.Statement)
+}
+
+// GetInsertableColumns returns the columns of the table that can be inserted in the order of the table definition.
+// The generated columns are excluded as they cannot be inserted.
+func GetInsertableColumns(ctx context.Context, db *sql.DB, databaseName, tableName string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT COLUMN_NAME
+		FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND EXTRA NOT LIKE '%GENERATED%'
+		ORDER BY ORDINAL_POSITION`, databaseName, tableName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query columns of table %s.%s", quoteIdentifier(databaseName), quoteIdentifier(tableName))
+	}
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, errors.Errorf("table %s.%s not found", quoteIdentifier(databaseName), quoteIdentifier(tableName))
+	}
+	return columns, nil
+}
+
+// GetRollbackSQL returns the statements restoring the backed up rows.
+// The rows are matched by the primary key, so the UPDATE statement changing the primary key cannot be rolled back.
+// The table without primary key only supports rolling back the DELETE statement.
+// Only the insertable columns are restored.
+func (b *PriorBackup) GetRollbackSQL(primaryKey, columns []string) (string, error) {
+	if b.SkippedReason != "" {
+		if b.Table == "" {
+			return fmt.Sprintf("-- The rows changed by a statement are not backed up: %s.\n", b.SkippedReason), nil
+		}
+		return fmt.Sprintf("-- The rows changed by a statement on table %s are not backed up: %s.\n", quoteIdentifier(b.Table), b.SkippedReason), nil
+	}
+	if b.BackupTable == "" {
+		return "", nil
+	}
+	table := fmt.Sprintf("%s.%s", quoteIdentifier(b.Database), quoteIdentifier(b.Table))
+	backupTable := fmt.Sprintf("%s.%s", quoteIdentifier(BackupDatabaseName), quoteIdentifier(b.BackupTable))
+	var buf strings.Builder
+	if len(primaryKey) > 0 {
+		var conditions []string
+		for _, column := range primaryKey {
+			conditions = append(conditions, fmt.Sprintf("t.%s = b.%s", quoteIdentifier(column), quoteIdentifier(column)))
+		}
+		_, _ = fmt.Fprintf(&buf, "DELETE t FROM %s AS t JOIN %s AS b ON %s;\n", table, backupTable, strings.Join(conditions, " AND "))
+	} else if !b.IsDelete {
+		return "", errors.Errorf("rolling back UPDATE statement requires a primary key on table %s", table)
+	}
+	if len(columns) == 0 {
+		return "", errors.Errorf("no column to restore on table %s", table)
+	}
+	var quotedColumns []string
+	for _, column := range columns {
+		quotedColumns = append(quotedColumns, quoteIdentifier(column))
+	}
+	columnList := strings.Join(quotedColumns, ", ")
+	_, _ = fmt.Fprintf(&buf, "INSERT INTO %s (%s) SELECT %s FROM %s;\n", table, columnList, columnList, backupTable)
+	return buf.String(), nil
+}
+
+// GetDropSQL returns the statement dropping the backup table.
+func (b *PriorBackup) GetDropSQL() string {
+	return fmt.Sprintf("DROP TABLE IF EXISTS %s.%s", quoteIdentifier(BackupDatabaseName), quoteIdentifier(b.BackupTable))
+}
+
+func truncateIdentifier(name string) string {
+	if utf8.RuneCountInString(name) <= maxIdentifierLength {
+		return name
+	}
+	return string([]rune(name)[:maxIdentifierLength])
+}
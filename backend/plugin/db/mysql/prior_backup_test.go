@@ -0,0 +1,121 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPriorBackups(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		statement string
+		want      *PriorBackup
+	}{
+		{
+			statement: "UPDATE t SET b = 1 WHERE a > 1;",
+			want: &PriorBackup{
+				Database:    "db",
+				Table:       "t",
+				BackupTable: "task1_0_t",
+				Statement:   "SELECT * FROM `t` WHERE `a`>1",
+			},
+		},
+		{
+			statement: "DELETE FROM db2.t2 WHERE c = 'v' ORDER BY a LIMIT 10;",
+			want: &PriorBackup{
+				Database:    "db2",
+				Table:       "t2",
+				BackupTable: "task1_0_t2",
+				IsDelete:    true,
+				Statement:   "SELECT * FROM `db2`.`t2` WHERE `c`=_UTF8MB4'v' ORDER BY `a` LIMIT 10",
+			},
+		},
+		{
+			statement: "DELETE FROM t LIMIT 10;",
+			want: &PriorBackup{
+				Database:      "db",
+				Table:         "t",
+				IsDelete:      true,
+				SkippedReason: "statement with LIMIT clause but without ORDER BY clause is not supported",
+			},
+		},
+		{
+			statement: "UPDATE t JOIN t2 ON t.a = t2.a SET t.b = 1;",
+			want: &PriorBackup{
+				SkippedReason: "statement on multiple tables is not supported",
+			},
+		},
+	}
+	for _, test := range tests {
+		backups, err := GetPriorBackups(test.statement, "db", "task1")
+		a.NoError(err, test.statement)
+		a.Equal([]*PriorBackup{test.want}, backups, test.statement)
+	}
+
+	// Each statement is backed up right before executing it, and only the ones whose changed rows cannot be determined are skipped.
+	backups, err := GetPriorBackups("SET @a = 1;\nUPDATE t SET b = 1 WHERE a > 1;\nINSERT INTO t(a) VALUES (1);\nUPDATE t SET WHERE;\nDELETE FROM t WHERE b = 1;", "db", "task1")
+	a.NoError(err)
+	a.Equal([]*PriorBackup{
+		{},
+		{
+			Database:    "db",
+			Table:       "t",
+			BackupTable: "task1_1_t",
+			Statement:   "SELECT * FROM `t` WHERE `a`>1",
+		},
+		{
+			SkippedReason: `only UPDATE and DELETE statements are backed up, but got "INSERT INTO t(a) VALUES (1);"`,
+		},
+		{
+			SkippedReason: "failed to parse statement at line 4",
+		},
+		{
+			Database:    "db",
+			Table:       "t",
+			BackupTable: "task1_4_t",
+			IsDelete:    true,
+			Statement:   "SELECT * FROM `t` WHERE `b`=1",
+		},
+	}, backups)
+	a.False(backups[0].IsBackedUp())
+	a.True(backups[1].IsBackedUp())
+	a.False(backups[2].IsBackedUp())
+}
+
+func TestPriorBackupGetRollbackSQL(t *testing.T) {
+	a := require.New(t)
+	update := &PriorBackup{Database: "db", Table: "t", BackupTable: "task1_0_t", Statement: "SELECT * FROM `t` WHERE `a`>1"}
+	a.Equal([]string{
+		"DROP TABLE IF EXISTS `bbdataarchive`.`task1_0_t`",
+		"CREATE TABLE `bbdataarchive`.`task1_0_t` LIKE `db`.`t`",
+	}, update.GetCreateSQL())
+	a.Equal("INSERT INTO `bbdataarchive`.`task1_0_t` (`a`, `b`) SELECT `a`, `b` FROM (SELECT * FROM `t` WHERE `a`>1) AS `rows`", update.GetBackupSQL([]string{"a", "b"}))
+	rollbackSQL, err := update.GetRollbackSQL([]string{"a", "b"}, []string{"a", "b", "c"})
+	a.NoError(err)
+	a.Equal("DELETE t FROM `db`.`t` AS t JOIN `bbdataarchive`.`task1_0_t` AS b ON t.`a` = b.`a` AND t.`b` = b.`b`;\nINSERT INTO `db`.`t` (`a`, `b`, `c`) SELECT `a`, `b`, `c` FROM `bbdataarchive`.`task1_0_t`;\n", rollbackSQL)
+	_, err = update.GetRollbackSQL(nil, []string{"a", "b", "c"})
+	a.Error(err)
+	_, err = update.GetRollbackSQL([]string{"a", "b"}, nil)
+	a.Error(err)
+
+	deleteBackup := &PriorBackup{Database: "db", Table: "t", BackupTable: "task1_1_t", IsDelete: true}
+	rollbackSQL, err = deleteBackup.GetRollbackSQL(nil, []string{"a"})
+	a.NoError(err)
+	a.Equal("INSERT INTO `db`.`t` (`a`) SELECT `a` FROM `bbdataarchive`.`task1_1_t`;\n", rollbackSQL)
+
+	skipped := &PriorBackup{Database: "db", Table: "t", SkippedReason: "statement on multiple tables is not supported"}
+	rollbackSQL, err = skipped.GetRollbackSQL(nil, nil)
+	a.NoError(err)
+	a.Equal("-- The rows changed by a statement on table `t` are not backed up: statement on multiple tables is not supported.\n", rollbackSQL)
+
+	quoted := &PriorBackup{Database: "d`b", Table: "t`1", BackupTable: "task1_0_t`1", IsDelete: true, Statement: "SELECT * FROM `t``1`"}
+	a.Equal([]string{
+		"DROP TABLE IF EXISTS `bbdataarchive`.`task1_0_t``1`",
+		"CREATE TABLE `bbdataarchive`.`task1_0_t``1` LIKE `d``b`.`t``1`",
+	}, quoted.GetCreateSQL())
+	a.Equal("INSERT INTO `bbdataarchive`.`task1_0_t``1` (`i``d`) SELECT `i``d` FROM (SELECT * FROM `t``1`) AS `rows`", quoted.GetBackupSQL([]string{"i`d"}))
+	rollbackSQL, err = quoted.GetRollbackSQL([]string{"i`d"}, []string{"i`d"})
+	a.NoError(err)
+	a.Equal("DELETE t FROM `d``b`.`t``1` AS t JOIN `bbdataarchive`.`task1_0_t``1` AS b ON t.`i``d` = b.`i``d`;\nINSERT INTO `d``b`.`t``1` (`i``d`) SELECT `i``d` FROM `bbdataarchive`.`task1_0_t``1`;\n", rollbackSQL)
+}
@@ -299,6 +299,36 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 		return nil, util.FormatErrorWithQuery(err, viewQuery)
 	}
 
+	// Query function info.
+	functionQuery := `
+		SELECT
+			ROUTINE_NAME,
+			ROUTINE_DEFINITION
+		FROM information_schema.ROUTINES
+		WHERE ROUTINE_SCHEMA = ? AND ROUTINE_TYPE = 'FUNCTION'
+		ORDER BY ROUTINE_NAME`
+	functionRows, err := driver.db.QueryContext(ctx, functionQuery, driver.databaseName)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, functionQuery)
+	}
+	defer functionRows.Close()
+	for functionRows.Next() {
+		function := &storepb.FunctionMetadata{}
+		// The definition is NULL without the privilege of the function.
+		var definition sql.NullString
+		if err := functionRows.Scan(
+			&function.Name,
+			&definition,
+		); err != nil {
+			return nil, err
+		}
+		function.Definition = definition.String
+		schemaMetadata.Functions = append(schemaMetadata.Functions, function)
+	}
+	if err := functionRows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, functionQuery)
+	}
+
 	// Query foreign key info.
 	foreignKeysMap, err := driver.getForeignKeyList(ctx, driver.databaseName)
 	if err != nil {
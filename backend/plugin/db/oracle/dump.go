@@ -8,10 +8,16 @@ import (
 	"log/slog"
 	"sort"
 	"strings"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 )
 
-// Dump dumps the database.
-func (driver *Driver) Dump(ctx context.Context, out io.Writer, _ bool) (string, error) {
+// Dump dumps the database schema. Dump with data is not supported.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	if !schemaOnly {
+		return "", common.Errorf(common.NotImplemented, "dump is not supported for %s", db.Oracle)
+	}
 	txn, err := driver.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		return "", err
@@ -2292,7 +2298,7 @@ func dumpTriggerOrderingTxn(ctx context.Context, txn *sql.Tx, schema string, _ i
 }
 
 // Restore restores a database.
-func (*Driver) Restore(_ context.Context, _ io.Reader) (err error) {
+func (*Driver) Restore(_ context.Context, _ io.Reader) error {
 	// TODO(d): implement it.
-	return nil
+	return common.Errorf(common.NotImplemented, "restore is not supported for %s", db.Oracle)
 }
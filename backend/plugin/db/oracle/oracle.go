@@ -28,7 +28,7 @@ var (
 )
 
 func init() {
-	db.Register(db.Oracle, newDriver)
+	db.Register(db.Oracle, newDriver, db.CapabilitySchemaDump)
 }
 
 // Driver is the Oracle driver.
@@ -0,0 +1,216 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// BatchStatement is a single-table UPDATE or DELETE statement executed in batches of the primary key ranges.
+type BatchStatement struct {
+	// Schema and Table are the table changed by the statement.
+	Schema string
+	Table  string
+	// UpdatedColumns are the columns set by the UPDATE statement.
+	UpdatedColumns []string
+
+	stmt  *pgquery.RawStmt
+	where *pgquery.Node
+}
+
+// GetBatchStatement parses the statement executed in batches.
+// The statement must be a single UPDATE or DELETE statement on a single table.
+func GetBatchStatement(statement string) (*BatchStatement, error) {
+	tree, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	if len(tree.Stmts) != 1 {
+		return nil, errors.Errorf("batched execution only supports a single statement")
+	}
+	batch := &BatchStatement{stmt: tree.Stmts[0]}
+	var relation *pgquery.RangeVar
+	switch node := batch.stmt.Stmt.Node.(type) {
+	case *pgquery.Node_UpdateStmt:
+		if node.UpdateStmt.WithClause != nil || len(node.UpdateStmt.FromClause) > 0 {
+			return nil, errors.Errorf("batched execution does not support UPDATE statement with WITH or FROM clause")
+		}
+		relation, batch.where = node.UpdateStmt.Relation, node.UpdateStmt.WhereClause
+		for _, target := range node.UpdateStmt.TargetList {
+			if resTarget := target.GetResTarget(); resTarget != nil {
+				batch.UpdatedColumns = append(batch.UpdatedColumns, resTarget.Name)
+			}
+		}
+		if err := checkIdempotentAssignments(node.UpdateStmt.TargetList, batch.UpdatedColumns); err != nil {
+			return nil, err
+		}
+	case *pgquery.Node_DeleteStmt:
+		if node.DeleteStmt.WithClause != nil || len(node.DeleteStmt.UsingClause) > 0 {
+			return nil, errors.Errorf("batched execution does not support DELETE statement with WITH or USING clause")
+		}
+		relation, batch.where = node.DeleteStmt.Relation, node.DeleteStmt.WhereClause
+	default:
+		return nil, errors.Errorf("batched execution only supports UPDATE and DELETE statements")
+	}
+	if !relation.Inh {
+		return nil, errors.Errorf("batched execution does not support statement with ONLY on table %q", relation.Relname)
+	}
+
+	batch.Schema = relation.Schemaname
+	if batch.Schema == "" {
+		batch.Schema = "public"
+	}
+	batch.Table = relation.Relname
+	return batch, nil
+}
+
+// checkIdempotentAssignments checks that the assignments don't depend on the updated columns, because a batch may be executed
+// again on resuming, e.g. "SET c = c + 1" increases the column twice.
+func checkIdempotentAssignments(targets []*pgquery.Node, updatedColumns []string) error {
+	for _, target := range targets {
+		resTarget := target.GetResTarget()
+		if resTarget == nil {
+			continue
+		}
+		columns, hasSubquery := getColumnRefs(resTarget.Val)
+		if hasSubquery {
+			return errors.Errorf("batched execution does not support the assignment to column %q with a subquery", resTarget.Name)
+		}
+		for _, column := range columns {
+			for _, updatedColumn := range updatedColumns {
+				if column == updatedColumn {
+					return errors.Errorf("batched execution does not support the assignment to column %q referencing the updated column %q, because it's not idempotent", resTarget.Name, updatedColumn)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// getColumnRefs returns the names of the columns referenced by the node, and whether the node has a subquery.
+func getColumnRefs(node *pgquery.Node) ([]string, bool) {
+	var columns []string
+	var hasSubquery bool
+	var walk func(m protoreflect.Message)
+	walk = func(m protoreflect.Message) {
+		switch msg := m.Interface().(type) {
+		case *pgquery.ColumnRef:
+			if len(msg.Fields) > 0 {
+				if name := msg.Fields[len(msg.Fields)-1].GetString_(); name != nil {
+					columns = append(columns, name.Sval)
+				}
+			}
+			return
+		case *pgquery.SubLink:
+			hasSubquery = true
+			return
+		}
+		m.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			switch {
+			case field.Kind() != protoreflect.MessageKind || field.IsMap():
+			case field.IsList():
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					walk(list.Get(i).Message())
+				}
+			default:
+				walk(value.Message())
+			}
+			return true
+		})
+	}
+	if node != nil {
+		walk(node.ProtoReflect())
+	}
+	return columns, hasSubquery
+}
+
+// GetChunkSQL returns the statement changing the rows in the primary key range (lower, upper].
+// The bounds are the parameters of the primary key values, the lower bound first. The range is unbounded on the side without the bound.
+func (b *BatchStatement) GetChunkSQL(primaryKey []string, hasLower, hasUpper bool) (string, error) {
+	var conditions []string
+	param := 1
+	if hasLower {
+		conditions = append(conditions, getKeyCondition(primaryKey, ">", param))
+		param += len(primaryKey)
+	}
+	if hasUpper {
+		conditions = append(conditions, getKeyCondition(primaryKey, "<=", param))
+	}
+	where := b.where
+	if len(conditions) > 0 {
+		tree, err := pgquery.Parse(fmt.Sprintf("SELECT 1 WHERE %s", strings.Join(conditions, " AND ")))
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to parse the primary key range")
+		}
+		keyRange := tree.Stmts[0].Stmt.GetSelectStmt().WhereClause
+		if where == nil {
+			where = keyRange
+		} else {
+			where = pgquery.MakeBoolExprNode(pgquery.BoolExprType_AND_EXPR, []*pgquery.Node{where, keyRange}, 0)
+		}
+	}
+
+	switch node := b.stmt.Stmt.Node.(type) {
+	case *pgquery.Node_UpdateStmt:
+		node.UpdateStmt.WhereClause = where
+	case *pgquery.Node_DeleteStmt:
+		node.DeleteStmt.WhereClause = where
+	}
+	statement, err := pgquery.Deparse(&pgquery.ParseResult{Stmts: []*pgquery.RawStmt{b.stmt}})
+	switch node := b.stmt.Stmt.Node.(type) {
+	case *pgquery.Node_UpdateStmt:
+		node.UpdateStmt.WhereClause = b.where
+	case *pgquery.Node_DeleteStmt:
+		node.DeleteStmt.WhereClause = b.where
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to deparse the batch statement on table %q", b.Table)
+	}
+	return statement, nil
+}
+
+// GetChunkKeysSQL returns the query selecting the primary keys in text of the rows in the next primary key range of at most batchSize rows.
+// The lower bound of the range is the parameters of the primary key values if hasLower.
+func (b *BatchStatement) GetChunkKeysSQL(primaryKey []string, hasLower bool, batchSize int) string {
+	var columns, textColumns []string
+	for _, column := range primaryKey {
+		columns = append(columns, pgx.Identifier{column}.Sanitize())
+		textColumns = append(textColumns, fmt.Sprintf("%s::text", pgx.Identifier{column}.Sanitize()))
+	}
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "SELECT %s FROM %s", strings.Join(textColumns, ", "), pgx.Identifier{b.Schema, b.Table}.Sanitize())
+	if hasLower {
+		_, _ = fmt.Fprintf(&buf, " WHERE %s", getKeyCondition(primaryKey, ">", 1))
+	}
+	_, _ = fmt.Fprintf(&buf, " ORDER BY %s LIMIT %d", strings.Join(columns, ", "), batchSize)
+	return buf.String()
+}
+
+// GetReplicationLagSeconds returns the maximum replay lag in seconds of the replicas connected to the primary.
+func GetReplicationLagSeconds(ctx context.Context, conn *sql.Conn) (float64, error) {
+	var lag float64
+	if err := conn.QueryRowContext(ctx, "SELECT COALESCE(MAX(EXTRACT(EPOCH FROM replay_lag)), 0) FROM pg_stat_replication").Scan(&lag); err != nil {
+		return 0, err
+	}
+	return lag, nil
+}
+
+// getKeyCondition returns the comparison of the primary key with the parameters starting from $param.
+func getKeyCondition(primaryKey []string, operator string, param int) string {
+	var columns, params []string
+	for i, column := range primaryKey {
+		columns = append(columns, pgx.Identifier{column}.Sanitize())
+		params = append(params, fmt.Sprintf("$%d", param+i))
+	}
+	if len(primaryKey) == 1 {
+		return fmt.Sprintf("%s %s %s", columns[0], operator, params[0])
+	}
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(columns, ", "), operator, strings.Join(params, ", "))
+}
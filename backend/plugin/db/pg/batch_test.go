@@ -0,0 +1,44 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBatchStatement(t *testing.T) {
+	a := require.New(t)
+	batch, err := GetBatchStatement("UPDATE t SET b = 1, c = d + 1 WHERE a > 1 OR d IS NULL RETURNING id;")
+	a.NoError(err)
+	a.Equal("public", batch.Schema)
+	a.Equal("t", batch.Table)
+	a.Equal([]string{"b", "c"}, batch.UpdatedColumns)
+
+	chunk, err := batch.GetChunkSQL([]string{"id"}, true, true)
+	a.NoError(err)
+	a.Equal("UPDATE t SET b = 1, c = d + 1 WHERE (a > 1 OR d IS NULL) AND (id > $1 AND id <= $2) RETURNING id", chunk)
+	a.Equal(`SELECT "id"::text FROM "public"."t" WHERE "id" > $1 ORDER BY "id" LIMIT 1000`, batch.GetChunkKeysSQL([]string{"id"}, true, 1000))
+
+	batch, err = GetBatchStatement(`DELETE FROM "S"."T"`)
+	a.NoError(err)
+	a.Equal("S", batch.Schema)
+	a.Equal("T", batch.Table)
+	chunk, err = batch.GetChunkSQL([]string{"a", "b"}, false, true)
+	a.NoError(err)
+	a.Equal(`DELETE FROM "S"."T" WHERE (a, b) <= ($1, $2)`, chunk)
+	a.Equal(`SELECT "a"::text, "b"::text FROM "S"."T" ORDER BY "a", "b" LIMIT 100`, batch.GetChunkKeysSQL([]string{"a", "b"}, false, 100))
+
+	for _, statement := range []string{
+		"UPDATE t SET a = 1; DELETE FROM t;",
+		"UPDATE t SET b = t2.b FROM t2 WHERE t.a = t2.a",
+		"DELETE FROM ONLY t",
+		"INSERT INTO t VALUES (1)",
+		"UPDATE t SET c = c + 1",
+		"UPDATE t SET b = c, c = t.b",
+		"UPDATE t SET (b, c) = (SELECT a, d FROM t2 WHERE t2.a = t.a)",
+		"UPDATE t SET c = (SELECT MAX(a) FROM t2)",
+	} {
+		_, err := GetBatchStatement(statement)
+		a.Error(err, statement)
+	}
+}
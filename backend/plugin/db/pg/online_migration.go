@@ -0,0 +1,714 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxOnlineMigrationTableNameLength keeps the names of the shadow table, trigger and function within the 63 bytes identifier limit.
+	maxOnlineMigrationTableNameLength = 63 - len("__shadow_truncate")
+	// lockNotAvailableSQLState is the SQLSTATE of the lock_timeout error.
+	lockNotAvailableSQLState = "55P03"
+	// shadowTableComment marks the shadow tables created by Prepare, so that a table with the same name is never dropped.
+	shadowTableComment = "bytebase online migration shadow table"
+	// onlineMigrationColumnsQuery returns the names and types of the columns of the table $1 in one text.
+	// It is recorded as the comment of the sync function by Prepare, and compared at the cutover.
+	onlineMigrationColumnsQuery = `
+	SELECT pg_catalog.string_agg(pg_catalog.format('%I %s', attname, pg_catalog.format_type(atttypid, atttypmod)), ', ' ORDER BY attnum)
+	FROM pg_catalog.pg_attribute
+	WHERE attrelid = pg_catalog.to_regclass($1) AND attnum > 0 AND NOT attisdropped`
+)
+
+// OnlineMigration is an ALTER TABLE change applied to a shadow table instead of the original table.
+// Triggers on the original table replay the row changes onto the shadow table while the existing rows are copied in batches,
+// and the cutover switches the tables in a short transaction holding the ACCESS EXCLUSIVE lock.
+// The original table is kept as the old table after the cutover, and it's up to the users to drop it.
+type OnlineMigration struct {
+	// Schema and Table are the table altered by the statement.
+	Schema string
+	Table  string
+	// RestrictsWrites is true if the statement adds constraints or changes column types.
+	// The writes to the original table violating them fail once the triggers are created, because they are replayed onto the shadow table.
+	RestrictsWrites bool
+	// PrimaryKey and Columns are the primary key and the columns copied to the shadow table.
+	// They are set by Prepare.
+	PrimaryKey []string
+	Columns    []string
+
+	shadowStatements []string
+}
+
+// onlineMigrationIndex is an index of the original or the shadow table.
+type onlineMigrationIndex struct {
+	name    string
+	primary bool
+	unique  bool
+	// definition is the index definition after the USING keyword, which doesn't contain the index and table names.
+	definition string
+}
+
+// GetOnlineMigration parses the statement applied by the online migration.
+// The statement must consist of ALTER TABLE statements on the same table.
+func GetOnlineMigration(statement string) (*OnlineMigration, error) {
+	tree, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	if len(tree.Stmts) == 0 {
+		return nil, errors.Errorf("empty statement")
+	}
+	m := &OnlineMigration{}
+	for _, stmt := range tree.Stmts {
+		alter := stmt.Stmt.GetAlterTableStmt()
+		if alter == nil || alter.Objtype != pgquery.ObjectType_OBJECT_TABLE {
+			return nil, errors.Errorf("online migration only supports ALTER TABLE statements")
+		}
+		schema := alter.Relation.Schemaname
+		if schema == "" {
+			schema = "public"
+		}
+		if m.Table == "" {
+			m.Schema, m.Table = schema, alter.Relation.Relname
+		} else if m.Schema != schema || m.Table != alter.Relation.Relname {
+			return nil, errors.Errorf("online migration only supports altering a single table, but got %q and %q", m.Table, alter.Relation.Relname)
+		}
+		for _, cmd := range alter.Cmds {
+			if restrictsWrites(cmd.GetAlterTableCmd()) {
+				m.RestrictsWrites = true
+			}
+		}
+		alter.Relation.Schemaname, alter.Relation.Relname = m.Schema, m.shadowTable()
+		shadowStatement, err := pgquery.Deparse(&pgquery.ParseResult{Stmts: []*pgquery.RawStmt{stmt}})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to deparse the statement on the shadow table of %q", m.Table)
+		}
+		m.shadowStatements = append(m.shadowStatements, shadowStatement)
+	}
+	if len(m.Table) > maxOnlineMigrationTableNameLength {
+		return nil, errors.Errorf("online migration does not support table name %q longer than %d bytes", m.Table, maxOnlineMigrationTableNameLength)
+	}
+	return m, nil
+}
+
+// restrictsWrites returns true if the command adds constraints or changes the column type.
+func restrictsWrites(cmd *pgquery.AlterTableCmd) bool {
+	if cmd == nil {
+		return false
+	}
+	switch cmd.Subtype {
+	case pgquery.AlterTableType_AT_SetNotNull, pgquery.AlterTableType_AT_AddConstraint, pgquery.AlterTableType_AT_AddIndex, pgquery.AlterTableType_AT_AlterColumnType:
+		return true
+	case pgquery.AlterTableType_AT_AddColumn:
+		for _, constraint := range cmd.Def.GetColumnDef().GetConstraints() {
+			switch constraint.GetConstraint().GetContype() {
+			case pgquery.ConstrType_CONSTR_NULL, pgquery.ConstrType_CONSTR_DEFAULT:
+			default:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Check checks that the table can be migrated online.
+// The objects depending on the table, such as the views and the foreign keys referencing it, would be left on the original table at the cutover.
+func (m *OnlineMigration) Check(ctx context.Context, sqlDB *sql.DB) error {
+	return m.check(ctx, sqlDB)
+}
+
+func (m *OnlineMigration) check(ctx context.Context, q queryer) error {
+	const query = `
+	SELECT
+		c.relkind::text,
+		c.relrowsecurity OR EXISTS (SELECT 1 FROM pg_catalog.pg_policy p WHERE p.polrelid = c.oid),
+		EXISTS (SELECT 1 FROM pg_catalog.pg_inherits i WHERE i.inhrelid = c.oid OR i.inhparent = c.oid),
+		EXISTS (SELECT 1 FROM pg_catalog.pg_constraint f WHERE f.confrelid = c.oid AND f.contype = 'f'),
+		EXISTS (
+			SELECT 1 FROM pg_catalog.pg_depend d JOIN pg_catalog.pg_rewrite r ON r.oid = d.objid
+			WHERE d.classid = 'pg_catalog.pg_rewrite'::pg_catalog.regclass AND d.refobjid = c.oid AND r.ev_class <> c.oid
+		),
+		EXISTS (SELECT 1 FROM pg_catalog.pg_trigger t WHERE t.tgrelid = c.oid AND NOT t.tgisinternal AND t.tgname NOT IN ($2, $3)),
+		EXISTS (SELECT 1 FROM pg_catalog.pg_publication_rel p WHERE p.prrelid = c.oid),
+		EXISTS (SELECT 1 FROM information_schema.columns WHERE table_schema = $4 AND table_name = $5 AND is_identity = 'YES')
+	FROM pg_catalog.pg_class c
+	WHERE c.oid = pg_catalog.to_regclass($1)`
+	var relkind string
+	var hasPolicy, hasInheritance, hasReferencingForeignKey, hasDependentView, hasTrigger, hasPublication, hasIdentity bool
+	if err := q.QueryRowContext(ctx, query, m.quotedTable(), m.syncTrigger(), m.truncateTrigger(), m.Schema, m.Table).Scan(
+		&relkind, &hasPolicy, &hasInheritance, &hasReferencingForeignKey, &hasDependentView, &hasTrigger, &hasPublication, &hasIdentity,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("table %q not found in schema %q", m.Table, m.Schema)
+		}
+		return errors.Wrapf(err, "failed to check table %q", m.Table)
+	}
+	switch {
+	case relkind != "r":
+		return errors.Errorf("online migration only supports regular tables, but %q is a partitioned table or not a table", m.Table)
+	case hasPolicy:
+		return errors.Errorf("online migration does not support table %q with row level security", m.Table)
+	case hasInheritance:
+		return errors.Errorf("online migration does not support table %q with inheritance", m.Table)
+	case hasReferencingForeignKey:
+		return errors.Errorf("online migration does not support table %q referenced by foreign keys", m.Table)
+	case hasDependentView:
+		return errors.Errorf("online migration does not support table %q referenced by views", m.Table)
+	case hasTrigger:
+		return errors.Errorf("online migration does not support table %q with triggers", m.Table)
+	case hasPublication:
+		return errors.Errorf("online migration does not support table %q in publications", m.Table)
+	case hasIdentity:
+		return errors.Errorf("online migration does not support table %q with identity columns", m.Table)
+	}
+
+	primaryKey, err := getOnlineMigrationPrimaryKey(ctx, q, m.Schema, m.Table)
+	if err != nil {
+		return err
+	}
+	if len(primaryKey) == 0 {
+		return errors.Errorf("online migration requires a primary key on table %q", m.Table)
+	}
+	return nil
+}
+
+// Prepare creates the shadow table with the change applied, and the triggers replaying the row changes of the original table onto the shadow table.
+// The shadow table left by the previous run is recreated.
+func (m *OnlineMigration) Prepare(ctx context.Context, sqlDB *sql.DB, lockTimeout time.Duration) error {
+	tx, err := sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := setLockTimeout(ctx, tx, lockTimeout); err != nil {
+		return err
+	}
+	if err := m.dropShadowObjects(ctx, tx); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING ALL)", m.quotedShadowTable(), m.quotedTable())); err != nil {
+		return errors.Wrapf(err, "failed to create the shadow table of %q", m.Table)
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("COMMENT ON TABLE %s IS '%s'", m.quotedShadowTable(), shadowTableComment)); err != nil {
+		return errors.Wrapf(err, "failed to mark the shadow table of %q", m.Table)
+	}
+	// LIKE doesn't copy the foreign keys. They are copied before applying the change, which may drop them.
+	foreignKeyStatements, err := queryStatements(ctx, tx, `
+	SELECT pg_catalog.format('ALTER TABLE %s ADD CONSTRAINT %I %s', $2::text, conname, pg_catalog.pg_get_constraintdef(oid))
+	FROM pg_catalog.pg_constraint
+	WHERE conrelid = pg_catalog.to_regclass($1) AND contype = 'f'
+	ORDER BY conname`, m.quotedTable(), m.quotedShadowTable())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the foreign keys of %q", m.Table)
+	}
+	for _, statement := range foreignKeyStatements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to copy the foreign key to the shadow table of %q", m.Table)
+		}
+	}
+	for _, statement := range m.shadowStatements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to alter the shadow table of %q", m.Table)
+		}
+	}
+
+	primaryKey, err := getOnlineMigrationPrimaryKey(ctx, tx, m.Schema, m.Table)
+	if err != nil {
+		return err
+	}
+	shadowPrimaryKey, err := getOnlineMigrationPrimaryKey(ctx, tx, m.Schema, m.shadowTable())
+	if err != nil {
+		return err
+	}
+	if len(primaryKey) == 0 || strings.Join(primaryKey, ",") != strings.Join(shadowPrimaryKey, ",") {
+		return errors.Errorf("online migration does not support changing the primary key of table %q", m.Table)
+	}
+	columns, err := queryStatements(ctx, tx, `
+	SELECT s.column_name
+	FROM information_schema.columns s
+	JOIN information_schema.columns o ON o.table_schema = s.table_schema AND o.table_name = $2 AND o.column_name = s.column_name
+	WHERE s.table_schema = $1 AND s.table_name = $3 AND s.is_generated = 'NEVER'
+	ORDER BY s.ordinal_position`, m.Schema, m.Table, m.shadowTable())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the columns of %q", m.Table)
+	}
+	m.PrimaryKey, m.Columns = primaryKey, columns
+
+	// The function runs as the definer, so that the writers of the original table don't need the privileges of the shadow table.
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+		"CREATE FUNCTION %s() RETURNS trigger LANGUAGE plpgsql SECURITY DEFINER SET search_path = pg_catalog, pg_temp AS $bb$%s$bb$",
+		m.quotedSyncFunction(), m.getSyncFunctionBody(),
+	)); err != nil {
+		return errors.Wrapf(err, "failed to create the sync function of %q", m.Table)
+	}
+	// Record the columns of the original table, so that the cutover is aborted if they are changed in the meantime.
+	var commentStatement string
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT pg_catalog.format('COMMENT ON FUNCTION %%s() IS %%L', $2::text, (%s))", onlineMigrationColumnsQuery),
+		m.quotedTable(), m.quotedSyncFunction(),
+	).Scan(&commentStatement); err != nil {
+		return errors.Wrapf(err, "failed to get the columns of %q", m.Table)
+	}
+	if _, err := tx.ExecContext(ctx, commentStatement); err != nil {
+		return errors.Wrapf(err, "failed to record the columns of %q", m.Table)
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+		"CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON %s FOR EACH ROW EXECUTE PROCEDURE %s()",
+		pgx.Identifier{m.syncTrigger()}.Sanitize(), m.quotedTable(), m.quotedSyncFunction(),
+	)); err != nil {
+		return errors.Wrapf(err, "failed to create the sync trigger on %q", m.Table)
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+		"CREATE TRIGGER %s AFTER TRUNCATE ON %s FOR EACH STATEMENT EXECUTE PROCEDURE %s()",
+		pgx.Identifier{m.truncateTrigger()}.Sanitize(), m.quotedTable(), m.quotedSyncFunction(),
+	)); err != nil {
+		return errors.Wrapf(err, "failed to create the truncate trigger on %q", m.Table)
+	}
+	return tx.Commit()
+}
+
+// GetChunkSQL returns the statement copying the rows in the primary key range (lower, upper] to the shadow table.
+// The bounds are the parameters of the primary key values, the lower bound first. The range is unbounded on the side without the bound.
+// The copied rows are locked, so that the concurrent changes replayed by the triggers are not overwritten by the stale rows.
+func (m *OnlineMigration) GetChunkSQL(primaryKey []string, hasLower, hasUpper bool) (string, error) {
+	var conditions []string
+	param := 1
+	if hasLower {
+		conditions = append(conditions, getKeyCondition(primaryKey, ">", param))
+		param += len(primaryKey)
+	}
+	if hasUpper {
+		conditions = append(conditions, getKeyCondition(primaryKey, "<=", param))
+	}
+	columns := quoteIdentifiers(m.Columns)
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "INSERT INTO %s (%s) SELECT %s FROM %s", m.quotedShadowTable(), columns, columns, m.quotedTable())
+	if len(conditions) > 0 {
+		_, _ = fmt.Fprintf(&buf, " WHERE %s", strings.Join(conditions, " AND "))
+	}
+	// The rows violating the other unique constraints fail the copy instead of being skipped.
+	_, _ = fmt.Fprintf(&buf, " FOR SHARE ON CONFLICT (%s) DO NOTHING", quoteIdentifiers(primaryKey))
+	return buf.String(), nil
+}
+
+// GetChunkKeysSQL returns the query selecting the primary keys in text of the rows in the next primary key range of at most batchSize rows.
+func (m *OnlineMigration) GetChunkKeysSQL(primaryKey []string, hasLower bool, batchSize int) string {
+	return (&BatchStatement{Schema: m.Schema, Table: m.Table}).GetChunkKeysSQL(primaryKey, hasLower, batchSize)
+}
+
+// GetRowEstimate returns the estimated row count of the table.
+func (m *OnlineMigration) GetRowEstimate(ctx context.Context, sqlDB *sql.DB) (int64, error) {
+	var count int64
+	if err := sqlDB.QueryRowContext(ctx, "SELECT GREATEST(reltuples, 0)::bigint FROM pg_catalog.pg_class WHERE oid = pg_catalog.to_regclass($1)", m.quotedTable()).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Cutover replaces the original table with the shadow table in a transaction holding the ACCESS EXCLUSIVE lock of the original table.
+// The shadow table takes over the name, the index names, the owner, the privileges, the comment and the owned sequences of the original table.
+// The original table is renamed to the old table instead of being dropped, so that its data is kept until the users drop it.
+// It returns an error satisfying IsLockNotAvailable if the lock is not acquired within lockTimeout.
+func (m *OnlineMigration) Cutover(ctx context.Context, sqlDB *sql.DB, lockTimeout time.Duration) error {
+	tx, err := sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := setLockTimeout(ctx, tx, lockTimeout); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("LOCK TABLE %s IN ACCESS EXCLUSIVE MODE", m.quotedTable())); err != nil {
+		return err
+	}
+	shadowExists, err := m.checkShadowTable(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if !shadowExists {
+		return errors.Errorf("shadow table %q not found, rerun the sync task", m.shadowTable())
+	}
+	// The table may have been changed since the sync task, e.g. a view or a column is added,
+	// which would be left on the old table or lost at the cutover.
+	if err := m.check(ctx, tx); err != nil {
+		return errors.Wrapf(err, "table %q is changed since the sync task", m.Table)
+	}
+	var columnsChanged bool
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT (%s) IS DISTINCT FROM pg_catalog.obj_description(pg_catalog.to_regprocedure($2), 'pg_proc')", onlineMigrationColumnsQuery),
+		m.quotedTable(), m.quotedSyncFunction()+"()",
+	).Scan(&columnsChanged); err != nil {
+		return errors.Wrapf(err, "failed to check the columns of %q", m.Table)
+	}
+	if columnsChanged {
+		return errors.Errorf("the columns of table %q are changed since the sync task, rerun the sync task", m.Table)
+	}
+	var oldExists bool
+	if err := tx.QueryRowContext(ctx, "SELECT pg_catalog.to_regclass($1) IS NOT NULL", m.GetOldTable()).Scan(&oldExists); err != nil {
+		return err
+	}
+	if oldExists {
+		return errors.Errorf("table %q left by the previous online migration exists, drop it before the cutover", m.oldTable())
+	}
+
+	sequenceStatements, err := queryStatements(ctx, tx, `
+	SELECT pg_catalog.format('ALTER SEQUENCE %I.%I OWNED BY %s.%I', n.nspname, s.relname, $2::text, a.attname)
+	FROM pg_catalog.pg_depend d
+	JOIN pg_catalog.pg_class s ON s.oid = d.objid AND s.relkind = 'S'
+	JOIN pg_catalog.pg_namespace n ON n.oid = s.relnamespace
+	JOIN pg_catalog.pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+	WHERE d.classid = 'pg_catalog.pg_class'::pg_catalog.regclass AND d.refclassid = 'pg_catalog.pg_class'::pg_catalog.regclass
+		AND d.refobjid = pg_catalog.to_regclass($1) AND d.deptype = 'a'
+		AND a.attname IN (SELECT column_name FROM information_schema.columns WHERE table_schema = $3 AND table_name = $4)`,
+		m.quotedTable(), m.quotedShadowTable(), m.Schema, m.shadowTable())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the sequences owned by %q", m.Table)
+	}
+	// The statements are executed after the shadow table is renamed to the table name.
+	var tableStatements []string
+	for _, query := range []string{
+		`SELECT pg_catalog.format('ALTER TABLE %s OWNER TO %I', $2::text, pg_catalog.pg_get_userbyid(relowner))
+		FROM pg_catalog.pg_class WHERE oid = pg_catalog.to_regclass($1) AND pg_catalog.pg_get_userbyid(relowner) <> current_user`,
+		`SELECT pg_catalog.format('GRANT %s ON TABLE %s TO %s%s', a.privilege_type, $2::text,
+			CASE WHEN a.grantee = 0 THEN 'PUBLIC' ELSE pg_catalog.quote_ident(pg_catalog.pg_get_userbyid(a.grantee)) END,
+			CASE WHEN a.is_grantable THEN ' WITH GRANT OPTION' ELSE '' END)
+		FROM pg_catalog.pg_class c, pg_catalog.aclexplode(c.relacl) a
+		WHERE c.oid = pg_catalog.to_regclass($1) AND a.grantee <> c.relowner`,
+		`SELECT pg_catalog.format('COMMENT ON TABLE %s IS %L', $2::text, description)
+		FROM pg_catalog.pg_description
+		WHERE objoid = pg_catalog.to_regclass($1) AND classoid = 'pg_catalog.pg_class'::pg_catalog.regclass AND objsubid = 0`,
+	} {
+		statements, err := queryStatements(ctx, tx, query, m.quotedTable(), m.quotedTable())
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the properties of %q", m.Table)
+		}
+		tableStatements = append(tableStatements, statements...)
+	}
+	// The privileges of the columns kept in the shadow table.
+	columnPrivilegeStatements, err := queryStatements(ctx, tx, `
+	SELECT pg_catalog.format('GRANT %s (%I) ON TABLE %s TO %s%s', a.privilege_type, att.attname, $2::text,
+		CASE WHEN a.grantee = 0 THEN 'PUBLIC' ELSE pg_catalog.quote_ident(pg_catalog.pg_get_userbyid(a.grantee)) END,
+		CASE WHEN a.is_grantable THEN ' WITH GRANT OPTION' ELSE '' END)
+	FROM pg_catalog.pg_class c
+	JOIN pg_catalog.pg_attribute att ON att.attrelid = c.oid AND att.attnum > 0 AND NOT att.attisdropped AND att.attacl IS NOT NULL,
+		pg_catalog.aclexplode(att.attacl) a
+	WHERE c.oid = pg_catalog.to_regclass($1) AND a.grantee <> c.relowner
+		AND att.attname IN (SELECT column_name FROM information_schema.columns WHERE table_schema = $3 AND table_name = $4)
+	ORDER BY att.attnum`, m.quotedTable(), m.quotedTable(), m.Schema, m.shadowTable())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the column privileges of %q", m.Table)
+	}
+	tableStatements = append(tableStatements, columnPrivilegeStatements...)
+	// The foreign keys of the old table are dropped, so that its stale rows don't restrict the referenced tables.
+	oldForeignKeyStatements, err := queryStatements(ctx, tx, `
+	SELECT pg_catalog.format('ALTER TABLE %s DROP CONSTRAINT %I', $2::text, conname)
+	FROM pg_catalog.pg_constraint
+	WHERE conrelid = pg_catalog.to_regclass($1) AND contype = 'f'
+	ORDER BY conname`, m.quotedTable(), m.GetOldTable())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the foreign keys of %q", m.Table)
+	}
+	indexes, err := getOnlineMigrationIndexes(ctx, tx, m.quotedTable())
+	if err != nil {
+		return err
+	}
+	shadowIndexes, err := getOnlineMigrationIndexes(ctx, tx, m.quotedShadowTable())
+	if err != nil {
+		return err
+	}
+
+	if err := m.dropSyncTriggers(ctx, tx); err != nil {
+		return err
+	}
+	for _, statement := range sequenceStatements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to transfer the sequence owned by %q", m.Table)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", m.quotedTable(), pgx.Identifier{m.oldTable()}.Sanitize())); err != nil {
+		return errors.Wrapf(err, "failed to rename the original table %q", m.Table)
+	}
+	for _, statement := range oldForeignKeyStatements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to drop the foreign key of the original table %q", m.Table)
+		}
+	}
+	// The index names are unique in the schema, so that the indexes of the old table are renamed for the shadow indexes to take over.
+	for i, index := range indexes {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER INDEX %s RENAME TO %s", pgx.Identifier{m.Schema, index.name}.Sanitize(), pgx.Identifier{fmt.Sprintf("%s_%d", m.oldTable(), i)}.Sanitize())); err != nil {
+			return errors.Wrapf(err, "failed to rename index %q of the original table", index.name)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", m.quotedShadowTable(), pgx.Identifier{m.Table}.Sanitize())); err != nil {
+		return errors.Wrapf(err, "failed to rename the shadow table of %q", m.Table)
+	}
+	// Remove the shadow table mark. The comment of the original table is restored below.
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("COMMENT ON TABLE %s IS NULL", m.quotedTable())); err != nil {
+		return errors.Wrapf(err, "failed to remove the comment of %q", m.Table)
+	}
+	for _, rename := range getIndexRenames(indexes, shadowIndexes, m.Table, m.shadowTable()) {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER INDEX %s RENAME TO %s", pgx.Identifier{m.Schema, rename[0]}.Sanitize(), pgx.Identifier{rename[1]}.Sanitize())); err != nil {
+			return errors.Wrapf(err, "failed to rename index %q", rename[0])
+		}
+	}
+	for _, statement := range tableStatements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to restore the properties of %q", m.Table)
+		}
+	}
+	return tx.Commit()
+}
+
+// Cleanup drops the triggers, the function and the shadow table created by Prepare.
+func (m *OnlineMigration) Cleanup(ctx context.Context, sqlDB *sql.DB, lockTimeout time.Duration) error {
+	tx, err := sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := setLockTimeout(ctx, tx, lockTimeout); err != nil {
+		return err
+	}
+	if err := m.dropShadowObjects(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// IsLockNotAvailable returns true if the error is caused by the lock_timeout.
+func IsLockNotAvailable(err error) bool {
+	var sqlStateErr interface{ SQLState() string }
+	return errors.As(err, &sqlStateErr) && sqlStateErr.SQLState() == lockNotAvailableSQLState
+}
+
+// checkShadowTable returns whether the shadow table exists.
+// It returns an error if the table with the shadow table name is not created by Prepare.
+func (m *OnlineMigration) checkShadowTable(ctx context.Context, tx *sql.Tx) (bool, error) {
+	var exists bool
+	var comment sql.NullString
+	if err := tx.QueryRowContext(ctx,
+		"SELECT pg_catalog.to_regclass($1) IS NOT NULL, pg_catalog.obj_description(pg_catalog.to_regclass($1), 'pg_class')",
+		m.quotedShadowTable(),
+	).Scan(&exists, &comment); err != nil {
+		return false, errors.Wrapf(err, "failed to check the shadow table of %q", m.Table)
+	}
+	if exists && comment.String != shadowTableComment {
+		return false, errors.Errorf("table %q is not a shadow table created by the online migration, rename or drop it before the online migration", m.shadowTable())
+	}
+	return exists, nil
+}
+
+func (m *OnlineMigration) dropShadowObjects(ctx context.Context, tx *sql.Tx) error {
+	if _, err := m.checkShadowTable(ctx, tx); err != nil {
+		return err
+	}
+	for _, trigger := range []string{m.syncTrigger(), m.truncateTrigger()} {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP TRIGGER IF EXISTS %s ON %s", pgx.Identifier{trigger}.Sanitize(), m.quotedTable())); err != nil {
+			return errors.Wrapf(err, "failed to drop trigger %q", trigger)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP FUNCTION IF EXISTS %s()", m.quotedSyncFunction())); err != nil {
+		return errors.Wrapf(err, "failed to drop function %q", m.syncFunction())
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP TABLE IF EXISTS %s", m.quotedShadowTable())); err != nil {
+		return errors.Wrapf(err, "failed to drop table %q", m.shadowTable())
+	}
+	return nil
+}
+
+func (m *OnlineMigration) dropSyncTriggers(ctx context.Context, tx *sql.Tx) error {
+	for _, trigger := range []string{m.syncTrigger(), m.truncateTrigger()} {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP TRIGGER %s ON %s", pgx.Identifier{trigger}.Sanitize(), m.quotedTable())); err != nil {
+			return errors.Wrapf(err, "failed to drop trigger %q", trigger)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP FUNCTION %s()", m.quotedSyncFunction())); err != nil {
+		return errors.Wrapf(err, "failed to drop function %q", m.syncFunction())
+	}
+	return nil
+}
+
+// getSyncFunctionBody returns the trigger function body replaying the row changes onto the shadow table.
+// The shadow table has the change applied, so that the writes violating the new constraints or column types fail, see RestrictsWrites.
+func (m *OnlineMigration) getSyncFunctionBody() string {
+	var oldConditions, newConditions, newValues []string
+	for _, column := range m.PrimaryKey {
+		quoted := pgx.Identifier{column}.Sanitize()
+		oldConditions = append(oldConditions, fmt.Sprintf("%s = OLD.%s", quoted, quoted))
+		newConditions = append(newConditions, fmt.Sprintf("%s = NEW.%s", quoted, quoted))
+	}
+	for _, column := range m.Columns {
+		newValues = append(newValues, fmt.Sprintf("NEW.%s", pgx.Identifier{column}.Sanitize()))
+	}
+	shadowTable := m.quotedShadowTable()
+	return fmt.Sprintf(`
+BEGIN
+	IF TG_OP = 'TRUNCATE' THEN
+		TRUNCATE %s;
+		RETURN NULL;
+	END IF;
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		DELETE FROM %s WHERE %s;
+	END IF;
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		DELETE FROM %s WHERE %s;
+		INSERT INTO %s (%s) VALUES (%s);
+	END IF;
+	RETURN NULL;
+END
+`,
+		shadowTable,
+		shadowTable, strings.Join(oldConditions, " AND "),
+		shadowTable, strings.Join(newConditions, " AND "),
+		shadowTable, quoteIdentifiers(m.Columns), strings.Join(newValues, ", "),
+	)
+}
+
+func (m *OnlineMigration) shadowTable() string {
+	return fmt.Sprintf("_%s_shadow", m.Table)
+}
+
+// GetOldTable returns the name of the old table, which the original table is renamed to at the cutover.
+func (m *OnlineMigration) GetOldTable() string {
+	return pgx.Identifier{m.Schema, m.oldTable()}.Sanitize()
+}
+
+func (m *OnlineMigration) oldTable() string {
+	return fmt.Sprintf("_%s_old", m.Table)
+}
+
+func (m *OnlineMigration) syncFunction() string {
+	return fmt.Sprintf("_%s_shadow_sync", m.Table)
+}
+
+func (m *OnlineMigration) syncTrigger() string {
+	return fmt.Sprintf("_%s_shadow_sync", m.Table)
+}
+
+func (m *OnlineMigration) truncateTrigger() string {
+	return fmt.Sprintf("_%s_shadow_truncate", m.Table)
+}
+
+func (m *OnlineMigration) quotedTable() string {
+	return pgx.Identifier{m.Schema, m.Table}.Sanitize()
+}
+
+func (m *OnlineMigration) quotedShadowTable() string {
+	return pgx.Identifier{m.Schema, m.shadowTable()}.Sanitize()
+}
+
+func (m *OnlineMigration) quotedSyncFunction() string {
+	return pgx.Identifier{m.Schema, m.syncFunction()}.Sanitize()
+}
+
+// getIndexRenames returns the pairs of the shadow index name and its new name.
+// The shadow index takes the name of the original index with the same definition.
+// Otherwise, the name generated from the shadow table name is changed to the one generated from the table name.
+func getIndexRenames(indexes, shadowIndexes []*onlineMigrationIndex, table, shadowTable string) [][2]string {
+	used := make(map[string]bool)
+	var renames [][2]string
+	for _, shadowIndex := range shadowIndexes {
+		name := shadowIndex.name
+		for _, index := range indexes {
+			if !used[index.name] && index.primary == shadowIndex.primary && index.unique == shadowIndex.unique && index.definition == shadowIndex.definition {
+				used[index.name] = true
+				name = index.name
+				break
+			}
+		}
+		if name == shadowIndex.name && strings.HasPrefix(name, shadowTable) {
+			name = table + strings.TrimPrefix(name, shadowTable)
+		}
+		if name != shadowIndex.name {
+			renames = append(renames, [2]string{shadowIndex.name, name})
+		}
+	}
+	return renames
+}
+
+func getOnlineMigrationIndexes(ctx context.Context, tx *sql.Tx, table string) ([]*onlineMigrationIndex, error) {
+	rows, err := tx.QueryContext(ctx, `
+	SELECT c.relname, i.indisprimary, i.indisunique, pg_catalog.regexp_replace(pg_catalog.pg_get_indexdef(i.indexrelid), '^.*? USING ', '')
+	FROM pg_catalog.pg_index i JOIN pg_catalog.pg_class c ON c.oid = i.indexrelid
+	WHERE i.indrelid = pg_catalog.to_regclass($1)
+	ORDER BY c.relname`, table)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the indexes of %s", table)
+	}
+	defer rows.Close()
+	var indexes []*onlineMigrationIndex
+	for rows.Next() {
+		index := &onlineMigrationIndex{}
+		if err := rows.Scan(&index.name, &index.primary, &index.unique, &index.definition); err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return indexes, nil
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func getOnlineMigrationPrimaryKey(ctx context.Context, q queryer, schema, table string) ([]string, error) {
+	primaryKey, err := queryStatements(ctx, q, `
+	SELECT kcu.column_name
+	FROM information_schema.table_constraints tc
+	JOIN information_schema.key_column_usage kcu
+		ON kcu.constraint_schema = tc.constraint_schema AND kcu.constraint_name = tc.constraint_name AND kcu.table_name = tc.table_name
+	WHERE tc.table_schema = $1 AND tc.table_name = $2 AND tc.constraint_type = 'PRIMARY KEY'
+	ORDER BY kcu.ordinal_position`, schema, table)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the primary key of %q", table)
+	}
+	return primaryKey, nil
+}
+
+// queryStatements returns the single text column of the query rows.
+func queryStatements(ctx context.Context, q queryer, query string, args ...any) ([]string, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var results []string
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func setLockTimeout(ctx context.Context, tx *sql.Tx, lockTimeout time.Duration) error {
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL lock_timeout = %d", lockTimeout.Milliseconds())); err != nil {
+		return errors.Wrap(err, "failed to set lock_timeout")
+	}
+	return nil
+}
+
+func quoteIdentifiers(identifiers []string) string {
+	var quoted []string
+	for _, identifier := range identifiers {
+		quoted = append(quoted, pgx.Identifier{identifier}.Sanitize())
+	}
+	return strings.Join(quoted, ", ")
+}
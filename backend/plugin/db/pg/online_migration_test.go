@@ -0,0 +1,78 @@
+package pg
+
+import (
+	"fmt"
+	"testing"
+
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOnlineMigration(t *testing.T) {
+	a := require.New(t)
+	m, err := GetOnlineMigration(`ALTER TABLE t ADD COLUMN c int NOT NULL DEFAULT 0; ALTER TABLE public.t ALTER COLUMN b TYPE bigint;`)
+	a.NoError(err)
+	a.Equal("public", m.Schema)
+	a.Equal("t", m.Table)
+	a.Equal([]string{
+		"ALTER TABLE public._t_shadow ADD COLUMN c int NOT NULL DEFAULT 0",
+		"ALTER TABLE public._t_shadow ALTER COLUMN b TYPE bigint",
+	}, m.shadowStatements)
+	a.True(m.RestrictsWrites)
+
+	m.PrimaryKey, m.Columns = []string{"id"}, []string{"id", "b"}
+	chunk, err := m.GetChunkSQL([]string{"id"}, true, true)
+	a.NoError(err)
+	a.Equal(`INSERT INTO "public"."_t_shadow" ("id", "b") SELECT "id", "b" FROM "public"."t" WHERE "id" > $1 AND "id" <= $2 FOR SHARE ON CONFLICT ("id") DO NOTHING`, chunk)
+	_, err = pgquery.Parse(chunk)
+	a.NoError(err)
+	a.Equal(`SELECT "id"::text FROM "public"."t" ORDER BY "id" LIMIT 100`, m.GetChunkKeysSQL([]string{"id"}, false, 100))
+
+	m.PrimaryKey = []string{"id", "b"}
+	_, err = pgquery.ParsePlPgSqlToJSON(fmt.Sprintf("CREATE FUNCTION %s() RETURNS trigger LANGUAGE plpgsql AS $bb$%s$bb$", m.quotedSyncFunction(), m.getSyncFunctionBody()))
+	a.NoError(err)
+
+	for statement, want := range map[string]bool{
+		"ALTER TABLE t ADD COLUMN c int DEFAULT 0, DROP COLUMN d":   false,
+		"ALTER TABLE t ALTER COLUMN c SET NOT NULL":                 true,
+		"ALTER TABLE t ADD CONSTRAINT c_check CHECK (c > 0)":        true,
+		"ALTER TABLE t ADD COLUMN c int UNIQUE":                     true,
+		"ALTER TABLE t ALTER COLUMN c TYPE varchar(10)":             true,
+		"ALTER TABLE t ALTER COLUMN c DROP NOT NULL, DROP COLUMN d": false,
+	} {
+		m, err := GetOnlineMigration(statement)
+		a.NoError(err, statement)
+		a.Equal(want, m.RestrictsWrites, statement)
+	}
+
+	for _, statement := range []string{
+		"ALTER TABLE t ADD COLUMN c int; ALTER TABLE t2 ADD COLUMN c int;",
+		"ALTER TABLE t RENAME COLUMN a TO b",
+		"CREATE INDEX ON t (a)",
+		"ALTER INDEX i SET TABLESPACE s",
+		"",
+	} {
+		_, err := GetOnlineMigration(statement)
+		a.Error(err, statement)
+	}
+}
+
+func TestGetIndexRenames(t *testing.T) {
+	a := require.New(t)
+	indexes := []*onlineMigrationIndex{
+		{name: "t_pkey", primary: true, unique: true, definition: "btree (id)"},
+		{name: "idx_email", unique: true, definition: "btree (lower(email))"},
+		{name: "t_name_idx", definition: "btree (name)"},
+	}
+	shadowIndexes := []*onlineMigrationIndex{
+		{name: "_t_shadow_email_idx", unique: true, definition: "btree (lower(email))"},
+		{name: "_t_shadow_name_age_idx", definition: "btree (name, age)"},
+		{name: "_t_shadow_pkey", primary: true, unique: true, definition: "btree (id)"},
+		{name: "idx_age", definition: "btree (age)"},
+	}
+	a.Equal([][2]string{
+		{"_t_shadow_email_idx", "idx_email"},
+		{"_t_shadow_name_age_idx", "t_name_age_idx"},
+		{"_t_shadow_pkey", "t_pkey"},
+	}, getIndexRenames(indexes, shadowIndexes, "t", "_t_shadow"))
+}
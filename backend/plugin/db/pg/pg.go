@@ -38,7 +38,7 @@ var (
 )
 
 func init() {
-	db.Register(db.Postgres, newDriver)
+	db.Register(db.Postgres, newDriver, db.CapabilitySchemaDump, db.CapabilityDump, db.CapabilityRestore, db.CapabilityRole, db.CapabilitySlowQuery)
 }
 
 // Driver is the Postgres driver.
@@ -495,6 +495,30 @@ func (*Driver) querySingleSQL(ctx context.Context, conn *sql.Conn, singleSQL par
 	return result, nil
 }
 
+// QueryConnStream queries a single SQL statement in a given connection and streams the result to w.
+func (*Driver) QueryConnStream(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext, w db.QueryResultWriter) error {
+	singleSQLs, err := parser.SplitMultiSQL(parser.Postgres, statement)
+	if err != nil {
+		return err
+	}
+	var nonEmptySQLs []parser.SingleSQL
+	for _, singleSQL := range singleSQLs {
+		if !singleSQL.Empty {
+			nonEmptySQLs = append(nonEmptySQLs, singleSQL)
+		}
+	}
+	if len(nonEmptySQLs) != 1 {
+		return errors.Errorf("expecting 1 statement, but got %d", len(nonEmptySQLs))
+	}
+
+	stmt := strings.TrimRight(nonEmptySQLs[0].Text, " \n\t;")
+	if !strings.HasPrefix(stmt, "EXPLAIN") && queryContext.Limit > 0 {
+		stmt = getStatementWithResultLimit(stmt, queryContext.Limit)
+	}
+
+	return util.QueryStream(ctx, db.Postgres, conn, stmt, queryContext, w)
+}
+
 // RunStatement runs a SQL statement in a given connection.
 func (*Driver) RunStatement(ctx context.Context, conn *sql.Conn, statement string) ([]*v1pb.QueryResult, error) {
 	return util.RunStatement(ctx, parser.Postgres, conn, statement)
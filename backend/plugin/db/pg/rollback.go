@@ -0,0 +1,192 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v4"
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+)
+
+const (
+	// BackupSchemaName is the schema storing the rows changed by the data change tasks for rolling back.
+	BackupSchemaName = "bbdataarchive"
+	// maxIdentifierLength is the max length in bytes of PostgreSQL identifiers.
+	maxIdentifierLength = 63
+)
+
+// DataBackup is the backup of the rows changed by an UPDATE or DELETE statement.
+type DataBackup struct {
+	// Schema and Table are the table changed by the statement.
+	Schema string
+	Table  string
+	// BackupTable is the table in the backup schema storing the rows before the change.
+	BackupTable string
+	// IsDelete is true for the DELETE statement, and false for the UPDATE statement.
+	IsDelete bool
+	// Statement is the statement creating the backup table.
+	Statement string
+
+	// query selects the rows to back up.
+	query string
+}
+
+// GetDataBackups returns the backup for the statement, which is backed up before executing the whole statement.
+// Only a single UPDATE or DELETE statement is supported, because the rows changed by a later statement may be
+// changed by the earlier statements after the backup. The backup tables are named with the prefix and the index of the backup.
+func GetDataBackups(statement string, prefix string) ([]*DataBackup, error) {
+	tree, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	if len(tree.Stmts) > 1 {
+		return nil, errors.Errorf("only a single UPDATE or DELETE statement is supported, but there are %d statements", len(tree.Stmts))
+	}
+	var backups []*DataBackup
+	for _, stmt := range tree.Stmts {
+		var relation *pgquery.RangeVar
+		var whereClause *pgquery.Node
+		isDelete := false
+		switch node := stmt.Stmt.Node.(type) {
+		case *pgquery.Node_UpdateStmt:
+			if node.UpdateStmt.WithClause != nil || len(node.UpdateStmt.FromClause) > 0 {
+				return nil, errors.Errorf("UPDATE statement with WITH or FROM clause is not supported on table %q", node.UpdateStmt.Relation.Relname)
+			}
+			relation, whereClause = node.UpdateStmt.Relation, node.UpdateStmt.WhereClause
+		case *pgquery.Node_DeleteStmt:
+			if node.DeleteStmt.WithClause != nil || len(node.DeleteStmt.UsingClause) > 0 {
+				return nil, errors.Errorf("DELETE statement with WITH or USING clause is not supported on table %q", node.DeleteStmt.Relation.Relname)
+			}
+			relation, whereClause, isDelete = node.DeleteStmt.Relation, node.DeleteStmt.WhereClause, true
+		default:
+			return nil, errors.Errorf("only UPDATE and DELETE statements are supported, but got %q", getStatementText(statement, stmt))
+		}
+
+		schema := relation.Schemaname
+		if schema == "" {
+			schema = "public"
+		}
+		backupTable := truncateIdentifier(fmt.Sprintf("%s_%d_%s", prefix, len(backups), relation.Relname))
+		selectStmt, err := pgquery.Deparse(&pgquery.ParseResult{
+			Stmts: []*pgquery.RawStmt{{
+				Stmt: &pgquery.Node{Node: &pgquery.Node_SelectStmt{SelectStmt: &pgquery.SelectStmt{
+					TargetList:  []*pgquery.Node{pgquery.MakeResTargetNodeWithVal(pgquery.MakeColumnRefNode([]*pgquery.Node{pgquery.MakeAStarNode()}, 0), 0)},
+					FromClause:  []*pgquery.Node{{Node: &pgquery.Node_RangeVar{RangeVar: relation}}},
+					WhereClause: whereClause,
+				}}},
+			}},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to deparse the backup statement for table %q", relation.Relname)
+		}
+		backupTableName := pgx.Identifier{BackupSchemaName, backupTable}.Sanitize()
+		backups = append(backups, &DataBackup{
+			Schema:      schema,
+			Table:       relation.Relname,
+			BackupTable: backupTable,
+			IsDelete:    isDelete,
+			Statement:   fmt.Sprintf("DROP TABLE IF EXISTS %s;\nCREATE TABLE %s AS %s;", backupTableName, backupTableName, selectStmt),
+			query:       selectStmt,
+		})
+	}
+	return backups, nil
+}
+
+// GetCountSQL returns the query counting the rows to back up.
+func (b *DataBackup) GetCountSQL() string {
+	return fmt.Sprintf("SELECT COUNT(*) FROM (%s) AS rows", b.query)
+}
+
+// GetInsertableColumns returns the columns of the table that can be inserted in the order of the table definition.
+// The generated columns are excluded as they cannot be inserted.
+func GetInsertableColumns(ctx context.Context, db *sql.DB, schemaName, tableName string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_schema = $1 AND table_name = $2 AND is_generated = 'NEVER'
+		ORDER BY ordinal_position`, schemaName, tableName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query columns of table %q.%q", schemaName, tableName)
+	}
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, errors.Errorf("table %q.%q not found", schemaName, tableName)
+	}
+	return columns, nil
+}
+
+// GetRollbackSQL returns the statements restoring the backed up rows.
+// The rows are matched by the primary key, so the UPDATE statement changing the primary key cannot be rolled back.
+// The table without primary key only supports rolling back the DELETE statement.
+// Only the insertable columns are restored, and the identity columns keep the backed up values.
+func (b *DataBackup) GetRollbackSQL(primaryKey, columns []string) (string, error) {
+	table := pgx.Identifier{b.Schema, b.Table}.Sanitize()
+	backupTable := pgx.Identifier{BackupSchemaName, b.BackupTable}.Sanitize()
+	var buf strings.Builder
+	if len(primaryKey) > 0 {
+		var conditions []string
+		for _, column := range primaryKey {
+			conditions = append(conditions, fmt.Sprintf("t.%s = b.%s", pgx.Identifier{column}.Sanitize(), pgx.Identifier{column}.Sanitize()))
+		}
+		_, _ = fmt.Fprintf(&buf, "DELETE FROM %s AS t USING %s AS b WHERE %s;\n", table, backupTable, strings.Join(conditions, " AND "))
+	} else if !b.IsDelete {
+		return "", errors.Errorf("rolling back UPDATE statement requires a primary key on table %q.%q", b.Schema, b.Table)
+	}
+	if len(columns) == 0 {
+		return "", errors.Errorf("no column to restore on table %q.%q", b.Schema, b.Table)
+	}
+	var quotedColumns []string
+	for _, column := range columns {
+		quotedColumns = append(quotedColumns, pgx.Identifier{column}.Sanitize())
+	}
+	columnList := strings.Join(quotedColumns, ", ")
+	_, _ = fmt.Fprintf(&buf, "INSERT INTO %s (%s) OVERRIDING SYSTEM VALUE SELECT %s FROM %s;\n", table, columnList, columnList, backupTable)
+	return buf.String(), nil
+}
+
+// getStatementText returns the text of the statement in the parse tree.
+func getStatementText(statement string, stmt *pgquery.RawStmt) string {
+	start := int(stmt.StmtLocation)
+	end := len(statement)
+	if stmt.StmtLen > 0 {
+		end = start + int(stmt.StmtLen)
+	}
+	if start < 0 || start > end || end > len(statement) {
+		return ""
+	}
+	text, _ := common.TruncateString(strings.TrimSpace(statement[start:end]), 100)
+	return text
+}
+
+// GetDropSQL returns the statement dropping the backup table.
+func (b *DataBackup) GetDropSQL() string {
+	return fmt.Sprintf("DROP TABLE IF EXISTS %s;", pgx.Identifier{BackupSchemaName, b.BackupTable}.Sanitize())
+}
+
+func truncateIdentifier(name string) string {
+	if len(name) <= maxIdentifierLength {
+		return name
+	}
+	name = name[:maxIdentifierLength]
+	for !utf8.ValidString(name) {
+		name = name[:len(name)-1]
+	}
+	return name
+}
@@ -0,0 +1,67 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDataBackups(t *testing.T) {
+	a := require.New(t)
+	backups, err := GetDataBackups("UPDATE t SET b = 1 WHERE a > 1;", "task1")
+	a.NoError(err)
+	a.Equal([]*DataBackup{
+		{
+			Schema:      "public",
+			Table:       "t",
+			BackupTable: "task1_0_t",
+			Statement:   "DROP TABLE IF EXISTS \"bbdataarchive\".\"task1_0_t\";\nCREATE TABLE \"bbdataarchive\".\"task1_0_t\" AS SELECT * FROM t WHERE a > 1;",
+			query:       "SELECT * FROM t WHERE a > 1",
+		},
+	}, backups)
+	a.Equal("SELECT COUNT(*) FROM (SELECT * FROM t WHERE a > 1) AS rows", backups[0].GetCountSQL())
+
+	backups, err = GetDataBackups(`DELETE FROM "S"."T" AS x WHERE x.a = 'v';`, "task1")
+	a.NoError(err)
+	a.Equal([]*DataBackup{
+		{
+			Schema:      "S",
+			Table:       "T",
+			BackupTable: "task1_0_T",
+			IsDelete:    true,
+			Statement:   "DROP TABLE IF EXISTS \"bbdataarchive\".\"task1_0_T\";\nCREATE TABLE \"bbdataarchive\".\"task1_0_T\" AS SELECT * FROM \"S\".\"T\" x WHERE x.a = 'v';",
+			query:       `SELECT * FROM "S"."T" x WHERE x.a = 'v'`,
+		},
+	}, backups)
+
+	for _, statement := range []string{
+		"UPDATE t SET b = 1 FROM s WHERE t.a = s.a;",
+		"DELETE FROM t USING s WHERE t.a = s.a;",
+		"WITH s AS (SELECT 1) DELETE FROM t;",
+		"INSERT INTO t(a) VALUES (1);",
+		"UPDATE t SET b = 1 WHERE a > 1;\nDELETE FROM t WHERE b = 1;",
+		"INSERT INTO t(a) VALUES (1);\nUPDATE t SET b = 1 WHERE a > 1;",
+	} {
+		_, err := GetDataBackups(statement, "task1")
+		a.Error(err, statement)
+	}
+}
+
+func TestDataBackupGetRollbackSQL(t *testing.T) {
+	a := require.New(t)
+	update := &DataBackup{Schema: "public", Table: "t", BackupTable: "task1_0_t"}
+	rollbackSQL, err := update.GetRollbackSQL([]string{"a", "b"}, []string{"a", "b", "c"})
+	a.NoError(err)
+	a.Equal(`DELETE FROM "public"."t" AS t USING "bbdataarchive"."task1_0_t" AS b WHERE t."a" = b."a" AND t."b" = b."b";
+INSERT INTO "public"."t" ("a", "b", "c") OVERRIDING SYSTEM VALUE SELECT "a", "b", "c" FROM "bbdataarchive"."task1_0_t";
+`, rollbackSQL)
+	_, err = update.GetRollbackSQL(nil, []string{"a", "b", "c"})
+	a.Error(err)
+	_, err = update.GetRollbackSQL([]string{"a", "b"}, nil)
+	a.Error(err)
+
+	deleteBackup := &DataBackup{Schema: "public", Table: "t", BackupTable: "task1_1_t", IsDelete: true}
+	rollbackSQL, err = deleteBackup.GetRollbackSQL(nil, []string{"a"})
+	a.NoError(err)
+	a.Equal("INSERT INTO \"public\".\"t\" (\"a\") OVERRIDING SYSTEM VALUE SELECT \"a\" FROM \"bbdataarchive\".\"task1_1_t\";\n", rollbackSQL)
+}
@@ -18,6 +18,7 @@ import (
 	"golang.org/x/crypto/ssh"
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
@@ -175,15 +176,15 @@ func (d *Driver) Execute(ctx context.Context, statement string, createDatabase b
 // Dump the database, if dbName is empty, then dump all databases.
 // Redis is schemaless, we don't support dump Redis data currently.
 func (*Driver) Dump(_ context.Context, _ io.Writer, schemaOnly bool) (string, error) {
-	if !schemaOnly {
-		return "", errors.New("redis: not supported")
+	if schemaOnly {
+		return "", common.Errorf(common.NotImplemented, "schema dump is not supported for %s", db.Redis)
 	}
-	return "", nil
+	return "", common.Errorf(common.NotImplemented, "dump is not supported for %s", db.Redis)
 }
 
 // Restore the database from src, which is a full backup.
 func (*Driver) Restore(context.Context, io.Reader) error {
-	return errors.New("redis: not supported")
+	return common.Errorf(common.NotImplemented, "restore is not supported for %s", db.Redis)
 }
 
 // QueryConn queries a SQL statement in a given connection.
@@ -5,15 +5,20 @@ import (
 	"context"
 	"io"
 
-	"github.com/pkg/errors"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 )
 
 // Dump dumps the database to the writer. But not implemented yet.
-func (*Driver) Dump(context.Context, io.Writer, bool) (string, error) {
-	return "", nil
+// Both schema-only dump and dump with data fail.
+func (*Driver) Dump(_ context.Context, _ io.Writer, schemaOnly bool) (string, error) {
+	if schemaOnly {
+		return "", common.Errorf(common.NotImplemented, "schema dump is not supported for %s", db.Redshift)
+	}
+	return "", common.Errorf(common.NotImplemented, "dump is not supported for %s", db.Redshift)
 }
 
 // Restore the database from src, which is a full backup.
 func (*Driver) Restore(context.Context, io.Reader) error {
-	return errors.Errorf("not implemented")
+	return common.Errorf(common.NotImplemented, "restore is not supported for %s", db.Redshift)
 }
@@ -3,16 +3,22 @@ package risingwave
 import (
 	"context"
 	"io"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 )
 
 // Dump dumps the database.
 // TODO: RisingWave doesn't support pg_dump yet.
-func (*Driver) Dump(_ context.Context, _ io.Writer, _ bool) (string, error) {
-	return "", nil
+func (*Driver) Dump(_ context.Context, _ io.Writer, schemaOnly bool) (string, error) {
+	if schemaOnly {
+		return "", common.Errorf(common.NotImplemented, "schema dump is not supported for %s", db.RisingWave)
+	}
+	return "", common.Errorf(common.NotImplemented, "dump is not supported for %s", db.RisingWave)
 }
 
 // Restore restores a database.
 // TODO: RisingWave doesn't support pg_dump yet.
 func (*Driver) Restore(_ context.Context, _ io.Reader) error {
-	return nil
+	return common.Errorf(common.NotImplemented, "restore is not supported for %s", db.RisingWave)
 }
@@ -37,7 +37,7 @@ var (
 )
 
 func init() {
-	db.Register(db.RisingWave, newDriver)
+	db.Register(db.RisingWave, newDriver, db.CapabilityRole)
 }
 
 // Driver is the Postgres driver.
@@ -29,7 +29,7 @@ var (
 )
 
 func init() {
-	db.Register(db.Snowflake, newDriver)
+	db.Register(db.Snowflake, newDriver, db.CapabilitySchemaDump, db.CapabilityRestore)
 }
 
 // Driver is the Snowflake driver.
@@ -7,12 +7,15 @@ import (
 
 	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
 	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 )
 
 // Dump dumps the database.
 func (d *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
 	if !schemaOnly {
-		return "", errors.New("Dump can only dump schemas")
+		return "", common.Errorf(common.NotImplemented, "dump is not supported for %s", db.Spanner)
 	}
 	instance, err := d.SyncInstance(ctx)
 	if err != nil {
@@ -55,5 +58,5 @@ func (d *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (stri
 
 // Restore restores a database.
 func (*Driver) Restore(_ context.Context, _ io.Reader) error {
-	panic("not implemented")
+	return common.Errorf(common.NotImplemented, "restore is not supported for %s", db.Spanner)
 }
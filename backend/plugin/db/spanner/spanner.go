@@ -35,7 +35,7 @@ var (
 )
 
 func init() {
-	db.Register(db.Spanner, newDriver)
+	db.Register(db.Spanner, newDriver, db.CapabilitySchemaDump)
 }
 
 // Driver is the Spanner driver.
@@ -29,7 +29,7 @@ var (
 )
 
 func init() {
-	db.Register(db.SQLite, newDriver)
+	db.Register(db.SQLite, newDriver, db.CapabilitySchemaDump, db.CapabilityDump, db.CapabilityRestore)
 }
 
 // Driver is the SQLite driver.
@@ -21,6 +21,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -121,21 +122,45 @@ func ApplyMultiStatements(sc io.Reader, f func(string) error) error {
 
 // Query will execute a readonly / SELECT query.
 func Query(ctx context.Context, dbType db.Type, conn *sql.Conn, statement string, queryContext *db.QueryContext) (*v1pb.QueryResult, error) {
+	w := &queryResultBuffer{}
+	if err := QueryStream(ctx, dbType, conn, statement, queryContext, w); err != nil {
+		return nil, err
+	}
+	return w.result, nil
+}
+
+// queryResultBuffer collects a streamed query result in memory.
+type queryResultBuffer struct {
+	result *v1pb.QueryResult
+}
+
+func (b *queryResultBuffer) WriteHeader(result *v1pb.QueryResult) error {
+	b.result = result
+	return nil
+}
+
+func (b *queryResultBuffer) WriteRow(row *v1pb.QueryRow) error {
+	b.result.Rows = append(b.result.Rows, row)
+	return nil
+}
+
+// QueryStream will execute a readonly / SELECT query and write the masked rows to w as they are read from the database.
+func QueryStream(ctx context.Context, dbType db.Type, conn *sql.Conn, statement string, queryContext *db.QueryContext, w db.QueryResultWriter) error {
 	tx, err := conn.BeginTx(ctx, &sql.TxOptions{ReadOnly: queryContext.ReadOnly})
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer tx.Rollback()
 
 	rows, err := tx.QueryContext(ctx, statement)
 	if err != nil {
-		return nil, FormatErrorWithQuery(err, statement)
+		return FormatErrorWithQuery(err, statement)
 	}
 	defer rows.Close()
 
 	columnNames, err := rows.Columns()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// TODO(d): use a Redshift extraction for shared database.
@@ -144,10 +169,10 @@ func Query(ctx context.Context, dbType db.Type, conn *sql.Conn, statement string
 	}
 	fieldList, err := extractSensitiveField(dbType, statement, queryContext.CurrentDatabase, queryContext.SensitiveSchemaInfo)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to extract sensitive fields: %q", statement)
+		return errors.Wrapf(err, "failed to extract sensitive fields: %q", statement)
 	}
 	if len(fieldList) != 0 && len(fieldList) != len(columnNames) {
-		return nil, errors.Errorf("failed to extract sensitive fields: %q", statement)
+		return errors.Errorf("failed to extract sensitive fields: %q", statement)
 	}
 
 	var fieldMaskingLevels []storepb.MaskingLevel
@@ -163,10 +188,11 @@ func Query(ctx context.Context, dbType db.Type, conn *sql.Conn, statement string
 		fieldMaskInfo = append(fieldMaskInfo, sensitive && queryContext.EnableSensitive)
 		fieldSensitiveInfo = append(fieldSensitiveInfo, sensitive)
 	}
+	fieldMaskers := getFieldMaskers(fieldList, fieldMaskingLevels, queryContext.SensitiveSchemaInfo)
 
 	columnTypes, err := rows.ColumnTypes()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	var columnTypeNames []string
@@ -176,22 +202,31 @@ func Query(ctx context.Context, dbType db.Type, conn *sql.Conn, statement string
 		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
 	}
 
-	data, err := readRows(rows, columnTypeNames, fieldMaskingLevels)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return &v1pb.QueryResult{
+	if err := w.WriteHeader(&v1pb.QueryResult{
 		ColumnNames:     columnNames,
 		ColumnTypeNames: columnTypeNames,
-		Rows:            data,
 		Masked:          fieldMaskInfo,
 		Sensitive:       fieldSensitiveInfo,
-	}, nil
+	}); err != nil {
+		return err
+	}
+
+	if len(columnTypeNames) == 0 {
+		// No rows.
+		// The oracle driver will panic if there is no rows such as EXPLAIN PLAN FOR statement.
+		return nil
+	}
+	for rows.Next() {
+		row, err := readRow(rows, columnTypeNames, fieldMaskers)
+		if err != nil {
+			return err
+		}
+		if err := w.WriteRow(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
 }
 
 // RunStatement runs a SQL statement in a given connection.
@@ -303,7 +338,7 @@ func rowsToQueryResult(rows *sql.Rows) (*v1pb.QueryResult, error) {
 	}, nil
 }
 
-func readRows(rows *sql.Rows, columnTypeNames []string, fieldMaskingLevels []storepb.MaskingLevel) ([]*v1pb.QueryRow, error) {
+func readRows(rows *sql.Rows, columnTypeNames []string, fieldMaskers []masker.Masker) ([]*v1pb.QueryRow, error) {
 	var data []*v1pb.QueryRow
 	if len(columnTypeNames) == 0 {
 		// No rows.
@@ -311,132 +346,74 @@ func readRows(rows *sql.Rows, columnTypeNames []string, fieldMaskingLevels []sto
 		return data, nil
 	}
 	for rows.Next() {
-		// wantBytesValue want to convert StringValue to BytesValue when columnTypeName is BIT or VARBIT
-		wantBytesValue := make([]bool, len(columnTypeNames))
-		scanArgs := make([]any, len(columnTypeNames))
-		for i, v := range columnTypeNames {
-			// TODO(steven need help): Consult a common list of data types from database driver documentation. e.g. MySQL,PostgreSQL.
-			switch v {
-			case "VARCHAR", "TEXT", "UUID", "TIMESTAMP":
-				scanArgs[i] = new(sql.NullString)
-			case "BOOL":
-				scanArgs[i] = new(sql.NullBool)
-			case "INT", "INTEGER":
-				scanArgs[i] = new(sql.NullInt64)
-			case "FLOAT":
-				scanArgs[i] = new(sql.NullFloat64)
-			case "BIT", "VARBIT":
-				wantBytesValue[i] = true
-				scanArgs[i] = new(sql.NullString)
-			default:
-				scanArgs[i] = new(sql.NullString)
-			}
-		}
-
-		if err := rows.Scan(scanArgs...); err != nil {
+		row, err := readRow(rows, columnTypeNames, fieldMaskers)
+		if err != nil {
 			return nil, err
 		}
-
-		var rowData v1pb.QueryRow
-		for i := range columnTypeNames {
-			if len(fieldMaskingLevels) > i && fieldMaskingLevels[i] == storepb.MaskingLevel_FULL {
-				rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}})
-				continue
-			}
-			if v, ok := (scanArgs[i]).(*sql.NullBool); ok && v.Valid {
-				if len(fieldMaskingLevels) > i && fieldMaskingLevels[i] == storepb.MaskingLevel_PARTIAL {
-					s := fmt.Sprintf("%t", v.Bool)
-					result := getMiddlePartOfString(s)
-					rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: paddingAsterisk(result)}})
-				} else {
-					rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: v.Bool}})
-				}
-				continue
-			}
-			if v, ok := (scanArgs[i]).(*sql.NullString); ok && v.Valid {
-				if wantBytesValue[i] {
-					if len(fieldMaskingLevels) > i && fieldMaskingLevels[i] == storepb.MaskingLevel_PARTIAL {
-						result := getMiddlePartOfString(v.String)
-						rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: paddingAsterisk(result)}})
-					} else {
-						rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte(v.String)}})
-					}
-				} else {
-					if len(fieldMaskingLevels) > i && fieldMaskingLevels[i] == storepb.MaskingLevel_PARTIAL {
-						result := getMiddlePartOfString(v.String)
-						rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: paddingAsterisk(result)}})
-					} else {
-						rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v.String}})
-					}
-				}
-				continue
-			}
-			if v, ok := (scanArgs[i]).(*sql.NullInt64); ok && v.Valid {
-				if len(fieldMaskingLevels) > i && fieldMaskingLevels[i] == storepb.MaskingLevel_PARTIAL {
-					s := strconv.FormatInt(v.Int64, 10)
-					result := getMiddlePartOfString(s)
-					rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: paddingAsterisk(result)}})
-				} else {
-					rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v.Int64}})
-				}
-				continue
-			}
-			if v, ok := (scanArgs[i]).(*sql.NullInt32); ok && v.Valid {
-				if len(fieldMaskingLevels) > i && fieldMaskingLevels[i] == storepb.MaskingLevel_PARTIAL {
-					s := strconv.FormatInt(int64(v.Int32), 10)
-					result := getMiddlePartOfString(s)
-					rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: paddingAsterisk(result)}})
-				} else {
-					rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: v.Int32}})
-				}
-				continue
-			}
-			if v, ok := (scanArgs[i]).(*sql.NullFloat64); ok && v.Valid {
-				if len(fieldMaskingLevels) > i && fieldMaskingLevels[i] == storepb.MaskingLevel_PARTIAL {
-					s := strconv.FormatFloat(v.Float64, 'f', -1, 64)
-					result := getMiddlePartOfString(s)
-					rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: paddingAsterisk(result)}})
-				} else {
-					rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: v.Float64}})
-				}
-				continue
-			}
-			// If none of them match, set nil to its value.
-			if len(fieldMaskingLevels) > i && fieldMaskingLevels[i] == storepb.MaskingLevel_PARTIAL {
-				rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "**UL**"}})
-			} else {
-				rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{NullValue: structpb.NullValue_NULL_VALUE}})
-			}
-		}
-
-		data = append(data, &rowData)
+		data = append(data, row)
 	}
 
 	return data, nil
 }
 
-func paddingAsterisk(s string) string {
-	return fmt.Sprintf("**%s**", s)
-}
-
-// getMiddlePartOfString will get the middle part of the string.
-func getMiddlePartOfString(stmt string) string {
-	if len(stmt) == 0 || len(stmt) == 1 {
-		return ""
+// readRow scans the current row and applies the field maskers.
+func readRow(rows *sql.Rows, columnTypeNames []string, fieldMaskers []masker.Masker) (*v1pb.QueryRow, error) {
+	// wantBytesValue want to convert StringValue to BytesValue when columnTypeName is BIT or VARBIT
+	wantBytesValue := make([]bool, len(columnTypeNames))
+	scanArgs := make([]any, len(columnTypeNames))
+	for i, v := range columnTypeNames {
+		// TODO(steven need help): Consult a common list of data types from database driver documentation. e.g. MySQL,PostgreSQL.
+		switch v {
+		case "VARCHAR", "TEXT", "UUID", "TIMESTAMP":
+			scanArgs[i] = new(sql.NullString)
+		case "BOOL":
+			scanArgs[i] = new(sql.NullBool)
+		case "INT", "INTEGER":
+			scanArgs[i] = new(sql.NullInt64)
+		case "FLOAT":
+			scanArgs[i] = new(sql.NullFloat64)
+		case "BIT", "VARBIT":
+			wantBytesValue[i] = true
+			scanArgs[i] = new(sql.NullString)
+		default:
+			scanArgs[i] = new(sql.NullString)
+		}
 	}
-	if len(stmt) == 2 || len(stmt) == 3 {
-		return string(stmt[1])
+
+	if err := rows.Scan(scanArgs...); err != nil {
+		return nil, err
 	}
 
-	s := []rune(stmt)
-	if len(s)%4 != 0 {
-		s = s[:len(s)/4*4]
+	var rowData v1pb.QueryRow
+	for i := range columnTypeNames {
+		value := &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{NullValue: structpb.NullValue_NULL_VALUE}}
+		switch v := scanArgs[i].(type) {
+		case *sql.NullBool:
+			if v.Valid {
+				value = &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: v.Bool}}
+			}
+		case *sql.NullString:
+			if v.Valid && wantBytesValue[i] {
+				value = &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte(v.String)}}
+			} else if v.Valid {
+				value = &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v.String}}
+			}
+		case *sql.NullInt64:
+			if v.Valid {
+				value = &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v.Int64}}
+			}
+		case *sql.NullFloat64:
+			if v.Valid {
+				value = &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: v.Float64}}
+			}
+		}
+		if len(fieldMaskers) > i && fieldMaskers[i] != nil {
+			value = fieldMaskers[i].Mask(value)
+		}
+		rowData.Values = append(rowData.Values, value)
 	}
 
-	var ret []rune
-	ret = append(ret, s[len(s)/4:len(s)/2]...)
-	ret = append(ret, s[len(s)/2:len(s)/4*3]...)
-	return string(ret)
+	return &rowData, nil
 }
 
 func getStatementWithResultLimit(stmt string, limit int) string {
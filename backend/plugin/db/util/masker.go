@@ -0,0 +1,86 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// getFieldMaskers returns the maskers of the result fields by their masking levels.
+//
+// The sensitive field extraction only tracks the masking level, so the masking algorithm of a field is found by the column with the same name and masking level.
+// If the columns with the same name and masking level use different algorithms, e.g. the field is an expression or an alias, the default masker of the masking level is used.
+func getFieldMaskers(fieldList []db.SensitiveField, fieldMaskingLevels []storepb.MaskingLevel, schemaInfo *db.SensitiveSchemaInfo) []masker.Masker {
+	var maskers []masker.Masker
+	for i, level := range fieldMaskingLevels {
+		defaultMasker := masker.NewDefault(level)
+		if defaultMasker == nil || len(fieldList) <= i {
+			maskers = append(maskers, defaultMasker)
+			continue
+		}
+		candidates := map[masker.Masker]bool{}
+		if schemaInfo != nil {
+			for _, database := range schemaInfo.DatabaseList {
+				for _, schema := range database.SchemaList {
+					for _, table := range schema.TableList {
+						for _, column := range table.ColumnList {
+							if column.MaskingLevel == level && strings.EqualFold(column.Name, fieldList[i].Name) {
+								candidates[column.Masker] = true
+							}
+						}
+					}
+				}
+			}
+		}
+		fieldMasker := defaultMasker
+		if len(candidates) == 1 {
+			for candidate := range candidates {
+				if candidate != nil {
+					fieldMasker = candidate
+				}
+			}
+		}
+		maskers = append(maskers, fieldMasker)
+	}
+	return maskers
+}
+
+// MaskQueryResult masks the sensitive fields in the result of the statement, which is not queried by Query.
+func MaskQueryResult(dbType db.Type, result *v1pb.QueryResult, currentDatabase string, schemaInfo *db.SensitiveSchemaInfo) error {
+	if schemaInfo == nil || result.Error != "" || len(result.ColumnNames) == 0 {
+		return nil
+	}
+	fieldList, err := extractSensitiveField(dbType, result.Statement, currentDatabase, schemaInfo)
+	if err != nil {
+		return errors.Wrapf(err, "failed to extract sensitive fields: %q", result.Statement)
+	}
+	if len(fieldList) == 0 {
+		return nil
+	}
+	if len(fieldList) != len(result.ColumnNames) {
+		return errors.Errorf("failed to extract sensitive fields: %q", result.Statement)
+	}
+
+	var fieldMaskingLevels []storepb.MaskingLevel
+	result.Masked, result.Sensitive = nil, nil
+	for _, field := range fieldList {
+		fieldMaskingLevels = append(fieldMaskingLevels, field.MaskingLevel)
+		sensitive := field.MaskingLevel == storepb.MaskingLevel_FULL || field.MaskingLevel == storepb.MaskingLevel_PARTIAL
+		result.Masked = append(result.Masked, sensitive)
+		result.Sensitive = append(result.Sensitive, sensitive)
+	}
+	fieldMaskers := getFieldMaskers(fieldList, fieldMaskingLevels, schemaInfo)
+	for _, row := range result.Rows {
+		for i, value := range row.Values {
+			if len(fieldMaskers) > i && fieldMaskers[i] != nil {
+				row.Values[i] = fieldMaskers[i].Mask(value)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+// Package discovery provides the detectors discovering the sensitive data in the sampled column values.
+package discovery
+
+import (
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// valueWeight is the weight of the matched value ratio in the confidence, the rest is the weight of the column name.
+	valueWeight = 0.8
+	// columnNameOnlyConfidence is the confidence of the column whose name matches but no value is sampled.
+	columnNameOnlyConfidence = 0.5
+)
+
+var (
+	emailRegexp = regexp.MustCompile(`^[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}$`)
+	// phoneRegexps match the international numbers with the "+" prefix, the formatted North American numbers and the China mobile numbers.
+	// The unformatted numbers in other formats are not matched because they are indistinguishable from the other numbers.
+	phoneRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`^\+[0-9][0-9 ()\-.]{5,}[0-9]$`),
+		regexp.MustCompile(`^(\([0-9]{3}\) ?|[0-9]{3}[\-. ])[0-9]{3}[\-. ][0-9]{4}$`),
+		regexp.MustCompile(`^1[3-9][0-9]{9}$`),
+	}
+	ssnRegexp           = regexp.MustCompile(`^([0-9]{3})-([0-9]{2})-([0-9]{4})$`)
+	residentIDRegexp    = regexp.MustCompile(`^[0-9]{17}[0-9Xx]$`)
+	residentIDWeights   = []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+	residentIDCheckSums = "10X98765432"
+
+	builtinColumnNamePatterns = map[storepb.SensitiveDataDiscoverySetting_Detector_Type]string{
+		storepb.SensitiveDataDiscoverySetting_Detector_EMAIL:       `(?i)e_?mail`,
+		storepb.SensitiveDataDiscoverySetting_Detector_PHONE:       `(?i)phone|mobile|(^|_)(tel|cell|fax)(_|$)`,
+		storepb.SensitiveDataDiscoverySetting_Detector_NATIONAL_ID: `(?i)ssn|social_?security|national_?id|id_?card|id_?number|identity|passport`,
+		storepb.SensitiveDataDiscoverySetting_Detector_CREDIT_CARD: `(?i)credit_?card|card_?(no|num|number)|(^|_)(ccn|pan)(_|$)`,
+		storepb.SensitiveDataDiscoverySetting_Detector_IP_ADDRESS:  `(?i)(^|_)ip(_|$)|ip_?addr|ipv[46]`,
+	}
+	builtinValueMatchers = map[storepb.SensitiveDataDiscoverySetting_Detector_Type]func(string) bool{
+		storepb.SensitiveDataDiscoverySetting_Detector_EMAIL:       emailRegexp.MatchString,
+		storepb.SensitiveDataDiscoverySetting_Detector_PHONE:       isPhoneNumber,
+		storepb.SensitiveDataDiscoverySetting_Detector_NATIONAL_ID: isNationalID,
+		storepb.SensitiveDataDiscoverySetting_Detector_CREDIT_CARD: isCreditCardNumber,
+		storepb.SensitiveDataDiscoverySetting_Detector_IP_ADDRESS:  isIPAddress,
+	}
+)
+
+// Detector detects the sensitive data of a classification in a column.
+type Detector struct {
+	// ID is the id of the detector.
+	ID string
+	// ClassificationID is the data classification proposed for the detected columns.
+	ClassificationID string
+
+	matchValue func(string) bool
+	columnName *regexp.Regexp
+}
+
+// New returns the detector of the detector config.
+func New(config *storepb.SensitiveDataDiscoverySetting_Detector) (*Detector, error) {
+	if config.ClassificationId == "" {
+		return nil, errors.Errorf("classification id of detector %q must be set", config.Id)
+	}
+	detector := &Detector{
+		ID:               config.Id,
+		ClassificationID: config.ClassificationId,
+	}
+	columnNamePattern := config.ColumnNamePattern
+	switch config.Type {
+	case storepb.SensitiveDataDiscoverySetting_Detector_CUSTOM:
+		if config.ValuePattern == "" && config.ColumnNamePattern == "" {
+			return nil, errors.Errorf("value pattern or column name pattern of custom detector %q must be set", config.Id)
+		}
+		if config.ValuePattern != "" {
+			// Match the whole value rather than a part of it.
+			valuePattern, err := regexp.Compile(`^(?:` + config.ValuePattern + `)$`)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid value pattern of detector %q", config.Id)
+			}
+			detector.matchValue = valuePattern.MatchString
+		}
+	default:
+		matchValue, ok := builtinValueMatchers[config.Type]
+		if !ok {
+			return nil, errors.Errorf("unsupported type %v of detector %q", config.Type, config.Id)
+		}
+		if config.ValuePattern != "" {
+			return nil, errors.Errorf("value pattern is only supported by the custom detector, but detector %q is %v", config.Id, config.Type)
+		}
+		detector.matchValue = matchValue
+		if columnNamePattern == "" {
+			columnNamePattern = builtinColumnNamePatterns[config.Type]
+		}
+	}
+	if columnNamePattern != "" {
+		columnName, err := regexp.Compile(columnNamePattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid column name pattern of detector %q", config.Id)
+		}
+		detector.columnName = columnName
+	}
+	return detector, nil
+}
+
+// Detect returns the confidence in [0, 1] that the column contains the sensitive data of the detector.
+// The confidence mostly comes from the ratio of the sampled values matched, and the rest from the column name.
+// The columns whose values are sampled but never matched are not sensitive no matter what the name is.
+func (d *Detector) Detect(columnName string, values []*v1pb.RowValue) float64 {
+	nameMatched := d.columnName != nil && d.columnName.MatchString(columnName)
+	if d.matchValue == nil {
+		if nameMatched {
+			return 1
+		}
+		return 0
+	}
+
+	sampled, matched := 0, 0
+	for _, value := range values {
+		s, ok := stringOf(value)
+		if !ok {
+			continue
+		}
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		sampled++
+		if d.matchValue(s) {
+			matched++
+		}
+	}
+	if sampled == 0 {
+		if nameMatched {
+			return columnNameOnlyConfidence
+		}
+		return 0
+	}
+	if matched == 0 {
+		return 0
+	}
+	confidence := valueWeight * float64(matched) / float64(sampled)
+	if nameMatched {
+		confidence += 1 - valueWeight
+	}
+	return confidence
+}
+
+// stringOf returns the text of the string, bytes and integer values.
+func stringOf(value *v1pb.RowValue) (string, bool) {
+	switch v := value.GetKind().(type) {
+	case *v1pb.RowValue_StringValue:
+		return v.StringValue, true
+	case *v1pb.RowValue_BytesValue:
+		if !utf8.Valid(v.BytesValue) {
+			return "", false
+		}
+		return string(v.BytesValue), true
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(v.Int32Value), 10), true
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(v.Int64Value, 10), true
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(v.Uint32Value), 10), true
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(v.Uint64Value, 10), true
+	default:
+		return "", false
+	}
+}
+
+func isPhoneNumber(s string) bool {
+	matched := false
+	for _, phoneRegexp := range phoneRegexps {
+		if phoneRegexp.MatchString(s) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return false
+	}
+	// E.164 numbers have at most 15 digits.
+	digits := countDigits(s)
+	return digits >= 7 && digits <= 15
+}
+
+// isNationalID returns true for the US social security numbers and the China resident identity card numbers.
+func isNationalID(s string) bool {
+	if matches := ssnRegexp.FindStringSubmatch(s); matches != nil {
+		area, group, serial := matches[1], matches[2], matches[3]
+		return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+	}
+	if residentIDRegexp.MatchString(s) {
+		sum := 0
+		for i, weight := range residentIDWeights {
+			sum += int(s[i]-'0') * weight
+		}
+		return strings.ToUpper(s[17:]) == string(residentIDCheckSums[sum%11])
+	}
+	return false
+}
+
+// isCreditCardNumber returns true for the 13 to 19 digits, optionally separated by spaces or dashes, passing the Luhn check.
+func isCreditCardNumber(s string) bool {
+	var digits []int
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, int(r-'0'))
+		case r == ' ' || r == '-':
+		default:
+			return false
+		}
+	}
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := range digits {
+		digit := digits[len(digits)-1-i]
+		if i%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+func isIPAddress(s string) bool {
+	_, err := netip.ParseAddr(s)
+	return err == nil
+}
+
+func countDigits(s string) int {
+	count := 0
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,127 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestBuiltinValueMatchers(t *testing.T) {
+	tests := []struct {
+		tp    storepb.SensitiveDataDiscoverySetting_Detector_Type
+		value string
+		want  bool
+	}{
+		{storepb.SensitiveDataDiscoverySetting_Detector_EMAIL, "alice@example.com", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_EMAIL, "alice.bob+tag@mail.example.co.uk", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_EMAIL, "alice@localhost", false},
+		{storepb.SensitiveDataDiscoverySetting_Detector_EMAIL, "not an email", false},
+		{storepb.SensitiveDataDiscoverySetting_Detector_PHONE, "+1 (415) 555-0100", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_PHONE, "(415) 555-0100", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_PHONE, "415-555-0100", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_PHONE, "13812345678", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_PHONE, "2023-10-16", false},
+		{storepb.SensitiveDataDiscoverySetting_Detector_PHONE, "1697414400", false},
+		{storepb.SensitiveDataDiscoverySetting_Detector_PHONE, "+1234567890123456", false},
+		{storepb.SensitiveDataDiscoverySetting_Detector_NATIONAL_ID, "123-45-6789", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_NATIONAL_ID, "666-45-6789", false},
+		{storepb.SensitiveDataDiscoverySetting_Detector_NATIONAL_ID, "123-00-6789", false},
+		{storepb.SensitiveDataDiscoverySetting_Detector_NATIONAL_ID, "11010519491231002X", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_NATIONAL_ID, "110105194912310021", false},
+		{storepb.SensitiveDataDiscoverySetting_Detector_CREDIT_CARD, "4111111111111111", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_CREDIT_CARD, "4111 1111 1111 1111", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_CREDIT_CARD, "5500-0000-0000-0004", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_CREDIT_CARD, "4111111111111112", false},
+		{storepb.SensitiveDataDiscoverySetting_Detector_CREDIT_CARD, "41111111", false},
+		{storepb.SensitiveDataDiscoverySetting_Detector_IP_ADDRESS, "192.168.0.1", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_IP_ADDRESS, "2001:db8::1", true},
+		{storepb.SensitiveDataDiscoverySetting_Detector_IP_ADDRESS, "256.1.1.1", false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, builtinValueMatchers[test.tp](test.value), "%v %q", test.tp, test.value)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		detector   *storepb.SensitiveDataDiscoverySetting_Detector
+		columnName string
+		values     []*v1pb.RowValue
+		want       float64
+	}{
+		{
+			detector:   &storepb.SensitiveDataDiscoverySetting_Detector{Id: "email", Type: storepb.SensitiveDataDiscoverySetting_Detector_EMAIL, ClassificationId: "1-1"},
+			columnName: "contact",
+			values:     []*v1pb.RowValue{newStringValue("a@example.com"), newStringValue("b@example.com"), newStringValue("n/a"), newStringValue("c@example.com")},
+			want:       0.6,
+		},
+		{
+			detector:   &storepb.SensitiveDataDiscoverySetting_Detector{Id: "email", Type: storepb.SensitiveDataDiscoverySetting_Detector_EMAIL, ClassificationId: "1-1"},
+			columnName: "user_email",
+			values:     []*v1pb.RowValue{newStringValue("a@example.com"), newStringValue(""), {Kind: &v1pb.RowValue_NullValue{}}},
+			want:       1,
+		},
+		{
+			detector:   &storepb.SensitiveDataDiscoverySetting_Detector{Id: "email", Type: storepb.SensitiveDataDiscoverySetting_Detector_EMAIL, ClassificationId: "1-1"},
+			columnName: "email",
+			values:     nil,
+			want:       0.5,
+		},
+		{
+			detector:   &storepb.SensitiveDataDiscoverySetting_Detector{Id: "email", Type: storepb.SensitiveDataDiscoverySetting_Detector_EMAIL, ClassificationId: "1-1"},
+			columnName: "email_verified",
+			values:     []*v1pb.RowValue{{Kind: &v1pb.RowValue_BoolValue{BoolValue: true}}, newStringValue("yes")},
+			want:       0,
+		},
+		{
+			detector:   &storepb.SensitiveDataDiscoverySetting_Detector{Id: "card", Type: storepb.SensitiveDataDiscoverySetting_Detector_CREDIT_CARD, ClassificationId: "1-2"},
+			columnName: "card_no",
+			values:     []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 4111111111111111}}},
+			want:       1,
+		},
+		{
+			detector:   &storepb.SensitiveDataDiscoverySetting_Detector{Id: "employee", Type: storepb.SensitiveDataDiscoverySetting_Detector_CUSTOM, ValuePattern: `E[0-9]{6}`, ClassificationId: "1-3"},
+			columnName: "code",
+			values:     []*v1pb.RowValue{newStringValue("E123456"), newStringValue("XE123456")},
+			want:       0.4,
+		},
+		{
+			detector:   &storepb.SensitiveDataDiscoverySetting_Detector{Id: "salary", Type: storepb.SensitiveDataDiscoverySetting_Detector_CUSTOM, ColumnNamePattern: `(?i)salary`, ClassificationId: "1-4"},
+			columnName: "base_salary",
+			values:     []*v1pb.RowValue{newStringValue("1000")},
+			want:       1,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		detector, err := New(test.detector)
+		a.NoError(err)
+		a.InDelta(test.want, detector.Detect(test.columnName, test.values), 1e-9, test.columnName)
+	}
+}
+
+func TestNewInvalidDetector(t *testing.T) {
+	tests := []*storepb.SensitiveDataDiscoverySetting_Detector{
+		{Id: "no-classification", Type: storepb.SensitiveDataDiscoverySetting_Detector_EMAIL},
+		{Id: "unspecified", Type: storepb.SensitiveDataDiscoverySetting_Detector_TYPE_UNSPECIFIED, ClassificationId: "1-1"},
+		{Id: "empty-custom", Type: storepb.SensitiveDataDiscoverySetting_Detector_CUSTOM, ClassificationId: "1-1"},
+		{Id: "invalid-pattern", Type: storepb.SensitiveDataDiscoverySetting_Detector_CUSTOM, ValuePattern: `(`, ClassificationId: "1-1"},
+		{Id: "builtin-value-pattern", Type: storepb.SensitiveDataDiscoverySetting_Detector_EMAIL, ValuePattern: `.*`, ClassificationId: "1-1"},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := New(test)
+		a.Error(err, test.Id)
+	}
+}
+
+func newStringValue(s string) *v1pb.RowValue {
+	return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-ldap/ldap/v3"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
 
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
@@ -56,6 +57,12 @@ type IdentityProviderConfig struct {
 	// FieldMapping is the mapping of the user attributes returned by the LDAP
 	// server.
 	FieldMapping *storepb.FieldMapping `json:"fieldMapping"`
+	// GroupBaseDN is the base DN to search for the groups of users, e.g.
+	// "ou=groups,dc=example,dc=com". The group search is disabled if it is empty.
+	GroupBaseDN string `json:"groupBaseDn"`
+	// GroupFilter is the filter to search for the groups of a user, e.g.
+	// "(member=%s)", where "%s" is replaced by the DN of the user.
+	GroupFilter string `json:"groupFilter"`
 }
 
 // NewIdentityProvider initializes a new LDAP Identity Provider with the given
@@ -77,6 +84,10 @@ func NewIdentityProvider(config IdentityProviderConfig) (*IdentityProvider, erro
 		}
 	}
 
+	if config.GroupBaseDN != "" && config.GroupFilter == "" {
+		return nil, errors.Errorf("the field %q is required when %q is set", "groupFilter", "groupBaseDn")
+	}
+
 	if config.Port <= 0 {
 		if config.SecurityProtocol == SecurityProtocolLDAPS {
 			config.Port = 636
@@ -150,7 +161,7 @@ func (p *IdentityProvider) Authenticate(username, password string) (*storepb.Ide
 			0,
 			false,
 			strings.ReplaceAll(p.config.UserFilter, "%s", username),
-			[]string{"dn", p.config.FieldMapping.Identifier, p.config.FieldMapping.DisplayName, p.config.FieldMapping.Email},
+			p.userAttributes(),
 			nil,
 		),
 	)
@@ -167,13 +178,121 @@ func (p *IdentityProvider) Authenticate(username, password string) (*storepb.Ide
 		return nil, errors.Errorf("bind user: %v", err)
 	}
 
+	userInfo, err := p.getUserInfo(entry)
+	if err != nil {
+		return nil, err
+	}
+	if p.config.GroupBaseDN != "" {
+		// Bind with the system account again as the user may not be allowed to search groups.
+		if err := conn.Bind(p.config.BindDN, p.config.BindPassword); err != nil {
+			return nil, errors.Errorf("bind: %v", err)
+		}
+		if err := p.searchGroups(conn, entry.DN, userInfo); err != nil {
+			return nil, err
+		}
+	}
+	return userInfo, nil
+}
+
+// ListUsers lists all the users matching the user filter, which is used to sync
+// the group memberships periodically.
+func (p *IdentityProvider) ListUsers() ([]*storepb.IdentityProviderUserInfo, error) {
+	conn, err := p.Connect()
+	if err != nil {
+		return nil, errors.Errorf("connect: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	sr, err := conn.SearchWithPaging(
+		ldap.NewSearchRequest(
+			p.config.BaseDN,
+			ldap.ScopeWholeSubtree,
+			ldap.NeverDerefAliases,
+			0,
+			0,
+			false,
+			strings.ReplaceAll(p.config.UserFilter, "%s", "*"),
+			p.userAttributes(),
+			nil,
+		),
+		500,
+	)
+	if err != nil {
+		return nil, errors.Errorf("search users: %v", err)
+	}
+	var userInfos []*storepb.IdentityProviderUserInfo
+	for _, entry := range sr.Entries {
+		userInfo, err := p.getUserInfo(entry)
+		if err != nil {
+			// Skip the users without an identifier.
+			continue
+		}
+		if p.config.GroupBaseDN != "" {
+			if err := p.searchGroups(conn, entry.DN, userInfo); err != nil {
+				return nil, err
+			}
+		}
+		userInfos = append(userInfos, userInfo)
+	}
+	return userInfos, nil
+}
+
+func (p *IdentityProvider) userAttributes() []string {
+	attributes := []string{"dn", p.config.FieldMapping.Identifier, p.config.FieldMapping.DisplayName, p.config.FieldMapping.Email}
+	if p.config.FieldMapping.Groups != "" {
+		attributes = append(attributes, p.config.FieldMapping.Groups)
+	}
+	return attributes
+}
+
+func (p *IdentityProvider) getUserInfo(entry *ldap.Entry) (*storepb.IdentityProviderUserInfo, error) {
 	identifier := entry.GetAttributeValue(p.config.FieldMapping.Identifier)
 	if identifier == "" {
 		return nil, errors.Errorf("the attribute %q is not found or has empty value", p.config.FieldMapping.Identifier)
 	}
-	return &storepb.IdentityProviderUserInfo{
+	userInfo := &storepb.IdentityProviderUserInfo{
 		Identifier:  identifier,
 		DisplayName: entry.GetAttributeValue(p.config.FieldMapping.DisplayName),
 		Email:       entry.GetAttributeValue(p.config.FieldMapping.Email),
-	}, nil
+	}
+	if p.config.FieldMapping.Groups != "" {
+		userInfo.Groups = entry.GetAttributeValues(p.config.FieldMapping.Groups)
+	}
+	return userInfo, nil
+}
+
+// searchGroups searches the groups that the user belongs to, and adds their DNs to the user info.
+func (p *IdentityProvider) searchGroups(conn *ldap.Conn, userDN string, userInfo *storepb.IdentityProviderUserInfo) error {
+	sr, err := conn.Search(
+		ldap.NewSearchRequest(
+			p.config.GroupBaseDN,
+			ldap.ScopeWholeSubtree,
+			ldap.NeverDerefAliases,
+			0,
+			0,
+			false,
+			strings.ReplaceAll(p.config.GroupFilter, "%s", ldap.EscapeFilter(userDN)),
+			[]string{"dn"},
+			nil,
+		),
+	)
+	if err != nil {
+		return errors.Errorf("search groups: %v", err)
+	}
+	for _, entry := range sr.Entries {
+		if !slices.Contains(userInfo.Groups, entry.DN) {
+			userInfo.Groups = append(userInfo.Groups, entry.DN)
+		}
+	}
+	return nil
+}
+
+// GetGroupTitle returns the title of the group with the DN, which is the value of
+// the first relative DN, e.g. "dba" for "cn=dba,ou=groups,dc=example,dc=com".
+func GetGroupTitle(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 || len(parsed.RDNs[0].Attributes) == 0 {
+		return dn
+	}
+	return parsed.RDNs[0].Attributes[0].Value
 }
@@ -215,6 +215,7 @@ ZboOWVe3icTy64BT3OQhmg==
 		e.AddAttribute("uid", message.AttributeValue(uid))
 		e.AddAttribute("displayName", message.AttributeValue(displayName))
 		e.AddAttribute("mail", message.AttributeValue(mail))
+		e.AddAttribute("memberOf", message.AttributeValue("cn=dba,ou=Groups,dc=example,dc=com"), message.AttributeValue("cn=qa,ou=Groups,dc=example,dc=com"))
 		w.Write(e)
 		w.Write(ldapserver.NewSearchResultDoneResponse(ldapserver.LDAPResultSuccess))
 	})
@@ -257,6 +258,7 @@ func TestIdentityProvider(t *testing.T) {
 				Identifier:  "uid",
 				DisplayName: "displayName",
 				Email:       "mail",
+				Groups:      "memberOf",
 			},
 		},
 	)
@@ -269,6 +271,30 @@ func TestIdentityProvider(t *testing.T) {
 		Identifier:  testUID,
 		DisplayName: testDisplayName,
 		Email:       testMail,
+		Groups:      []string{"cn=dba,ou=Groups,dc=example,dc=com", "cn=qa,ou=Groups,dc=example,dc=com"},
 	}
 	assert.Equal(t, wantUserInfo, userInfo)
 }
+
+func TestGetGroupTitle(t *testing.T) {
+	tests := []struct {
+		dn   string
+		want string
+	}{
+		{
+			dn:   "cn=dba,ou=Groups,dc=example,dc=com",
+			want: "dba",
+		},
+		{
+			dn:   "cn=Database Admins+ou=IT,dc=example,dc=com",
+			want: "Database Admins",
+		},
+		{
+			dn:   "not a dn",
+			want: "not a dn",
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, GetGroupTitle(test.dn))
+	}
+}
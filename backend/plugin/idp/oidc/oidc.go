@@ -166,5 +166,16 @@ func (p *IdentityProvider) UserInfo(ctx context.Context, token *oauth2.Token, no
 			}
 		}
 	}
+	if p.config.FieldMapping.Groups != "" {
+		userInfo.Groups = idp.GetStringSliceWithKey(claims, p.config.FieldMapping.Groups)
+		// Some issuers only put the groups claim in the ID Token.
+		if len(userInfo.Groups) == 0 {
+			var idTokenClaims map[string]any
+			if err := idToken.Claims(&idTokenClaims); err != nil {
+				return nil, errors.Wrap(err, "unmarshal ID Token claims")
+			}
+			userInfo.Groups = idp.GetStringSliceWithKey(idTokenClaims, p.config.FieldMapping.Groups)
+		}
+	}
 	return userInfo, nil
 }
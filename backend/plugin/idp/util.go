@@ -17,3 +17,27 @@ func GetValueWithKey(data map[string]any, key string) any {
 
 	return value
 }
+
+// GetStringSliceWithKey returns the string values of the key in the data.
+// The value can be either a string or an array of strings, e.g. the groups claim.
+func GetStringSliceWithKey(data map[string]any, key string) []string {
+	switch value := GetValueWithKey(data, key).(type) {
+	case string:
+		if value == "" {
+			return nil
+		}
+		return []string{value}
+	case []string:
+		return value
+	case []any:
+		var values []string
+		for _, v := range value {
+			if s, ok := v.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
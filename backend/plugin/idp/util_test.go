@@ -67,3 +67,44 @@ func TestGetValueWithKeyFromJSONString(t *testing.T) {
 		}
 	}
 }
+
+func TestGetStringSliceWithKey(t *testing.T) {
+	tests := []struct {
+		data string
+		key  string
+		want []string
+	}{
+		{
+			data: `{"groups": ["dba", "qa"]}`,
+			key:  "groups",
+			want: []string{"dba", "qa"},
+		},
+		{
+			data: `{"data": {"groups": "dba"}}`,
+			key:  "data.groups",
+			want: []string{"dba"},
+		},
+		{
+			data: `{"groups": ["dba", 1, ""]}`,
+			key:  "groups",
+			want: []string{"dba"},
+		},
+		{
+			data: `{"groups": 1}`,
+			key:  "groups",
+			want: nil,
+		},
+		{
+			data: `{}`,
+			key:  "groups",
+			want: nil,
+		},
+	}
+
+	for _, test := range tests {
+		var data map[string]any
+		err := json.Unmarshal([]byte(test.data), &data)
+		require.NoError(t, err)
+		require.Equal(t, test.want, GetStringSliceWithKey(data, test.key))
+	}
+}
@@ -0,0 +1,317 @@
+// Package masker provides the algorithms masking the sensitive data in the query results.
+package masker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	defaultSubstitution          = "******"
+	defaultCharacterSubstitution = "*"
+)
+
+// Masker masks a value in the query results.
+type Masker interface {
+	Mask(value *v1pb.RowValue) *v1pb.RowValue
+}
+
+// NewDefault returns the default masker of the masking level, or nil if the level does not mask.
+func NewDefault(level storepb.MaskingLevel) Masker {
+	switch level {
+	case storepb.MaskingLevel_FULL:
+		return &fullMasker{substitution: defaultSubstitution}
+	case storepb.MaskingLevel_PARTIAL:
+		return &defaultPartialMasker{}
+	default:
+		return nil
+	}
+}
+
+// New returns the masker of the masking algorithm.
+// The algorithm without a mask masks as the default masker of the masking level.
+func New(algorithm *storepb.MaskingAlgorithmSetting_MaskingAlgorithm, level storepb.MaskingLevel) (Masker, error) {
+	switch mask := algorithm.Mask.(type) {
+	case *storepb.MaskingAlgorithmSetting_MaskingAlgorithm_FullMask_:
+		return &fullMasker{substitution: withDefault(mask.FullMask.Substitution, defaultSubstitution)}, nil
+	case *storepb.MaskingAlgorithmSetting_MaskingAlgorithm_HashMask_:
+		// The hash without a salt can be reversed by hashing the guessed values.
+		if mask.HashMask.Salt == "" {
+			return nil, errors.Errorf("salt of masking algorithm %q must not be empty", algorithm.Id)
+		}
+		return &hashMasker{salt: mask.HashMask.Salt}, nil
+	case *storepb.MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask_:
+		if mask.InnerOuterMask.PrefixLength < 0 || mask.InnerOuterMask.SuffixLength < 0 {
+			return nil, errors.Errorf("prefix length and suffix length of masking algorithm %q must not be negative", algorithm.Id)
+		}
+		return &innerOuterMasker{
+			prefixLength: int(mask.InnerOuterMask.PrefixLength),
+			suffixLength: int(mask.InnerOuterMask.SuffixLength),
+			substitution: withDefault(mask.InnerOuterMask.Substitution, defaultCharacterSubstitution),
+		}, nil
+	case *storepb.MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask_:
+		return &emailMasker{substitution: withDefault(mask.EmailMask.Substitution, defaultSubstitution)}, nil
+	case *storepb.MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask_:
+		if mask.RangeMask.BucketSize <= 0 {
+			return nil, errors.Errorf("bucket size of masking algorithm %q must be positive", algorithm.Id)
+		}
+		return &rangeMasker{bucketSize: mask.RangeMask.BucketSize}, nil
+	case *storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_:
+		if mask.DateTruncateMask.Unit == storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_UNIT_UNSPECIFIED {
+			return nil, errors.Errorf("unit of masking algorithm %q must be specified", algorithm.Id)
+		}
+		return &dateTruncateMasker{unit: mask.DateTruncateMask.Unit}, nil
+	case *storepb.MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask_:
+		pattern, err := regexp.Compile(mask.RegexMask.Pattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pattern of masking algorithm %q", algorithm.Id)
+		}
+		return &regexMasker{pattern: pattern, replacement: mask.RegexMask.Replacement}, nil
+	case nil:
+		return NewDefault(level), nil
+	default:
+		return nil, errors.Errorf("unsupported mask %T of masking algorithm %q", mask, algorithm.Id)
+	}
+}
+
+// fullMasker replaces all values including NULL with the substitution.
+type fullMasker struct {
+	substitution string
+}
+
+func (m *fullMasker) Mask(*v1pb.RowValue) *v1pb.RowValue {
+	return newStringValue(m.substitution)
+}
+
+// defaultPartialMasker keeps the middle part of the value and pads it with asterisks.
+type defaultPartialMasker struct{}
+
+func (*defaultPartialMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := toString(value)
+	if !ok {
+		return newStringValue("**UL**")
+	}
+	return newStringValue(fmt.Sprintf("**%s**", getMiddlePartOfString(s)))
+}
+
+// hashMasker replaces the values with the salted hash. NULL is kept so that the masked values are consistent with the original values in joins.
+type hashMasker struct {
+	salt string
+}
+
+func (m *hashMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := toString(value)
+	if !ok {
+		return value
+	}
+	sum := sha256.Sum256([]byte(m.salt + s))
+	return newStringValue(hex.EncodeToString(sum[:]))
+}
+
+type innerOuterMasker struct {
+	prefixLength int
+	suffixLength int
+	substitution string
+}
+
+func (m *innerOuterMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := toString(value)
+	if !ok {
+		return value
+	}
+	runes := []rune(s)
+	if len(runes) <= m.prefixLength+m.suffixLength {
+		return newStringValue(strings.Repeat(m.substitution, len(runes)))
+	}
+	var buf strings.Builder
+	_, _ = buf.WriteString(string(runes[:m.prefixLength]))
+	_, _ = buf.WriteString(strings.Repeat(m.substitution, len(runes)-m.prefixLength-m.suffixLength))
+	_, _ = buf.WriteString(string(runes[len(runes)-m.suffixLength:]))
+	return newStringValue(buf.String())
+}
+
+type emailMasker struct {
+	substitution string
+}
+
+func (m *emailMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := toString(value)
+	if !ok {
+		return value
+	}
+	at := strings.LastIndex(s, "@")
+	if at <= 0 || at == len(s)-1 {
+		return newStringValue(m.substitution)
+	}
+	return newStringValue(m.substitution + s[at:])
+}
+
+type rangeMasker struct {
+	bucketSize float64
+}
+
+func (m *rangeMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	number, ok := toNumber(value)
+	if !ok {
+		if _, isNull := value.GetKind().(*v1pb.RowValue_NullValue); isNull {
+			return value
+		}
+		return newStringValue(defaultSubstitution)
+	}
+	lower := math.Floor(number/m.bucketSize) * m.bucketSize
+	upper := lower + m.bucketSize
+	return newStringValue(fmt.Sprintf("[%s, %s)", strconv.FormatFloat(lower, 'f', -1, 64), strconv.FormatFloat(upper, 'f', -1, 64)))
+}
+
+// timeLayouts are the layouts of the date and time values returned by the drivers.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+type dateTruncateMasker struct {
+	unit storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit
+}
+
+func (m *dateTruncateMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := toString(value)
+	if !ok {
+		return value
+	}
+	for _, layout := range timeLayouts {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+		switch m.unit {
+		case storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_YEAR:
+			t = time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+		case storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_MONTH:
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+		case storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_DAY:
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		case storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_HOUR:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+		}
+		return newStringValue(t.Format(layout))
+	}
+	return newStringValue(defaultSubstitution)
+}
+
+type regexMasker struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+func (m *regexMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := toString(value)
+	if !ok {
+		return value
+	}
+	return newStringValue(m.pattern.ReplaceAllString(s, m.replacement))
+}
+
+func newStringValue(s string) *v1pb.RowValue {
+	return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+}
+
+// toString returns the string representation of the value, and false for NULL.
+func toString(value *v1pb.RowValue) (string, bool) {
+	switch kind := value.GetKind().(type) {
+	case *v1pb.RowValue_BoolValue:
+		return strconv.FormatBool(kind.BoolValue), true
+	case *v1pb.RowValue_BytesValue:
+		return string(kind.BytesValue), true
+	case *v1pb.RowValue_DoubleValue:
+		return strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64), true
+	case *v1pb.RowValue_FloatValue:
+		return strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 32), true
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(kind.Int32Value), 10), true
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(kind.Int64Value, 10), true
+	case *v1pb.RowValue_StringValue:
+		return kind.StringValue, true
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(kind.Uint32Value), 10), true
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(kind.Uint64Value, 10), true
+	case *v1pb.RowValue_ValueValue:
+		if kind.ValueValue == nil {
+			return "", false
+		}
+		return fmt.Sprint(kind.ValueValue.AsInterface()), true
+	default:
+		return "", false
+	}
+}
+
+// toNumber returns the numeric value, and false if the value is not a number.
+func toNumber(value *v1pb.RowValue) (float64, bool) {
+	switch kind := value.GetKind().(type) {
+	case *v1pb.RowValue_DoubleValue:
+		return kind.DoubleValue, true
+	case *v1pb.RowValue_FloatValue:
+		return float64(kind.FloatValue), true
+	case *v1pb.RowValue_Int32Value:
+		return float64(kind.Int32Value), true
+	case *v1pb.RowValue_Int64Value:
+		return float64(kind.Int64Value), true
+	case *v1pb.RowValue_Uint32Value:
+		return float64(kind.Uint32Value), true
+	case *v1pb.RowValue_Uint64Value:
+		return float64(kind.Uint64Value), true
+	}
+	s, ok := toString(value)
+	if !ok {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, false
+	}
+	return number, true
+}
+
+func withDefault(s, defaultValue string) string {
+	if s == "" {
+		return defaultValue
+	}
+	return s
+}
+
+// getMiddlePartOfString will get the middle part of the string.
+func getMiddlePartOfString(stmt string) string {
+	if len(stmt) == 0 || len(stmt) == 1 {
+		return ""
+	}
+	if len(stmt) == 2 || len(stmt) == 3 {
+		return string(stmt[1])
+	}
+
+	s := []rune(stmt)
+	if len(s)%4 != 0 {
+		s = s[:len(s)/4*4]
+	}
+
+	var ret []rune
+	ret = append(ret, s[len(s)/4:len(s)/2]...)
+	ret = append(ret, s[len(s)/2:len(s)/4*3]...)
+	return string(ret)
+}
@@ -0,0 +1,124 @@
+package masker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		algorithm *storepb.MaskingAlgorithmSetting_MaskingAlgorithm
+		value     *v1pb.RowValue
+		want      *v1pb.RowValue
+	}{
+		{
+			algorithm: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm{},
+			value:     newStringValue("bytebase"),
+			want:      newStringValue("******"),
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm{
+				Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_FullMask_{FullMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_FullMask{Substitution: "###"}},
+			},
+			value: &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{NullValue: structpb.NullValue_NULL_VALUE}},
+			want:  newStringValue("###"),
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm{
+				Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_HashMask_{HashMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_HashMask{Salt: "salt"}},
+			},
+			value: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}},
+			// sha256("salt1").
+			want: newStringValue("dc90cf07de907ccc64636ceddb38e552a1a0d984743b1f36a447b73877012c39"),
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm{
+				Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask_{InnerOuterMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask{PrefixLength: 3, SuffixLength: 4}},
+			},
+			value: newStringValue("138-0013-8000"),
+			want:  newStringValue("138******8000"),
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm{
+				Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask_{InnerOuterMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask{PrefixLength: 1, SuffixLength: 1}},
+			},
+			value: newStringValue("ab"),
+			want:  newStringValue("**"),
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm{
+				Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask_{EmailMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask{}},
+			},
+			value: newStringValue("alice@example.com"),
+			want:  newStringValue("******@example.com"),
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm{
+				Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask_{RangeMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask{BucketSize: 10}},
+			},
+			value: &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: 37}},
+			want:  newStringValue("[30, 40)"),
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm{
+				Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_{DateTruncateMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask{Unit: storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_MONTH}},
+			},
+			value: newStringValue("1990-05-17"),
+			want:  newStringValue("1990-05-01"),
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm{
+				Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask_{RegexMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask{Pattern: `\d`, Replacement: "#"}},
+			},
+			value: newStringValue("card 4111"),
+			want:  newStringValue("card ####"),
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		m, err := New(test.algorithm, storepb.MaskingLevel_FULL)
+		a.NoError(err)
+		got := m.Mask(test.value)
+		a.Equal(test.want.GetStringValue(), got.GetStringValue(), test.algorithm.String())
+	}
+}
+
+func TestNewInvalidAlgorithm(t *testing.T) {
+	tests := []*storepb.MaskingAlgorithmSetting_MaskingAlgorithm{
+		{
+			Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_HashMask_{HashMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_HashMask{}},
+		},
+		{
+			Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask_{InnerOuterMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask{PrefixLength: -1}},
+		},
+		{
+			Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask_{RangeMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask{}},
+		},
+		{
+			Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_{DateTruncateMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask{}},
+		},
+		{
+			Mask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask_{RegexMask: &storepb.MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask{Pattern: "("}},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := New(test, storepb.MaskingLevel_FULL)
+		a.Error(err, test.String())
+	}
+}
+
+func TestDefaultPartialMasker(t *testing.T) {
+	a := require.New(t)
+	m := NewDefault(storepb.MaskingLevel_PARTIAL)
+	a.Equal("**droc**", m.Mask(newStringValue("bedrocks")).GetStringValue())
+	a.Equal("**UL**", m.Mask(&v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}).GetStringValue())
+	a.Nil(NewDefault(storepb.MaskingLevel_NONE))
+}
@@ -8,6 +8,11 @@ import (
 // ParsePostgreSQL parses the given SQL and returns the AST tree.
 // Use the PostgreSQL parser based on antlr4.
 func ParsePostgreSQL(sql string) (antlr.Tree, error) {
+	tree, _, err := parsePostgreSQLWithTokens(sql)
+	return tree, err
+}
+
+func parsePostgreSQLWithTokens(sql string) (antlr.Tree, *antlr.CommonTokenStream, error) {
 	lexer := parser.NewPostgreSQLLexer(antlr.NewInputStream(sql))
 	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
 	p := parser.NewPostgreSQLParser(stream)
@@ -24,12 +29,12 @@ func ParsePostgreSQL(sql string) (antlr.Tree, error) {
 
 	tree := p.Root()
 	if lexerErrorListener.err != nil {
-		return nil, lexerErrorListener.err
+		return nil, nil, lexerErrorListener.err
 	}
 
 	if parserErrorListener.err != nil {
-		return nil, parserErrorListener.err
+		return nil, nil, parserErrorListener.err
 	}
 
-	return tree, nil
+	return tree, stream, nil
 }
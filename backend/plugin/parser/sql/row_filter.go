@@ -0,0 +1,415 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	mysql "github.com/bytebase/mysql-parser"
+	postgres "github.com/bytebase/postgresql-parser"
+	"github.com/pkg/errors"
+)
+
+// RowFilter is the predicate filtering the rows of a table.
+type RowFilter struct {
+	Resource SchemaResource
+	// Predicate is the rendered SQL boolean expression, e.g. "tenant_id = 'acme'".
+	Predicate string
+}
+
+// RowFilterContext is the context to apply the row filters.
+type RowFilterContext struct {
+	// CurrentDatabase is the database of the unqualified MySQL tables.
+	CurrentDatabase string
+	// Filters are the row filters of the tables.
+	// The unqualified PostgreSQL tables are rejected if they have filters in a schema other than "public",
+	// because the search path may resolve them to that schema.
+	Filters []RowFilter
+	// RestrictedViews and RestrictedFunctions are the views and the function names in the databases with row filters.
+	// They can read the filtered tables without the filters, so the statement referencing them is rejected.
+	RestrictedViews     []SchemaResource
+	RestrictedFunctions []string
+	// HasRules is true if the queried databases have row filter rules.
+	// The built-in functions reading the tables by the names or the queries in their arguments are rejected then.
+	HasRules bool
+}
+
+// postgreSQLTableReadingFunctions are the PostgreSQL built-in and extension functions reading the tables
+// by the names or the queries in their arguments, which cannot be rewritten with the row filters.
+var postgreSQLTableReadingFunctions = []string{
+	"table_to_xml", "table_to_xmlschema", "table_to_xml_and_xmlschema",
+	"query_to_xml", "query_to_xmlschema", "query_to_xml_and_xmlschema",
+	"cursor_to_xml", "cursor_to_xmlschema",
+	"schema_to_xml", "schema_to_xmlschema", "schema_to_xml_and_xmlschema",
+	"database_to_xml", "database_to_xmlschema", "database_to_xml_and_xmlschema",
+	"ts_stat",
+	"dblink", "dblink_exec", "dblink_open", "dblink_fetch", "dblink_send_query", "dblink_get_result",
+	"crosstab", "crosstab2", "crosstab3", "crosstab4", "connectby",
+}
+
+// ApplyRowFilters rewrites the statement so that every filtered table is replaced with the subquery selecting the filtered rows,
+// e.g. "SELECT * FROM t" is rewritten to "SELECT * FROM (SELECT * FROM t WHERE (tenant_id = 'acme')) AS `t`".
+// The filters on the same table are joined by AND. The references to the common table expressions are not rewritten.
+// It returns an error if a filtered table is referenced in a way that cannot be rewritten, so that the rows are never exposed unfiltered.
+func ApplyRowFilters(engineType EngineType, statement string, rowFilterContext *RowFilterContext) (string, error) {
+	if !rowFilterContext.HasRules && len(rowFilterContext.Filters) == 0 && len(rowFilterContext.RestrictedViews) == 0 && len(rowFilterContext.RestrictedFunctions) == 0 {
+		return statement, nil
+	}
+	switch engineType {
+	case MySQL, MariaDB, OceanBase:
+		return applyMySQLRowFilters(statement, rowFilterContext)
+	case Postgres:
+		return applyPostgreSQLRowFilters(statement, rowFilterContext)
+	default:
+		return "", errors.Errorf("row filters are not supported for engine type: %s", engineType)
+	}
+}
+
+// joinRowFilterPredicates returns the predicates of the table joined by AND, or an empty string if the table is not filtered.
+func joinRowFilterPredicates(filters []RowFilter, match func(SchemaResource) bool) string {
+	var predicates []string
+	for _, filter := range filters {
+		if match(filter.Resource) {
+			predicates = append(predicates, fmt.Sprintf("(%s)", filter.Predicate))
+		}
+	}
+	return strings.Join(predicates, " AND ")
+}
+
+// cteScope is the common table expressions defined by a WITH clause.
+type cteScope struct {
+	// owner is the rule containing the WITH clause, and the scope ends when the owner exits.
+	owner antlr.ParserRuleContext
+	names []string
+}
+
+// cteScopes is the stack of the common table expression scopes.
+type cteScopes []*cteScope
+
+func (s *cteScopes) push(owner antlr.ParserRuleContext, names []string) {
+	*s = append(*s, &cteScope{owner: owner, names: names})
+}
+
+// add adds the name to the innermost scope.
+func (s cteScopes) add(name string) {
+	if len(s) > 0 {
+		s[len(s)-1].names = append(s[len(s)-1].names, name)
+	}
+}
+
+// exit ends the innermost scope if the rule is its owner.
+func (s *cteScopes) exit(ctx antlr.ParserRuleContext) {
+	if n := len(*s); n > 0 && (*s)[n-1].owner == ctx {
+		*s = (*s)[:n-1]
+	}
+}
+
+func (s cteScopes) contains(name string, equal func(string, string) bool) bool {
+	for _, scope := range s {
+		for _, cteName := range scope.names {
+			if equal(cteName, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsFunction(functions []string, name string) bool {
+	for _, function := range functions {
+		if strings.EqualFold(function, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func applyMySQLRowFilters(statement string, rowFilterContext *RowFilterContext) (string, error) {
+	list, err := ParseMySQL(statement)
+	if err != nil {
+		return "", err
+	}
+	var result []string
+	for _, item := range list {
+		l := &mysqlRowFilterListener{
+			rowFilterContext: rowFilterContext,
+			tokens:           item.Tokens,
+			rewriter:         antlr.NewTokenStreamRewriter(item.Tokens),
+		}
+		antlr.ParseTreeWalkerDefault.Walk(l, item.Tree)
+		if l.err != nil {
+			return "", l.err
+		}
+		result = append(result, l.rewriter.GetTextDefault())
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+type mysqlRowFilterListener struct {
+	*mysql.BaseMySQLParserListener
+
+	rowFilterContext *RowFilterContext
+	tokens           *antlr.CommonTokenStream
+	rewriter         *antlr.TokenStreamRewriter
+	cteScopes        cteScopes
+	err              error
+}
+
+// EnterWithClause is called when production withClause is entered.
+func (l *mysqlRowFilterListener) EnterWithClause(ctx *mysql.WithClauseContext) {
+	l.cteScopes.push(ctx.GetParent().(antlr.ParserRuleContext), nil)
+}
+
+// EnterCommonTableExpression is called when production commonTableExpression is entered.
+func (l *mysqlRowFilterListener) EnterCommonTableExpression(ctx *mysql.CommonTableExpressionContext) {
+	// The recursive common table expression can reference itself, and the others can only reference the previous ones.
+	if withClause, ok := ctx.GetParent().(*mysql.WithClauseContext); ok && withClause.RECURSIVE_SYMBOL() != nil {
+		l.cteScopes.add(NormalizeMySQLIdentifier(ctx.Identifier()))
+	}
+}
+
+// ExitCommonTableExpression is called when production commonTableExpression is exited.
+func (l *mysqlRowFilterListener) ExitCommonTableExpression(ctx *mysql.CommonTableExpressionContext) {
+	l.cteScopes.add(NormalizeMySQLIdentifier(ctx.Identifier()))
+}
+
+// ExitEveryRule is called when any rule is exited.
+func (l *mysqlRowFilterListener) ExitEveryRule(ctx antlr.ParserRuleContext) {
+	l.cteScopes.exit(ctx)
+}
+
+// EnterFunctionCall is called when production functionCall is entered.
+func (l *mysqlRowFilterListener) EnterFunctionCall(ctx *mysql.FunctionCallContext) {
+	if l.err != nil {
+		return
+	}
+	var name string
+	if ctx.PureIdentifier() != nil {
+		name = strings.Trim(ctx.PureIdentifier().GetText(), "`")
+	} else if ctx.QualifiedIdentifier() != nil {
+		_, name = normalizeMySQLQualifiedIdentifier(ctx.QualifiedIdentifier())
+	}
+	if containsFunction(l.rowFilterContext.RestrictedFunctions, name) {
+		l.err = errors.Errorf("function %q cannot be called because it may read the tables with row filters", name)
+	}
+}
+
+// EnterTableRef is called when production tableRef is entered.
+func (l *mysqlRowFilterListener) EnterTableRef(ctx *mysql.TableRefContext) {
+	if l.err != nil {
+		return
+	}
+	database, table := NormalizeMySQLTableRef(ctx)
+	if database == "" {
+		if l.cteScopes.contains(table, strings.EqualFold) {
+			return
+		}
+		database = l.rowFilterContext.CurrentDatabase
+	}
+	for _, view := range l.rowFilterContext.RestrictedViews {
+		if strings.EqualFold(view.Database, database) && strings.EqualFold(view.Table, table) {
+			l.err = errors.Errorf("view %q cannot be referenced because it may read the tables with row filters", table)
+			return
+		}
+	}
+	predicate := joinRowFilterPredicates(l.rowFilterContext.Filters, func(resource SchemaResource) bool {
+		return strings.EqualFold(resource.Database, database) && strings.EqualFold(resource.Table, table)
+	})
+	if predicate == "" {
+		return
+	}
+
+	singleTable, ok := ctx.GetParent().(*mysql.SingleTableContext)
+	if !ok {
+		l.err = errors.Errorf("table %q with row filters can only be referenced in the FROM clause of SELECT", table)
+		return
+	}
+	if singleTable.UsePartition() != nil || singleTable.IndexHintList() != nil {
+		l.err = errors.Errorf("table %q with row filters cannot be referenced with partitions or index hints", table)
+		return
+	}
+	text := l.tokens.GetTextFromInterval(ctx.GetSourceInterval())
+	subquery := fmt.Sprintf("(SELECT * FROM %s WHERE %s)", text, predicate)
+	if singleTable.TableAlias() == nil {
+		subquery = fmt.Sprintf("%s AS `%s`", subquery, strings.ReplaceAll(table, "`", "``"))
+	}
+	l.rewriter.ReplaceDefault(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex(), subquery)
+}
+
+func applyPostgreSQLRowFilters(statement string, rowFilterContext *RowFilterContext) (string, error) {
+	tree, tokens, err := parsePostgreSQLWithTokens(statement)
+	if err != nil {
+		return "", err
+	}
+	l := &postgresRowFilterListener{
+		rowFilterContext: rowFilterContext,
+		tokens:           tokens,
+		rewriter:         antlr.NewTokenStreamRewriter(tokens),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(l, tree)
+	if l.err != nil {
+		return "", l.err
+	}
+	return l.rewriter.GetTextDefault(), nil
+}
+
+type postgresRowFilterListener struct {
+	*postgres.BasePostgreSQLParserListener
+
+	rowFilterContext *RowFilterContext
+	tokens           *antlr.CommonTokenStream
+	rewriter         *antlr.TokenStreamRewriter
+	cteScopes        cteScopes
+	err              error
+}
+
+// EnterWith_clause is called when production with_clause is entered.
+func (l *postgresRowFilterListener) EnterWith_clause(ctx *postgres.With_clauseContext) {
+	var names []string
+	if ctx.RECURSIVE() != nil {
+		// All the common table expressions of the recursive WITH clause can reference each other.
+		for _, cte := range ctx.Cte_list().AllCommon_table_expr() {
+			names = append(names, normalizePostgreSQLName(cte.Name().GetText()))
+		}
+	}
+	l.cteScopes.push(ctx.GetParent().(antlr.ParserRuleContext), names)
+}
+
+// ExitCommon_table_expr is called when production common_table_expr is exited.
+func (l *postgresRowFilterListener) ExitCommon_table_expr(ctx *postgres.Common_table_exprContext) {
+	l.cteScopes.add(normalizePostgreSQLName(ctx.Name().GetText()))
+}
+
+// ExitEveryRule is called when any rule is exited.
+func (l *postgresRowFilterListener) ExitEveryRule(ctx antlr.ParserRuleContext) {
+	l.cteScopes.exit(ctx)
+}
+
+// EnterFunc_application is called when production func_application is entered.
+func (l *postgresRowFilterListener) EnterFunc_application(ctx *postgres.Func_applicationContext) {
+	if l.err != nil {
+		return
+	}
+	parts := splitPostgreSQLQualifiedName(ctx.Func_name().GetText())
+	name := parts[len(parts)-1]
+	if containsFunction(l.rowFilterContext.RestrictedFunctions, name) {
+		l.err = errors.Errorf("function %q cannot be called because it may read the tables with row filters", name)
+		return
+	}
+	if (l.rowFilterContext.HasRules || len(l.rowFilterContext.Filters) > 0) && containsFunction(postgreSQLTableReadingFunctions, name) {
+		l.err = errors.Errorf("function %q cannot be called because it may read the tables with row filters", name)
+	}
+}
+
+// EnterRelation_expr is called when production relation_expr is entered.
+func (l *postgresRowFilterListener) EnterRelation_expr(ctx *postgres.Relation_exprContext) {
+	if l.err != nil {
+		return
+	}
+	schema, table, err := normalizePostgreSQLQualifiedName(ctx.Qualified_name())
+	if err != nil {
+		l.err = err
+		return
+	}
+	// The unqualified table may be resolved to any schema in the search path.
+	match := func(resource SchemaResource) bool {
+		return (schema == "" || resource.Schema == schema) && resource.Table == table
+	}
+	if schema == "" {
+		if l.cteScopes.contains(table, func(a, b string) bool { return a == b }) {
+			return
+		}
+		for _, filter := range l.rowFilterContext.Filters {
+			if match(filter.Resource) && filter.Resource.Schema != "public" {
+				l.err = errors.Errorf("table %q must be qualified with the schema because it has row filters in schema %q", table, filter.Resource.Schema)
+				return
+			}
+		}
+	}
+	for _, view := range l.rowFilterContext.RestrictedViews {
+		if match(view) {
+			l.err = errors.Errorf("view %q cannot be referenced because it may read the tables with row filters", table)
+			return
+		}
+	}
+	predicate := joinRowFilterPredicates(l.rowFilterContext.Filters, match)
+	if predicate == "" {
+		return
+	}
+
+	tableRef, ok := ctx.GetParent().(*postgres.Table_refContext)
+	if !ok {
+		l.err = errors.Errorf("table %q with row filters can only be referenced in the FROM clause of SELECT", table)
+		return
+	}
+	if tableRef.Tablesample_clause() != nil {
+		l.err = errors.Errorf("table %q with row filters cannot be referenced with TABLESAMPLE", table)
+		return
+	}
+	text := l.tokens.GetTextFromInterval(ctx.GetSourceInterval())
+	subquery := fmt.Sprintf("(SELECT * FROM %s WHERE %s)", text, predicate)
+	if alias := tableRef.Opt_alias_clause(); alias == nil || alias.Table_alias_clause() == nil {
+		subquery = fmt.Sprintf(`%s AS "%s"`, subquery, strings.ReplaceAll(table, `"`, `""`))
+	}
+	l.rewriter.ReplaceDefault(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex(), subquery)
+}
+
+// normalizePostgreSQLQualifiedName returns the schema and the table of the qualified name.
+// The schema is empty if the name is unqualified. The unquoted identifiers are folded to lower case.
+func normalizePostgreSQLQualifiedName(ctx postgres.IQualified_nameContext) (string, string, error) {
+	if ctx == nil {
+		return "", "", errors.New("qualified name must be set")
+	}
+	parts := splitPostgreSQLQualifiedName(ctx.GetText())
+	switch len(parts) {
+	case 1:
+		return "", parts[0], nil
+	case 2:
+		return parts[0], parts[1], nil
+	case 3:
+		// The database part is ignored because PostgreSQL cannot reference other databases.
+		return parts[1], parts[2], nil
+	default:
+		return "", "", errors.Errorf("invalid qualified name %q", ctx.GetText())
+	}
+}
+
+// normalizePostgreSQLName returns the name with the unquoted identifiers folded to lower case.
+func normalizePostgreSQLName(text string) string {
+	return strings.Join(splitPostgreSQLQualifiedName(text), ".")
+}
+
+func splitPostgreSQLQualifiedName(text string) []string {
+	var parts []string
+	var buf strings.Builder
+	quoted := false
+	flush := func() {
+		parts = append(parts, buf.String())
+		buf.Reset()
+	}
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quoted && c == '"' && i+1 < len(text) && text[i+1] == '"':
+			_ = buf.WriteByte('"')
+			i++
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == '.':
+			flush()
+		case !quoted:
+			_ = buf.WriteByte(lowerASCII(c))
+		default:
+			_ = buf.WriteByte(c)
+		}
+	}
+	flush()
+	return parts
+}
+
+func lowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
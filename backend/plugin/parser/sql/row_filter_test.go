@@ -0,0 +1,214 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyRowFilters(t *testing.T) {
+	tests := []struct {
+		engineType EngineType
+		statement  string
+		filters    []RowFilter
+		views      []SchemaResource
+		functions  []string
+		hasRules   bool
+		want       string
+		wantErr    bool
+	}{
+		{
+			engineType: MySQL,
+			statement:  "SELECT * FROM t1 JOIN t2 ON t1.id = t2.id",
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Table: "t1"}, Predicate: "tenant_id = 'acme'"},
+				{Resource: SchemaResource{Database: "db", Table: "t1"}, Predicate: "region = 'eu'"},
+			},
+			want: "SELECT * FROM (SELECT * FROM t1 WHERE (tenant_id = 'acme') AND (region = 'eu')) AS `t1` JOIN t2 ON t1.id = t2.id;",
+		},
+		{
+			engineType: MySQL,
+			statement:  "SELECT a.id FROM db.t1 AS a WHERE a.id IN (SELECT id FROM other.t1)",
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Table: "t1"}, Predicate: "tenant_id = 'acme'"},
+			},
+			want: "SELECT a.id FROM (SELECT * FROM db.t1 WHERE (tenant_id = 'acme')) AS a WHERE a.id IN (SELECT id FROM other.t1);",
+		},
+		{
+			engineType: MySQL,
+			statement:  "SELECT * FROM t1 USE INDEX (idx)",
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Table: "t1"}, Predicate: "tenant_id = 'acme'"},
+			},
+			wantErr: true,
+		},
+		{
+			engineType: MySQL,
+			statement:  "TABLE t1",
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Table: "t1"}, Predicate: "tenant_id = 'acme'"},
+			},
+			wantErr: true,
+		},
+		{
+			engineType: MySQL,
+			statement:  "WITH t1 AS (SELECT 1 AS id) SELECT * FROM t1",
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Table: "t1"}, Predicate: "tenant_id = 'acme'"},
+			},
+			want: "WITH t1 AS (SELECT 1 AS id) SELECT * FROM t1;",
+		},
+		{
+			engineType: MySQL,
+			statement:  "WITH a AS (SELECT * FROM t1), t1 AS (SELECT 1 AS id) SELECT * FROM a, t1",
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Table: "t1"}, Predicate: "tenant_id = 'acme'"},
+			},
+			want: "WITH a AS (SELECT * FROM (SELECT * FROM t1 WHERE (tenant_id = 'acme')) AS `t1`), t1 AS (SELECT 1 AS id) SELECT * FROM a, t1;",
+		},
+		{
+			engineType: MySQL,
+			statement:  "SELECT * FROM (WITH t1 AS (SELECT 1 AS id) SELECT * FROM t1) x, t1",
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Table: "t1"}, Predicate: "tenant_id = 'acme'"},
+			},
+			want: "SELECT * FROM (WITH t1 AS (SELECT 1 AS id) SELECT * FROM t1) x, (SELECT * FROM t1 WHERE (tenant_id = 'acme')) AS `t1`;",
+		},
+		{
+			engineType: MySQL,
+			statement:  "SELECT * FROM v1",
+			views:      []SchemaResource{{Database: "db", Table: "v1"}},
+			wantErr:    true,
+		},
+		{
+			engineType: MySQL,
+			statement:  "SELECT db.get_secret(1)",
+			functions:  []string{"get_secret"},
+			wantErr:    true,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT * FROM "Orders" o, public.users WHERE o.user_id = users.id`,
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Schema: "public", Table: "Orders"}, Predicate: "tenant_id = 'acme'"},
+				{Resource: SchemaResource{Database: "db", Schema: "public", Table: "users"}, Predicate: "region = 'eu'"},
+			},
+			want: `SELECT * FROM (SELECT * FROM "Orders" WHERE (tenant_id = 'acme')) o, (SELECT * FROM public.users WHERE (region = 'eu')) AS "users" WHERE o.user_id = users.id`,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT * FROM Users`,
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Schema: "public", Table: "users"}, Predicate: "region = 'eu'"},
+			},
+			want: `SELECT * FROM (SELECT * FROM Users WHERE (region = 'eu')) AS "users"`,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT * FROM users TABLESAMPLE SYSTEM (10)`,
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Schema: "public", Table: "users"}, Predicate: "region = 'eu'"},
+			},
+			wantErr: true,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT * FROM private.users`,
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Schema: "public", Table: "users"}, Predicate: "region = 'eu'"},
+			},
+			want: `SELECT * FROM private.users`,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT * FROM users`,
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Schema: "private", Table: "users"}, Predicate: "region = 'eu'"},
+			},
+			wantErr: true,
+		},
+		{
+			engineType: Postgres,
+			statement:  `WITH users AS (SELECT 1 AS id) SELECT * FROM users`,
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Schema: "public", Table: "users"}, Predicate: "region = 'eu'"},
+			},
+			want: `WITH users AS (SELECT 1 AS id) SELECT * FROM users`,
+		},
+		{
+			engineType: Postgres,
+			statement:  `WITH a AS (SELECT * FROM users), users AS (SELECT 1 AS id) SELECT * FROM a`,
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Schema: "public", Table: "users"}, Predicate: "region = 'eu'"},
+			},
+			want: `WITH a AS (SELECT * FROM (SELECT * FROM users WHERE (region = 'eu')) AS "users"), users AS (SELECT 1 AS id) SELECT * FROM a`,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT * FROM public.user_view`,
+			views:      []SchemaResource{{Database: "db", Schema: "public", Table: "user_view"}},
+			wantErr:    true,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT * FROM public.get_users()`,
+			functions:  []string{"get_users"},
+			wantErr:    true,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT table_to_xml('public.t', true, false, '')`,
+			hasRules:   true,
+			wantErr:    true,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT pg_catalog.query_to_xml('select * from t', true, false, '')`,
+			hasRules:   true,
+			wantErr:    true,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT * FROM dblink('dbname=db', 'select * from t') AS t(id int)`,
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Schema: "public", Table: "users"}, Predicate: "region = 'eu'"},
+			},
+			wantErr: true,
+		},
+		{
+			engineType: Postgres,
+			statement:  `SELECT table_to_xml('public.t', true, false, '')`,
+			want:       `SELECT table_to_xml('public.t', true, false, '')`,
+		},
+		{
+			engineType: Oracle,
+			statement:  `SELECT * FROM users`,
+			filters: []RowFilter{
+				{Resource: SchemaResource{Database: "db", Schema: "db", Table: "USERS"}, Predicate: "region = 'eu'"},
+			},
+			wantErr: true,
+		},
+		{
+			engineType: Oracle,
+			statement:  `SELECT * FROM users`,
+			want:       `SELECT * FROM users`,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := ApplyRowFilters(test.engineType, test.statement, &RowFilterContext{
+			CurrentDatabase:     "db",
+			Filters:             test.filters,
+			RestrictedViews:     test.views,
+			RestrictedFunctions: test.functions,
+			HasRules:            test.hasRules,
+		})
+		if test.wantErr {
+			a.Error(err, test.statement)
+			continue
+		}
+		a.NoError(err, test.statement)
+		a.Equal(test.want, got)
+	}
+}
@@ -0,0 +1,105 @@
+// Package azblob provides the client for Azure Blob Storage.
+package azblob
+
+import (
+	"context"
+	"io"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+)
+
+var _ storage.Backend = (*Client)(nil)
+
+// Client wraps the Azure Blob Storage client.
+type Client struct {
+	c         *azblob.Client
+	container string
+}
+
+// NewClient returns a new Azure Blob Storage client for the container.
+// The connection string is the one shown in the "Access keys" page of the storage account.
+func NewClient(container, connectionString string) (*Client, error) {
+	c, err := azblob.NewClientFromConnectionString(connectionString, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create Azure Blob Storage client")
+	}
+	return &Client{
+		c:         c,
+		container: container,
+	}, nil
+}
+
+// Upload uploads a blob with the path.
+// Defaults to block upload with block size 1MB.
+func (c *Client) Upload(ctx context.Context, path string, body io.Reader) error {
+	if _, err := c.c.UploadStream(ctx, c.container, path, body, nil); err != nil {
+		return errors.Wrapf(err, "failed to upload Azure blob %q", path)
+	}
+	return nil
+}
+
+// Download returns a reader of the blob with path.
+func (c *Client) Download(ctx context.Context, path string) (io.ReadCloser, error) {
+	resp, err := c.c.DownloadStream(ctx, c.container, path, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get Azure blob %q", path)
+	}
+	return resp.Body, nil
+}
+
+// List lists blobs with prefix in their names.
+func (c *Client) List(ctx context.Context, prefix string) ([]*storage.ObjectInfo, error) {
+	var ret []*storage.ObjectInfo
+	pager := c.c.NewListBlobsFlatPager(c.container, &azblob.ListBlobsFlatOptions{
+		Prefix: &prefix,
+	})
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load the next page of Azure blobs")
+		}
+		for _, item := range resp.Segment.BlobItems {
+			info := &storage.ObjectInfo{Path: *item.Name}
+			if item.Properties != nil {
+				if item.Properties.ContentLength != nil {
+					info.Size = *item.Properties.ContentLength
+				}
+				if item.Properties.LastModified != nil {
+					info.LastModified = *item.Properties.LastModified
+				}
+			}
+			ret = append(ret, info)
+		}
+	}
+	return ret, nil
+}
+
+// Delete deletes the blobs with path.
+func (c *Client) Delete(ctx context.Context, pathList ...string) error {
+	for _, path := range pathList {
+		if _, err := c.c.DeleteBlob(ctx, c.container, path, nil); err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return errors.Wrapf(err, "failed to delete Azure blob %q", path)
+		}
+	}
+	return nil
+}
+
+// Stat returns the information of the blob with path.
+func (c *Client) Stat(ctx context.Context, path string) (*storage.ObjectInfo, error) {
+	resp, err := c.c.ServiceClient().NewContainerClient(c.container).NewBlobClient(path).GetProperties(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get Azure blob %q", path)
+	}
+	info := &storage.ObjectInfo{Path: path}
+	if resp.ContentLength != nil {
+		info.Size = *resp.ContentLength
+	}
+	if resp.LastModified != nil {
+		info.LastModified = *resp.LastModified
+	}
+	return info, nil
+}
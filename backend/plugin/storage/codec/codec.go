@@ -0,0 +1,446 @@
+// Package codec provides the streaming compression and encryption of the backup artifacts.
+//
+// An encoded artifact starts with a magic number followed by a JSON header describing the encoding.
+// The body is the compressed dump, optionally encrypted with a random data key in segments of AES-256-GCM.
+// The data key is wrapped by the key encryption key referenced in the header, so that the key encryption key
+// itself never leaves the key store. Artifacts without the magic number are treated as plain dumps.
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+const (
+	magic   = "BBBACKUP"
+	version = byte(1)
+
+	// keySize is the size of both the data key and the key encryption key, which makes AES-256.
+	keySize = 32
+	// segmentSize is the size of the plaintext in each encrypted segment.
+	segmentSize = 64 * 1024
+	// noncePrefixSize is the size of the random nonce prefix, the rest of the 12-byte nonce is
+	// the 4-byte segment counter and the 1-byte last segment flag.
+	noncePrefixSize = 7
+	// maxHeaderSize is the sanity limit of the header size.
+	maxHeaderSize = 64 * 1024
+)
+
+// Options is the encoding options of a backup artifact.
+type Options struct {
+	// Compression is the compression algorithm. Empty or NONE means uncompressed.
+	Compression api.BackupCompression
+	// EncryptionKeyRef is the reference of the key encryption key. Empty means unencrypted.
+	EncryptionKeyRef string
+}
+
+// IsPlain returns true if the artifact is neither compressed nor encrypted.
+func (o *Options) IsPlain() bool {
+	return (o.Compression == "" || o.Compression == api.BackupCompressionNone) && o.EncryptionKeyRef == ""
+}
+
+// KeyResolver resolves the key encryption key by its reference.
+type KeyResolver func(keyRef string) ([]byte, error)
+
+// header is the JSON header of an encoded artifact.
+type header struct {
+	Compression api.BackupCompression `json:"compression,omitempty"`
+	KeyRef      string                `json:"keyRef,omitempty"`
+	WrappedKey  []byte                `json:"wrappedKey,omitempty"`
+	NoncePrefix []byte                `json:"noncePrefix,omitempty"`
+}
+
+// ResolveKey resolves the key encryption key from the environment variable (env://NAME) or the file (file:///path/to/key).
+// The key must be a base64-encoded 256-bit key.
+func ResolveKey(keyRef string) ([]byte, error) {
+	var encoded string
+	switch {
+	case strings.HasPrefix(keyRef, "env://"):
+		name := strings.TrimPrefix(keyRef, "env://")
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			return nil, errors.Errorf("environment variable %q of key %q is not set", name, keyRef)
+		}
+		encoded = v
+	case strings.HasPrefix(keyRef, "file://"):
+		content, err := os.ReadFile(strings.TrimPrefix(keyRef, "file://"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read key %q", keyRef)
+		}
+		encoded = string(content)
+	default:
+		return nil, errors.Errorf("unsupported key reference %q, should start with env:// or file://", keyRef)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, errors.Wrapf(err, "key %q is not base64-encoded", keyRef)
+	}
+	if len(key) != keySize {
+		return nil, errors.Errorf("key %q must be %d bytes, got %d bytes", keyRef, keySize, len(key))
+	}
+	return key, nil
+}
+
+// NewWriter returns a writer encoding the backup artifact into w. Closing the writer flushes the encoded
+// artifact but does not close w. A plain writer is returned if opts requires neither compression nor encryption.
+func NewWriter(w io.Writer, opts *Options, resolve KeyResolver) (io.WriteCloser, error) {
+	if opts.IsPlain() {
+		return nopWriteCloser{Writer: w}, nil
+	}
+
+	h := &header{}
+	if opts.Compression != api.BackupCompressionNone {
+		h.Compression = opts.Compression
+	}
+	var dataKey []byte
+	if opts.EncryptionKeyRef != "" {
+		kek, err := resolve(opts.EncryptionKeyRef)
+		if err != nil {
+			return nil, err
+		}
+		dataKey = make([]byte, keySize)
+		if _, err := rand.Read(dataKey); err != nil {
+			return nil, errors.Wrap(err, "failed to generate data key")
+		}
+		wrappedKey, err := wrapKey(kek, dataKey)
+		if err != nil {
+			return nil, err
+		}
+		h.KeyRef = opts.EncryptionKeyRef
+		h.WrappedKey = wrappedKey
+		h.NoncePrefix = make([]byte, noncePrefixSize)
+		if _, err := rand.Read(h.NoncePrefix); err != nil {
+			return nil, errors.Wrap(err, "failed to generate nonce")
+		}
+	}
+	headerBytes, err := writeHeader(w, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// The closers are closed in order, from the outermost compression stage to the innermost encryption stage.
+	var closers []io.Closer
+	out := w
+	if dataKey != nil {
+		aead, err := newAEAD(dataKey)
+		if err != nil {
+			return nil, err
+		}
+		ew := &encryptWriter{w: w, aead: aead, noncePrefix: h.NoncePrefix, aad: headerBytes}
+		closers = append(closers, ew)
+		out = ew
+	}
+	switch h.Compression {
+	case "":
+	case api.BackupCompressionGzip:
+		gw := gzip.NewWriter(out)
+		closers = append([]io.Closer{gw}, closers...)
+		out = gw
+	case api.BackupCompressionZstd:
+		zw, err := zstd.NewWriter(out)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create zstd writer")
+		}
+		closers = append([]io.Closer{zw}, closers...)
+		out = zw
+	default:
+		return nil, errors.Errorf("unsupported backup compression %q", h.Compression)
+	}
+	return &chainWriter{Writer: out, closers: closers}, nil
+}
+
+// NewReader returns a reader decoding the backup artifact from r. The artifact is passed through as is if it
+// is not encoded by NewWriter. Closing the reader releases the decoder but does not close r.
+func NewReader(r io.Reader, resolve KeyResolver) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	prefix, err := br.Peek(len(magic))
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "failed to read backup artifact")
+	}
+	if string(prefix) != magic {
+		return io.NopCloser(br), nil
+	}
+
+	h, headerBytes, err := readHeader(br)
+	if err != nil {
+		return nil, err
+	}
+	var in io.Reader = br
+	if h.KeyRef != "" {
+		kek, err := resolve(h.KeyRef)
+		if err != nil {
+			return nil, err
+		}
+		dataKey, err := unwrapKey(kek, h.WrappedKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unwrap the data key with key %q", h.KeyRef)
+		}
+		aead, err := newAEAD(dataKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(h.NoncePrefix) != noncePrefixSize {
+			return nil, errors.Errorf("invalid nonce size %d", len(h.NoncePrefix))
+		}
+		in = &decryptReader{r: br, aead: aead, noncePrefix: h.NoncePrefix, aad: headerBytes}
+	}
+	switch h.Compression {
+	case "":
+		return io.NopCloser(in), nil
+	case api.BackupCompressionGzip:
+		gr, err := gzip.NewReader(in)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create gzip reader")
+		}
+		return gr, nil
+	case api.BackupCompressionZstd:
+		zr, err := zstd.NewReader(in)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create zstd reader")
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return nil, errors.Errorf("unsupported backup compression %q", h.Compression)
+	}
+}
+
+func writeHeader(w io.Writer, h *header) ([]byte, error) {
+	headerBytes, err := json.Marshal(h)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal backup artifact header")
+	}
+	var buf bytes.Buffer
+	buf.WriteString(magic)
+	buf.WriteByte(version)
+	if err := binary.Write(&buf, binary.BigEndian, uint32(len(headerBytes))); err != nil {
+		return nil, err
+	}
+	buf.Write(headerBytes)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return nil, errors.Wrap(err, "failed to write backup artifact header")
+	}
+	return headerBytes, nil
+}
+
+func readHeader(r io.Reader) (*header, []byte, error) {
+	prelude := make([]byte, len(magic)+1+4)
+	if _, err := io.ReadFull(r, prelude); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read backup artifact header")
+	}
+	if v := prelude[len(magic)]; v != version {
+		return nil, nil, errors.Errorf("unsupported backup artifact version %d", v)
+	}
+	size := binary.BigEndian.Uint32(prelude[len(magic)+1:])
+	if size > maxHeaderSize {
+		return nil, nil, errors.Errorf("backup artifact header size %d exceeds the limit %d", size, maxHeaderSize)
+	}
+	headerBytes := make([]byte, size)
+	if _, err := io.ReadFull(r, headerBytes); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read backup artifact header")
+	}
+	h := &header{}
+	if err := json.Unmarshal(headerBytes, h); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to unmarshal backup artifact header")
+	}
+	return h, headerBytes, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, errors.Errorf("key must be %d bytes, got %d bytes", keySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create GCM")
+	}
+	return aead, nil
+}
+
+// wrapKey encrypts the data key with the key encryption key, the nonce is prepended to the ciphertext.
+func wrapKey(kek, dataKey []byte) ([]byte, error) {
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	return aead.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+func unwrapKey(kek, wrappedKey []byte) ([]byte, error) {
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, errors.Errorf("wrapped key is too short")
+	}
+	nonce, ciphertext := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+// segmentNonce returns the nonce of the segment, which binds the position of the segment and
+// whether it is the last one so that reordering and truncation are detected.
+func segmentNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, noncePrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+	if last {
+		nonce[noncePrefixSize+4] = 1
+	}
+	return nonce
+}
+
+type encryptWriter struct {
+	w           io.Writer
+	aead        cipher.AEAD
+	noncePrefix []byte
+	aad         []byte
+	counter     uint32
+	buf         []byte
+	closed      bool
+}
+
+func (ew *encryptWriter) Write(p []byte) (int, error) {
+	if ew.closed {
+		return 0, errors.New("write to closed encrypt writer")
+	}
+	ew.buf = append(ew.buf, p...)
+	// Always keep some plaintext in the buffer so that the last segment is written on Close.
+	sealed := false
+	for len(ew.buf) > segmentSize {
+		if err := ew.seal(ew.buf[:segmentSize], false); err != nil {
+			return 0, err
+		}
+		ew.buf = ew.buf[segmentSize:]
+		sealed = true
+	}
+	if sealed {
+		// Compact the buffer to release the sealed plaintext.
+		ew.buf = append(make([]byte, 0, 2*segmentSize), ew.buf...)
+	}
+	return len(p), nil
+}
+
+func (ew *encryptWriter) Close() error {
+	if ew.closed {
+		return nil
+	}
+	ew.closed = true
+	return ew.seal(ew.buf, true)
+}
+
+func (ew *encryptWriter) seal(plaintext []byte, last bool) error {
+	if ew.counter == ^uint32(0) {
+		return errors.New("backup artifact is too large to encrypt")
+	}
+	ciphertext := ew.aead.Seal(nil, segmentNonce(ew.noncePrefix, ew.counter, last), plaintext, ew.aad)
+	ew.counter++
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(ciphertext)))
+	if _, err := ew.w.Write(size[:]); err != nil {
+		return err
+	}
+	_, err := ew.w.Write(ciphertext)
+	return err
+}
+
+type decryptReader struct {
+	r           io.Reader
+	aead        cipher.AEAD
+	noncePrefix []byte
+	aad         []byte
+	counter     uint32
+	pending     []byte
+	done        bool
+}
+
+func (dr *decryptReader) Read(p []byte) (int, error) {
+	for len(dr.pending) == 0 {
+		if dr.done {
+			return 0, io.EOF
+		}
+		if err := dr.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, dr.pending)
+	dr.pending = dr.pending[n:]
+	return n, nil
+}
+
+func (dr *decryptReader) open() error {
+	var size [4]byte
+	if _, err := io.ReadFull(dr.r, size[:]); err != nil {
+		if err == io.EOF {
+			return errors.New("backup artifact is truncated")
+		}
+		return errors.Wrap(err, "failed to read encrypted segment")
+	}
+	n := binary.BigEndian.Uint32(size[:])
+	if n > segmentSize+uint32(dr.aead.Overhead()) {
+		return errors.Errorf("encrypted segment size %d exceeds the limit", n)
+	}
+	ciphertext := make([]byte, n)
+	if _, err := io.ReadFull(dr.r, ciphertext); err != nil {
+		return errors.Wrap(err, "failed to read encrypted segment")
+	}
+	plaintext, err := dr.aead.Open(nil, segmentNonce(dr.noncePrefix, dr.counter, false), ciphertext, dr.aad)
+	if err != nil {
+		plaintext, err = dr.aead.Open(nil, segmentNonce(dr.noncePrefix, dr.counter, true), ciphertext, dr.aad)
+		if err != nil {
+			return errors.Errorf("failed to decrypt segment %d, the backup artifact is corrupted or tampered", dr.counter)
+		}
+		dr.done = true
+		var trailing [1]byte
+		if n, _ := io.ReadFull(dr.r, trailing[:]); n > 0 {
+			return errors.New("unexpected data after the last encrypted segment")
+		}
+	}
+	dr.counter++
+	dr.pending = plaintext
+	return nil
+}
+
+type chainWriter struct {
+	io.Writer
+	closers []io.Closer
+}
+
+func (cw *chainWriter) Close() error {
+	for _, c := range cw.closers {
+		if err := c.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
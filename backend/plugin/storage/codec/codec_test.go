@@ -0,0 +1,97 @@
+package codec
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+func TestRoundTrip(t *testing.T) {
+	a := require.New(t)
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	a.NoError(err)
+	t.Setenv("BB_TEST_BACKUP_KEY", base64.StdEncoding.EncodeToString(key))
+
+	// Cover the empty dump, a dump within one segment and a dump across segments.
+	dumps := []string{
+		"",
+		"CREATE TABLE t(id INT);\nINSERT INTO t VALUES (1);\n",
+		strings.Repeat("INSERT INTO t VALUES (1);\n", 3*segmentSize/10),
+	}
+	optionsList := []*Options{
+		{},
+		{Compression: api.BackupCompressionGzip},
+		{Compression: api.BackupCompressionZstd},
+		{EncryptionKeyRef: "env://BB_TEST_BACKUP_KEY"},
+		{Compression: api.BackupCompressionZstd, EncryptionKeyRef: "env://BB_TEST_BACKUP_KEY"},
+	}
+	for _, opts := range optionsList {
+		for _, dump := range dumps {
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, opts, ResolveKey)
+			a.NoError(err)
+			_, err = io.Copy(w, strings.NewReader(dump))
+			a.NoError(err)
+			a.NoError(w.Close())
+			if opts.IsPlain() {
+				a.Equal(dump, buf.String())
+			}
+			if opts.EncryptionKeyRef != "" && dump != "" {
+				a.NotContains(buf.String(), "INSERT INTO")
+			}
+
+			r, err := NewReader(&buf, ResolveKey)
+			a.NoError(err)
+			got, err := io.ReadAll(r)
+			a.NoError(err)
+			a.NoError(r.Close())
+			a.Equal(dump, string(got))
+		}
+	}
+}
+
+func TestTamperedArtifact(t *testing.T) {
+	a := require.New(t)
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	a.NoError(err)
+	t.Setenv("BB_TEST_BACKUP_KEY", base64.StdEncoding.EncodeToString(key))
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, &Options{EncryptionKeyRef: "env://BB_TEST_BACKUP_KEY"}, ResolveKey)
+	a.NoError(err)
+	_, err = io.Copy(w, strings.NewReader(strings.Repeat("x", 2*segmentSize)))
+	a.NoError(err)
+	a.NoError(w.Close())
+	encoded := buf.Bytes()
+
+	// Flip a byte in the body.
+	tampered := bytes.Clone(encoded)
+	tampered[len(tampered)-1] ^= 1
+	r, err := NewReader(bytes.NewReader(tampered), ResolveKey)
+	a.NoError(err)
+	_, err = io.ReadAll(r)
+	a.Error(err)
+
+	// Drop the last segment.
+	truncated := encoded[:len(encoded)-(4+16)]
+	r, err = NewReader(bytes.NewReader(truncated), ResolveKey)
+	a.NoError(err)
+	_, err = io.ReadAll(r)
+	a.Error(err)
+
+	// Use a different key.
+	_, err = rand.Read(key)
+	a.NoError(err)
+	t.Setenv("BB_TEST_BACKUP_KEY", base64.StdEncoding.EncodeToString(key))
+	_, err = NewReader(bytes.NewReader(encoded), ResolveKey)
+	a.Error(err)
+}
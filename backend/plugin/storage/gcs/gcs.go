@@ -0,0 +1,106 @@
+// Package gcs provides the client for Google Cloud Storage.
+package gcs
+
+import (
+	"context"
+	"io"
+
+	gcstorage "cloud.google.com/go/storage"
+	"github.com/pkg/errors"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+)
+
+var _ storage.Backend = (*Client)(nil)
+
+// Client wraps the Google Cloud Storage client.
+type Client struct {
+	c      *gcstorage.Client
+	bucket string
+}
+
+// NewClient returns a new Google Cloud Storage client.
+// If credentialFile is empty, the application default credentials are used.
+func NewClient(ctx context.Context, bucket, credentialFile string) (*Client, error) {
+	var opts []option.ClientOption
+	if credentialFile != "" {
+		opts = append(opts, option.WithCredentialsFile(credentialFile))
+	}
+	c, err := gcstorage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create Google Cloud Storage client")
+	}
+	return &Client{
+		c:      c,
+		bucket: bucket,
+	}, nil
+}
+
+// Upload uploads an object with the path.
+func (c *Client) Upload(ctx context.Context, path string, body io.Reader) error {
+	w := c.c.Bucket(c.bucket).Object(path).NewWriter(ctx)
+	if _, err := io.Copy(w, body); err != nil {
+		w.Close()
+		return errors.Wrapf(err, "failed to upload GCS object %q", path)
+	}
+	// The object is committed on Close.
+	if err := w.Close(); err != nil {
+		return errors.Wrapf(err, "failed to upload GCS object %q", path)
+	}
+	return nil
+}
+
+// Download returns a reader of the object with path.
+func (c *Client) Download(ctx context.Context, path string) (io.ReadCloser, error) {
+	r, err := c.c.Bucket(c.bucket).Object(path).NewReader(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get GCS object %q", path)
+	}
+	return r, nil
+}
+
+// List lists objects with prefix in their names.
+func (c *Client) List(ctx context.Context, prefix string) ([]*storage.ObjectInfo, error) {
+	var ret []*storage.ObjectInfo
+	it := c.c.Bucket(c.bucket).Objects(ctx, &gcstorage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list GCS objects")
+		}
+		ret = append(ret, &storage.ObjectInfo{
+			Path:         attrs.Name,
+			Size:         attrs.Size,
+			LastModified: attrs.Updated,
+		})
+	}
+	return ret, nil
+}
+
+// Delete deletes the objects with path.
+func (c *Client) Delete(ctx context.Context, pathList ...string) error {
+	for _, path := range pathList {
+		if err := c.c.Bucket(c.bucket).Object(path).Delete(ctx); err != nil && !errors.Is(err, gcstorage.ErrObjectNotExist) {
+			return errors.Wrapf(err, "failed to delete GCS object %q", path)
+		}
+	}
+	return nil
+}
+
+// Stat returns the information of the object with path.
+func (c *Client) Stat(ctx context.Context, path string) (*storage.ObjectInfo, error) {
+	attrs, err := c.c.Bucket(c.bucket).Object(path).Attrs(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get GCS object %q", path)
+	}
+	return &storage.ObjectInfo{
+		Path:         path,
+		Size:         attrs.Size,
+		LastModified: attrs.Updated,
+	}, nil
+}
@@ -0,0 +1,156 @@
+// Package local provides the backup storage backend on the local disk.
+package local
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+)
+
+var _ storage.Backend = (*Backend)(nil)
+
+// Backend stores the objects as files under the root directory.
+type Backend struct {
+	root string
+}
+
+// NewBackend returns a new local storage backend rooted at the directory.
+func NewBackend(root string) *Backend {
+	return &Backend{root: filepath.Clean(root)}
+}
+
+func (b *Backend) absPath(path string) (string, error) {
+	absPath := filepath.Join(b.root, filepath.FromSlash(path))
+	if absPath != b.root && !strings.HasPrefix(absPath, b.root+string(filepath.Separator)) {
+		return "", errors.Errorf("path %q is outside of the storage root", path)
+	}
+	return absPath, nil
+}
+
+// Upload uploads the content of the reader to path.
+func (b *Backend) Upload(_ context.Context, path string, body io.Reader) error {
+	absPath, err := b.absPath(path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(absPath), os.ModePerm); err != nil {
+		return errors.Wrapf(err, "failed to create directory for %q", path)
+	}
+	// Write to a temporary file first so that readers never see a partial object.
+	tempPath := absPath + ".tmp"
+	file, err := os.Create(tempPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create file %q", tempPath)
+	}
+	if _, err := io.Copy(file, body); err != nil {
+		file.Close()
+		os.Remove(tempPath)
+		return errors.Wrapf(err, "failed to write file %q", tempPath)
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(tempPath)
+		return errors.Wrapf(err, "failed to close file %q", tempPath)
+	}
+	if err := os.Rename(tempPath, absPath); err != nil {
+		return errors.Wrapf(err, "failed to rename %q to %q", tempPath, absPath)
+	}
+	return nil
+}
+
+// Download returns a reader of the object at path.
+func (b *Backend) Download(_ context.Context, path string) (io.ReadCloser, error) {
+	absPath, err := b.absPath(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(absPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open file %q", absPath)
+	}
+	return file, nil
+}
+
+// List lists the objects whose paths start with prefix.
+func (b *Backend) List(_ context.Context, prefix string) ([]*storage.ObjectInfo, error) {
+	// Only walk the deepest directory covering the prefix.
+	dir := prefix
+	if !strings.HasSuffix(dir, "/") {
+		dir = filepath.ToSlash(filepath.Dir(dir))
+	}
+	absDir, err := b.absPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	var objects []*storage.ObjectInfo
+	err = filepath.WalkDir(absDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(b.root, p)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if !strings.HasPrefix(relPath, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, &storage.ObjectInfo{
+			Path:         relPath,
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list objects with prefix %q", prefix)
+	}
+	return objects, nil
+}
+
+// Delete deletes the objects at the paths.
+func (b *Backend) Delete(_ context.Context, paths ...string) error {
+	for _, path := range paths {
+		absPath, err := b.absPath(path)
+		if err != nil {
+			return err
+		}
+		if err := os.Remove(absPath); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to delete file %q", absPath)
+		}
+	}
+	return nil
+}
+
+// Stat returns the information of the object at path.
+func (b *Backend) Stat(_ context.Context, path string) (*storage.ObjectInfo, error) {
+	absPath, err := b.absPath(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to stat file %q", absPath)
+	}
+	return &storage.ObjectInfo{
+		Path:         path,
+		Size:         info.Size(),
+		LastModified: info.ModTime(),
+	}, nil
+}
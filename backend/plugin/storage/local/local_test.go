@@ -0,0 +1,57 @@
+package local
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+)
+
+func TestBackend(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	backend := NewBackend(t.TempDir())
+
+	a.NoError(backend.Upload(ctx, "backup/db/101/a.sql", strings.NewReader("a")))
+	a.NoError(backend.Upload(ctx, "backup/db/101/b.sql", strings.NewReader("bb")))
+	a.NoError(backend.Upload(ctx, "backup/db/102/c.sql", strings.NewReader("ccc")))
+
+	info, err := backend.Stat(ctx, "backup/db/101/b.sql")
+	a.NoError(err)
+	a.Equal(int64(2), info.Size)
+
+	reader, err := backend.Download(ctx, "backup/db/102/c.sql")
+	a.NoError(err)
+	content, err := io.ReadAll(reader)
+	a.NoError(err)
+	a.NoError(reader.Close())
+	a.Equal("ccc", string(content))
+
+	listPaths := func(prefix string) []string {
+		objects, err := backend.List(ctx, prefix)
+		a.NoError(err)
+		var paths []string
+		for _, object := range objects {
+			paths = append(paths, object.Path)
+		}
+		sort.Strings(paths)
+		return paths
+	}
+	a.Equal([]string{"backup/db/101/a.sql", "backup/db/101/b.sql"}, listPaths("backup/db/101/"))
+	a.Equal([]string{"backup/db/101/a.sql", "backup/db/101/b.sql", "backup/db/102/c.sql"}, listPaths("backup/db/10"))
+	a.Empty(listPaths("backup/instance/"))
+
+	a.NoError(backend.Delete(ctx, "backup/db/101/a.sql", "backup/db/101/not-exist.sql"))
+	a.Equal([]string{"backup/db/101/b.sql"}, listPaths("backup/db/101/"))
+
+	localPath := t.TempDir() + "/b.sql"
+	a.NoError(storage.DownloadFile(ctx, backend, localPath, "backup/db/101/b.sql"))
+
+	_, err = backend.Stat(ctx, "../outside")
+	a.Error(err)
+}
@@ -1,10 +1,9 @@
-// Package s3 provides the client for AWS S3 storage.
+// Package s3 provides the client for AWS S3 and S3-compatible storages such as MinIO.
 package s3
 
 import (
 	"context"
 	"io"
-	"os"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
@@ -13,8 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/storage"
 )
 
+var _ storage.Backend = (*Client)(nil)
+
 // Client wraps the AWS S3 client.
 type Client struct {
 	c      *s3.Client
@@ -37,7 +40,8 @@ func GetCredentialsFromFile(ctx context.Context, credentialsFileName string) (aw
 }
 
 // NewClient returns a new AWS S3 client.
-func NewClient(ctx context.Context, region, bucket string, credentials aws.Credentials) (*Client, error) {
+// If endpoint is not empty, the client talks to the S3-compatible storage at the endpoint with path-style addressing, e.g., MinIO.
+func NewClient(ctx context.Context, region, bucket, endpoint string, credentials aws.Credentials) (*Client, error) {
 	cfg, err := awsconfig.LoadDefaultConfig(ctx,
 		awsconfig.WithRegion(region),
 		awsconfig.WithCredentialsProvider(awscredentials.NewStaticCredentialsProvider(credentials.AccessKeyID, credentials.SecretAccessKey, "")),
@@ -46,14 +50,19 @@ func NewClient(ctx context.Context, region, bucket string, credentials aws.Crede
 		return nil, errors.Wrap(err, "failed to load AWS S3 config")
 	}
 	return &Client{
-		c:      s3.NewFromConfig(cfg),
+		c: s3.NewFromConfig(cfg, func(o *s3.Options) {
+			if endpoint != "" {
+				o.BaseEndpoint = aws.String(endpoint)
+				o.UsePathStyle = true
+			}
+		}),
 		bucket: bucket,
 	}, nil
 }
 
-// ListObjects lists objects with prefix in their names.
-func (c *Client) ListObjects(ctx context.Context, prefix string) ([]types.Object, error) {
-	var ret []types.Object
+// List lists objects with prefix in their names.
+func (c *Client) List(ctx context.Context, prefix string) ([]*storage.ObjectInfo, error) {
+	var ret []*storage.ObjectInfo
 	paginator := s3.NewListObjectsV2Paginator(c.c, &s3.ListObjectsV2Input{
 		Bucket: &c.bucket,
 		Prefix: &prefix,
@@ -63,65 +72,80 @@ func (c *Client) ListObjects(ctx context.Context, prefix string) ([]types.Object
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to load the next page of S3 objects")
 		}
-		ret = append(ret, output.Contents...)
+		for _, object := range output.Contents {
+			ret = append(ret, &storage.ObjectInfo{
+				Path:         aws.ToString(object.Key),
+				Size:         object.Size,
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
 	}
 	return ret, nil
 }
 
-// DownloadObject downloads the object with path.
-// Defaults to multipart download with chunk size 5MB.
-func (c *Client) DownloadObject(ctx context.Context, path string, w io.WriterAt) (int64, error) {
-	downloader := manager.NewDownloader(c.c)
-	return downloader.Download(ctx, w, &s3.GetObjectInput{
+// Download returns a reader of the object with path.
+func (c *Client) Download(ctx context.Context, path string) (io.ReadCloser, error) {
+	output, err := c.c.GetObject(ctx, &s3.GetObjectInput{
 		Bucket: &c.bucket,
 		Key:    &path,
 	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get S3 object %q", path)
+	}
+	return output.Body, nil
 }
 
-// UploadObject uploads an object with the path.
+// Upload uploads an object with the path.
 // Defaults to multipart upload with chunk size 5MB.
-func (c *Client) UploadObject(ctx context.Context, path string, body io.Reader) (*manager.UploadOutput, error) {
+func (c *Client) Upload(ctx context.Context, path string, body io.Reader) error {
 	uploader := manager.NewUploader(c.c)
-	return uploader.Upload(ctx, &s3.PutObjectInput{
+	if _, err := uploader.Upload(ctx, &s3.PutObjectInput{
 		Bucket:            &c.bucket,
 		Key:               &path,
 		Body:              body,
 		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
-	})
+	}); err != nil {
+		return errors.Wrapf(err, "failed to upload S3 object %q", path)
+	}
+	return nil
 }
 
-// DeleteObjects deletes the objects with path.
-func (c *Client) DeleteObjects(ctx context.Context, pathList ...string) (*s3.DeleteObjectsOutput, error) {
+// Delete deletes the objects with path.
+func (c *Client) Delete(ctx context.Context, pathList ...string) error {
+	if len(pathList) == 0 {
+		return nil
+	}
 	var oidList []types.ObjectIdentifier
 	for _, path := range pathList {
 		path := path // create a new 'path'.
 		oidList = append(oidList, types.ObjectIdentifier{Key: &path})
 	}
-	return c.c.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+	if _, err := c.c.DeleteObjects(ctx, &s3.DeleteObjectsInput{
 		Bucket: &c.bucket,
 		Delete: &types.Delete{Objects: oidList},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to delete S3 objects %v", pathList)
+	}
+	return nil
+}
+
+// Stat returns the information of the object with path.
+func (c *Client) Stat(ctx context.Context, path string) (*storage.ObjectInfo, error) {
+	output, err := c.c.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: &c.bucket,
+		Key:    &path,
 	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get S3 object %q", path)
+	}
+	return &storage.ObjectInfo{
+		Path:         path,
+		Size:         output.ContentLength,
+		LastModified: aws.ToTime(output.LastModified),
+	}, nil
 }
 
 // GetBucket returns the bucket.
 func (c *Client) GetBucket() string {
 	return c.bucket
 }
-
-// DownloadFileFromCloud downloads a binlog or metadata file from the cloud storage.
-// In case of network errors which will get partially downloaded files, we first download to a temporary file.
-// After that, we then rename it to the target file path.
-func (c *Client) DownloadFileFromCloud(ctx context.Context, filePathLocal, filePathOnCloud string) error {
-	filePathTemp := filePathLocal + ".tmp"
-	fileTemp, err := os.Create(filePathTemp)
-	if err != nil {
-		return errors.Wrapf(err, "failed to create the local temporary file %s", filePathTemp)
-	}
-	if _, err := c.DownloadObject(ctx, filePathOnCloud, fileTemp); err != nil {
-		return errors.Wrapf(err, "failed to download file %q from the cloud storage", filePathOnCloud)
-	}
-	if err := os.Rename(filePathTemp, filePathLocal); err != nil {
-		return errors.Wrapf(err, "failed to rename %q to %q", filePathTemp, filePathLocal)
-	}
-	return nil
-}
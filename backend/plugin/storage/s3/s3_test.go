@@ -3,6 +3,7 @@ package s3
 import (
 	"bytes"
 	"context"
+	"io"
 	"log/slog"
 	"os"
 	"testing"
@@ -29,36 +30,38 @@ func TestS3Operations(t *testing.T) {
 	t.Skip()
 	a := require.New(t)
 	ctx := context.Background()
-	client, err := NewClient(ctx, region, bucket, credentials)
+	client, err := NewClient(ctx, region, bucket, "" /* endpoint */, credentials)
 	a.NoError(err)
 
 	t.Run("ListObjects", func(t *testing.T) {
-		list, err := client.ListObjects(ctx, "backup/")
+		list, err := client.List(ctx, "backup/")
 		a.NoError(err)
 		for _, obj := range list {
-			slog.Info("Object", slog.String("Key", *obj.Key), slog.Time("LastModified", *obj.LastModified))
+			slog.Info("Object", slog.String("Key", obj.Path), slog.Time("LastModified", obj.LastModified))
 		}
 	})
 
 	t.Run("UploadObjects", func(t *testing.T) {
 		buf := make([]byte, 10*1024*1024)
 		blob := bytes.NewReader(buf)
-		resp, err := client.UploadObject(ctx, "backup/test/blob", blob)
+		err := client.Upload(ctx, "backup/test/blob", blob)
 		a.NoError(err)
-		slog.Info("Uploaded", slog.String("name", *resp.Key))
+		info, err := client.Stat(ctx, "backup/test/blob")
+		a.NoError(err)
+		slog.Info("Uploaded", slog.String("name", info.Path), slog.Int64("size", info.Size))
 	})
 
 	t.Run("DownloadObjects", func(t *testing.T) {
-		file, err := os.CreateTemp(t.TempDir(), "blob")
+		reader, err := client.Download(ctx, "backup/test/blob")
 		a.NoError(err)
-		n, err := client.DownloadObject(ctx, "backup/test/blob", file)
+		defer reader.Close()
+		n, err := io.Copy(io.Discard, reader)
 		a.NoError(err)
 		slog.Info("Downloaded", slog.Int64("length", n))
 	})
 
 	t.Run("DeleteObjects", func(t *testing.T) {
-		resp, err := client.DeleteObjects(ctx, "backup/test/blob")
+		err := client.Delete(ctx, "backup/test/blob")
 		a.NoError(err)
-		slog.Info("Deleted", slog.Any("meta", resp.ResultMetadata))
 	})
 }
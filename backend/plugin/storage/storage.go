@@ -0,0 +1,89 @@
+// Package storage provides the interface for the backup storage backends.
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+// ObjectInfo is the information of an object in the storage backend.
+type ObjectInfo struct {
+	// Path is the path of the object relative to the root of the storage backend.
+	Path         string
+	Size         int64
+	LastModified time.Time
+}
+
+// Backend is the interface of a backup storage backend.
+// The paths are relative to the root of the backend, e.g., the bucket for cloud storages, and always use "/" as the separator.
+type Backend interface {
+	// Upload uploads the content of the reader to path, overwriting the existing object.
+	Upload(ctx context.Context, path string, body io.Reader) error
+	// Download returns a reader of the object at path. The caller must close the reader.
+	Download(ctx context.Context, path string) (io.ReadCloser, error)
+	// List lists the objects whose paths start with prefix.
+	List(ctx context.Context, prefix string) ([]*ObjectInfo, error)
+	// Delete deletes the objects at the paths. Deleting a non-existent object is not an error.
+	Delete(ctx context.Context, paths ...string) error
+	// Stat returns the information of the object at path.
+	Stat(ctx context.Context, path string) (*ObjectInfo, error)
+}
+
+// Backends are the configured backup storage backends keyed by the storage backend type.
+type Backends map[api.BackupStorageBackend]Backend
+
+// Get returns the backend of the storage backend type.
+func (b Backends) Get(storageBackend api.BackupStorageBackend) (Backend, error) {
+	backend, ok := b[storageBackend]
+	if !ok {
+		return nil, errors.Errorf("backup storage backend %s is not configured", storageBackend)
+	}
+	return backend, nil
+}
+
+// DownloadFile downloads the object at path to the local file.
+// In case of network errors which will get partially downloaded files, we first download to a temporary file.
+// After that, we then rename it to the target file path.
+func DownloadFile(ctx context.Context, backend Backend, localPath, path string) error {
+	reader, err := backend.Download(ctx, path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download file %q from the storage backend", path)
+	}
+	defer reader.Close()
+
+	tempPath := localPath + ".tmp"
+	tempFile, err := os.Create(tempPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create the local temporary file %s", tempPath)
+	}
+	if _, err := io.Copy(tempFile, reader); err != nil {
+		tempFile.Close()
+		return errors.Wrapf(err, "failed to download file %q from the storage backend", path)
+	}
+	if err := tempFile.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close the local temporary file %s", tempPath)
+	}
+	if err := os.Rename(tempPath, localPath); err != nil {
+		return errors.Wrapf(err, "failed to rename %q to %q", tempPath, localPath)
+	}
+	return nil
+}
+
+// UploadFile uploads the local file to path.
+func UploadFile(ctx context.Context, backend Backend, path, localPath string) error {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open file %s", localPath)
+	}
+	defer file.Close()
+	if err := backend.Upload(ctx, path, file); err != nil {
+		return errors.Wrapf(err, "failed to upload file %s to %q", localPath, path)
+	}
+	return nil
+}
@@ -0,0 +1,798 @@
+// Package git is the plugin for plain Git servers over HTTPS or SSH.
+//
+// Unlike the other providers, it doesn't talk to any hosting API. It keeps a local bare mirror of
+// the repository and runs the git command line against the mirror. The repository ID is the clone URL,
+// e.g. https://git.example.com/db/schema.git or git@git.example.com:db/schema.git.
+//
+// Since there is no API to register a webhook, the Git server is expected to call
+// /hook/git/{webhookEndpointID} from a post-receive hook with the WebhookPushEvent payload,
+// signed by the HMAC SHA256 of the body using the version control secret in the
+// X-Bytebase-Signature-256 header.
+package git
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+func init() {
+	vcs.Register(vcs.Git, newProvider)
+}
+
+const (
+	// SignatureHeader is the header of the HMAC SHA256 signature of the webhook payload.
+	SignatureHeader = "X-Bytebase-Signature-256"
+
+	// emptyCommitID is the commit ID of the "before" commit when a branch is created.
+	emptyCommitID = "0000000000000000000000000000000000000000"
+	// mirrorDirName is the directory under the data directory holding the local mirrors.
+	mirrorDirName = "git-mirror"
+)
+
+var (
+	_ vcs.Provider = (*Provider)(nil)
+
+	// mirrorMu guards mirrorLocks.
+	mirrorMu sync.Mutex
+	// mirrorLocks serializes the git commands on the same mirror.
+	mirrorLocks = make(map[string]*sync.Mutex)
+	// defaultMirrorDir is the directory holding the local mirrors, set by Init.
+	defaultMirrorDir string
+
+	// allowedProtocols are the transports git may use, so that it never runs the ext:: helper or reads local files.
+	// It is replaced in tests to fetch from the local repositories.
+	allowedProtocols = "https:ssh"
+	// scpLikeURLRegex matches the scp-like syntax of SSH, e.g., git@git.example.com:db/schema.git.
+	scpLikeURLRegex = regexp.MustCompile(`^(?:[A-Za-z0-9_][A-Za-z0-9._-]*@)?[A-Za-z0-9][A-Za-z0-9.-]*:[^:]`)
+)
+
+// Init creates the directory under the data directory holding the local mirrors.
+// The mirrors contain the repository content and are only accessible by the server process.
+func Init(dataDir string) error {
+	dir := filepath.Join(dataDir, mirrorDirName)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Wrapf(err, "failed to create git mirror directory %q", dir)
+	}
+	// Tighten the permission of the directory created by the previous versions.
+	if err := os.Chmod(dir, 0700); err != nil {
+		return errors.Wrapf(err, "failed to change the permission of git mirror directory %q", dir)
+	}
+	defaultMirrorDir = dir
+	return nil
+}
+
+// Provider is a plain Git VCS provider.
+type Provider struct {
+	// mirrorDir is the directory holding the local mirrors.
+	mirrorDir string
+}
+
+func newProvider(vcs.ProviderConfig) vcs.Provider {
+	return &Provider{
+		mirrorDir: defaultMirrorDir,
+	}
+}
+
+// WebhookPushEvent is the payload sent by the post-receive hook of the Git server.
+type WebhookPushEvent struct {
+	// Ref is the full name of the pushed ref, e.g. refs/heads/main.
+	Ref string `json:"ref"`
+	// Before is the commit ID before the push, all zeros for a new branch.
+	Before string `json:"before"`
+	// After is the commit ID after the push.
+	After      string            `json:"after"`
+	Repository WebhookRepository `json:"repository"`
+	// Pusher is the name of the user who pushed the commits.
+	Pusher string `json:"pusher"`
+}
+
+// WebhookRepository is the repository in the push event.
+type WebhookRepository struct {
+	// URL is the clone URL of the repository, which must match the repository ID linked in Bytebase.
+	URL string `json:"url"`
+	// WebURL is the optional URL to browse the repository.
+	WebURL string `json:"webUrl"`
+}
+
+// APIURL returns the instance URL as is since there is no API.
+func (*Provider) APIURL(instanceURL string) string {
+	return instanceURL
+}
+
+// ExchangeOAuthToken is not supported since plain Git servers authenticate with the access token directly.
+func (*Provider) ExchangeOAuthToken(context.Context, string, *common.OAuthExchange) (*vcs.OAuthToken, error) {
+	return nil, errors.New("not supported")
+}
+
+// FetchCommitByID fetches the commit data by its ID.
+func (p *Provider) FetchCommitByID(ctx context.Context, oauthCtx common.OauthContext, _, repositoryID, commitID string) (*vcs.Commit, error) {
+	m, unlock, err := p.open(ctx, oauthCtx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err := m.ensureCommit(ctx, commitID); err != nil {
+		return nil, err
+	}
+	return m.commit(ctx, commitID)
+}
+
+// GetDiffFileList gets the diff files list between two commits.
+func (p *Provider) GetDiffFileList(ctx context.Context, oauthCtx common.OauthContext, _, repositoryID, beforeCommit, afterCommit string) ([]vcs.FileDiff, error) {
+	m, unlock, err := p.open(ctx, oauthCtx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err := m.ensureCommit(ctx, afterCommit); err != nil {
+		return nil, err
+	}
+	if beforeCommit != "" {
+		if err := checkCommitID(beforeCommit); err != nil {
+			return nil, err
+		}
+	}
+	return m.diff(ctx, beforeCommit, afterCommit)
+}
+
+// ListCommits lists the commits pushed between the before and after commits, from the oldest to the newest.
+// If the push creates a new branch, only the head commit is returned.
+func ListCommits(ctx context.Context, oauthCtx common.OauthContext, repositoryID, beforeCommit, afterCommit string) ([]*vcs.Commit, error) {
+	p := newProvider(vcs.ProviderConfig{}).(*Provider)
+	m, unlock, err := p.open(ctx, oauthCtx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err := m.ensureCommit(ctx, afterCommit); err != nil {
+		return nil, err
+	}
+
+	commitIDs := []string{afterCommit}
+	if beforeCommit != "" && beforeCommit != emptyCommitID {
+		if err := checkCommitID(beforeCommit); err != nil {
+			return nil, err
+		}
+		out, err := m.run(ctx, nil, "rev-list", "--reverse", fmt.Sprintf("%s..%s", beforeCommit, afterCommit), "--")
+		if err != nil {
+			return nil, err
+		}
+		commitIDs = strings.Fields(out)
+	}
+	var commits []*vcs.Commit
+	for _, id := range commitIDs {
+		commit, err := m.commit(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, commit)
+	}
+	return commits, nil
+}
+
+// FetchAllRepositoryList is not supported since a plain Git server cannot list the repositories.
+func (*Provider) FetchAllRepositoryList(context.Context, common.OauthContext, string) ([]*vcs.Repository, error) {
+	return nil, errors.New("not supported")
+}
+
+// FetchRepositoryFileList fetches the files under the path recursively.
+func (p *Provider) FetchRepositoryFileList(ctx context.Context, oauthCtx common.OauthContext, _, repositoryID, ref, filePath string) ([]*vcs.RepositoryTreeNode, error) {
+	m, unlock, err := p.open(ctx, oauthCtx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err := m.fetch(ctx); err != nil {
+		return nil, err
+	}
+
+	revision, err := resolveRef(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	args := []string{"ls-tree", "-r", "-z", revision}
+	if filePath != "" {
+		if err := checkFilePath(filePath); err != nil {
+			return nil, err
+		}
+		args = append(args, "--", filePath)
+	}
+	out, err := m.run(ctx, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*vcs.RepositoryTreeNode
+	for _, line := range strings.Split(out, "\x00") {
+		// <mode> SP <type> SP <object> TAB <file>
+		info, path, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(info)
+		if len(fields) != 3 || fields[1] != "blob" {
+			continue
+		}
+		nodes = append(nodes, &vcs.RepositoryTreeNode{
+			Path: path,
+			Type: fields[1],
+		})
+	}
+	return nodes, nil
+}
+
+// CreateFile commits a new file to the branch.
+func (p *Provider) CreateFile(ctx context.Context, oauthCtx common.OauthContext, _, repositoryID, filePath string, fileCommit vcs.FileCommitCreate) error {
+	return p.writeFile(ctx, oauthCtx, repositoryID, filePath, fileCommit, false /* overwrite */)
+}
+
+// OverwriteFile commits the new content of an existing file to the branch.
+func (p *Provider) OverwriteFile(ctx context.Context, oauthCtx common.OauthContext, _, repositoryID, filePath string, fileCommit vcs.FileCommitCreate) error {
+	return p.writeFile(ctx, oauthCtx, repositoryID, filePath, fileCommit, true /* overwrite */)
+}
+
+// ReadFileMeta reads the file metadata.
+func (p *Provider) ReadFileMeta(ctx context.Context, oauthCtx common.OauthContext, _, repositoryID, filePath string, refInfo vcs.RefInfo) (*vcs.FileMeta, error) {
+	if err := checkFilePath(filePath); err != nil {
+		return nil, err
+	}
+	m, unlock, err := p.open(ctx, oauthCtx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	ref, err := m.resolveRefInfo(ctx, refInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	object := fmt.Sprintf("%s:%s", ref, filePath)
+	sha, err := m.run(ctx, nil, "rev-parse", "--verify", "--quiet", object)
+	if err != nil {
+		return nil, common.Errorf(common.NotFound, "file %q not found in %q", filePath, ref)
+	}
+	size, err := m.run(ctx, nil, "cat-file", "-s", object)
+	if err != nil {
+		return nil, err
+	}
+	sizeBytes, err := strconv.ParseInt(strings.TrimSpace(size), 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse size of file %q", filePath)
+	}
+	lastCommitID, err := m.run(ctx, nil, "log", "-1", "--format=%H", ref, "--", filePath)
+	if err != nil {
+		return nil, err
+	}
+	return &vcs.FileMeta{
+		Name:         filepath.Base(filePath),
+		Path:         filePath,
+		Size:         sizeBytes,
+		LastCommitID: strings.TrimSpace(lastCommitID),
+		SHA:          strings.TrimSpace(sha),
+	}, nil
+}
+
+// ReadFileContent reads the file content.
+func (p *Provider) ReadFileContent(ctx context.Context, oauthCtx common.OauthContext, _, repositoryID, filePath string, refInfo vcs.RefInfo) (string, error) {
+	if err := checkFilePath(filePath); err != nil {
+		return "", err
+	}
+	m, unlock, err := p.open(ctx, oauthCtx, repositoryID)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+	ref, err := m.resolveRefInfo(ctx, refInfo)
+	if err != nil {
+		return "", err
+	}
+
+	object := fmt.Sprintf("%s:%s", ref, filePath)
+	if _, err := m.run(ctx, nil, "rev-parse", "--verify", "--quiet", object); err != nil {
+		return "", common.Errorf(common.NotFound, "file %q not found in %q", filePath, ref)
+	}
+	return m.run(ctx, nil, "cat-file", "blob", object)
+}
+
+// GetBranch gets the given branch in the repository.
+func (p *Provider) GetBranch(ctx context.Context, oauthCtx common.OauthContext, _, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	m, unlock, err := p.open(ctx, oauthCtx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err := checkRefName(ctx, "refs/heads/", branchName); err != nil {
+		return nil, err
+	}
+	if err := m.fetch(ctx); err != nil {
+		return nil, err
+	}
+
+	commitID, err := m.run(ctx, nil, "rev-parse", "--verify", "--quiet", "refs/heads/"+branchName)
+	if err != nil {
+		return nil, common.Errorf(common.NotFound, "branch %q not found in repository %q", branchName, repositoryID)
+	}
+	return &vcs.BranchInfo{
+		Name:         branchName,
+		LastCommitID: strings.TrimSpace(commitID),
+	}, nil
+}
+
+// CreateBranch creates the branch pointing to the last commit in the repository.
+func (p *Provider) CreateBranch(ctx context.Context, oauthCtx common.OauthContext, _, repositoryID string, branch *vcs.BranchInfo) error {
+	m, unlock, err := p.open(ctx, oauthCtx, repositoryID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := checkRefName(ctx, "refs/heads/", branch.Name); err != nil {
+		return err
+	}
+	if err := m.fetch(ctx); err != nil {
+		return err
+	}
+	ref := "refs/heads/" + branch.Name
+	if _, err := m.run(ctx, nil, "rev-parse", "--verify", "--quiet", ref); err == nil {
+		return common.Errorf(common.Conflict, "branch %q already exists in repository %q", branch.Name, repositoryID)
+	}
+	if err := m.ensureCommit(ctx, branch.LastCommitID); err != nil {
+		return err
+	}
+	// The empty lease rejects the push if the branch is created by someone else in the meantime.
+	if _, err := m.run(ctx, nil, "push", "--force-with-lease="+ref+":", m.url, fmt.Sprintf("%s:%s", branch.LastCommitID, ref)); err != nil {
+		return errors.Wrapf(err, "failed to create branch %q", branch.Name)
+	}
+	return m.fetch(ctx)
+}
+
+// ListPullRequestFile is not supported since a plain Git server has no pull requests.
+func (*Provider) ListPullRequestFile(context.Context, common.OauthContext, string, string, string) ([]*vcs.PullRequestFile, error) {
+	return nil, errors.New("not supported")
+}
+
+// CreatePullRequest is not supported since a plain Git server has no pull requests.
+func (*Provider) CreatePullRequest(context.Context, common.OauthContext, string, string, *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	return nil, errors.New("not supported")
+}
+
+// UpsertEnvironmentVariable is not supported since a plain Git server has no CI variables.
+func (*Provider) UpsertEnvironmentVariable(context.Context, common.OauthContext, string, string, string, string) error {
+	return errors.New("not supported")
+}
+
+// CreateWebhook returns an empty webhook ID since the post-receive hook is set up on the Git server by the administrator.
+func (*Provider) CreateWebhook(context.Context, common.OauthContext, string, string, []byte) (string, error) {
+	return "", nil
+}
+
+// PatchWebhook is a no-op since the post-receive hook is managed on the Git server.
+func (*Provider) PatchWebhook(context.Context, common.OauthContext, string, string, string, []byte) error {
+	return nil
+}
+
+// DeleteWebhook is a no-op since the post-receive hook is managed on the Git server.
+func (*Provider) DeleteWebhook(context.Context, common.OauthContext, string, string, string) error {
+	return nil
+}
+
+func (p *Provider) writeFile(ctx context.Context, oauthCtx common.OauthContext, repositoryID, filePath string, fileCommit vcs.FileCommitCreate, overwrite bool) error {
+	if err := checkFilePath(filePath); err != nil {
+		return err
+	}
+	m, unlock, err := p.open(ctx, oauthCtx, repositoryID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := checkRefName(ctx, "refs/heads/", fileCommit.Branch); err != nil {
+		return err
+	}
+	if err := m.fetch(ctx); err != nil {
+		return err
+	}
+
+	ref := "refs/heads/" + fileCommit.Branch
+	parent, err := m.run(ctx, nil, "rev-parse", "--verify", "--quiet", ref)
+	if err != nil {
+		return common.Errorf(common.NotFound, "branch %q not found in repository %q", fileCommit.Branch, repositoryID)
+	}
+	parent = strings.TrimSpace(parent)
+	_, existErr := m.run(ctx, nil, "rev-parse", "--verify", "--quiet", fmt.Sprintf("%s:%s", parent, filePath))
+	if overwrite && existErr != nil {
+		return common.Errorf(common.NotFound, "file %q not found in branch %q", filePath, fileCommit.Branch)
+	}
+	if !overwrite && existErr == nil {
+		return common.Errorf(common.Conflict, "file %q already exists in branch %q", filePath, fileCommit.Branch)
+	}
+
+	blob, err := m.run(ctx, strings.NewReader(fileCommit.Content), "hash-object", "-w", "--stdin")
+	if err != nil {
+		return err
+	}
+	// Build the tree in a temporary index so that concurrent writes never share the state.
+	index, err := os.CreateTemp("", "bytebase-git-index-*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary index")
+	}
+	indexPath := index.Name()
+	index.Close()
+	// git refuses to read an empty index file.
+	os.Remove(indexPath)
+	defer os.Remove(indexPath)
+	indexEnv := []string{"GIT_INDEX_FILE=" + indexPath}
+	if _, err := m.runWithEnv(ctx, indexEnv, nil, "read-tree", parent); err != nil {
+		return err
+	}
+	if _, err := m.runWithEnv(ctx, indexEnv, nil, "update-index", "--add", "--cacheinfo", fmt.Sprintf("100644,%s,%s", strings.TrimSpace(blob), filePath)); err != nil {
+		return err
+	}
+	tree, err := m.runWithEnv(ctx, indexEnv, nil, "write-tree")
+	if err != nil {
+		return err
+	}
+
+	authorName, authorEmail := fileCommit.AuthorName, fileCommit.AuthorEmail
+	if authorName == "" {
+		authorName, authorEmail = vcs.BytebaseAuthorName, vcs.BytebaseAuthorEmail
+	}
+	authorEnv := []string{
+		"GIT_AUTHOR_NAME=" + authorName,
+		"GIT_AUTHOR_EMAIL=" + authorEmail,
+		"GIT_COMMITTER_NAME=" + authorName,
+		"GIT_COMMITTER_EMAIL=" + authorEmail,
+	}
+	commitID, err := m.runWithEnv(ctx, authorEnv, strings.NewReader(fileCommit.CommitMessage), "commit-tree", strings.TrimSpace(tree), "-p", parent)
+	if err != nil {
+		return err
+	}
+	commitID = strings.TrimSpace(commitID)
+
+	// The lease rejects the push if someone else has updated the branch in the meantime.
+	if _, err := m.run(ctx, nil, "push", fmt.Sprintf("--force-with-lease=%s:%s", ref, parent), m.url, fmt.Sprintf("%s:%s", commitID, ref)); err != nil {
+		return errors.Wrapf(err, "failed to push file %q to branch %q", filePath, fileCommit.Branch)
+	}
+	return m.fetch(ctx)
+}
+
+// mirror is a local bare mirror of the remote repository.
+type mirror struct {
+	url string
+	dir string
+	env []string
+}
+
+// open opens the mirror of the repository, cloning it on first use.
+// The returned unlock function must be called once done with the mirror.
+func (p *Provider) open(ctx context.Context, oauthCtx common.OauthContext, repositoryURL string) (*mirror, func(), error) {
+	if err := checkRepositoryURL(repositoryURL); err != nil {
+		return nil, nil, err
+	}
+	if p.mirrorDir == "" {
+		return nil, nil, errors.New("git mirror directory is not initialized")
+	}
+	hash := sha256.Sum256([]byte(repositoryURL))
+	m := &mirror{
+		url: repositoryURL,
+		dir: filepath.Join(p.mirrorDir, hex.EncodeToString(hash[:])),
+		env: authEnv(repositoryURL, oauthCtx.AccessToken),
+	}
+
+	mirrorMu.Lock()
+	mu, ok := mirrorLocks[m.dir]
+	if !ok {
+		mu = &sync.Mutex{}
+		mirrorLocks[m.dir] = mu
+	}
+	mirrorMu.Unlock()
+	mu.Lock()
+
+	if _, err := os.Stat(filepath.Join(m.dir, "HEAD")); err != nil {
+		if err := m.init(ctx); err != nil {
+			mu.Unlock()
+			return nil, nil, err
+		}
+	}
+	return m, mu.Unlock, nil
+}
+
+func (m *mirror) init(ctx context.Context) error {
+	if err := os.RemoveAll(m.dir); err != nil {
+		return errors.Wrapf(err, "failed to clean up mirror directory %q", m.dir)
+	}
+	if err := os.MkdirAll(m.dir, 0700); err != nil {
+		return errors.Wrapf(err, "failed to create mirror directory %q", m.dir)
+	}
+	if _, err := m.run(ctx, nil, "init", "--bare", "--quiet"); err != nil {
+		return err
+	}
+	// Only fetch the branches and tags, pushing is done to the URL with explicit refspecs.
+	if _, err := m.run(ctx, nil, "config", "remote.origin.url", m.url); err != nil {
+		return err
+	}
+	if _, err := m.run(ctx, nil, "config", "--add", "remote.origin.fetch", "+refs/heads/*:refs/heads/*"); err != nil {
+		return err
+	}
+	if _, err := m.run(ctx, nil, "config", "--add", "remote.origin.fetch", "+refs/tags/*:refs/tags/*"); err != nil {
+		return err
+	}
+	return m.fetch(ctx)
+}
+
+func (m *mirror) fetch(ctx context.Context) error {
+	if _, err := m.run(ctx, nil, "fetch", "--prune", "--quiet", "origin"); err != nil {
+		return errors.Wrapf(err, "failed to fetch repository %q", m.url)
+	}
+	return nil
+}
+
+// ensureCommit fetches the repository if the commit is not in the mirror yet.
+func (m *mirror) ensureCommit(ctx context.Context, commitID string) error {
+	if err := checkCommitID(commitID); err != nil {
+		return err
+	}
+	if _, err := m.run(ctx, nil, "cat-file", "-e", commitID+"^{commit}"); err == nil {
+		return nil
+	}
+	if err := m.fetch(ctx); err != nil {
+		return err
+	}
+	if _, err := m.run(ctx, nil, "cat-file", "-e", commitID+"^{commit}"); err != nil {
+		return common.Errorf(common.NotFound, "commit %q not found in repository %q", commitID, m.url)
+	}
+	return nil
+}
+
+// resolveRefInfo returns the revision for the ref, making sure it's up to date.
+func (m *mirror) resolveRefInfo(ctx context.Context, refInfo vcs.RefInfo) (string, error) {
+	switch refInfo.RefType {
+	case vcs.RefTypeCommit:
+		if err := m.ensureCommit(ctx, refInfo.RefName); err != nil {
+			return "", err
+		}
+		return refInfo.RefName, nil
+	case vcs.RefTypeTag:
+		if err := checkRefName(ctx, "refs/tags/", refInfo.RefName); err != nil {
+			return "", err
+		}
+		if err := m.fetch(ctx); err != nil {
+			return "", err
+		}
+		return "refs/tags/" + refInfo.RefName, nil
+	default:
+		ref, err := resolveRef(ctx, refInfo.RefName)
+		if err != nil {
+			return "", err
+		}
+		if err := m.fetch(ctx); err != nil {
+			return "", err
+		}
+		return ref, nil
+	}
+}
+
+func (m *mirror) commit(ctx context.Context, commitID string) (*vcs.Commit, error) {
+	out, err := m.run(ctx, nil, "show", "-s", "--format=%H%x00%an%x00%ae%x00%ct%x00%B", commitID, "--")
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.SplitN(out, "\x00", 5)
+	if len(fields) != 5 {
+		return nil, errors.Errorf("unexpected commit format %q", out)
+	}
+	createdTs, err := strconv.ParseInt(fields[3], 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse commit time %q", fields[3])
+	}
+	message := strings.TrimRight(fields[4], "\n")
+	// Per Git convention, the message title and body are separated by two new line characters.
+	title, _, _ := strings.Cut(message, "\n\n")
+
+	// Compare with the first parent, or the empty tree for the root commit.
+	before := emptyCommitID
+	if parent, err := m.run(ctx, nil, "rev-parse", "--verify", "--quiet", commitID+"^1"); err == nil {
+		before = strings.TrimSpace(parent)
+	}
+	fileDiffs, err := m.diff(ctx, before, commitID)
+	if err != nil {
+		return nil, err
+	}
+	commit := &vcs.Commit{
+		ID:          fields[0],
+		Title:       title,
+		Message:     message,
+		CreatedTs:   createdTs,
+		AuthorName:  fields[1],
+		AuthorEmail: fields[2],
+	}
+	for _, fileDiff := range fileDiffs {
+		switch fileDiff.Type {
+		case vcs.FileDiffTypeAdded:
+			commit.AddedList = append(commit.AddedList, fileDiff.Path)
+		case vcs.FileDiffTypeModified:
+			commit.ModifiedList = append(commit.ModifiedList, fileDiff.Path)
+		}
+	}
+	return commit, nil
+}
+
+func (m *mirror) diff(ctx context.Context, beforeCommit, afterCommit string) ([]vcs.FileDiff, error) {
+	args := []string{"diff-tree", "-r", "-z", "--no-commit-id", "--no-renames", "--name-status"}
+	if beforeCommit == "" || beforeCommit == emptyCommitID {
+		args = append(args, "--root", afterCommit, "--")
+	} else {
+		args = append(args, beforeCommit, afterCommit, "--")
+	}
+	out, err := m.run(ctx, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	return parseNameStatus(out), nil
+}
+
+// parseNameStatus parses the NUL separated output of `git diff-tree --name-status -z`,
+// which is the sequence of status and path pairs.
+func parseNameStatus(out string) []vcs.FileDiff {
+	fields := strings.Split(strings.TrimRight(out, "\x00"), "\x00")
+	var fileDiffs []vcs.FileDiff
+	for i := 0; i+1 < len(fields); i += 2 {
+		status, path := fields[i], fields[i+1]
+		fileDiff := vcs.FileDiff{Path: path}
+		switch status {
+		case "A":
+			fileDiff.Type = vcs.FileDiffTypeAdded
+		case "M", "T":
+			fileDiff.Type = vcs.FileDiffTypeModified
+		case "D":
+			fileDiff.Type = vcs.FileDiffTypeRemoved
+		default:
+			fileDiff.Type = vcs.FileDiffTypeUnknown
+		}
+		fileDiffs = append(fileDiffs, fileDiff)
+	}
+	return fileDiffs
+}
+
+func (m *mirror) run(ctx context.Context, stdin *strings.Reader, args ...string) (string, error) {
+	return m.runWithEnv(ctx, nil, stdin, args...)
+}
+
+func (m *mirror) runWithEnv(ctx context.Context, env []string, stdin *strings.Reader, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"--git-dir", m.dir}, args...)...)
+	cmd.Env = append(append(os.Environ(), m.env...), env...)
+	if stdin != nil {
+		cmd.Stdin = stdin
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", errors.Wrapf(err, "git %s: %s", args[0], strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// authEnv returns the environment variables to authenticate to the repository.
+// For HTTPS, the access token is sent in the Authorization header with the basic scheme. The token can be
+// "username:password", otherwise "oauth2" is used as the username which is accepted by most Git servers.
+// The header is passed by the environment instead of the command line or the config to avoid leaking the token.
+// For SSH, the keys of the server process are used, e.g. by GIT_SSH_COMMAND.
+func authEnv(repositoryURL, accessToken string) []string {
+	env := []string{"GIT_TERMINAL_PROMPT=0", "GIT_ALLOW_PROTOCOL=" + allowedProtocols}
+	if accessToken == "" || !strings.HasPrefix(repositoryURL, "https://") {
+		return env
+	}
+	credential := accessToken
+	if !strings.Contains(credential, ":") {
+		credential = "oauth2:" + credential
+	}
+	return append(env,
+		"GIT_CONFIG_COUNT=1",
+		"GIT_CONFIG_KEY_0=http.extraHeader",
+		"GIT_CONFIG_VALUE_0=Authorization: Basic "+base64.StdEncoding.EncodeToString([]byte(credential)),
+	)
+}
+
+// resolveRef returns the full ref name for the branch name, leaving commit IDs and full ref names as is.
+func resolveRef(ctx context.Context, ref string) (string, error) {
+	if isCommitID(ref) {
+		return ref, nil
+	}
+	if strings.HasPrefix(ref, "refs/") {
+		return ref, checkRefName(ctx, "", ref)
+	}
+	return "refs/heads/" + ref, checkRefName(ctx, "refs/heads/", ref)
+}
+
+// checkRepositoryURL only accepts the HTTPS, SSH and scp-like SSH URLs, so that git never reads the URL as an option,
+// runs a transport helper or clones a local path.
+func checkRepositoryURL(repositoryURL string) error {
+	if repositoryURL == "" {
+		return common.Errorf(common.Invalid, "empty repository URL")
+	}
+	if strings.HasPrefix(repositoryURL, "-") || strings.ContainsFunc(repositoryURL, func(r rune) bool {
+		return r <= ' ' || r == 0x7f
+	}) {
+		return common.Errorf(common.Invalid, "invalid repository URL %q", repositoryURL)
+	}
+	if strings.Contains(allowedProtocols, "file") && filepath.IsAbs(repositoryURL) {
+		return nil
+	}
+	if !strings.Contains(repositoryURL, "://") {
+		if !scpLikeURLRegex.MatchString(repositoryURL) {
+			return common.Errorf(common.Invalid, "invalid repository URL %q, should be an HTTPS or SSH URL", repositoryURL)
+		}
+		return nil
+	}
+	u, err := url.Parse(repositoryURL)
+	if err != nil {
+		return common.Wrapf(err, common.Invalid, "invalid repository URL %q", repositoryURL)
+	}
+	if u.Scheme != "https" && u.Scheme != "ssh" {
+		return common.Errorf(common.Invalid, "invalid repository URL %q, only HTTPS and SSH are supported", repositoryURL)
+	}
+	// The host is passed to ssh as an argument.
+	if u.Hostname() == "" || strings.HasPrefix(u.Hostname(), "-") || (u.User != nil && strings.HasPrefix(u.User.Username(), "-")) {
+		return common.Errorf(common.Invalid, "invalid repository URL %q", repositoryURL)
+	}
+	return nil
+}
+
+// checkFilePath rejects the file paths escaping the repository or read as an option,
+// since they are also passed in the <rev>:<path> and --cacheinfo arguments.
+func checkFilePath(filePath string) error {
+	if filePath == "" || strings.HasPrefix(filePath, "-") || path.IsAbs(filePath) || strings.ContainsFunc(filePath, func(r rune) bool {
+		return r < ' ' || r == 0x7f
+	}) {
+		return common.Errorf(common.Invalid, "invalid file path %q", filePath)
+	}
+	for _, segment := range strings.Split(filePath, "/") {
+		if segment == ".." {
+			return common.Errorf(common.Invalid, "invalid file path %q", filePath)
+		}
+	}
+	return nil
+}
+
+// checkCommitID rejects anything but a full commit SHA, so that git never reads it as an option or a revision expression.
+func checkCommitID(commitID string) error {
+	if !isCommitID(commitID) {
+		return common.Errorf(common.Invalid, "invalid commit ID %q", commitID)
+	}
+	return nil
+}
+
+// checkRefName validates the branch or tag name with `git check-ref-format`, so that git never reads it as an option.
+func checkRefName(ctx context.Context, prefix, name string) error {
+	if name == "" || strings.HasPrefix(name, "-") {
+		return common.Errorf(common.Invalid, "invalid ref name %q", name)
+	}
+	if err := exec.CommandContext(ctx, "git", "check-ref-format", prefix+name).Run(); err != nil {
+		return common.Errorf(common.Invalid, "invalid ref name %q", name)
+	}
+	return nil
+}
+
+func isCommitID(s string) bool {
+	if len(s) != 40 && len(s) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -0,0 +1,135 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+// newTestRepository creates a bare repository with one commit on the main branch and returns its path.
+func newTestRepository(t *testing.T) string {
+	a := require.New(t)
+	dir := t.TempDir()
+	remote := filepath.Join(dir, "remote.git")
+	work := filepath.Join(dir, "work")
+	a.NoError(os.MkdirAll(filepath.Join(work, "migrations"), 0700))
+	a.NoError(os.WriteFile(filepath.Join(work, "migrations", "prod##db##0001##migrate##init.sql"), []byte("CREATE TABLE t(id INT);\n"), 0600))
+	for _, args := range [][]string{
+		{"init", "--bare", "--quiet", remote},
+		{"-C", work, "init", "--quiet", "--initial-branch", "main"},
+		{"-C", work, "add", "."},
+		{"-C", work, "-c", "user.name=dev", "-c", "user.email=dev@example.com", "commit", "--quiet", "-m", "Init schema"},
+		{"-C", work, "push", "--quiet", remote, "main"},
+	} {
+		out, err := exec.Command("git", args...).CombinedOutput()
+		a.NoError(err, string(out))
+	}
+	return remote
+}
+
+func TestProvider(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	a := require.New(t)
+	ctx := context.Background()
+	remote := newTestRepository(t)
+	// Allow the local test repository, which is rejected in production.
+	allowedProtocols = "https:ssh:file"
+	defer func() { allowedProtocols = "https:ssh" }()
+	p := &Provider{mirrorDir: t.TempDir()}
+	oauthCtx := common.OauthContext{}
+
+	branch, err := p.GetBranch(ctx, oauthCtx, "", remote, "main")
+	a.NoError(err)
+	a.Len(branch.LastCommitID, 40)
+	_, err = p.GetBranch(ctx, oauthCtx, "", remote, "nonexistent")
+	a.Equal(common.NotFound, common.ErrorCode(err))
+	_, err = p.GetBranch(ctx, oauthCtx, "", remote, "--upload-pack=touch")
+	a.Equal(common.Invalid, common.ErrorCode(err))
+	_, err = p.FetchRepositoryFileList(ctx, oauthCtx, "", remote, "main..HEAD", "")
+	a.Equal(common.Invalid, common.ErrorCode(err))
+	_, err = p.FetchCommitByID(ctx, oauthCtx, "", remote, "--output=/tmp/x")
+	a.Equal(common.Invalid, common.ErrorCode(err))
+	for _, filePath := range []string{"/etc/passwd", "../x.sql", "migrations/../../x.sql", "--output=/tmp/x"} {
+		_, err = p.ReadFileContent(ctx, oauthCtx, "", remote, filePath, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: "main"})
+		a.Equal(common.Invalid, common.ErrorCode(err), filePath)
+	}
+
+	nodes, err := p.FetchRepositoryFileList(ctx, oauthCtx, "", remote, "main", "migrations")
+	a.NoError(err)
+	a.Equal([]*vcs.RepositoryTreeNode{{Path: "migrations/prod##db##0001##migrate##init.sql", Type: "blob"}}, nodes)
+
+	commit, err := p.FetchCommitByID(ctx, oauthCtx, "", remote, branch.LastCommitID)
+	a.NoError(err)
+	a.Equal("Init schema", commit.Title)
+	a.Equal("dev", commit.AuthorName)
+	a.Equal([]string{"migrations/prod##db##0001##migrate##init.sql"}, commit.AddedList)
+
+	// Commit a new file and read it back.
+	a.NoError(p.CreateBranch(ctx, oauthCtx, "", remote, &vcs.BranchInfo{Name: "bytebase", LastCommitID: branch.LastCommitID}))
+	a.Equal(common.Conflict, common.ErrorCode(p.CreateBranch(ctx, oauthCtx, "", remote, &vcs.BranchInfo{Name: "bytebase", LastCommitID: branch.LastCommitID})))
+	a.NoError(p.CreateFile(ctx, oauthCtx, "", remote, "schema/db.sql", vcs.FileCommitCreate{
+		Branch:        "bytebase",
+		Content:       "CREATE TABLE t(id INT);\n",
+		CommitMessage: "Update schema",
+	}))
+	a.Equal(common.Conflict, common.ErrorCode(p.CreateFile(ctx, oauthCtx, "", remote, "schema/db.sql", vcs.FileCommitCreate{Branch: "bytebase"})))
+	a.NoError(p.OverwriteFile(ctx, oauthCtx, "", remote, "schema/db.sql", vcs.FileCommitCreate{
+		Branch:        "bytebase",
+		Content:       "CREATE TABLE t(id BIGINT);\n",
+		CommitMessage: "Update schema",
+	}))
+	content, err := p.ReadFileContent(ctx, oauthCtx, "", remote, "schema/db.sql", vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: "bytebase"})
+	a.NoError(err)
+	a.Equal("CREATE TABLE t(id BIGINT);\n", content)
+	meta, err := p.ReadFileMeta(ctx, oauthCtx, "", remote, "schema/db.sql", vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: "bytebase"})
+	a.NoError(err)
+	a.Equal(int64(len(content)), meta.Size)
+	_, err = p.ReadFileContent(ctx, oauthCtx, "", remote, "schema/db.sql", vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: "main"})
+	a.Equal(common.NotFound, common.ErrorCode(err))
+
+	head, err := p.GetBranch(ctx, oauthCtx, "", remote, "bytebase")
+	a.NoError(err)
+	fileDiffs, err := p.GetDiffFileList(ctx, oauthCtx, "", remote, branch.LastCommitID, head.LastCommitID)
+	a.NoError(err)
+	a.Equal([]vcs.FileDiff{{Path: "schema/db.sql", Type: vcs.FileDiffTypeAdded}}, fileDiffs)
+
+	a.NoError(Init(t.TempDir()))
+	commits, err := ListCommits(ctx, oauthCtx, remote, branch.LastCommitID, head.LastCommitID)
+	a.NoError(err)
+	a.Len(commits, 2)
+	a.Equal(vcs.BytebaseAuthorName, commits[0].AuthorName)
+	a.Equal([]string{"schema/db.sql"}, commits[0].AddedList)
+	a.Equal([]string{"schema/db.sql"}, commits[1].ModifiedList)
+}
+
+func TestCheckRepositoryURL(t *testing.T) {
+	a := require.New(t)
+	for _, repositoryURL := range []string{
+		"https://git.example.com/db/schema.git",
+		"ssh://git@git.example.com:2222/db/schema.git",
+		"git@git.example.com:db/schema.git",
+	} {
+		a.NoError(checkRepositoryURL(repositoryURL), repositoryURL)
+	}
+	for _, repositoryURL := range []string{
+		"",
+		"/srv/git/schema.git",
+		"file:///srv/git/schema.git",
+		"http://git.example.com/db/schema.git",
+		"ext::sh -c touch% /tmp/x",
+		"--upload-pack=touch /tmp/x",
+		"ssh://-oProxyCommand=touch/db/schema.git",
+		"-oProxyCommand=x@git.example.com:db/schema.git",
+	} {
+		a.Equal(common.Invalid, common.ErrorCode(checkRepositoryURL(repositoryURL)), repositoryURL)
+	}
+}
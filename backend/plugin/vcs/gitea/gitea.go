@@ -0,0 +1,930 @@
+// Package gitea is the plugin for Gitea and its fork Forgejo.
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/internal/oauth"
+)
+
+const (
+	// apiPageSize is the default page size when making API requests.
+	// Gitea limits the page size to 50 by default, see MAX_RESPONSE_ITEMS.
+	apiPageSize = 50
+	// treePageSize is the page size when listing the repository tree.
+	treePageSize = 1000
+)
+
+func init() {
+	vcs.Register(vcs.Gitea, newProvider)
+}
+
+var _ vcs.Provider = (*Provider)(nil)
+
+// Provider is a Gitea VCS provider.
+type Provider struct {
+	client *http.Client
+}
+
+func newProvider(config vcs.ProviderConfig) vcs.Provider {
+	if config.Client == nil {
+		config.Client = &http.Client{}
+	}
+	return &Provider{
+		client: config.Client,
+	}
+}
+
+// APIURL returns the API URL path of Gitea.
+func (*Provider) APIURL(instanceURL string) string {
+	return fmt.Sprintf("%s/api/v1", instanceURL)
+}
+
+// Repository represents a Gitea API response for a repository.
+type Repository struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	FullName    string `json:"full_name"`
+	HTMLURL     string `json:"html_url"`
+	Permissions struct {
+		Admin bool `json:"admin"`
+	} `json:"permissions"`
+}
+
+// RepositoryTree represents a Gitea API response for a repository tree.
+type RepositoryTree struct {
+	Tree      []RepositoryTreeNode `json:"tree"`
+	Truncated bool                 `json:"truncated"`
+}
+
+// RepositoryTreeNode represents a Gitea API response for a repository tree node.
+type RepositoryTreeNode struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+// File represents a Gitea API response for a repository file.
+type File struct {
+	Name          string `json:"name"`
+	Path          string `json:"path"`
+	SHA           string `json:"sha"`
+	LastCommitSHA string `json:"last_commit_sha"`
+	Type          string `json:"type"`
+	Size          int64  `json:"size"`
+}
+
+// CommitUser represents a Gitea API message for a commit author.
+type CommitUser struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// Date is in RFC 3339 format.
+	Date string `json:"date,omitempty"`
+}
+
+// CommitFile represents a Gitea API response for the file changed in a commit.
+type CommitFile struct {
+	FileName string `json:"filename"`
+	// Available values: "added", "modified", "removed".
+	Status string `json:"status"`
+}
+
+// Commit represents a Gitea API response for a commit.
+type Commit struct {
+	SHA     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Commit  struct {
+		Message string     `json:"message"`
+		Author  CommitUser `json:"author"`
+	} `json:"commit"`
+	Files []CommitFile `json:"files"`
+}
+
+// Compare represents a Gitea API response for comparing two commits.
+type Compare struct {
+	TotalCommits int      `json:"total_commits"`
+	Commits      []Commit `json:"commits"`
+}
+
+// FileCommit represents a Gitea API request for committing a file.
+type FileCommit struct {
+	Message string      `json:"message"`
+	Content string      `json:"content"`
+	SHA     string      `json:"sha,omitempty"`
+	Branch  string      `json:"branch,omitempty"`
+	Author  *CommitUser `json:"author,omitempty"`
+}
+
+// WebhookType is the Gitea webhook event type.
+type WebhookType string
+
+const (
+	// WebhookPush is the webhook type for push.
+	WebhookPush WebhookType = "push"
+)
+
+// WebhookInfo represents a Gitea API response for the webhook information.
+type WebhookInfo struct {
+	ID int `json:"id"`
+}
+
+// WebhookConfig represents the Gitea API message for webhook configuration.
+type WebhookConfig struct {
+	// URL is the URL to which the payloads will be delivered.
+	URL string `json:"url"`
+	// ContentType is the media type used to serialize the payloads, "json" or "form".
+	ContentType string `json:"content_type"`
+	// Secret is used as the key to generate the HMAC hex digest in the X-Gitea-Signature header.
+	Secret string `json:"secret"`
+}
+
+// WebhookCreateOrUpdate represents a Gitea API request for creating or updating a webhook.
+type WebhookCreateOrUpdate struct {
+	// Type is the webhook type, "gitea" for the Gitea payload. Only used for creating.
+	Type   string        `json:"type,omitempty"`
+	Config WebhookConfig `json:"config"`
+	// Events determines what events the hook is triggered for.
+	Events []string `json:"events"`
+	Active bool     `json:"active"`
+}
+
+// WebhookRepository is the API message for webhook repository.
+type WebhookRepository struct {
+	ID       int    `json:"id"`
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+}
+
+// WebhookCommitAuthor is the API message for webhook commit author.
+type WebhookCommitAuthor struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// WebhookUser is the API message for webhook pusher.
+type WebhookUser struct {
+	Login string `json:"login"`
+}
+
+// WebhookCommit is the API message for webhook commit.
+type WebhookCommit struct {
+	ID        string              `json:"id"`
+	Message   string              `json:"message"`
+	Timestamp time.Time           `json:"timestamp"`
+	URL       string              `json:"url"`
+	Author    WebhookCommitAuthor `json:"author"`
+	Added     []string            `json:"added"`
+	Modified  []string            `json:"modified"`
+}
+
+// WebhookPushEvent is the API message for webhook push event.
+type WebhookPushEvent struct {
+	Ref        string            `json:"ref"`
+	Before     string            `json:"before"`
+	After      string            `json:"after"`
+	Repository WebhookRepository `json:"repository"`
+	Pusher     WebhookUser       `json:"pusher"`
+	Commits    []WebhookCommit   `json:"commits"`
+}
+
+// ToVCS returns the push event in VCS format.
+func (p WebhookPushEvent) ToVCS() vcs.PushEvent {
+	var commitList []vcs.Commit
+	for _, commit := range p.Commits {
+		// Per Git convention, the message title and body are separated by two new line characters.
+		messages := strings.SplitN(commit.Message, "\n\n", 2)
+		messageTitle := strings.TrimSpace(messages[0])
+
+		commitList = append(commitList, vcs.Commit{
+			ID:           commit.ID,
+			Title:        messageTitle,
+			Message:      commit.Message,
+			CreatedTs:    commit.Timestamp.Unix(),
+			URL:          commit.URL,
+			AuthorName:   commit.Author.Name,
+			AuthorEmail:  commit.Author.Email,
+			AddedList:    commit.Added,
+			ModifiedList: commit.Modified,
+		})
+	}
+	return vcs.PushEvent{
+		VCSType:            vcs.Gitea,
+		Ref:                p.Ref,
+		Before:             p.Before,
+		After:              p.After,
+		RepositoryID:       p.Repository.FullName,
+		RepositoryURL:      p.Repository.HTMLURL,
+		RepositoryFullPath: p.Repository.FullName,
+		AuthorName:         p.Pusher.Login,
+		CommitList:         commitList,
+	}
+}
+
+// FetchCommitByID fetches the commit data by its ID from the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetSingleCommit
+func (p *Provider) FetchCommitByID(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string) (*vcs.Commit, error) {
+	url := fmt.Sprintf("%s/repos/%s/git/commits/%s", p.APIURL(instanceURL), repositoryID, commitID)
+	code, _, body, err := oauth.Get(ctx, p.client, url, &oauthCtx.AccessToken, tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to fetch commit data from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to fetch commit data from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	commit := &Commit{}
+	if err := json.Unmarshal([]byte(body), commit); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return commit.toVCS()
+}
+
+func (c *Commit) toVCS() (*vcs.Commit, error) {
+	createdTs := int64(0)
+	if c.Commit.Author.Date != "" {
+		t, err := time.Parse(time.RFC3339, c.Commit.Author.Date)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse commit date %q", c.Commit.Author.Date)
+		}
+		createdTs = t.Unix()
+	}
+	messages := strings.SplitN(c.Commit.Message, "\n\n", 2)
+	commit := &vcs.Commit{
+		ID:          c.SHA,
+		Title:       strings.TrimSpace(messages[0]),
+		Message:     c.Commit.Message,
+		CreatedTs:   createdTs,
+		URL:         c.HTMLURL,
+		AuthorName:  c.Commit.Author.Name,
+		AuthorEmail: c.Commit.Author.Email,
+	}
+	for _, file := range c.Files {
+		switch file.Status {
+		case "added":
+			commit.AddedList = append(commit.AddedList, file.FileName)
+		case "modified":
+			commit.ModifiedList = append(commit.ModifiedList, file.FileName)
+		}
+	}
+	return commit, nil
+}
+
+// GetDiffFileList gets the diff files list between two commits.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCompareDiff
+func (p *Provider) GetDiffFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, beforeCommit, afterCommit string) ([]vcs.FileDiff, error) {
+	url := fmt.Sprintf("%s/repos/%s/compare/%s...%s", p.APIURL(instanceURL), repositoryID, beforeCommit, afterCommit)
+	code, _, body, err := oauth.Get(ctx, p.client, url, &oauthCtx.AccessToken, tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get file diff list from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get file diff list from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	compare := &Compare{}
+	if err := json.Unmarshal([]byte(body), compare); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal file diff data from Gitea instance %s", instanceURL)
+	}
+
+	// Gitea returns the changed files per commit, so we fold them into the diff of the whole range.
+	var ret []vcs.FileDiff
+	index := make(map[string]int)
+	for _, commit := range compare.Commits {
+		for _, file := range commit.Files {
+			tp := vcs.FileDiffTypeUnknown
+			switch file.Status {
+			case "added":
+				tp = vcs.FileDiffTypeAdded
+			case "modified":
+				tp = vcs.FileDiffTypeModified
+			case "removed":
+				tp = vcs.FileDiffTypeRemoved
+			}
+			i, ok := index[file.FileName]
+			if !ok {
+				index[file.FileName] = len(ret)
+				ret = append(ret, vcs.FileDiff{Path: file.FileName, Type: tp})
+				continue
+			}
+			// A file added and then modified in the range is still an added file.
+			if ret[i].Type == vcs.FileDiffTypeAdded && tp == vcs.FileDiffTypeModified {
+				continue
+			}
+			ret[i].Type = tp
+		}
+	}
+	return ret, nil
+}
+
+// oauthResponse is a Gitea OAuth response.
+type oauthResponse struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	TokenType        string `json:"token_type"`
+	Error            string `json:"error,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// toVCSOAuthToken converts the response to *vcs.OAuthToken.
+func (o oauthResponse) toVCSOAuthToken() *vcs.OAuthToken {
+	oauthToken := &vcs.OAuthToken{
+		AccessToken:  o.AccessToken,
+		RefreshToken: o.RefreshToken,
+		ExpiresIn:    o.ExpiresIn,
+		CreatedAt:    time.Now().Unix(),
+	}
+	if oauthToken.ExpiresIn != 0 {
+		oauthToken.ExpiresTs = oauthToken.CreatedAt + oauthToken.ExpiresIn
+	}
+	return oauthToken
+}
+
+// ExchangeOAuthToken exchanges OAuth content with the provided authorization code.
+//
+// Docs: https://docs.gitea.com/development/oauth2-provider
+func (p *Provider) ExchangeOAuthToken(ctx context.Context, instanceURL string, oauthExchange *common.OAuthExchange) (*vcs.OAuthToken, error) {
+	params := &url.Values{}
+	params.Set("client_id", oauthExchange.ClientID)
+	params.Set("client_secret", oauthExchange.ClientSecret)
+	params.Set("code", oauthExchange.Code)
+	params.Set("grant_type", "authorization_code")
+	params.Set("redirect_uri", oauthExchange.RedirectURL)
+	url := fmt.Sprintf("%s/login/oauth/access_token", instanceURL)
+
+	oauthResp, err := requestOAuthToken(ctx, p.client, url, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to exchange OAuth token")
+	}
+	return oauthResp.toVCSOAuthToken(), nil
+}
+
+// requestOAuthToken requests the token endpoint for exchanging or refreshing the token.
+func requestOAuthToken(ctx context.Context, client *http.Client, tokenURL string, params *url.Values) (*oauthResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, errors.Wrapf(err, "construct POST %s", tokenURL)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "POST %s", tokenURL)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read OAuth response body, code %v", resp.StatusCode)
+	}
+
+	oauthResp := new(oauthResponse)
+	if err := json.Unmarshal(body, oauthResp); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal OAuth response body, code %v", resp.StatusCode)
+	}
+	if oauthResp.Error != "" {
+		return nil, errors.Errorf("OAuth error: %v, error_description: %v", oauthResp.Error, oauthResp.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("non-200 POST %s status code %d with body %q", tokenURL, resp.StatusCode, body)
+	}
+	return oauthResp, nil
+}
+
+// FetchAllRepositoryList fetches all repositories where the authenticated user
+// has admin permissions, which is required to create webhook in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/user/userCurrentListRepos
+func (p *Provider) FetchAllRepositoryList(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) ([]*vcs.Repository, error) {
+	var giteaRepos []Repository
+	page := 1
+	for {
+		url := fmt.Sprintf("%s/user/repos?page=%d&limit=%d", p.APIURL(instanceURL), page, apiPageSize)
+		code, _, body, err := oauth.Get(ctx, p.client, url, &oauthCtx.AccessToken, tokenRefresher(instanceURL, oauthCtx))
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code >= 300 {
+			return nil, errors.Errorf("failed to fetch repository list from URL %s, status code: %d, body: %s", url, code, body)
+		}
+		var repos []Repository
+		if err := json.Unmarshal([]byte(body), &repos); err != nil {
+			return nil, errors.Wrap(err, "unmarshal")
+		}
+		giteaRepos = append(giteaRepos, repos...)
+
+		if len(repos) < apiPageSize {
+			break
+		}
+		page++
+	}
+
+	var allRepos []*vcs.Repository
+	for _, r := range giteaRepos {
+		if !r.Permissions.Admin {
+			continue
+		}
+		allRepos = append(allRepos,
+			&vcs.Repository{
+				ID:       r.FullName,
+				Name:     r.Name,
+				FullPath: r.FullName,
+				WebURL:   r.HTMLURL,
+			},
+		)
+	}
+	return allRepos, nil
+}
+
+// FetchRepositoryFileList fetches the all files from the given repository tree recursively.
+//
+// Docs: https://gitea.com/api/swagger#/repository/GetTree
+func (p *Provider) FetchRepositoryFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, ref, filePath string) ([]*vcs.RepositoryTreeNode, error) {
+	if filePath != "" && !strings.HasSuffix(filePath, "/") {
+		filePath += "/"
+	}
+
+	var allTreeNodes []*vcs.RepositoryTreeNode
+	page := 1
+	for {
+		url := fmt.Sprintf("%s/repos/%s/git/trees/%s?recursive=true&page=%d&per_page=%d", p.APIURL(instanceURL), repositoryID, url.PathEscape(ref), page, treePageSize)
+		code, _, body, err := oauth.Get(ctx, p.client, url, &oauthCtx.AccessToken, tokenRefresher(instanceURL, oauthCtx))
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to fetch repository file list from URL %s", url)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to fetch repository file list from URL %s, status code: %d, body: %s", url, code, body)
+		}
+
+		var repoTree RepositoryTree
+		if err := json.Unmarshal([]byte(body), &repoTree); err != nil {
+			return nil, errors.Wrap(err, "unmarshal body")
+		}
+		for _, n := range repoTree.Tree {
+			// Gitea does not support filtering by path prefix, thus simulating the behavior here.
+			if n.Type == "blob" && strings.HasPrefix(n.Path, filePath) {
+				allTreeNodes = append(allTreeNodes,
+					&vcs.RepositoryTreeNode{
+						Path: n.Path,
+						Type: n.Type,
+					},
+				)
+			}
+		}
+
+		// The tree is truncated if there are more pages.
+		if !repoTree.Truncated {
+			break
+		}
+		page++
+	}
+	return allTreeNodes, nil
+}
+
+// CreateFile creates a file at given path in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateFile
+func (p *Provider) CreateFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	// Gitea uses POST to create a file and PUT with the blob SHA to update it.
+	method := http.MethodPost
+	if fileCommitCreate.SHA != "" {
+		method = http.MethodPut
+	}
+	return p.commitFile(ctx, oauthCtx, method, instanceURL, repositoryID, filePath, fileCommitCreate)
+}
+
+// OverwriteFile overwrites an existing file at given path in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoUpdateFile
+func (p *Provider) OverwriteFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	if fileCommitCreate.SHA == "" {
+		// The blob SHA is required to update the file, the file is created if it doesn't exist.
+		return p.CreateFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate)
+	}
+	return p.commitFile(ctx, oauthCtx, http.MethodPut, instanceURL, repositoryID, filePath, fileCommitCreate)
+}
+
+func (p *Provider) commitFile(ctx context.Context, oauthCtx common.OauthContext, method, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	fileCommit := FileCommit{
+		Message: fileCommitCreate.CommitMessage,
+		Content: base64.StdEncoding.EncodeToString([]byte(fileCommitCreate.Content)),
+		Branch:  fileCommitCreate.Branch,
+		SHA:     fileCommitCreate.SHA,
+	}
+	if fileCommitCreate.AuthorName != "" && fileCommitCreate.AuthorEmail != "" {
+		fileCommit.Author = &CommitUser{
+			Name:  fileCommitCreate.AuthorName,
+			Email: fileCommitCreate.AuthorEmail,
+		}
+	}
+	body, err := json.Marshal(fileCommit)
+	if err != nil {
+		return errors.Wrap(err, "marshal file commit")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/contents/%s", p.APIURL(instanceURL), repositoryID, escapePath(filePath))
+	var code int
+	var resp string
+	if method == http.MethodPost {
+		code, _, resp, err = oauth.Post(ctx, p.client, url, &oauthCtx.AccessToken, bytes.NewReader(body), tokenRefresher(instanceURL, oauthCtx))
+	} else {
+		code, _, resp, err = oauth.Put(ctx, p.client, url, &oauthCtx.AccessToken, bytes.NewReader(body), tokenRefresher(instanceURL, oauthCtx))
+	}
+	if err != nil {
+		return errors.Wrapf(err, "%s %s", method, url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create/update file through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create/update file through URL %s, status code: %d, body: %s", url, code, resp)
+	}
+	return nil
+}
+
+// ReadFileMeta reads the metadata of the given file in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetContents
+func (p *Provider) ReadFileMeta(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, refInfo vcs.RefInfo) (*vcs.FileMeta, error) {
+	url := fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", p.APIURL(instanceURL), repositoryID, escapePath(filePath), url.QueryEscape(refInfo.RefName))
+	code, _, body, err := oauth.Get(ctx, p.client, url, &oauthCtx.AccessToken, tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to read file meta from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to read file meta from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	// This API endpoint returns a JSON array if the path is a directory, and we do not want that.
+	if body != "" && body[0] == '[' {
+		return nil, errors.Errorf("%q is a directory not a file", filePath)
+	}
+
+	var file File
+	if err = json.Unmarshal([]byte(body), &file); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+
+	return &vcs.FileMeta{
+		Name:         file.Name,
+		Path:         file.Path,
+		Size:         file.Size,
+		SHA:          file.SHA,
+		LastCommitID: file.LastCommitSHA,
+	}, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetRawFile
+func (p *Provider) ReadFileContent(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, refInfo vcs.RefInfo) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/raw/%s?ref=%s", p.APIURL(instanceURL), repositoryID, escapePath(filePath), url.QueryEscape(refInfo.RefName))
+	code, _, body, err := oauth.Get(ctx, p.client, url, &oauthCtx.AccessToken, tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return "", errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to read file content from URL %s", url)
+	} else if code >= 300 {
+		return "", errors.Errorf("failed to read file content from URL %s, status code: %d, body: %s", url, code, body)
+	}
+	return body, nil
+}
+
+// PullRequestFile is the API message for files in Gitea pull request.
+type PullRequestFile struct {
+	FileName string `json:"filename"`
+	// Available values: "added", "deleted", "changed", "renamed", "copied", "unchanged".
+	Status string `json:"status"`
+	// The file content API URL, which contains the ref value in the query.
+	// Example: https://gitea.com/api/v1/repos/octocat/Hello-World/contents/file1.txt?ref=6dcb09b5b57875f334f61aebed695e2e4193db5e
+	ContentsURL string `json:"contents_url"`
+}
+
+// ListPullRequestFile lists the changed files in the pull request.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetPullRequestFiles
+func (p *Provider) ListPullRequestFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	var allPRFiles []PullRequestFile
+	page := 1
+	for {
+		requestURL := fmt.Sprintf("%s/repos/%s/pulls/%s/files?limit=%d&page=%d", p.APIURL(instanceURL), repositoryID, pullRequestID, apiPageSize, page)
+		code, _, body, err := oauth.Get(ctx, p.client, requestURL, &oauthCtx.AccessToken, tokenRefresher(instanceURL, oauthCtx))
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", requestURL)
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list pull request file from URL %s", requestURL)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to list pull request file from URL %s, status code: %d, body: %s", requestURL, code, body)
+		}
+
+		var fileList []PullRequestFile
+		if err := json.Unmarshal([]byte(body), &fileList); err != nil {
+			return nil, err
+		}
+		allPRFiles = append(allPRFiles, fileList...)
+		if len(fileList) < apiPageSize {
+			break
+		}
+		page++
+	}
+
+	var res []*vcs.PullRequestFile
+	for _, file := range allPRFiles {
+		u, err := url.Parse(file.ContentsURL)
+		if err != nil {
+			slog.Debug("Failed to parse content url for file",
+				slog.String("content_url", file.ContentsURL),
+				slog.String("file", file.FileName),
+				log.BBError(err),
+			)
+			continue
+		}
+		ref := u.Query().Get("ref")
+		if ref == "" {
+			continue
+		}
+
+		res = append(res, &vcs.PullRequestFile{
+			Path:         file.FileName,
+			LastCommitID: ref,
+			IsDeleted:    file.Status == "deleted",
+		})
+	}
+	return res, nil
+}
+
+// Branch is the API message for Gitea branch.
+type Branch struct {
+	Name   string `json:"name"`
+	Commit struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+// BranchCreate is the API message to create the branch.
+type BranchCreate struct {
+	NewBranchName string `json:"new_branch_name"`
+	// OldRefName is the branch, tag or commit to create the branch from.
+	OldRefName string `json:"old_ref_name"`
+}
+
+// GetBranch gets the given branch in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetBranch
+func (p *Provider) GetBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/branches/%s", p.APIURL(instanceURL), repositoryID, escapePath(branchName))
+	code, _, body, err := oauth.Get(ctx, p.client, url, &oauthCtx.AccessToken, tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get branch from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get branch from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	res := new(Branch)
+	if err := json.Unmarshal([]byte(body), res); err != nil {
+		return nil, err
+	}
+	return &vcs.BranchInfo{
+		Name:         res.Name,
+		LastCommitID: res.Commit.ID,
+	}, nil
+}
+
+// CreateBranch creates the branch in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateBranch
+func (p *Provider) CreateBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, branch *vcs.BranchInfo) error {
+	body, err := json.Marshal(
+		BranchCreate{
+			NewBranchName: branch.Name,
+			OldRefName:    branch.LastCommitID,
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "marshal branch create")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/branches", p.APIURL(instanceURL), repositoryID)
+	code, _, resp, err := oauth.Post(ctx, p.client, url, &oauthCtx.AccessToken, bytes.NewReader(body), tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch from URL %s", url)
+	} else if code == http.StatusConflict {
+		return common.Errorf(common.Conflict, "branch %q already exists", branch.Name)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create branch from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+	return nil
+}
+
+// PullRequestCreate is the API message to create the pull request.
+type PullRequestCreate struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// PullRequest is the API message for Gitea pull request.
+type PullRequest struct {
+	HTMLURL string `json:"html_url"`
+}
+
+// CreatePullRequest creates the pull request in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreatePullRequest
+func (p *Provider) CreatePullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, pullRequestCreate *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	body, err := json.Marshal(
+		PullRequestCreate{
+			Title: pullRequestCreate.Title,
+			Body:  pullRequestCreate.Body,
+			Head:  pullRequestCreate.Head,
+			Base:  pullRequestCreate.Base,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal pull request create")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/pulls", p.APIURL(instanceURL), repositoryID)
+	code, _, resp, err := oauth.Post(ctx, p.client, url, &oauthCtx.AccessToken, bytes.NewReader(body), tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to create pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to create pull request from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+
+	var res PullRequest
+	if err := json.Unmarshal([]byte(resp), &res); err != nil {
+		return nil, err
+	}
+	return &vcs.PullRequest{
+		URL: res.HTMLURL,
+	}, nil
+}
+
+// RepositorySecretUpdate is the API message to update the repository secret.
+type RepositorySecretUpdate struct {
+	Data string `json:"data"`
+}
+
+// UpsertEnvironmentVariable creates or updates the Actions secret in the repository.
+// Unlike GitHub, Gitea encrypts the secret on the server side so the value is sent as is.
+//
+// Docs: https://gitea.com/api/swagger#/repository/updateRepoSecret
+func (p *Provider) UpsertEnvironmentVariable(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, key, value string) error {
+	body, err := json.Marshal(RepositorySecretUpdate{Data: value})
+	if err != nil {
+		return errors.Wrap(err, "marshal environment variable")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/actions/secrets/%s", p.APIURL(instanceURL), repositoryID, key)
+	code, _, resp, err := oauth.Put(ctx, p.client, url, &oauthCtx.AccessToken, bytes.NewReader(body), tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return errors.Wrapf(err, "PUT %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to upsert environment variable from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to upsert environment variable from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+	return nil
+}
+
+// CreateWebhook creates a webhook in the repository with given payload.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateHook
+func (p *Provider) CreateWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, payload []byte) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/hooks", p.APIURL(instanceURL), repositoryID)
+	code, _, body, err := oauth.Post(ctx, p.client, url, &oauthCtx.AccessToken, bytes.NewReader(payload), tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create webhook through URL %s", url)
+	}
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create webhook through URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	var webhookInfo WebhookInfo
+	if err = json.Unmarshal([]byte(body), &webhookInfo); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return strconv.Itoa(webhookInfo.ID), nil
+}
+
+// PatchWebhook patches the webhook in the repository with given payload.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoEditHook
+func (p *Provider) PatchWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string, payload []byte) error {
+	url := fmt.Sprintf("%s/repos/%s/hooks/%s", p.APIURL(instanceURL), repositoryID, webhookID)
+	code, _, body, err := oauth.Patch(ctx, p.client, url, &oauthCtx.AccessToken, bytes.NewReader(payload), tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return errors.Wrapf(err, "PATCH %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to patch webhook through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to patch webhook through URL %s, status code: %d, body: %s", url, code, body)
+	}
+	return nil
+}
+
+// DeleteWebhook deletes the webhook from the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoDeleteHook
+func (p *Provider) DeleteWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string) error {
+	url := fmt.Sprintf("%s/repos/%s/hooks/%s", p.APIURL(instanceURL), repositoryID, webhookID)
+	code, _, body, err := oauth.Delete(ctx, p.client, url, &oauthCtx.AccessToken, tokenRefresher(instanceURL, oauthCtx))
+	if err != nil {
+		return errors.Wrapf(err, "DELETE %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil // It is OK if the webhook has already gone
+	} else if code >= 300 {
+		return errors.Errorf("failed to delete webhook through URL %s, status code: %d, body: %s", url, code, body)
+	}
+	return nil
+}
+
+// tokenRefresher refreshes the access token, which expires in an hour by default in Gitea.
+func tokenRefresher(instanceURL string, oauthCtx common.OauthContext) oauth.TokenRefresher {
+	return func(ctx context.Context, client *http.Client, oldToken *string) error {
+		params := &url.Values{}
+		params.Set("client_id", oauthCtx.ClientID)
+		params.Set("client_secret", oauthCtx.ClientSecret)
+		params.Set("refresh_token", oauthCtx.RefreshToken)
+		params.Set("grant_type", "refresh_token")
+
+		url := fmt.Sprintf("%s/login/oauth/access_token", instanceURL)
+		r, err := requestOAuthToken(ctx, client, url, params)
+		if err != nil {
+			return errors.Wrap(err, "failed to refresh OAuth token")
+		}
+
+		// Update the old token to new value for retries.
+		*oldToken = r.AccessToken
+
+		token := r.toVCSOAuthToken()
+		if oauthCtx.Refresher == nil {
+			return nil
+		}
+		return oauthCtx.Refresher(token.AccessToken, token.RefreshToken, token.ExpiresTs)
+	}
+}
+
+// escapePath escapes each segment of the file path, keeping the slashes which Gitea routes on.
+func escapePath(filePath string) string {
+	segments := strings.Split(filePath, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,235 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+const testInstanceURL = "https://gitea.example.com"
+
+func TestProvider_FetchCommitByID(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "sha": "7638417db6d59f3c431d3e1f261cc637155684cd",
+  "html_url": "https://gitea.example.com/octocat/Hello-World/commit/7638417db6d59f3c431d3e1f261cc637155684cd",
+  "commit": {
+    "message": "Add migration\n\nCreate the table.",
+    "author": {
+      "name": "Monalisa Octocat",
+      "email": "octocat@example.com",
+      "date": "2014-11-07T22:01:45Z"
+    }
+  },
+  "files": [
+    {"filename": "prod/db##0001##migrate##init.sql", "status": "added"},
+    {"filename": "README.md", "status": "modified"}
+  ]
+}
+`)),
+		}, nil
+	})
+
+	got, err := p.FetchCommitByID(context.Background(), common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "7638417db6d59f3c431d3e1f261cc637155684cd")
+	require.NoError(t, err)
+	want := &vcs.Commit{
+		ID:           "7638417db6d59f3c431d3e1f261cc637155684cd",
+		Title:        "Add migration",
+		Message:      "Add migration\n\nCreate the table.",
+		CreatedTs:    1415397705,
+		URL:          "https://gitea.example.com/octocat/Hello-World/commit/7638417db6d59f3c431d3e1f261cc637155684cd",
+		AuthorName:   "Monalisa Octocat",
+		AuthorEmail:  "octocat@example.com",
+		AddedList:    []string{"prod/db##0001##migrate##init.sql"},
+		ModifiedList: []string{"README.md"},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_GetDiffFileList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/compare/a...c", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "total_commits": 2,
+  "commits": [
+    {"sha": "b", "files": [{"filename": "file1.sql", "status": "added"}, {"filename": "file2.sql", "status": "modified"}]},
+    {"sha": "c", "files": [{"filename": "file1.sql", "status": "modified"}, {"filename": "file2.sql", "status": "removed"}]}
+  ]
+}
+`)),
+		}, nil
+	})
+
+	got, err := p.GetDiffFileList(context.Background(), common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "a", "c")
+	require.NoError(t, err)
+	want := []vcs.FileDiff{
+		{Path: "file1.sql", Type: vcs.FileDiffTypeAdded},
+		{Path: "file2.sql", Type: vcs.FileDiffTypeRemoved},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_ExchangeOAuthToken(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/login/oauth/access_token", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "test_code", r.PostForm.Get("code"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"access_token": "access", "refresh_token": "refresh", "token_type": "bearer", "expires_in": 3600}`)),
+		}, nil
+	})
+
+	got, err := p.ExchangeOAuthToken(context.Background(), testInstanceURL, &common.OAuthExchange{
+		ClientID:     "test_client_id",
+		ClientSecret: "test_client_secret",
+		Code:         "test_code",
+		RedirectURL:  "http://localhost:3000",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "access", got.AccessToken)
+	assert.Equal(t, "refresh", got.RefreshToken)
+	assert.Equal(t, got.CreatedAt+3600, got.ExpiresTs)
+}
+
+func TestProvider_CreateAndOverwriteFile(t *testing.T) {
+	var gotMethod string
+	var gotBody FileCommit
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/contents/.gitea/workflows/bytebase-sql-review.yml", r.URL.Path)
+		gotMethod = r.Method
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	})
+
+	ctx := context.Background()
+	err := p.OverwriteFile(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", SQLReviewActionFilePath, vcs.FileCommitCreate{
+		Branch:        "bytebase",
+		Content:       "on: [pull_request]",
+		CommitMessage: "Setup SQL review",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, gotMethod)
+	assert.Equal(t, "b246IFtwdWxsX3JlcXVlc3Rd", gotBody.Content)
+
+	err = p.OverwriteFile(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", SQLReviewActionFilePath, vcs.FileCommitCreate{
+		Branch:        "bytebase",
+		Content:       "on: [pull_request]",
+		CommitMessage: "Setup SQL review",
+		SHA:           "3d21ec53a331a6f037a91c368710b99387d012c1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, gotMethod)
+	assert.Equal(t, "3d21ec53a331a6f037a91c368710b99387d012c1", gotBody.SHA)
+}
+
+func TestProvider_ListPullRequestFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/pulls/1/files", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+[
+  {"filename": "file1.sql", "status": "added", "contents_url": "https://gitea.example.com/api/v1/repos/octocat/Hello-World/contents/file1.sql?ref=6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+  {"filename": "file2.sql", "status": "deleted", "contents_url": "https://gitea.example.com/api/v1/repos/octocat/Hello-World/contents/file2.sql?ref=6dcb09b5b57875f334f61aebed695e2e4193db5e"}
+]
+`)),
+		}, nil
+	})
+
+	got, err := p.ListPullRequestFile(context.Background(), common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "1")
+	require.NoError(t, err)
+	want := []*vcs.PullRequestFile{
+		{Path: "file1.sql", LastCommitID: "6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		{Path: "file2.sql", LastCommitID: "6dcb09b5b57875f334f61aebed695e2e4193db5e", IsDeleted: true},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_GetBranch(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Path == "/api/v1/repos/octocat/Hello-World/branches/main" {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"name": "main", "commit": {"id": "6dcb09b5b57875f334f61aebed695e2e4193db5e"}}`)),
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(`{"message": "not found"}`)),
+		}, nil
+	})
+
+	ctx := context.Background()
+	got, err := p.GetBranch(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "main")
+	require.NoError(t, err)
+	assert.Equal(t, &vcs.BranchInfo{Name: "main", LastCommitID: "6dcb09b5b57875f334f61aebed695e2e4193db5e"}, got)
+
+	_, err = p.GetBranch(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "feature")
+	assert.Equal(t, common.NotFound, common.ErrorCode(err))
+}
+
+func TestWebhookPushEvent_ToVCS(t *testing.T) {
+	var pushEvent WebhookPushEvent
+	require.NoError(t, json.Unmarshal([]byte(`
+{
+  "ref": "refs/heads/main",
+  "before": "a",
+  "after": "b",
+  "commits": [
+    {
+      "id": "b",
+      "message": "Add migration\n",
+      "url": "https://gitea.example.com/octocat/Hello-World/commit/b",
+      "author": {"name": "octocat", "email": "octocat@example.com", "username": "octocat"},
+      "timestamp": "2014-11-07T22:01:45Z",
+      "added": ["file1.sql"],
+      "removed": [],
+      "modified": []
+    }
+  ],
+  "repository": {"id": 1, "full_name": "octocat/Hello-World", "html_url": "https://gitea.example.com/octocat/Hello-World"},
+  "pusher": {"login": "octocat"}
+}
+`), &pushEvent))
+
+	got := pushEvent.ToVCS()
+	assert.Equal(t, vcs.Gitea, got.VCSType)
+	assert.Equal(t, "octocat/Hello-World", got.RepositoryID)
+	assert.Equal(t, "octocat", got.AuthorName)
+	require.Len(t, got.CommitList, 1)
+	assert.Equal(t, "Add migration", got.CommitList[0].Title)
+	assert.Equal(t, []string{"file1.sql"}, got.CommitList[0].AddedList)
+}
+
+func newMockProvider(mockRoundTrip func(r *http.Request) (*http.Response, error)) vcs.Provider {
+	return newProvider(
+		vcs.ProviderConfig{
+			Client: &http.Client{
+				Transport: &common.MockRoundTripper{
+					MockRoundTrip: mockRoundTrip,
+				},
+			},
+		},
+	)
+}
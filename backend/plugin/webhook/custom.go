@@ -15,18 +15,36 @@ type CustomWebhookResponse struct {
 	Message string `json:"message"`
 }
 
+// CustomWebhookSchemaVersion is the version of the custom webhook request schema.
+// The schema only adds fields within the same version.
+const CustomWebhookSchemaVersion = "1"
+
 // CustomWebhookRequest is the API message for Custom webhook request.
+// It is a structured event which covers every activity type, and the fields
+// irrelevant to the event are omitted.
 type CustomWebhookRequest struct {
-	Level        Level    `json:"level"`
-	ActivityType string   `json:"activity_type"`
-	Title        string   `json:"title"`
-	Description  string   `json:"description"`
-	Link         string   `json:"link"`
-	CreatorID    int      `json:"creator_id"`
-	CreatorName  string   `json:"creator_name"`
-	CreatedTS    int64    `json:"created_ts"`
-	Issue        *Issue   `json:"issue"`
-	Project      *Project `json:"project"`
+	SchemaVersion string `json:"schema_version"`
+	// ActivityType is the event type, which is also sent in the X-Bytebase-Event header.
+	ActivityType string      `json:"activity_type"`
+	Level        Level       `json:"level"`
+	Title        string      `json:"title"`
+	Description  string      `json:"description"`
+	Link         string      `json:"link"`
+	CreatorID    int         `json:"creator_id"`
+	CreatorName  string      `json:"creator_name"`
+	CreatedTS    int64       `json:"created_ts"`
+	Actor        *User       `json:"actor"`
+	Issue        *Issue      `json:"issue"`
+	Project      *Project    `json:"project"`
+	Plan         *Plan       `json:"plan,omitempty"`
+	Rollout      *Rollout    `json:"rollout,omitempty"`
+	Stage        *Stage      `json:"stage,omitempty"`
+	Task         *Task       `json:"task,omitempty"`
+	TaskResult   *TaskResult `json:"task_result,omitempty"`
+	Databases    []*Database `json:"databases,omitempty"`
+	Approval     *Approval   `json:"approval,omitempty"`
+	// Payload is the payload of the activity, which varies by the activity type.
+	Payload json.RawMessage `json:"payload,omitempty"`
 }
 
 func init() {
@@ -37,21 +55,8 @@ func init() {
 type CustomReceiver struct{}
 
 func (*CustomReceiver) post(context Context) error {
-	// TODO(p0ny): handle context.Task
-	payload := CustomWebhookRequest{
-		Level:        context.Level,
-		ActivityType: context.ActivityType,
-		Title:        context.Title,
-		Description:  context.Description,
-		Link:         context.Link,
-		CreatorID:    context.CreatorID,
-		CreatorName:  context.CreatorName,
-		CreatedTS:    context.CreatedTs,
-		Issue:        context.Issue,
-		Project:      context.Project,
-	}
-
-	body, err := json.Marshal(&payload)
+	payload := newCustomWebhookRequest(context)
+	body, err := json.Marshal(payload)
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
@@ -61,7 +66,7 @@ func (*CustomReceiver) post(context Context) error {
 		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	setHeaders(req, context, body)
 	client := &http.Client{
 		Timeout: timeout,
 	}
@@ -91,3 +96,37 @@ func (*CustomReceiver) post(context Context) error {
 
 	return nil
 }
+
+func newCustomWebhookRequest(context Context) *CustomWebhookRequest {
+	// Only the approvers are sent, the phone numbers are for mentioning users in the IM messages.
+	var approval *Approval
+	if context.Approval != nil && len(context.Approval.Approvers) > 0 {
+		approval = &Approval{Approvers: context.Approval.Approvers}
+	}
+	return &CustomWebhookRequest{
+		SchemaVersion: CustomWebhookSchemaVersion,
+		ActivityType:  context.ActivityType,
+		Level:         context.Level,
+		Title:         context.Title,
+		Description:   context.Description,
+		Link:          context.Link,
+		CreatorID:     context.CreatorID,
+		CreatorName:   context.CreatorName,
+		CreatedTS:     context.CreatedTs,
+		Actor: &User{
+			ID:    context.CreatorID,
+			Name:  context.CreatorName,
+			Email: context.CreatorEmail,
+		},
+		Issue:      context.Issue,
+		Project:    context.Project,
+		Plan:       context.Plan,
+		Rollout:    context.Rollout,
+		Stage:      context.Stage,
+		Task:       context.Task,
+		TaskResult: context.TaskResult,
+		Databases:  context.Databases,
+		Approval:   approval,
+		Payload:    context.ActivityPayload,
+	}
+}
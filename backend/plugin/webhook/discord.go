@@ -92,7 +92,7 @@ func (*DiscordReceiver) post(context Context) error {
 		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	setHeaders(req, context, body)
 	client := &http.Client{
 		Timeout: timeout,
 	}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/bytebase/bytebase/backend/common"
+)
+
+// MatchEventFilter returns whether the event matches the CEL filter of a webhook.
+// An empty filter matches all events.
+func MatchEventFilter(filter string, context *Context) (bool, error) {
+	if filter == "" {
+		return true, nil
+	}
+	prg, err := common.ValidateWebhookEventFilterCELExpr(filter)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := prg.Eval(getEventFilterAttributes(context))
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to evaluate webhook event filter %q", filter)
+	}
+	match, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.Errorf("expect bool result of webhook event filter %q, but got %T", filter, out.Value())
+	}
+	return match, nil
+}
+
+func getEventFilterAttributes(context *Context) map[string]any {
+	issueType, taskStatus := "", ""
+	if context.Issue != nil {
+		issueType = context.Issue.Type
+	}
+	if context.Task != nil {
+		taskStatus = context.Task.Status
+	}
+	environmentIDs, databaseNames := []string{}, []string{}
+	addEnvironment := func(environment string) {
+		environmentID := strings.TrimPrefix(environment, common.EnvironmentNamePrefix)
+		if environmentID != "" && !slices.Contains(environmentIDs, environmentID) {
+			environmentIDs = append(environmentIDs, environmentID)
+		}
+	}
+	if context.Stage != nil {
+		addEnvironment(context.Stage.Environment)
+	}
+	for _, database := range context.Databases {
+		databaseNames = append(databaseNames, database.Name)
+		addEnvironment(database.Environment)
+	}
+	return map[string]any{
+		"event_type":      context.ActivityType,
+		"level":           string(context.Level),
+		"issue_type":      issueType,
+		"task_status":     taskStatus,
+		"environment_ids": environmentIDs,
+		"database_names":  databaseNames,
+	}
+}
@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchEventFilter(t *testing.T) {
+	context := &Context{
+		Level:        WebhookError,
+		ActivityType: "bb.pipeline.taskrun.status.update",
+		Issue:        &Issue{Type: "bb.issue.database.general"},
+		Stage:        &Stage{Environment: "environments/prod"},
+		Task:         &Task{Status: "FAILED"},
+		Databases: []*Database{
+			{Name: "instances/mysql/databases/hr", Environment: "environments/prod"},
+			{Name: "instances/mysql/databases/hr_test", Environment: "environments/test"},
+		},
+	}
+	tests := []struct {
+		filter  string
+		want    bool
+		wantErr bool
+	}{
+		{filter: "", want: true},
+		{filter: `event_type == "bb.pipeline.taskrun.status.update" && level == "ERROR"`, want: true},
+		{filter: `event_type.startsWith("bb.issue.")`, want: false},
+		{filter: `"prod" in environment_ids && task_status == "FAILED"`, want: true},
+		{filter: `"instances/mysql/databases/hr_dev" in database_names`, want: false},
+		{filter: `issue_type == "bb.issue.database.general"`, want: true},
+		{filter: `level`, wantErr: true},
+		{filter: `unknown == "a"`, wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := MatchEventFilter(test.filter, context)
+		if test.wantErr {
+			require.Error(t, err, test.filter)
+			continue
+		}
+		require.NoError(t, err, test.filter)
+		require.Equal(t, test.want, got, test.filter)
+	}
+}
@@ -2,6 +2,12 @@
 package webhook
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -60,29 +66,93 @@ type TaskResult struct {
 type Project struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
+	// ResourceID is the resource ID of the project, e.g. "hr" in "projects/hr".
+	ResourceID string `json:"resource_id,omitempty"`
 }
 
 // Approval object of issue approval.
 type Approval struct {
-	MentionUsersByPhone []string
+	MentionUsersByPhone []string `json:"mentionUsersByPhone,omitempty"`
+	// Approvers are the users who can approve the pending approval step.
+	Approvers []*User `json:"approvers,omitempty"`
+}
+
+// User object of user.
+type User struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// Plan object of plan.
+type Plan struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Rollout object of rollout.
+type Rollout struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Stage object of rollout stage.
+type Stage struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	// Environment is the environment of the stage, in the format of "environments/{environment}".
+	Environment string `json:"environment"`
+}
+
+// Task object of rollout task.
+type Task struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	// Database is the target database of the task, in the format of "instances/{instance}/databases/{database}".
+	Database string `json:"database,omitempty"`
+	// Sheet is the statement sheet of the task, in the format of "projects/{project}/sheets/{sheet}".
+	Sheet string `json:"sheet,omitempty"`
+}
+
+// Database object of the database affected by the issue.
+type Database struct {
+	// Name is in the format of "instances/{instance}/databases/{database}".
+	Name string `json:"name"`
+	// Environment is in the format of "environments/{environment}".
+	Environment string `json:"environment"`
 }
 
 // Context is the context of webhook.
+// It is persisted as the payload of the webhook delivery, except the fields of the webhook itself.
 type Context struct {
-	URL          string
-	Level        Level
-	ActivityType string
-	Title        string
-	Description  string
-	Link         string
-	CreatorID    int
-	CreatorName  string
-	CreatorEmail string
-	CreatedTs    int64
-	Issue        *Issue
-	Project      *Project
-	TaskResult   *TaskResult
-	Approval     *Approval
+	URL          string      `json:"-"`
+	Level        Level       `json:"level"`
+	ActivityType string      `json:"activityType"`
+	Title        string      `json:"title"`
+	Description  string      `json:"description"`
+	Link         string      `json:"link"`
+	CreatorID    int         `json:"creatorId"`
+	CreatorName  string      `json:"creatorName"`
+	CreatorEmail string      `json:"creatorEmail"`
+	CreatedTs    int64       `json:"createdTs"`
+	Issue        *Issue      `json:"issue,omitempty"`
+	Project      *Project    `json:"project,omitempty"`
+	TaskResult   *TaskResult `json:"taskResult,omitempty"`
+	Approval     *Approval   `json:"approval,omitempty"`
+	Plan         *Plan       `json:"plan,omitempty"`
+	Rollout      *Rollout    `json:"rollout,omitempty"`
+	Stage        *Stage      `json:"stage,omitempty"`
+	Task         *Task       `json:"task,omitempty"`
+	Databases    []*Database `json:"databases,omitempty"`
+	// ActivityPayload is the payload of the activity, which varies by the activity type.
+	ActivityPayload json.RawMessage `json:"activityPayload,omitempty"`
+
+	// Secret is the key to sign the request body, the request is not signed if it's empty.
+	Secret string `json:"-"`
+	// DeliveryID is the ID of the webhook delivery, zero for the test webhooks.
+	DeliveryID int `json:"-"`
 }
 
 // Receiver is the webhook receiver.
@@ -166,3 +236,33 @@ func Post(webhookType string, context Context) error {
 	}
 	return r.post(context)
 }
+
+const (
+	// SignatureHeader is the header of the HMAC-SHA256 signature of the request body, in the format of "sha256={hex}".
+	SignatureHeader = "X-Bytebase-Signature-256"
+	// DeliveryHeader is the header of the webhook delivery ID.
+	DeliveryHeader = "X-Bytebase-Delivery"
+	// EventHeader is the header of the event type, which is the activity type such as "bb.issue.create".
+	EventHeader = "X-Bytebase-Event"
+)
+
+// setHeaders sets the headers of the webhook POST request.
+func setHeaders(req *http.Request, context Context, body []byte) {
+	req.Header.Set("Content-Type", "application/json")
+	if context.ActivityType != "" {
+		req.Header.Set(EventHeader, context.ActivityType)
+	}
+	if context.DeliveryID != 0 {
+		req.Header.Set(DeliveryHeader, strconv.Itoa(context.DeliveryID))
+	}
+	if context.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(context.Secret, body))
+	}
+}
+
+// Sign returns the HMAC-SHA256 signature of the body with the secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"crypto/rand"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -122,3 +123,23 @@ func TestContext_getMetaList(t *testing.T) {
 		a.Equal(want, context.getMetaList())
 	})
 }
+
+func TestSetHeaders(t *testing.T) {
+	a := require.New(t)
+	body := []byte(`{"title":"hello"}`)
+
+	req, err := http.NewRequest("POST", "https://example.com", nil)
+	a.NoError(err)
+	setHeaders(req, Context{}, body)
+	a.Equal("application/json", req.Header.Get("Content-Type"))
+	a.Empty(req.Header.Get(SignatureHeader))
+	a.Empty(req.Header.Get(DeliveryHeader))
+	a.Empty(req.Header.Get(EventHeader))
+
+	req, err = http.NewRequest("POST", "https://example.com", nil)
+	a.NoError(err)
+	setHeaders(req, Context{Secret: "secret", DeliveryID: 101, ActivityType: "bb.issue.create"}, body)
+	a.Equal("sha256=7d2f943616f709ce52152d5debc78993e4f1cdd2ed1ba65b5f347183899ac230", req.Header.Get(SignatureHeader))
+	a.Equal("101", req.Header.Get(DeliveryHeader))
+	a.Equal("bb.issue.create", req.Header.Get(EventHeader))
+}
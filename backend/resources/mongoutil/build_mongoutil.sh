@@ -1,35 +1,53 @@
 #!/bin/bash
 set -e
 
-OUT_PREFIX="mongoutil-1.6.1"
+OUT_PREFIX="mongoutil-1.6.1-tools-100.9.0"
 
-pack(){
-    if [[ ! -e $1 ]]; then
-        echo "Downloading $1..."
-        curl -o $1.tmp https://downloads.mongodb.com/compass/$1
-        mv $1.tmp $1
+download(){
+    if [[ ! -e $2 ]]; then
+        echo "Downloading $2..."
+        curl -o $2.tmp $1/$2
+        mv $2.tmp $2
     fi
+}
 
-    path=$1
-    dir=${path%.*}
-    case $2 in
-    linux)
+extract(){
+    case $1 in
+    *.zip)
+        unzip -o $1
+        ;;
+    *)
         tar -xzvf $1
-        cd $dir
-        tar -cJf ../${OUT_PREFIX}-$2-$3.txz bin/mongosh bin/mongosh_crypt_v1.so
+        ;;
+    esac
+}
+
+# pack packs mongosh and the mongodump/mongorestore database tools into one tarball.
+pack(){
+    download https://downloads.mongodb.com/compass $1
+    download https://fastdl.mongodb.org/tools/db $2
+
+    mongosh_dir=${1%.*}
+    tools_dir=${2%.*}
+    extract $1
+    extract $2
+    cp $tools_dir/bin/mongodump $tools_dir/bin/mongorestore $mongosh_dir/bin/
+
+    cd $mongosh_dir
+    case $3 in
+    linux)
+        tar -cJf ../${OUT_PREFIX}-$3-$4.txz bin/mongosh bin/mongosh_crypt_v1.so bin/mongodump bin/mongorestore
         ;;
     darwin)
-        unzip -o $1
-        cd $dir
-        tar -cJf ../${OUT_PREFIX}-$2-$3.txz bin/mongosh bin/mongosh_crypt_v1.dylib
+        tar -cJf ../${OUT_PREFIX}-$3-$4.txz bin/mongosh bin/mongosh_crypt_v1.dylib bin/mongodump bin/mongorestore
         ;;
     esac
     cd ..
-    rm -rf $dir
-    rm $1
+    rm -rf $mongosh_dir $tools_dir
+    rm $1 $2
 }
 
-pack mongosh-1.6.1-darwin-x64.zip darwin amd64
-pack mongosh-1.6.1-darwin-arm64.zip darwin arm64
-pack mongosh-1.6.1-linux-x64.tgz linux amd64
-pack mongosh-1.6.1-linux-arm64.tgz linux arm64
+pack mongosh-1.6.1-darwin-x64.zip mongodb-database-tools-macos-x86_64-100.9.0.zip darwin amd64
+pack mongosh-1.6.1-darwin-arm64.zip mongodb-database-tools-macos-arm64-100.9.0.zip darwin arm64
+pack mongosh-1.6.1-linux-x64.tgz mongodb-database-tools-ubuntu2004-x86_64-100.9.0.tgz linux amd64
+pack mongosh-1.6.1-linux-arm64.tgz mongodb-database-tools-ubuntu2004-arm64-100.9.0.tgz linux arm64
@@ -20,18 +20,28 @@ func GetMongoshPath(binDir string) string {
 	return path.Join(binDir, "mongosh")
 }
 
+// GetMongodumpPath returns the mongodump path.
+func GetMongodumpPath(binDir string) string {
+	return path.Join(binDir, "mongodump")
+}
+
+// GetMongorestorePath returns the mongorestore path.
+func GetMongorestorePath(binDir string) string {
+	return path.Join(binDir, "mongorestore")
+}
+
 // getTarnameAndVersion returns the mongoutil tarball name and version string.
 func getTarNameAndVersion() (tarname string, version string, err error) {
 	var tarName string
 	switch {
 	case runtime.GOOS == "darwin" && runtime.GOARCH == "amd64":
-		tarName = "mongoutil-1.6.1-darwin-amd64.txz"
+		tarName = "mongoutil-1.6.1-tools-100.9.0-darwin-amd64.txz"
 	case runtime.GOOS == "darwin" && runtime.GOARCH == "arm64":
-		tarName = "mongoutil-1.6.1-darwin-arm64.txz"
+		tarName = "mongoutil-1.6.1-tools-100.9.0-darwin-arm64.txz"
 	case runtime.GOOS == "linux" && runtime.GOARCH == "amd64":
-		tarName = "mongoutil-1.6.1-linux-amd64.txz"
+		tarName = "mongoutil-1.6.1-tools-100.9.0-linux-amd64.txz"
 	case runtime.GOOS == "linux" && runtime.GOARCH == "arm64":
-		tarName = "mongoutil-1.6.1-linux-arm64.txz"
+		tarName = "mongoutil-1.6.1-tools-100.9.0-linux-arm64.txz"
 	default:
 		return "", "", errors.Errorf("unsupported platform: %s/%s", runtime.GOOS, runtime.GOARCH)
 	}
@@ -167,9 +167,15 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 		approvalTemplate, err := getApprovalTemplate(approvalSetting, riskLevel, riskSource)
 		if err != nil {
 			err = errors.Wrapf(err, "failed to get approval template, riskLevel: %v", riskLevel)
+			return nil, true, err
+		}
+		if approvalTemplate != nil {
+			if err := checkApprovalTemplateApprovable(ctx, r.store, approvalTemplate, issue); err != nil {
+				return nil, true, err
+			}
 		}
 
-		return approvalTemplate, true, err
+		return approvalTemplate, true, nil
 	}()
 	if err != nil {
 		if updateErr := updateIssueApprovalPayload(ctx, r.store, issue, &storepb.IssuePayloadApproval{
@@ -330,6 +336,111 @@ func getApprovalTemplate(approvalSetting *storepb.WorkspaceApprovalSetting, risk
 	return nil, nil
 }
 
+// checkApprovalTemplateApprovable checks that every step has enough users to approve it.
+// Steps approved by an external approval node are not checked, and steps with a role or a group are only checked if they require more than one approver.
+// The issue creator is not counted if the step disallows self approval.
+func checkApprovalTemplateApprovable(ctx context.Context, s *store.Store, template *storepb.ApprovalTemplate, issue *store.IssueMessage) error {
+	for i, step := range template.Flow.GetSteps() {
+		for _, node := range step.Nodes {
+			required := utils.GetRequiredApproverCount(node)
+			if node.GetUserList() == nil && required <= 1 {
+				continue
+			}
+			approvers, ok, err := listApprovalNodeApprovers(ctx, s, node, issue.Project.UID)
+			if err != nil {
+				return errors.Wrapf(err, "failed to list the approvers of approval step %d", i+1)
+			}
+			if !ok {
+				continue
+			}
+			if node.DisallowSelfApproval {
+				delete(approvers, issue.Creator.ID)
+			}
+			if len(approvers) < required {
+				return errors.Errorf("approval step %d requires %d approvers but only %d users can approve it", i+1, required, len(approvers))
+			}
+		}
+	}
+	return nil
+}
+
+// listApprovalNodeApprovers returns the IDs of the users who can approve the node.
+// It returns false if the approvers are unknown, e.g. for the external approval nodes.
+func listApprovalNodeApprovers(ctx context.Context, s *store.Store, node *storepb.ApprovalNode, projectUID int) (map[int]bool, bool, error) {
+	approvers := map[int]bool{}
+	addProjectRoleMembers := func(role string) error {
+		policy, err := s.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &projectUID})
+		if err != nil {
+			return err
+		}
+		for _, binding := range policy.Bindings {
+			if common.RolePrefix+string(binding.Role) != role {
+				continue
+			}
+			for _, member := range binding.GetAllMembers() {
+				approvers[member.ID] = true
+			}
+		}
+		return nil
+	}
+	addWorkspaceRoleMembers := func(role api.Role) error {
+		users, err := s.ListUsers(ctx, &store.FindUserMessage{Role: &role})
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			approvers[user.ID] = true
+		}
+		return nil
+	}
+
+	switch val := node.Payload.(type) {
+	case *storepb.ApprovalNode_UserList_:
+		for _, name := range val.UserList.Users {
+			userID, err := common.GetUserID(name)
+			if err != nil {
+				return nil, false, err
+			}
+			approvers[userID] = true
+		}
+	case *storepb.ApprovalNode_GroupValue_:
+		var err error
+		switch val.GroupValue {
+		case storepb.ApprovalNode_WORKSPACE_OWNER:
+			err = addWorkspaceRoleMembers(api.Owner)
+		case storepb.ApprovalNode_WORKSPACE_DBA:
+			err = addWorkspaceRoleMembers(api.DBA)
+		case storepb.ApprovalNode_PROJECT_OWNER:
+			err = addProjectRoleMembers(common.RolePrefix + string(api.Owner))
+		case storepb.ApprovalNode_PROJECT_MEMBER:
+			err = addProjectRoleMembers(common.RolePrefix + string(api.Developer))
+		default:
+			return nil, false, errors.Errorf("invalid group value %v", val.GroupValue)
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	case *storepb.ApprovalNode_Role:
+		if err := addProjectRoleMembers(val.Role); err != nil {
+			return nil, false, err
+		}
+	case *storepb.ApprovalNode_UserGroup:
+		groupID := strings.TrimPrefix(val.UserGroup, common.UserGroupNamePrefix)
+		group, err := s.GetUserGroup(ctx, &store.FindUserGroupMessage{ResourceID: &groupID})
+		if err != nil {
+			return nil, false, err
+		}
+		if group != nil {
+			for _, memberID := range group.MemberIDs {
+				approvers[memberID] = true
+			}
+		}
+	default:
+		return nil, false, nil
+	}
+	return approvers, true, nil
+}
+
 func getIssueRisk(ctx context.Context, s *store.Store, licenseService enterpriseAPI.LicenseService, dbFactory *dbfactory.DBFactory, issue *store.IssueMessage, risks []*store.RiskMessage) (int64, store.RiskSource, bool, error) {
 	switch issue.Type {
 	case api.IssueGrantRequest:
@@ -398,7 +509,7 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, licenseSer
 		if seenTaskType[api.TaskDatabaseCreate] {
 			return store.RiskSourceDatabaseCreate
 		}
-		if seenTaskType[api.TaskDatabaseSchemaUpdate] || seenTaskType[api.TaskDatabaseSchemaUpdateSDL] || seenTaskType[api.TaskDatabaseSchemaUpdateGhostSync] {
+		if seenTaskType[api.TaskDatabaseSchemaUpdate] || seenTaskType[api.TaskDatabaseSchemaUpdateSDL] || seenTaskType[api.TaskDatabaseSchemaUpdateGhostSync] || seenTaskType[api.TaskDatabaseSchemaUpdatePGOnlineSync] {
 			return store.RiskSourceDatabaseSchemaUpdate
 		}
 		if seenTaskType[api.TaskDatabaseDataUpdate] {
@@ -3,8 +3,11 @@ package backuprun
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
 	"math"
 	"net/http"
@@ -25,17 +28,18 @@ import (
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/mysql"
-	"github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // NewRunner creates a new backup runner.
-func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, s3Client *s3.Client, stateCfg *state.State, profile *config.Profile) *Runner {
+func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, storageBackends storage.Backends, stateCfg *state.State, profile *config.Profile) *Runner {
 	return &Runner{
 		store:                     store,
 		dbFactory:                 dbFactory,
-		s3Client:                  s3Client,
+		storageBackends:           storageBackends,
 		stateCfg:                  stateCfg,
 		profile:                   profile,
 		downloadBinlogInstanceIDs: make(map[int]bool),
@@ -46,7 +50,7 @@ func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, s3Client *s3.
 type Runner struct {
 	store                     *store.Store
 	dbFactory                 *dbfactory.DBFactory
-	s3Client                  *s3.Client
+	storageBackends           storage.Backends
 	stateCfg                  *state.State
 	profile                   *config.Profile
 	downloadBinlogInstanceIDs map[int]bool
@@ -161,32 +165,32 @@ func (r *Runner) getMaxRetentionPeriodTsForMySQLInstance(ctx context.Context, in
 
 func (r *Runner) purgeBinlogFiles(ctx context.Context, instanceID, retentionPeriodTs int) error {
 	binlogDir := common.GetBinlogAbsDir(r.profile.DataDir, instanceID)
-	switch r.profile.BackupStorageBackend {
-	case api.BackupStorageBackendLocal:
+	if r.profile.BackupStorageBackend == api.BackupStorageBackendLocal {
 		return r.purgeBinlogFilesLocal(binlogDir, retentionPeriodTs)
-	case api.BackupStorageBackendS3:
-		return r.purgeBinlogFilesOnCloud(ctx, binlogDir, retentionPeriodTs)
-	default:
-		return errors.Errorf("purge binlog files not implemented for storage backend %s", r.profile.BackupStorageBackend)
 	}
+	return r.purgeBinlogFilesOnCloud(ctx, binlogDir, retentionPeriodTs)
 }
 
 func (r *Runner) purgeBinlogFilesOnCloud(ctx context.Context, binlogDir string, retentionPeriodTs int) error {
+	backend, err := r.storageBackends.Get(r.profile.BackupStorageBackend)
+	if err != nil {
+		return err
+	}
 	binlogDirOnCloud := common.GetBinlogRelativeDir(binlogDir)
-	listOutput, err := r.s3Client.ListObjects(ctx, binlogDirOnCloud)
+	objects, err := backend.List(ctx, binlogDirOnCloud)
 	if err != nil {
 		return errors.Wrapf(err, "failed to list binlog dir %q in the cloud storage", binlogDirOnCloud)
 	}
 	var purgeBinlogPathList []string
-	for _, item := range listOutput {
-		expireTime := item.LastModified.Add(time.Duration(retentionPeriodTs) * time.Second)
+	for _, object := range objects {
+		expireTime := object.LastModified.Add(time.Duration(retentionPeriodTs) * time.Second)
 		if time.Now().After(expireTime) {
-			purgeBinlogPathList = append(purgeBinlogPathList, *item.Key)
+			purgeBinlogPathList = append(purgeBinlogPathList, object.Path)
 		}
 	}
 	if len(purgeBinlogPathList) > 0 {
 		slog.Debug(fmt.Sprintf("Deleting %d expired binlog files from the cloud storage.", len(purgeBinlogPathList)))
-		if _, err := r.s3Client.DeleteObjects(ctx, purgeBinlogPathList...); err != nil {
+		if err := backend.Delete(ctx, purgeBinlogPathList...); err != nil {
 			return errors.Wrapf(err, "failed to delete %d expired binlog files from the cloud storage", len(purgeBinlogPathList))
 		}
 	}
@@ -239,20 +243,15 @@ func (r *Runner) purgeBackup(ctx context.Context, backup *store.BackupMessage) e
 	}
 	slog.Debug("Archived expired backup record", slog.String("name", backup.Name), slog.Int("id", backup.UID))
 
-	switch backup.StorageBackend {
-	case api.BackupStorageBackendLocal:
-		backupFilePath := GetBackupAbsFilePath(r.profile.DataDir, backup.DatabaseUID, backup.Name)
-		if err := os.Remove(backupFilePath); err != nil {
-			return errors.Wrapf(err, "failed to delete an expired backup file %q", backupFilePath)
-		}
-		slog.Debug(fmt.Sprintf("Deleted expired local backup file %s", backupFilePath))
-	case api.BackupStorageBackendS3:
-		backupFilePath := getBackupRelativeFilePath(backup.DatabaseUID, backup.Name)
-		if _, err := r.s3Client.DeleteObjects(ctx, backupFilePath); err != nil {
-			return errors.Wrapf(err, "failed to delete backup file %s in the cloud storage", backupFilePath)
-		}
-		slog.Debug(fmt.Sprintf("Deleted expired backup file %s in the cloud storage", backupFilePath))
+	backend, err := r.storageBackends.Get(backup.StorageBackend)
+	if err != nil {
+		return err
 	}
+	backupFilePath := getBackupRelativeFilePath(backup.DatabaseUID, backup.Name)
+	if err := backend.Delete(ctx, backupFilePath); err != nil {
+		return errors.Wrapf(err, "failed to delete an expired backup file %s in the %s storage backend", backupFilePath, backup.StorageBackend)
+	}
+	slog.Debug(fmt.Sprintf("Deleted expired backup file %s in the %s storage backend", backupFilePath, backup.StorageBackend))
 
 	return nil
 }
@@ -301,7 +300,12 @@ func (r *Runner) downloadBinlogFilesForInstance(ctx context.Context, instance *s
 		slog.Error("Failed to cast driver to mysql.Driver", slog.String("instance", instance.ResourceID))
 		return
 	}
-	if err := mysqlDriver.FetchAllBinlogFiles(ctx, false /* downloadLatestBinlogFile */, r.s3Client); err != nil {
+	binlogBackend, err := r.GetBinlogStorageBackend()
+	if err != nil {
+		slog.Error("Failed to get the binlog storage backend", slog.String("instance", instance.ResourceID), log.BBError(err))
+		return
+	}
+	if err := mysqlDriver.FetchAllBinlogFiles(ctx, false /* downloadLatestBinlogFile */, binlogBackend); err != nil {
 		slog.Error("Failed to download all binlog files for instance", slog.String("instance", instance.ResourceID), log.BBError(err))
 		return
 	}
@@ -343,7 +347,7 @@ func (r *Runner) startAutoBackups(ctx context.Context) {
 			continue
 		}
 		// backup for ClickHouse, Snowflake, MongoDB, Spanner, Redis, Oracle is not supported.
-		if instance.Engine == db.ClickHouse || instance.Engine == db.Snowflake || instance.Engine == db.MongoDB || instance.Engine == db.Spanner || instance.Engine == db.Redis || instance.Engine == db.Oracle {
+		if !db.HasCapability(instance.Engine, db.CapabilityDump) {
 			continue
 		}
 		environment, err := r.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
@@ -411,6 +415,9 @@ func (r *Runner) ScheduleBackupTask(ctx context.Context, database *store.Databas
 	if instance.Deleted {
 		return nil, errors.Errorf("instance %q deleted", database.InstanceID)
 	}
+	if err := db.CheckCapability(instance.Engine, db.CapabilityDump); err != nil {
+		return nil, err
+	}
 	environment, err := r.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
 	if err != nil {
 		return nil, err
@@ -429,6 +436,10 @@ func (r *Runner) ScheduleBackupTask(ctx context.Context, database *store.Databas
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get migration history for database %q", database.DatabaseName)
 	}
+	storageBackend, err := r.GetStorageBackend(ctx, database.UID, environment.UID)
+	if err != nil {
+		return nil, err
+	}
 	path := getBackupRelativeFilePath(database.UID, backupName)
 	if err := createBackupDirectory(r.profile.DataDir, database.UID); err != nil {
 		return nil, errors.Wrap(err, "failed to create backup directory")
@@ -439,7 +450,7 @@ func (r *Runner) ScheduleBackupTask(ctx context.Context, database *store.Databas
 		Status:                  api.BackupStatusPendingCreate,
 		BackupType:              backupType,
 		Comment:                 "",
-		StorageBackend:          r.profile.BackupStorageBackend,
+		StorageBackend:          storageBackend,
 		MigrationHistoryVersion: migrationHistoryVersion,
 		Path:                    path,
 	}, database.UID, creatorID)
@@ -509,6 +520,39 @@ func (r *Runner) ScheduleBackupTask(ctx context.Context, database *store.Databas
 	return backupNew, nil
 }
 
+// GetStorageBackend returns the storage backend for the new backups of the database.
+// The database backup setting takes precedence over the environment backup plan policy, which in turn takes precedence over the default storage backend of the server.
+func (r *Runner) GetStorageBackend(ctx context.Context, databaseUID, environmentUID int) (api.BackupStorageBackend, error) {
+	storageBackend := r.profile.BackupStorageBackend
+	policy, err := r.store.GetBackupPlanPolicyByEnvID(ctx, environmentUID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get backup plan policy for environment %d", environmentUID)
+	}
+	if policy.StorageBackend != "" {
+		storageBackend = policy.StorageBackend
+	}
+	backupSetting, err := r.store.GetBackupSettingV2(ctx, databaseUID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get backup setting for database %d", databaseUID)
+	}
+	if backupSetting != nil && backupSetting.StorageBackend != "" {
+		storageBackend = backupSetting.StorageBackend
+	}
+	if _, err := r.storageBackends.Get(storageBackend); err != nil {
+		return "", err
+	}
+	return storageBackend, nil
+}
+
+// GetBinlogStorageBackend returns the cloud storage backend archiving the MySQL binlog files, or nil if the binlog files are only kept on the local disk.
+// The binlog files are archived per instance, so they always use the default storage backend of the server.
+func (r *Runner) GetBinlogStorageBackend() (storage.Backend, error) {
+	if r.profile.BackupStorageBackend == api.BackupStorageBackendLocal {
+		return nil, nil
+	}
+	return r.storageBackends.Get(r.profile.BackupStorageBackend)
+}
+
 // Get backup dir relative to the data dir.
 func getBackupRelativeDir(databaseID int) string {
 	return filepath.Join("backup", "db", fmt.Sprintf("%d", databaseID))
@@ -525,6 +569,42 @@ func GetBackupAbsFilePath(dataDir string, databaseID int, name string) string {
 	return filepath.Join(dataDir, path)
 }
 
+// VerifyBackupFile verifies the local backup file against the checksum recorded on the backup.
+// Backups taken before checksums were recorded are not verified.
+func VerifyBackupFile(backup *store.BackupMessage, path string) error {
+	if backup.Payload.Checksum == "" {
+		return nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open backup file %q", path)
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return errors.Wrapf(err, "failed to read backup file %q", path)
+	}
+	if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != backup.Payload.Checksum {
+		return errors.Errorf("checksum mismatch for backup %q, expected %s but got %s", backup.Name, backup.Payload.Checksum, checksum)
+	}
+	return nil
+}
+
+// GetTableRowCounts returns the row counts of the tables in the metadata keyed by the schema-qualified table name.
+func GetTableRowCounts(metadata *storepb.DatabaseSchemaMetadata) map[string]int64 {
+	rowCounts := make(map[string]int64)
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			name := table.GetName()
+			if schema.GetName() != "" {
+				name = fmt.Sprintf("%s.%s", schema.GetName(), table.GetName())
+			}
+			rowCounts[name] = table.GetRowCount()
+		}
+	}
+	return rowCounts
+}
+
 // Create backup directory for database.
 func createBackupDirectory(dataDir string, databaseID int) error {
 	dir := getBackupRelativeDir(databaseID)
@@ -211,7 +211,7 @@ func (s *SlowQueryWeeklyMailSender) sendEmail(ctx context.Context, now time.Time
 
 		for _, binding := range projectPolicy.Bindings {
 			if binding.Role == api.Owner {
-				for _, member := range binding.Members {
+				for _, member := range binding.GetAllMembers() {
 					apiValue.SMTPTo = member.Email
 					subject := fmt.Sprintf("%s database slow query weekly report %s", project.Title, generateDateRange(now))
 					if err := send(apiValue, subject, body); err != nil {
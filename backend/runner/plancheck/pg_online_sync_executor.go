@@ -0,0 +1,127 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var _ Executor = (*PGOnlineSyncExecutor)(nil)
+
+// NewPGOnlineSyncExecutor creates a PostgreSQL online migration sync check executor.
+func NewPGOnlineSyncExecutor(store *store.Store, dbFactory *dbfactory.DBFactory) Executor {
+	return &PGOnlineSyncExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+	}
+}
+
+// PGOnlineSyncExecutor checks if the statement and the table are supported by the PostgreSQL online migration.
+type PGOnlineSyncExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// Run runs the PostgreSQL online migration sync check executor.
+func (e *PGOnlineSyncExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	if config.DatabaseGroupUid != nil {
+		return nil, errors.Errorf("database group is not supported")
+	}
+
+	instanceUID := int(config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+	if instance.Engine != db.Postgres {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Title:   "Online migration check failed",
+				Content: "online migration is only supported for PostgreSQL",
+				Code:    common.Internal.Int64(),
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID}, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+	}
+
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
+	renderedStatement := utils.RenderStatement(statement, materials)
+
+	var migration *pg.OnlineMigration
+	if err := func() error {
+		var err error
+		migration, err = pg.GetOnlineMigration(renderedStatement)
+		if err != nil {
+			return err
+		}
+		driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+		if err != nil {
+			return err
+		}
+		defer driver.Close(ctx)
+		return migration.Check(ctx, driver.GetDB())
+	}(); err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Title:   "Online migration check failed",
+				Content: err.Error(),
+				Code:    common.Internal.Int64(),
+			},
+		}, nil
+	}
+
+	if migration.RestrictsWrites {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Title:   "Writes may fail during online migration",
+				Content: fmt.Sprintf("The statement adds constraints or changes column types. From the start of the sync task, the writes to table %q are replayed onto the shadow table with the change applied, and the writes violating the new constraints or column types fail until the cutover.", migration.Table),
+				Code:    common.Ok.Int64(),
+			},
+		}, nil
+	}
+	return []*storepb.PlanCheckRunResult_Result{
+		{
+			Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+			Title:   "OK",
+			Content: "The table can be migrated online",
+			Code:    common.Ok.Int64(),
+		},
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/metrics"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -123,11 +124,14 @@ func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.Pla
 			s.stateCfg.InstanceOutstandingConnections[instanceUID]--
 			s.stateCfg.Unlock()
 		}()
+		start := time.Now()
 		results, err := runExecutorOnce(ctx, executor, planCheckRun.Config)
 		if err != nil {
+			metrics.ObserveDuration(metrics.PlanCheckRunDuration.WithLabelValues(string(planCheckRun.Type), string(store.PlanCheckRunStatusFailed)), start)
 			s.markPlanCheckRunFailed(ctx, planCheckRun, err.Error())
 			return
 		}
+		metrics.ObserveDuration(metrics.PlanCheckRunDuration.WithLabelValues(string(planCheckRun.Type), string(store.PlanCheckRunStatusDone)), start)
 		s.markPlanCheckRunDone(ctx, planCheckRun, results)
 	}()
 }
@@ -0,0 +1,321 @@
+// Package restoredrill is the runner for restore drills, which restores the latest backups into scratch databases
+// to verify that the backups are restorable.
+package restoredrill
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/codec"
+	"github.com/bytebase/bytebase/backend/runner/backuprun"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// The row counts recorded when the backup is taken are estimates, so we tolerate some differences.
+const rowCountToleranceRatio = 0.1
+
+// NewRunner creates a new restore drill runner.
+func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, storageBackends storage.Backends, profile *config.Profile) *Runner {
+	return &Runner{
+		store:           store,
+		dbFactory:       dbFactory,
+		storageBackends: storageBackends,
+		profile:         profile,
+	}
+}
+
+// Runner is the restore drill runner.
+type Runner struct {
+	store           *store.Store
+	dbFactory       *dbfactory.DBFactory
+	storageBackends storage.Backends
+	profile         *config.Profile
+}
+
+// Run is the runner for restore drills.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(r.profile.RestoreDrillInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Restore drill runner started", slog.String("instance", r.profile.RestoreDrillInstance), slog.Duration("interval", r.profile.RestoreDrillInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Restore drill runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.runDrills(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) runDrills(ctx context.Context) {
+	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &r.profile.RestoreDrillInstance})
+	if err != nil {
+		slog.Error("Failed to get the restore drill instance", slog.String("instance", r.profile.RestoreDrillInstance), log.BBError(err))
+		return
+	}
+	if instance == nil {
+		slog.Error("Restore drill instance not found", slog.String("instance", r.profile.RestoreDrillInstance))
+		return
+	}
+	if err := checkEngine(instance.Engine); err != nil {
+		slog.Error("Restore drill instance is not supported", slog.String("instance", instance.ResourceID), log.BBError(err))
+		return
+	}
+
+	backupStatus := api.BackupStatusDone
+	rowStatus := api.Normal
+	backupList, err := r.store.ListBackupV2(ctx, &store.FindBackupMessage{Status: &backupStatus, RowStatus: &rowStatus})
+	if err != nil {
+		slog.Error("Failed to list backups for restore drills", log.BBError(err))
+		return
+	}
+	// Only the latest backup of each database is drilled.
+	latestBackups := make(map[int]*store.BackupMessage)
+	for _, backup := range backupList {
+		if latest, ok := latestBackups[backup.DatabaseUID]; !ok || backup.CreatedTs > latest.CreatedTs {
+			latestBackups[backup.DatabaseUID] = backup
+		}
+	}
+
+	for _, backup := range latestBackups {
+		if backup.Payload.RestoreDrill != nil {
+			continue
+		}
+		database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &backup.DatabaseUID})
+		if err != nil {
+			slog.Error("Failed to get database", slog.Int("database", backup.DatabaseUID), log.BBError(err))
+			continue
+		}
+		if database == nil {
+			continue
+		}
+		sourceInstance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+		if err != nil {
+			slog.Error("Failed to get instance", slog.String("instance", database.InstanceID), log.BBError(err))
+			continue
+		}
+		// The logical dump can only be restored into an instance of the same engine.
+		if sourceInstance == nil || sourceInstance.Engine != instance.Engine {
+			continue
+		}
+
+		drill := &api.BackupRestoreDrill{
+			InstanceID: instance.ResourceID,
+		}
+		detail, err := r.drill(ctx, instance, database, backup)
+		if err != nil {
+			drill.Detail = err.Error()
+			slog.Warn("Restore drill failed", slog.String("database", database.DatabaseName), slog.String("backup", backup.Name), log.BBError(err))
+		} else {
+			drill.Passed = true
+			drill.Detail = detail
+			slog.Debug("Restore drill passed", slog.String("database", database.DatabaseName), slog.String("backup", backup.Name))
+		}
+		drill.Ts = time.Now().Unix()
+		if err := r.recordDrill(ctx, backup, drill); err != nil {
+			slog.Error("Failed to record restore drill", slog.String("backup", backup.Name), log.BBError(err))
+		}
+	}
+}
+
+// drill restores the backup into a scratch database on the instance, and compares the tables and row counts
+// of the scratch database with the ones recorded when the backup was taken.
+func (r *Runner) drill(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, backup *store.BackupMessage) (string, error) {
+	sourceRowCounts := backup.Payload.TableRowCounts
+	if sourceRowCounts == nil {
+		return "", errors.Errorf("backup %q of database %q has no table row counts recorded, take a new backup to drill", backup.Name, database.DatabaseName)
+	}
+
+	backupPath := filepath.Join(r.profile.DataDir, backup.Path)
+	if backup.StorageBackend != api.BackupStorageBackendLocal {
+		backend, err := r.storageBackends.Get(backup.StorageBackend)
+		if err != nil {
+			return "", err
+		}
+		tmpDir, err := os.MkdirTemp("", "bb-restore-drill-")
+		if err != nil {
+			return "", errors.Wrap(err, "failed to create temporary directory")
+		}
+		defer os.RemoveAll(tmpDir)
+		backupPath = filepath.Join(tmpDir, filepath.Base(backup.Path))
+		if err := storage.DownloadFile(ctx, backend, backupPath, backup.Path); err != nil {
+			return "", errors.Wrapf(err, "failed to download backup %q from %s", backup.Path, backup.StorageBackend)
+		}
+	}
+	if err := backuprun.VerifyBackupFile(backup, backupPath); err != nil {
+		return "", err
+	}
+	backupFile, err := os.Open(backupPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to open backup file %q", backupPath)
+	}
+	defer backupFile.Close()
+	backupReader, err := codec.NewReader(backupFile, codec.ResolveKey)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decode backup file %q", backupPath)
+	}
+	defer backupReader.Close()
+
+	adminDriver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
+	if err != nil {
+		return "", err
+	}
+	defer adminDriver.Close(ctx)
+	scratchDatabaseName := fmt.Sprintf("bbdrill_%d_%d", backup.UID, time.Now().Unix())
+	if _, err := adminDriver.Execute(ctx, fmt.Sprintf("CREATE DATABASE %s;", scratchDatabaseName), true /* createDatabase */, db.ExecuteOptions{}); err != nil {
+		return "", errors.Wrapf(err, "failed to create scratch database %q", scratchDatabaseName)
+	}
+	defer func() {
+		if _, err := adminDriver.Execute(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s;", scratchDatabaseName), true /* createDatabase */, db.ExecuteOptions{}); err != nil {
+			slog.Warn("Failed to drop scratch database of restore drill", slog.String("database", scratchDatabaseName), log.BBError(err))
+		}
+	}()
+
+	restoredRowCounts, err := func() (map[string]int64, error) {
+		// Close the connection to the scratch database before dropping it.
+		scratchDriver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, &store.DatabaseMessage{DatabaseName: scratchDatabaseName})
+		if err != nil {
+			return nil, err
+		}
+		defer scratchDriver.Close(ctx)
+		if err := scratchDriver.Restore(ctx, backupReader); err != nil {
+			return nil, errors.Wrapf(err, "failed to restore backup %q", backup.Name)
+		}
+		metadata, err := scratchDriver.SyncDBSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return countTableRows(ctx, scratchDriver.GetDB(), instance.Engine, metadata)
+	}()
+	if err != nil {
+		return "", err
+	}
+	return compareTableRowCounts(sourceRowCounts, restoredRowCounts)
+}
+
+// countTableRows returns the exact row counts of the tables in the metadata keyed by the schema-qualified table name.
+func countTableRows(ctx context.Context, sqlDB *sql.DB, engine db.Type, metadata *storepb.DatabaseSchemaMetadata) (map[string]int64, error) {
+	quote := func(name string) string {
+		if engine == db.Postgres {
+			return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+		}
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	rowCounts := make(map[string]int64)
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			name, quotedName := table.GetName(), quote(table.GetName())
+			if schema.GetName() != "" {
+				name = fmt.Sprintf("%s.%s", schema.GetName(), table.GetName())
+				quotedName = fmt.Sprintf("%s.%s", quote(schema.GetName()), quotedName)
+			}
+			var rowCount int64
+			if err := sqlDB.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s", quotedName)).Scan(&rowCount); err != nil {
+				return nil, errors.Wrapf(err, "failed to count the rows of table %s", name)
+			}
+			rowCounts[name] = rowCount
+		}
+	}
+	return rowCounts, nil
+}
+
+func (r *Runner) recordDrill(ctx context.Context, backup *store.BackupMessage, drill *api.BackupRestoreDrill) error {
+	payload := backup.Payload
+	payload.RestoreDrill = drill
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal backup payload")
+	}
+	payloadString := string(payloadBytes)
+	if _, err := r.store.UpdateBackupV2(ctx, &store.UpdateBackupMessage{
+		UID:       backup.UID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update backup %q", backup.Name)
+	}
+	return nil
+}
+
+// compareTableRowCounts compares the tables and row counts of the restored database with the source database.
+// It returns the summary if they match, otherwise the error describing the differences.
+func compareTableRowCounts(sourceTables, restoredTables map[string]int64) (string, error) {
+	var diffs []string
+	var totalRows int64
+	for table, rowCount := range sourceTables {
+		restoredRowCount, ok := restoredTables[table]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("table %s is missing", table))
+			continue
+		}
+		if !rowCountMatch(rowCount, restoredRowCount) {
+			diffs = append(diffs, fmt.Sprintf("table %s has %d rows, expected about %d rows", table, restoredRowCount, rowCount))
+		}
+		totalRows += restoredRowCount
+	}
+	for table := range restoredTables {
+		if _, ok := sourceTables[table]; !ok {
+			diffs = append(diffs, fmt.Sprintf("table %s is unexpected", table))
+		}
+	}
+	if len(diffs) > 0 {
+		sort.Strings(diffs)
+		return "", errors.Errorf("restored database does not match the source database: %s", strings.Join(diffs, "; "))
+	}
+	return fmt.Sprintf("restored %d tables with %d rows", len(restoredTables), totalRows), nil
+}
+
+// rowCountMatch reports whether the exact row count of the restored table matches the estimated one of the source table.
+func rowCountMatch(expected, actual int64) bool {
+	if expected <= 0 {
+		// The source table is not analyzed yet.
+		return true
+	}
+	if actual == 0 {
+		return false
+	}
+	diff := expected - actual
+	if diff < 0 {
+		diff = -diff
+	}
+	return float64(diff) <= float64(expected)*rowCountToleranceRatio
+}
+
+func checkEngine(engine db.Type) error {
+	switch engine {
+	case db.MySQL, db.MariaDB, db.TiDB, db.OceanBase, db.Postgres:
+		return db.CheckCapability(engine, db.CapabilityRestore)
+	default:
+		return errors.Errorf("restore drill is not supported for %s", engine)
+	}
+}
@@ -0,0 +1,61 @@
+package restoredrill
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/runner/backuprun"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestCompareTableRowCounts(t *testing.T) {
+	newMetadata := func(rowCounts map[string]int64) *storepb.DatabaseSchemaMetadata {
+		schema := &storepb.SchemaMetadata{Name: "public"}
+		for name, rowCount := range rowCounts {
+			schema.Tables = append(schema.Tables, &storepb.TableMetadata{Name: name, RowCount: rowCount})
+		}
+		return &storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{schema}}
+	}
+
+	tests := []struct {
+		source   map[string]int64
+		restored map[string]int64
+		wantErr  string
+	}{
+		{
+			source:   map[string]int64{"t1": 1000, "t2": 3},
+			restored: map[string]int64{"t1": 1000, "t2": 3},
+		},
+		{
+			// The source row counts are estimates.
+			source:   map[string]int64{"t1": 1000, "t2": 0},
+			restored: map[string]int64{"t1": 950, "t2": 2},
+		},
+		{
+			source:   map[string]int64{"t1": 1000, "t2": 3},
+			restored: map[string]int64{"t1": 1000, "t2": 0},
+			wantErr:  "restored database does not match the source database: table public.t2 has 0 rows, expected about 3 rows",
+		},
+		{
+			source:   map[string]int64{"t1": 1000, "t2": 3},
+			restored: map[string]int64{"t1": 10},
+			wantErr:  "restored database does not match the source database: table public.t1 has 10 rows, expected about 1000 rows; table public.t2 is missing",
+		},
+		{
+			source:   map[string]int64{"t1": 1},
+			restored: map[string]int64{"t1": 1, "t2": 1},
+			wantErr:  "restored database does not match the source database: table public.t2 is unexpected",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := compareTableRowCounts(backuprun.GetTableRowCounts(newMetadata(test.source)), backuprun.GetTableRowCounts(newMetadata(test.restored)))
+		if test.wantErr == "" {
+			a.NoError(err)
+		} else {
+			a.EqualError(err, test.wantErr)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,9 +22,17 @@ import (
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/mysql"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
+const (
+	// backupCleanupInterval is the interval to delete the expired backup tables.
+	backupCleanupInterval = 24 * time.Hour
+	// backupRetentionDays is the number of days to keep the backup tables after the task is last updated.
+	backupRetentionDays = 30
+)
+
 // NewRunner creates a new rollback runner.
 func NewRunner(profile *config.Profile, store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State) *Runner {
 	return &Runner{
@@ -46,10 +55,14 @@ type Runner struct {
 func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(backupCleanupInterval)
+	defer cleanupTicker.Stop()
 	defer wg.Done()
 	r.retryGenerateRollbackSQL(ctx)
 	for {
 		select {
+		case <-cleanupTicker.C:
+			r.deleteExpiredBackupTables(ctx)
 		case <-ticker.C:
 			r.stateCfg.RollbackGenerate.Range(func(key, value any) bool {
 				task := value.(*store.TaskMessage)
@@ -74,7 +87,7 @@ func (r *Runner) retryGenerateRollbackSQL(ctx context.Context) {
 	taskList, err := r.store.ListTasks(ctx, &api.TaskFind{
 		LatestTaskRunStatusList: &[]api.TaskRunStatus{api.TaskRunDone},
 		TypeList:                &[]api.TaskType{api.TaskDatabaseDataUpdate},
-		Payload:                 "(task.payload->>'rollbackEnabled')::BOOLEAN IS TRUE AND (task.payload->>'threadId'!='' OR task.payload->>'transactionId' != '') AND task.payload->>'rollbackSqlStatus'='PENDING'",
+		Payload:                 "(task.payload->>'rollbackEnabled')::BOOLEAN IS TRUE AND (task.payload->>'threadId'!='' OR task.payload->>'transactionId' != '' OR task.payload->'rollbackBackupTables' IS NOT NULL) AND task.payload->>'rollbackSqlStatus'='PENDING'",
 	})
 	if err != nil {
 		slog.Error("Failed to get running DML tasks", log.BBError(err))
@@ -86,6 +99,87 @@ func (r *Runner) retryGenerateRollbackSQL(ctx context.Context) {
 	}
 }
 
+// deleteExpiredBackupTables drops the backup tables of the tasks not updated in the retention period.
+// The rollback SQL of these tasks is marked failed because it restores the rows from the dropped backup tables.
+func (r *Runner) deleteExpiredBackupTables(ctx context.Context) {
+	expiredTs := time.Now().AddDate(0, 0, -backupRetentionDays).Unix()
+	taskList, err := r.store.ListTasks(ctx, &api.TaskFind{
+		TypeList: &[]api.TaskType{api.TaskDatabaseDataUpdate},
+		Payload:  fmt.Sprintf("task.payload->'rollbackBackupTables' IS NOT NULL AND task.payload->>'rollbackSqlStatus' != 'PENDING' AND task.updated_ts < %d", expiredTs),
+	})
+	if err != nil {
+		slog.Error("Failed to list tasks with expired backup tables", log.BBError(err))
+		return
+	}
+	for _, task := range taskList {
+		if err := r.deleteBackupTables(ctx, task); err != nil {
+			slog.Error("Failed to delete expired backup tables", slog.Int("taskID", task.ID), log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) deleteBackupTables(ctx context.Context, task *store.TaskMessage) error {
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return errors.Wrap(err, "invalid database data update payload")
+	}
+	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return errors.Wrap(err, "failed to find instance")
+	}
+	database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return errors.Wrap(err, "failed to find database")
+	}
+	if instance == nil || database == nil {
+		return nil
+	}
+
+	var statements []string
+	for _, backupTable := range payload.RollbackBackupTables {
+		switch instance.Engine {
+		case db.Postgres:
+			statements = append(statements, (&pg.DataBackup{BackupTable: backupTable.BackupTable}).GetDropSQL())
+		case db.MySQL:
+			// The backup table is created even if the statement is skipped when executing it.
+			if backupTable.BackupTable != "" {
+				statements = append(statements, (&mysql.PriorBackup{BackupTable: backupTable.BackupTable}).GetDropSQL())
+			}
+		default:
+			return errors.Errorf("unsupported engine %s", instance.Engine)
+		}
+	}
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return errors.Wrap(err, "failed to get driver")
+	}
+	defer driver.Close(ctx)
+	for _, statement := range statements {
+		if _, err := driver.GetDB().ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to execute %q", statement)
+		}
+	}
+
+	payload.RollbackBackupTables = nil
+	if payload.RollbackSQLStatus == api.RollbackSQLStatusDone {
+		payload.RollbackSQLStatus = api.RollbackSQLStatusFailed
+		payload.RollbackError = fmt.Sprintf("the backup tables are deleted after %d days", backupRetentionDays)
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	if _, err := r.store.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}); err != nil {
+		return errors.Wrap(err, "failed to patch task")
+	}
+	return nil
+}
+
 func (r *Runner) generateRollbackSQL(ctx context.Context, task *store.TaskMessage) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -121,10 +215,159 @@ func (r *Runner) generateRollbackSQL(ctx context.Context, task *store.TaskMessag
 	switch instance.Engine {
 	case db.MySQL:
 		// TODO(d): support MariaDB.
-		r.generateMySQLRollbackSQL(ctx, task, payload, instance, project)
+		if payload.ThreadID == "" {
+			// The thread ID is not set if the rows are backed up before executing the statements with the prior backup.
+			r.generateBackupRollbackSQL(ctx, task, payload, instance, database, project)
+		} else {
+			r.generateMySQLRollbackSQL(ctx, task, payload, instance, project)
+		}
 	case db.Oracle:
 		r.generateOracleRollbackSQL(ctx, task, payload, instance, project)
+	case db.Postgres:
+		r.generateBackupRollbackSQL(ctx, task, payload, instance, database, project)
+	}
+}
+
+// generateBackupRollbackSQL generates the rollback SQL restoring the rows backed up before executing the statements.
+func (r *Runner) generateBackupRollbackSQL(ctx context.Context, task *store.TaskMessage, payload *api.TaskDatabaseDataUpdatePayload, instance *store.InstanceMessage, database *store.DatabaseMessage, project *store.ProjectMessage) {
+	var rollbackSQLStatus api.RollbackSQLStatus
+	var rollbackStatement, rollbackError string
+
+	rollbackSQL, err := r.generateBackupRollbackSQLImpl(ctx, payload, instance, database)
+	if err != nil {
+		slog.Error("Failed to generate rollback SQL statement", log.BBError(err))
+		rollbackSQLStatus = api.RollbackSQLStatusFailed
+		rollbackError = err.Error()
+	} else {
+		rollbackSQLStatus = api.RollbackSQLStatusDone
+		rollbackStatement = rollbackSQL
+	}
+
+	sheet, err := r.store.CreateSheet(ctx, &store.SheetMessage{
+		CreatorID:  api.SystemBotID,
+		ProjectUID: project.UID,
+		Name:       fmt.Sprintf("Sheet for rolling back task %d", task.ID),
+		Statement:  rollbackStatement,
+		Visibility: store.ProjectSheet,
+		Source:     store.SheetFromBytebaseArtifact,
+		Type:       store.SheetForSQL,
+	})
+	if err != nil {
+		slog.Error("failed to create database creation sheet", log.BBError(err))
+		return
+	}
+	patch := &api.TaskPatch{
+		ID:                task.ID,
+		UpdaterID:         api.SystemBotID,
+		RollbackSQLStatus: &rollbackSQLStatus,
+		RollbackSheetID:   &sheet.UID,
+		RollbackError:     &rollbackError,
+	}
+	if _, err := r.store.UpdateTaskV2(ctx, patch); err != nil {
+		slog.Error("Failed to patch task with the rollback SQL from the backup", slog.Int("taskID", task.ID))
+		return
+	}
+	slog.Debug("Rollback SQL generation success", slog.Int("taskID", task.ID))
+}
+
+// generateBackupRollbackSQLImpl generates the statements restoring the backed up rows in the reversed order of the backups.
+func (r *Runner) generateBackupRollbackSQLImpl(ctx context.Context, payload *api.TaskDatabaseDataUpdatePayload, instance *store.InstanceMessage, database *store.DatabaseMessage) (string, error) {
+	// The backups of MySQL may be on other databases of the instance.
+	driverDatabase := database
+	if instance.Engine == db.MySQL {
+		driverDatabase = nil
+	}
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, driverDatabase)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get admin database driver")
+	}
+	defer driver.Close(ctx)
+
+	var buf strings.Builder
+	for i := len(payload.RollbackBackupTables) - 1; i >= 0; i-- {
+		backupTable := payload.RollbackBackupTables[i]
+		var statement string
+		switch instance.Engine {
+		case db.Postgres:
+			primaryKey, err := r.getPrimaryKey(ctx, instance, database.DatabaseName, backupTable.Schema, backupTable.Table)
+			if err != nil {
+				return "", err
+			}
+			backup := &pg.DataBackup{
+				Schema:      backupTable.Schema,
+				Table:       backupTable.Table,
+				BackupTable: backupTable.BackupTable,
+				IsDelete:    backupTable.IsDelete,
+			}
+			columns, err := pg.GetInsertableColumns(ctx, driver.GetDB(), backupTable.Schema, backupTable.Table)
+			if err != nil {
+				return "", err
+			}
+			if statement, err = backup.GetRollbackSQL(primaryKey, columns); err != nil {
+				return "", err
+			}
+		case db.MySQL:
+			var primaryKey, columns []string
+			if backupTable.SkippedReason == "" {
+				var err error
+				if primaryKey, err = r.getPrimaryKey(ctx, instance, backupTable.Schema, "", backupTable.Table); err != nil {
+					return "", err
+				}
+				if columns, err = mysql.GetInsertableColumns(ctx, driver.GetDB(), backupTable.Schema, backupTable.Table); err != nil {
+					return "", err
+				}
+			}
+			backup := &mysql.PriorBackup{
+				Database:      backupTable.Schema,
+				Table:         backupTable.Table,
+				BackupTable:   backupTable.BackupTable,
+				IsDelete:      backupTable.IsDelete,
+				SkippedReason: backupTable.SkippedReason,
+			}
+			var err error
+			if statement, err = backup.GetRollbackSQL(primaryKey, columns); err != nil {
+				return "", err
+			}
+		default:
+			return "", errors.Errorf("unsupported engine %s", instance.Engine)
+		}
+		_, _ = buf.WriteString(statement)
+	}
+	return buf.String(), nil
+}
+
+// getPrimaryKey returns the primary key columns of the table, or nil if the table has no primary key.
+func (r *Runner) getPrimaryKey(ctx context.Context, instance *store.InstanceMessage, databaseName, schemaName, tableName string) ([]string, error) {
+	database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &databaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", databaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database %q not found", databaseName)
+	}
+	dbSchema, err := r.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schema of database %q", databaseName)
+	}
+	if dbSchema == nil {
+		return nil, nil
+	}
+	for _, schema := range dbSchema.Metadata.GetSchemas() {
+		if schema.Name != schemaName {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Name != tableName {
+				continue
+			}
+			for _, index := range table.Indexes {
+				if index.Primary {
+					return index.Expressions, nil
+				}
+			}
+		}
 	}
+	return nil, nil
 }
 
 func (r *Runner) generateOracleRollbackSQL(ctx context.Context, task *store.TaskMessage, payload *api.TaskDatabaseDataUpdatePayload, instance *store.InstanceMessage, project *store.ProjectMessage) {
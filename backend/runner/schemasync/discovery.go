@@ -0,0 +1,282 @@
+package schemasync
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/discovery"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	sensitiveDataDiscoveryInterval = 24 * time.Hour
+	// sampleTableTimeout is the timeout of sampling a table.
+	sampleTableTimeout         = 10 * time.Second
+	defaultDiscoverySampleSize = 100
+	defaultMinConfidence       = 0.5
+	// discoveryConcurrency is the number of databases sampled concurrently.
+	discoveryConcurrency = 4
+)
+
+// RunDiscovery runs the sensitive data discovery on start and every sensitiveDataDiscoveryInterval.
+// It runs apart from the schema syncing because sampling all databases may take hours.
+func (s *Syncer) RunDiscovery(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(sensitiveDataDiscoveryInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Sensitive data discovery started and will run every %v", sensitiveDataDiscoveryInterval))
+	s.tryDiscoverAll(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			s.tryDiscoverAll(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// tryDiscoverAll samples the unclassified columns of all databases, and proposes the data classification
+// of the columns detected sensitive for review if the sensitive data discovery is enabled.
+func (s *Syncer) tryDiscoverAll(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				err = errors.Errorf("%v", r)
+			}
+			slog.Error("Sensitive data discovery PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+	if s.profile.Readonly {
+		return
+	}
+	setting, err := s.store.GetSensitiveDataDiscoverySetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get sensitive data discovery setting", log.BBError(err))
+		return
+	}
+	if !setting.Enabled {
+		return
+	}
+	var detectors []*discovery.Detector
+	for _, config := range setting.Detectors {
+		detector, err := discovery.New(config)
+		if err != nil {
+			slog.Error("Failed to create sensitive data detector", slog.String("detector", config.Id), log.BBError(err))
+			continue
+		}
+		detectors = append(detectors, detector)
+	}
+	if len(detectors) == 0 {
+		return
+	}
+
+	instances, err := s.store.ListInstancesV2(ctx, &store.FindInstanceMessage{})
+	if err != nil {
+		slog.Error("Failed to retrieve instances", log.BBError(err))
+		return
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, discoveryConcurrency)
+	defer wg.Wait()
+	for _, instance := range instances {
+		if !supportSensitiveDataDiscovery(instance.Engine) {
+			continue
+		}
+		if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) != nil {
+			continue
+		}
+		databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID})
+		if err != nil {
+			slog.Error("Failed to retrieve databases", slog.String("instance", instance.ResourceID), log.BBError(err))
+			continue
+		}
+		for _, database := range databases {
+			if database.SyncState != api.OK {
+				continue
+			}
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			wg.Add(1)
+			go func(instance *store.InstanceMessage, database *store.DatabaseMessage) {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Sensitive data discovery PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+					<-sem
+					wg.Done()
+				}()
+				if err := s.discoverSensitiveData(ctx, instance, database, detectors, setting); err != nil {
+					slog.Warn("Failed to discover sensitive data",
+						slog.String("instance", instance.ResourceID),
+						slog.String("database", database.DatabaseName),
+						log.BBError(err))
+				}
+			}(instance, database)
+		}
+	}
+}
+
+// discoverSensitiveData samples the unclassified columns of the database through the read-only data source,
+// and replaces the pending classification suggestions of the database.
+func (s *Syncer) discoverSensitiveData(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, detectors []*discovery.Detector, setting *storepb.SensitiveDataDiscoverySetting) error {
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return err
+	}
+	if dbSchema == nil {
+		return nil
+	}
+	sampleSize := int(setting.SampleSize)
+	if sampleSize <= 0 {
+		sampleSize = defaultDiscoverySampleSize
+	}
+	minConfidence := setting.MinConfidence
+	if minConfidence <= 0 {
+		minConfidence = defaultMinConfidence
+	}
+
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return err
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return errors.Errorf("engine %s does not support sampling", instance.Engine)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var suggestions []*store.ClassificationSuggestionMessage
+	for _, schema := range dbSchema.Metadata.GetSchemas() {
+		for _, table := range schema.Tables {
+			var columns []string
+			for _, column := range table.Columns {
+				if column.Classification == "" {
+					columns = append(columns, column.Name)
+				}
+			}
+			if len(columns) == 0 {
+				continue
+			}
+			tableSuggestions, err := sampleAndDetectTable(ctx, driver, conn, instance.Engine, database.DatabaseName, schema.Name, table.Name, columns, sampleSize, detectors, minConfidence)
+			if err != nil {
+				slog.Debug("Failed to sample table",
+					slog.String("instance", instance.ResourceID),
+					slog.String("database", database.DatabaseName),
+					slog.String("schema", schema.Name),
+					slog.String("table", table.Name),
+					log.BBError(err))
+				continue
+			}
+			for _, suggestion := range tableSuggestions {
+				suggestion.DatabaseUID = database.UID
+			}
+			suggestions = append(suggestions, tableSuggestions...)
+		}
+	}
+	return s.store.ReplaceClassificationSuggestions(ctx, database.UID, suggestions)
+}
+
+func sampleAndDetectTable(ctx context.Context, driver db.Driver, conn *sql.Conn, engine db.Type, databaseName, schemaName, tableName string, columns []string, sampleSize int, detectors []*discovery.Detector, minConfidence float64) ([]*store.ClassificationSuggestionMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, sampleTableTimeout)
+	defer cancel()
+
+	results, err := driver.QueryConn(ctx, conn, getSampleStatement(engine, schemaName, tableName, columns, sampleSize), &db.QueryContext{
+		Limit:           sampleSize,
+		ReadOnly:        true,
+		CurrentDatabase: databaseName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, errors.Errorf("expecting 1 result, but got %d", len(results))
+	}
+	if results[0].Error != "" {
+		return nil, errors.New(results[0].Error)
+	}
+
+	var suggestions []*store.ClassificationSuggestionMessage
+	for i, column := range columns {
+		var values []*v1pb.RowValue
+		for _, row := range results[0].Rows {
+			if i < len(row.Values) {
+				values = append(values, row.Values[i])
+			}
+		}
+		var best *store.ClassificationSuggestionMessage
+		for _, detector := range detectors {
+			confidence := detector.Detect(column, values)
+			if confidence < minConfidence || (best != nil && confidence <= best.Confidence) {
+				continue
+			}
+			best = &store.ClassificationSuggestionMessage{
+				Schema:           schemaName,
+				Table:            tableName,
+				Column:           column,
+				ClassificationID: detector.ClassificationID,
+				DetectorID:       detector.ID,
+				Confidence:       confidence,
+			}
+		}
+		if best != nil {
+			suggestions = append(suggestions, best)
+		}
+	}
+	return suggestions, nil
+}
+
+func getSampleStatement(engine db.Type, schemaName, tableName string, columns []string, sampleSize int) string {
+	quote := func(identifier string) string {
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+	}
+	switch engine {
+	case db.MySQL, db.TiDB, db.MariaDB, db.OceanBase:
+		quote = func(identifier string) string {
+			return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+		}
+	}
+	var quotedColumns []string
+	for _, column := range columns {
+		quotedColumns = append(quotedColumns, quote(column))
+	}
+	table := quote(tableName)
+	if schemaName != "" {
+		table = fmt.Sprintf("%s.%s", quote(schemaName), table)
+	}
+	return fmt.Sprintf("SELECT %s FROM %s LIMIT %d", strings.Join(quotedColumns, ", "), table, sampleSize)
+}
+
+func supportSensitiveDataDiscovery(engine db.Type) bool {
+	switch engine {
+	case db.MySQL, db.TiDB, db.MariaDB, db.OceanBase, db.Postgres:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,58 @@
+package schemasync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetSampleStatement(t *testing.T) {
+	a := require.New(t)
+	a.Equal("SELECT `email`, `a``b` FROM `user` LIMIT 100", getSampleStatement(db.MySQL, "", "user", []string{"email", "a`b"}, 100))
+	a.Equal(`SELECT "email" FROM "public"."User" LIMIT 10`, getSampleStatement(db.Postgres, "public", "User", []string{"email"}, 10))
+}
+
+func TestSetClassificationFromConfig(t *testing.T) {
+	a := require.New(t)
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "user",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "email"},
+							{Name: "phone", Comment: "1-2-3-mobile"},
+						},
+					},
+				},
+			},
+		},
+	}
+	config := &storepb.DatabaseConfig{
+		SchemaConfigs: []*storepb.SchemaConfig{
+			{
+				Name: "public",
+				TableConfigs: []*storepb.TableConfig{
+					{
+						Name: "user",
+						ColumnConfigs: []*storepb.ColumnConfig{
+							{Name: "email", ClassificationId: "1-1-1"},
+							{Name: "phone", ClassificationId: "1-1-1"},
+						},
+					},
+				},
+			},
+		},
+	}
+	setClassificationAndUserCommentFromComment(metadata, config)
+	columns := metadata.Schemas[0].Tables[0].Columns
+	a.Equal("1-1-1", columns[0].Classification)
+	// The classification in the comment takes precedence.
+	a.Equal("1-2-3", columns[1].Classification)
+	a.Equal("mobile", columns[1].UserComment)
+}
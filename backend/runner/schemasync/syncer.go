@@ -19,6 +19,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/metrics"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -241,6 +242,7 @@ func (s *Syncer) SyncInstance(ctx context.Context, instance *store.InstanceMessa
 	if s.profile.Readonly {
 		return nil
 	}
+	defer metrics.ObserveDuration(metrics.SchemaSyncDuration.WithLabelValues(instance.ResourceID, "instance"), time.Now())
 
 	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
 	if err != nil {
@@ -338,6 +340,7 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	if s.profile.Readonly {
 		return nil
 	}
+	defer metrics.ObserveDuration(metrics.SchemaSyncDuration.WithLabelValues(database.InstanceID, "database"), time.Now())
 
 	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
 	if err != nil {
@@ -358,8 +361,6 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	if err != nil {
 		return err
 	}
-	setClassificationAndUserCommentFromComment(databaseMetadata)
-
 	var patchSchemaVersion *string
 	if force {
 		// When there are too many databases, this might have performance issue and will
@@ -391,16 +392,19 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 		return err
 	}
 	var oldDatabaseMetadata *storepb.DatabaseSchemaMetadata
+	var databaseConfig *storepb.DatabaseConfig
 	var rawDump []byte
 	if dbSchema != nil {
 		oldDatabaseMetadata = dbSchema.Metadata
+		databaseConfig = dbSchema.Config
 		rawDump = dbSchema.Schema
 	}
+	setClassificationAndUserCommentFromComment(databaseMetadata, databaseConfig)
 
 	if !cmp.Equal(oldDatabaseMetadata, databaseMetadata, protocmp.Transform()) {
 		// Avoid updating dump everytime by dumping the schema only when the database metadata is changed.
 		// if oldDatabaseMetadata is nil and databaseMetadata is not, they are not equal resulting a sync.
-		if force || !equalDatabaseMetadata(oldDatabaseMetadata, databaseMetadata) {
+		if (force || !equalDatabaseMetadata(oldDatabaseMetadata, databaseMetadata)) && db.HasCapability(instance.Engine, db.CapabilitySchemaDump) {
 			var schemaBuf bytes.Buffer
 			if _, err := driver.Dump(ctx, &schemaBuf, true /* schemaOnly */); err != nil {
 				return err
@@ -748,17 +752,36 @@ func equalDatabaseMetadata(x, y *storepb.DatabaseSchemaMetadata) bool {
 	)
 }
 
-func setClassificationAndUserCommentFromComment(dbSchema *storepb.DatabaseSchemaMetadata) {
+// setClassificationAndUserCommentFromComment sets the classification from the comments,
+// or from the column config for the columns whose comment does not contain one.
+func setClassificationAndUserCommentFromComment(dbSchema *storepb.DatabaseSchemaMetadata, config *storepb.DatabaseConfig) {
+	columnClassification := make(map[string]string)
+	for _, schemaConfig := range config.GetSchemaConfigs() {
+		for _, tableConfig := range schemaConfig.TableConfigs {
+			for _, columnConfig := range tableConfig.ColumnConfigs {
+				if columnConfig.ClassificationId != "" {
+					columnClassification[getColumnKey(schemaConfig.Name, tableConfig.Name, columnConfig.Name)] = columnConfig.ClassificationId
+				}
+			}
+		}
+	}
 	for _, schema := range dbSchema.Schemas {
 		for _, table := range schema.Tables {
 			table.Classification, table.UserComment = common.GetClassificationAndUserComment(table.Comment)
 			for _, col := range table.Columns {
 				col.Classification, col.UserComment = common.GetClassificationAndUserComment(col.Comment)
+				if col.Classification == "" {
+					col.Classification = columnClassification[getColumnKey(schema.Name, table.Name, col.Name)]
+				}
 			}
 		}
 	}
 }
 
+func getColumnKey(schemaName, tableName, columnName string) string {
+	return fmt.Sprintf("%s.%s.%s", schemaName, tableName, columnName)
+}
+
 func getOrDefaultSyncInterval(instance *store.InstanceMessage) time.Duration {
 	if !instance.Activation {
 		return defaultSyncInterval
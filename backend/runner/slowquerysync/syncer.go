@@ -96,6 +96,9 @@ func (s *Syncer) syncSlowQuery(ctx context.Context, message *state.InstanceSlowQ
 		if instance.Deleted {
 			continue
 		}
+		if !db.HasCapability(instance.Engine, db.CapabilitySlowQuery) {
+			continue
+		}
 		instanceWG.Add(1)
 		go func(instance *store.InstanceMessage) {
 			defer instanceWG.Done()
@@ -118,14 +121,14 @@ func (s *Syncer) syncInstanceSlowQuery(ctx context.Context, instance *store.Inst
 		return nil
 	}
 
-	switch instance.Engine {
-	case db.MySQL:
-		return s.syncMySQLSlowQuery(ctx, instance)
-	case db.Postgres:
+	if err := db.CheckCapability(instance.Engine, db.CapabilitySlowQuery); err != nil {
+		return err
+	}
+	// PostgreSQL collects the slow queries from pg_stat_statements per database, and the others read the slow query log of the instance.
+	if instance.Engine == db.Postgres {
 		return s.syncPostgreSQLSlowQuery(ctx, instance, project)
-	default:
-		return errors.Errorf("unsupported database engine: %s", instance.Engine)
 	}
+	return s.syncMySQLSlowQuery(ctx, instance)
 }
 
 func (s *Syncer) syncPostgreSQLSlowQuery(ctx context.Context, instance *store.InstanceMessage, project string) error {
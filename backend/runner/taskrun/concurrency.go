@@ -0,0 +1,135 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// concurrencyKey is the resources whose rollout concurrency policies limit a task run.
+type concurrencyKey struct {
+	instance    *store.InstanceMessage
+	environment *store.EnvironmentMessage
+	project     *store.ProjectMessage
+}
+
+// concurrencyCounter counts the executing task runs by the resource names.
+type concurrencyCounter map[string]int
+
+func newConcurrencyCounter() concurrencyCounter {
+	return concurrencyCounter{}
+}
+
+func (c concurrencyCounter) add(key *concurrencyKey) {
+	for _, name := range key.names() {
+		c[name]++
+	}
+}
+
+func (k *concurrencyKey) names() []string {
+	return []string{
+		fmt.Sprintf("%s%s", common.InstanceNamePrefix, k.instance.ResourceID),
+		fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, k.environment.ResourceID),
+		common.FormatProject(k.project.ResourceID),
+	}
+}
+
+func (s *SchedulerV2) getConcurrencyKey(ctx context.Context, taskRun *store.TaskRunMessage, task *store.TaskMessage) (*concurrencyKey, error) {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %d", task.InstanceID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %d not found", task.InstanceID)
+	}
+	environment, err := s.getTaskEnvironment(ctx, task, instance)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &taskRun.ProjectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", taskRun.ProjectID)
+	}
+	if project == nil {
+		return nil, errors.Errorf("project %q not found", taskRun.ProjectID)
+	}
+	return &concurrencyKey{
+		instance:    instance,
+		environment: environment,
+		project:     project,
+	}, nil
+}
+
+// getReachedConcurrencyLimit returns the name of the first resource whose rollout concurrency limit is reached,
+// or empty if the task run may execute.
+func (s *SchedulerV2) getReachedConcurrencyLimit(ctx context.Context, key *concurrencyKey, counter concurrencyCounter) (string, error) {
+	resources := []struct {
+		resourceType api.PolicyResourceType
+		resourceUID  int
+	}{
+		{resourceType: api.PolicyResourceTypeInstance, resourceUID: key.instance.UID},
+		{resourceType: api.PolicyResourceTypeEnvironment, resourceUID: key.environment.UID},
+		{resourceType: api.PolicyResourceTypeProject, resourceUID: key.project.UID},
+	}
+	names := key.names()
+	for i, resource := range resources {
+		policy, err := s.store.GetRolloutConcurrencyPolicy(ctx, resource.resourceType, resource.resourceUID)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get rollout concurrency policy for %q", names[i])
+		}
+		if policy.MaxRunningTaskRuns > 0 && counter[names[i]] >= int(policy.MaxRunningTaskRuns) {
+			return names[i], nil
+		}
+	}
+	return "", nil
+}
+
+// getTaskEnvironment returns the effective environment of the task database,
+// or the environment of the instance if the task has no database.
+func (s *SchedulerV2) getTaskEnvironment(ctx context.Context, task *store.TaskMessage, instance *store.InstanceMessage) (*store.EnvironmentMessage, error) {
+	// TODO(p0ny): support create database with environment override.
+	environmentID := instance.EnvironmentID
+	if task.DatabaseID != nil {
+		database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+		if err != nil {
+			return nil, err
+		}
+		if database != nil {
+			environmentID = database.EffectiveEnvironmentID
+		}
+	}
+	environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &environmentID})
+	if err != nil {
+		return nil, err
+	}
+	if environment == nil {
+		return nil, errors.Errorf("environment %q not found", environmentID)
+	}
+	return environment, nil
+}
+
+// reportWaitingCause records why the task run is waiting to execute. A nil cause clears the reported cause.
+// The scheduler info is only updated if the cause changes.
+func (s *SchedulerV2) reportWaitingCause(ctx context.Context, taskRun *store.TaskRunMessage, cause *storepb.SchedulerInfo_WaitingCause) error {
+	if proto.Equal(taskRun.SchedulerInfo.GetWaitingCause(), cause) {
+		return nil
+	}
+	schedulerInfo := &storepb.SchedulerInfo{}
+	if cause != nil {
+		schedulerInfo.ReportTime = timestamppb.Now()
+		schedulerInfo.WaitingCause = cause
+	}
+	if err := s.store.UpdateTaskRunSchedulerInfo(ctx, taskRun.ID, schedulerInfo); err != nil {
+		return err
+	}
+	taskRun.SchedulerInfo = schedulerInfo
+	return nil
+}
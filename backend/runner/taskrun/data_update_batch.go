@@ -0,0 +1,368 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/mysql"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	defaultBatchSize = 1000
+	// replicationLagCheckInterval is the interval of checking the replication lag while the execution is throttled.
+	replicationLagCheckInterval = time.Second
+)
+
+// batchStatement is a single-table UPDATE or DELETE statement executed in batches of the primary key ranges.
+type batchStatement interface {
+	GetChunkSQL(primaryKey []string, hasLower, hasUpper bool) (string, error)
+	GetChunkKeysSQL(primaryKey []string, hasLower bool, batchSize int) string
+}
+
+// runBatchedMigration runs the data update in batches of the primary key ranges, each committed separately.
+// The execution resumes from the last committed batch if the previous task run is canceled or interrupted.
+func (exec *DataUpdateExecutor) runBatchedMigration(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, payload *api.TaskDatabaseDataUpdatePayload, statement string) (terminated bool, result *api.TaskRunResultPayload, err error) {
+	mi, err := getMigrationInfo(ctx, exec.store, exec.profile, task, db.Data, statement, payload.SchemaVersion)
+	if err != nil {
+		return true, nil, err
+	}
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	if database == nil {
+		return true, nil, errors.Errorf("database not found")
+	}
+
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
+	}
+	defer driver.Close(ctx)
+
+	migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, exec.store, driver, mi, statement, &payload.SheetID, func(execCtx context.Context, execStatement string) error {
+		return exec.executeInBatches(ctx, execCtx, driver, instance, database, task, payload, execStatement)
+	})
+	if err != nil {
+		return true, nil, err
+	}
+	return postMigration(ctx, exec.store, exec.activityManager, exec.license, task, mi, migrationID, schema, &payload.SheetID)
+}
+
+// executeInBatches executes the statement in batches, and records the progress in the task payload after committing each batch.
+// The batch statements run with the driverCtx, so that canceling the task run rolls back the running batch only.
+// The batches are executed at least once: a batch committed right before an interruption is executed again on resuming
+// because its progress is not recorded yet, so that the assignments depending on the updated columns are rejected when parsing the statement.
+func (exec *DataUpdateExecutor) executeInBatches(ctx context.Context, driverCtx context.Context, driver db.Driver, instance *store.InstanceMessage, database *store.DatabaseMessage, task *store.TaskMessage, payload *api.TaskDatabaseDataUpdatePayload, statement string) error {
+	var batch batchStatement
+	var schemaName, tableName string
+	var updatedColumns []string
+	var getReplicationLag func(context.Context, *sql.Conn) (float64, error)
+	switch instance.Engine {
+	case db.MySQL, db.MariaDB:
+		s, err := mysql.GetBatchStatement(statement)
+		if err != nil {
+			return err
+		}
+		if s.Database != "" && s.Database != database.DatabaseName {
+			return errors.Errorf("batched execution does not support table %q in another database %q", s.Table, s.Database)
+		}
+		batch, tableName, updatedColumns, getReplicationLag = s, s.Table, s.UpdatedColumns, mysql.GetReplicationLagSeconds
+	case db.Postgres:
+		s, err := pg.GetBatchStatement(statement)
+		if err != nil {
+			return err
+		}
+		batch, schemaName, tableName, updatedColumns, getReplicationLag = s, s.Schema, s.Table, s.UpdatedColumns, pg.GetReplicationLagSeconds
+	default:
+		return errors.Errorf("batched execution is not supported for %s", instance.Engine)
+	}
+
+	table, err := exec.getBatchTable(ctx, instance, database, schemaName, tableName)
+	if err != nil {
+		return err
+	}
+	var primaryKey []string
+	for _, index := range table.Indexes {
+		if index.Primary {
+			primaryKey = index.Expressions
+			break
+		}
+	}
+	if len(primaryKey) == 0 {
+		return errors.Errorf("batched execution requires a primary key on table %q", tableName)
+	}
+	for _, column := range updatedColumns {
+		if slices.ContainsFunc(primaryKey, func(key string) bool {
+			// The column names are case-insensitive in MySQL.
+			return key == column || ((instance.Engine == db.MySQL || instance.Engine == db.MariaDB) && strings.EqualFold(key, column))
+		}) {
+			// The changed rows could move into the primary key ranges of the following batches.
+			return errors.Errorf("batched execution does not support updating the primary key column %q", column)
+		}
+	}
+
+	config := payload.BatchConfig
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	progress := payload.BatchProgress
+	if progress == nil || progress.SheetID != payload.SheetID {
+		progress = &api.BatchProgress{SheetID: payload.SheetID}
+	}
+	if progress.Completed {
+		return nil
+	}
+
+	var lagConn *sql.Conn
+	if config.MaxReplicationLagSeconds > 0 {
+		lagDB := driver.GetDB()
+		if instance.Engine == db.MySQL || instance.Engine == db.MariaDB {
+			// MySQL reports the replication lag on the replica, so that we check it through the read-only data source.
+			if utils.DataSourceFromInstanceWithType(instance, api.RO) == nil {
+				return errors.Errorf("replication lag throttling requires a read-only data source connecting to the replica")
+			}
+			readOnlyDriver, err := exec.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database)
+			if err != nil {
+				return errors.Wrap(err, "failed to get the read-only driver")
+			}
+			defer readOnlyDriver.Close(ctx)
+			lagDB = readOnlyDriver.GetDB()
+		}
+		if lagConn, err = lagDB.Conn(ctx); err != nil {
+			return errors.Wrap(err, "failed to create connection")
+		}
+		defer lagConn.Close()
+		if _, err := getReplicationLag(driverCtx, lagConn); err != nil {
+			return errors.Wrap(err, "failed to get the replication lag")
+		}
+	}
+
+	createdTs := time.Now().Unix()
+	reportProgress := func(comment string) {
+		// The row count of the table is an estimate.
+		totalUnit := max(table.RowCount, progress.ScannedRows)
+		completedUnit := progress.ScannedRows
+		if progress.Completed {
+			completedUnit = totalUnit
+		}
+		taskProgress := api.Progress{
+			TotalUnit:     totalUnit,
+			CompletedUnit: completedUnit,
+			CreatedTs:     createdTs,
+			UpdatedTs:     time.Now().Unix(),
+		}
+		if comment != "" {
+			if bytes, err := json.Marshal(map[string]string{"comment": comment}); err == nil {
+				taskProgress.Payload = string(bytes)
+			}
+		}
+		exec.stateCfg.TaskProgress.Store(task.ID, taskProgress)
+	}
+	reportProgress("")
+
+	sqlDB := driver.GetDB()
+	lower := progress.LastKey
+	for {
+		if lagConn != nil {
+			if err := waitForReplicationLag(driverCtx, lagConn, getReplicationLag, config.MaxReplicationLagSeconds, reportProgress); err != nil {
+				return err
+			}
+		}
+
+		start := time.Now()
+		upper, chunkRows, err := getChunkUpperBound(driverCtx, sqlDB, batch, primaryKey, lower, batchSize)
+		if err != nil {
+			if driverCtx.Err() != nil {
+				return driverCtx.Err()
+			}
+			return errors.Wrapf(err, "failed to get the primary key range of the batch after %v", lower)
+		}
+		chunkStatement, err := batch.GetChunkSQL(primaryKey, lower != nil, upper != nil)
+		if err != nil {
+			return err
+		}
+		var args []any
+		for _, value := range append(slices.Clone(lower), upper...) {
+			args = append(args, value)
+		}
+		sqlResult, err := sqlDB.ExecContext(driverCtx, chunkStatement, args...)
+		if err != nil {
+			if driverCtx.Err() != nil {
+				return driverCtx.Err()
+			}
+			return errors.Wrapf(err, "failed to execute the batch after primary key %v", lower)
+		}
+		affectedRows, err := sqlResult.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "failed to get the affected rows of the batch")
+		}
+
+		progress.AffectedRows += affectedRows
+		progress.ScannedRows += chunkRows
+		if upper == nil {
+			progress.Completed = true
+		} else {
+			progress.LastKey = upper
+		}
+		if err := exec.saveBatchProgress(ctx, task.ID, progress); err != nil {
+			return err
+		}
+		reportProgress("")
+		if progress.Completed {
+			break
+		}
+		lower = upper
+
+		if config.MaxRowsPerSecond > 0 {
+			minDuration := time.Duration(float64(affectedRows) / float64(config.MaxRowsPerSecond) * float64(time.Second))
+			if err := sleepWithContext(driverCtx, minDuration-time.Since(start)); err != nil {
+				return err
+			}
+		}
+	}
+
+	slog.Debug("Batched execution done",
+		slog.Int("task_id", task.ID),
+		slog.String("table", tableName),
+		slog.Int64("affected_rows", progress.AffectedRows),
+	)
+	return nil
+}
+
+// getBatchTable returns the metadata of the table changed by the batched execution.
+func (exec *DataUpdateExecutor) getBatchTable(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, schemaName, tableName string) (*storepb.TableMetadata, error) {
+	dbSchema, err := exec.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schema of database %q", database.DatabaseName)
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("schema of database %q not found", database.DatabaseName)
+	}
+	ignoreCase := store.IgnoreDatabaseAndTableCaseSensitive(instance)
+	for _, schema := range dbSchema.Metadata.GetSchemas() {
+		if schema.Name != schemaName {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Name == tableName || (ignoreCase && strings.EqualFold(table.Name, tableName)) {
+				return table, nil
+			}
+		}
+	}
+	return nil, errors.Errorf("table %q not found in database %q, sync the database schema if the table is newly created", tableName, database.DatabaseName)
+}
+
+// saveBatchProgress records the batch progress in the task payload.
+func (exec *DataUpdateExecutor) saveBatchProgress(ctx context.Context, taskID int, progress *api.BatchProgress) error {
+	task, err := exec.store.GetTaskV2ByID(ctx, taskID)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get task by id %d", taskID)
+	}
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return errors.Wrap(err, "invalid database data update payload")
+	}
+	payload.BatchProgress = progress
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	if _, err := exec.store.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:        taskID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to patch task %d with the batch progress", taskID)
+	}
+	return nil
+}
+
+// getChunkUpperBound returns the primary key of the last row in the next batch, or nil if it's the last batch,
+// along with the number of rows in the batch.
+func getChunkUpperBound(ctx context.Context, sqlDB *sql.DB, batch batchStatement, primaryKey []string, lower []string, batchSize int) ([]string, int64, error) {
+	var args []any
+	for _, value := range lower {
+		args = append(args, value)
+	}
+	rows, err := sqlDB.QueryContext(ctx, batch.GetChunkKeysSQL(primaryKey, lower != nil, batchSize), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	upper := make([]string, len(primaryKey))
+	dests := make([]any, len(primaryKey))
+	for i := range upper {
+		dests[i] = &upper[i]
+	}
+	var count int64
+	for rows.Next() {
+		if err := rows.Scan(dests...); err != nil {
+			return nil, 0, err
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	if count < int64(batchSize) {
+		// The last batch has no upper bound to include the rows inserted after the query.
+		return nil, count, nil
+	}
+	return upper, count, nil
+}
+
+// waitForReplicationLag blocks until the replication lag is within maxLagSeconds.
+func waitForReplicationLag(ctx context.Context, conn *sql.Conn, getReplicationLag func(context.Context, *sql.Conn) (float64, error), maxLagSeconds int, reportProgress func(comment string)) error {
+	for {
+		lag, err := getReplicationLag(ctx, conn)
+		if err == nil && lag <= float64(maxLagSeconds) {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			reportProgress(fmt.Sprintf("throttled because failed to get the replication lag: %v", err))
+		} else {
+			reportProgress(fmt.Sprintf("throttled because the replication lag %.1fs exceeds %ds", lag, maxLagSeconds))
+		}
+		if err := sleepWithContext(ctx, replicationLagCheckInterval); err != nil {
+			return err
+		}
+	}
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -49,5 +49,8 @@ func (exec *DataUpdateExecutor) RunOnce(ctx context.Context, driverCtx context.C
 	if err != nil {
 		return true, nil, err
 	}
+	if payload.BatchConfig != nil {
+		return exec.runBatchedMigration(ctx, driverCtx, task, payload, statement)
+	}
 	return runMigration(ctx, driverCtx, exec.store, exec.dbFactory, exec.activityManager, exec.license, exec.stateCfg, exec.profile, task, db.Data, statement, payload.SchemaVersion, &payload.SheetID)
 }
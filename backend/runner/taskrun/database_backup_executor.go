@@ -2,11 +2,15 @@ package taskrun
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/sys/unix"
@@ -14,9 +18,11 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/metrics"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
-	bbs3 "github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/codec"
 	"github.com/bytebase/bytebase/backend/runner/backuprun"
 	"github.com/bytebase/bytebase/backend/store"
 )
@@ -27,21 +33,21 @@ const (
 )
 
 // NewDatabaseBackupExecutor creates a new database backup task executor.
-func NewDatabaseBackupExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile) Executor {
+func NewDatabaseBackupExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, storageBackends storage.Backends, profile config.Profile) Executor {
 	return &DatabaseBackupExecutor{
-		store:     store,
-		dbFactory: dbFactory,
-		s3Client:  s3Client,
-		profile:   profile,
+		store:           store,
+		dbFactory:       dbFactory,
+		storageBackends: storageBackends,
+		profile:         profile,
 	}
 }
 
 // DatabaseBackupExecutor is the task executor for database backup.
 type DatabaseBackupExecutor struct {
-	store     *store.Store
-	dbFactory *dbfactory.DBFactory
-	s3Client  *bbs3.Client
-	profile   config.Profile
+	store           *store.Store
+	dbFactory       *dbfactory.DBFactory
+	storageBackends storage.Backends
+	profile         config.Profile
 }
 
 // RunOnce will run database backup once.
@@ -81,7 +87,8 @@ func (exec *DatabaseBackupExecutor) RunOnce(ctx context.Context, _ context.Conte
 		}
 	}
 	slog.Debug("Start database backup.", slog.String("instance", instance.Title), slog.String("database", database.DatabaseName), slog.String("backup", backup.Name))
-	backupPayload, backupErr := exec.backupDatabase(ctx, exec.dbFactory, exec.s3Client, exec.profile, instance, database, backup)
+	start := time.Now()
+	backupPayload, backupErr := exec.backupDatabase(ctx, exec.dbFactory, exec.storageBackends, exec.profile, instance, database, backup)
 	backupStatus := string(api.BackupStatusDone)
 	comment := ""
 	if backupErr != nil {
@@ -91,6 +98,7 @@ func (exec *DatabaseBackupExecutor) RunOnce(ctx context.Context, _ context.Conte
 			slog.Warn(err.Error())
 		}
 	}
+	metrics.ObserveDuration(metrics.BackupDuration.WithLabelValues(string(instance.Engine), backupStatus), start)
 	backupPatch := store.UpdateBackupMessage{
 		UID:       backup.UID,
 		Status:    &backupStatus,
@@ -138,21 +146,56 @@ func getAvailableFSSpace(path string) (uint64, error) {
 	return stat.Bavail * uint64(stat.Bsize), nil
 }
 
-func dumpBackupFile(ctx context.Context, driver db.Driver, backupFilePath string) (string, error) {
+func dumpBackupFile(ctx context.Context, driver db.Driver, backupFilePath string, opts *codec.Options) (string, error) {
 	backupFile, err := os.Create(backupFilePath)
 	if err != nil {
 		return "", errors.Errorf("failed to open backup path %q", backupFilePath)
 	}
 	defer backupFile.Close()
-	payload, err := driver.Dump(ctx, backupFile, false /* schemaOnly */)
+	// Compress and encrypt the dump on the fly so that the plaintext never touches the disk.
+	// The checksum is computed over the encoded artifact, which is what the storage backend stores.
+	hash := sha256.New()
+	w, err := codec.NewWriter(io.MultiWriter(backupFile, hash), opts, codec.ResolveKey)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to encode backup file %q", backupFilePath)
+	}
+	payload, err := driver.Dump(ctx, w, false /* schemaOnly */)
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to dump database to local backup file %q", backupFilePath)
 	}
-	return payload, nil
+	if err := w.Close(); err != nil {
+		return "", errors.Wrapf(err, "failed to encode backup file %q", backupFilePath)
+	}
+	// Snapshot the row counts right after the dump, so that restore drills don't compare against the rows changed later.
+	metadata, err := driver.SyncDBSchema(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get the table row counts of the backup")
+	}
+
+	// Record the checksum and the encoding on the backup row, so that they are known before downloading the artifact.
+	backupPayload := api.BackupPayload{}
+	if payload != "" {
+		if err := json.Unmarshal([]byte(payload), &backupPayload); err != nil {
+			return "", errors.Wrapf(err, "failed to unmarshal backup payload %q", payload)
+		}
+	}
+	backupPayload.Compression = opts.Compression
+	backupPayload.EncryptionKeyRef = opts.EncryptionKeyRef
+	backupPayload.Checksum = hex.EncodeToString(hash.Sum(nil))
+	backupPayload.TableRowCounts = backuprun.GetTableRowCounts(metadata)
+	payloadBytes, err := json.Marshal(backupPayload)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal backup payload")
+	}
+	return string(payloadBytes), nil
 }
 
 // backupDatabase will take a backup of a database.
-func (*DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, instance *store.InstanceMessage, database *store.DatabaseMessage, backup *store.BackupMessage) (string, error) {
+func (*DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, storageBackends storage.Backends, profile config.Profile, instance *store.InstanceMessage, database *store.DatabaseMessage, backup *store.BackupMessage) (string, error) {
+	backend, err := storageBackends.Get(backup.StorageBackend)
+	if err != nil {
+		return "", err
+	}
 	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
 	if err != nil {
 		return "", err
@@ -160,34 +203,30 @@ func (*DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *db
 	defer driver.Close(ctx)
 
 	backupFilePathLocal := filepath.Join(profile.DataDir, backup.Path)
-	payload, err := dumpBackupFile(ctx, driver, backupFilePathLocal)
+	payload, err := dumpBackupFile(ctx, driver, backupFilePathLocal, &codec.Options{
+		Compression:      profile.BackupCompression,
+		EncryptionKeyRef: profile.BackupEncryptionKeyRef,
+	})
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to dump backup file %q", backupFilePathLocal)
 	}
-
-	switch backup.StorageBackend {
-	case api.BackupStorageBackendLocal:
+	if fileInfo, err := os.Stat(backupFilePathLocal); err == nil {
+		metrics.BackupSize.WithLabelValues(string(instance.Engine)).Observe(float64(fileInfo.Size()))
+	}
+	if backup.StorageBackend == api.BackupStorageBackendLocal {
 		return payload, nil
-	case api.BackupStorageBackendS3:
-		slog.Debug("Uploading backup to s3 bucket.", slog.String("bucket", s3Client.GetBucket()), slog.String("path", backupFilePathLocal))
-		bucketFileToUpload, err := os.Open(backupFilePathLocal)
-		if err != nil {
-			return "", errors.Wrapf(err, "failed to open backup file %q for uploading to s3 bucket", backupFilePathLocal)
-		}
-		defer bucketFileToUpload.Close()
+	}
 
-		if _, err := s3Client.UploadObject(ctx, backup.Path, bucketFileToUpload); err != nil {
-			return "", errors.Wrapf(err, "failed to upload backup to AWS S3")
-		}
-		slog.Debug("Successfully uploaded backup to s3 bucket.")
+	slog.Debug("Uploading backup to the cloud storage.", slog.String("storageBackend", string(backup.StorageBackend)), slog.String("path", backupFilePathLocal))
+	if err := storage.UploadFile(ctx, backend, backup.Path, backupFilePathLocal); err != nil {
+		return "", errors.Wrapf(err, "failed to upload backup to %s", backup.StorageBackend)
+	}
+	slog.Debug("Successfully uploaded backup to the cloud storage.")
 
-		if err := os.Remove(backupFilePathLocal); err != nil {
-			slog.Warn("Failed to remove the local backup file after uploading to s3 bucket.", slog.String("path", backupFilePathLocal), log.BBError(err))
-		} else {
-			slog.Debug("Successfully removed the local backup file after uploading to s3 bucket.", slog.String("path", backupFilePathLocal))
-		}
-		return payload, nil
-	default:
-		return "", errors.Errorf("backup to %s not implemented yet", backup.StorageBackend)
+	if err := os.Remove(backupFilePathLocal); err != nil {
+		slog.Warn("Failed to remove the local backup file after uploading to the cloud storage.", slog.String("path", backupFilePathLocal), log.BBError(err))
+	} else {
+		slog.Debug("Successfully removed the local backup file after uploading to the cloud storage.", slog.String("path", backupFilePathLocal))
 	}
+	return payload, nil
 }
@@ -371,6 +371,9 @@ func (*DatabaseCreateExecutor) getSchemaFromPeerTenantDatabase(ctx context.Conte
 		return "", "", errors.Wrapf(err, "failed to get migration history for database %q", similarDB.DatabaseName)
 	}
 
+	if !db.HasCapability(similarDBInstance.Engine, db.CapabilitySchemaDump) {
+		return schemaVersion, "", nil
+	}
 	var schemaBuf bytes.Buffer
 	if _, err := driver.Dump(ctx, &schemaBuf, true /* schemaOnly */); err != nil {
 		return "", "", err
@@ -24,6 +24,7 @@ import (
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/mysql"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
 	vcsPlugin "github.com/bytebase/bytebase/backend/plugin/vcs"
@@ -32,6 +33,10 @@ import (
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
+// defaultPriorBackupMaxRows is the default max number of rows to back up for a statement with the prior backup.
+// It is also the max number of rows to back up for a PostgreSQL statement.
+const defaultPriorBackupMaxRows = 10000
+
 // Executor is the task executor.
 type Executor interface {
 	// RunOnce will be called periodically by the scheduler until terminated is true.
@@ -209,7 +214,9 @@ func executeMigration(ctx context.Context, driverCtx context.Context, stores *st
 
 	var migrationID string
 	opts := db.ExecuteOptions{}
-	if task.Type == api.TaskDatabaseDataUpdate && (instance.Engine == db.MySQL || instance.Engine == db.MariaDB) {
+	// The prior backup backs up the rows to be changed before executing the statements, instead of generating the rollback SQL from the binlog.
+	priorBackup := instance.Engine == db.MySQL && instance.Options.GetPriorBackup().GetEnabled()
+	if task.Type == api.TaskDatabaseDataUpdate && (instance.Engine == db.MySQL || instance.Engine == db.MariaDB) && !priorBackup {
 		opts.BeginFunc = func(ctx context.Context, conn *sql.Conn) error {
 			updatedTask, err := setThreadIDAndStartBinlogCoordinate(ctx, conn, task, stores)
 			if err != nil {
@@ -223,12 +230,41 @@ func executeMigration(ctx context.Context, driverCtx context.Context, stores *st
 		// getSetOracleTransactionIdFunc will update the task payload to set the Oracle transaction id, we need to re-retrieve the task to store to the RollbackGenerate.
 		opts.EndTransactionFunc = getSetOracleTransactionIDFunc(ctx, task, stores)
 	}
+	if task.Type == api.TaskDatabaseDataUpdate && instance.Engine == db.Postgres {
+		// The rows changed by the migration are backed up before executing it, so that we can generate the rollback SQL from the backup.
+		updatedTask, err := backupPostgresData(ctx, driver, task, stores, statement, defaultPriorBackupMaxRows)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to back up data for PostgreSQL rollback SQL")
+		}
+		task = updatedTask
+	}
+	var mysqlBackups *mysqlPriorBackups
+	if task.Type == api.TaskDatabaseDataUpdate && priorBackup {
+		backups, updatedTask, err := prepareMySQLBackups(ctx, driver, task, stores, database.DatabaseName, statement, int(instance.Options.PriorBackup.MaxRows))
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to back up data for MySQL rollback SQL")
+		}
+		task = updatedTask
+		if backups != nil {
+			// The rows are backed up in the migration transaction.
+			mysqlBackups = backups
+			opts.BeforeStatementFunc = backups.backUp
+		}
+	}
 
 	migrationID, schema, err := utils.ExecuteMigrationDefault(ctx, driverCtx, stores, driver, mi, statement, sheetID, opts)
 	if err != nil {
 		return "", "", err
 	}
 
+	if mysqlBackups != nil {
+		updatedTask, err := mysqlBackups.record(ctx, task, stores)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to record the backup tables for MySQL rollback SQL")
+		}
+		task = updatedTask
+	}
+
 	// If the migration is a data migration, enable the rollback SQL generation and the type of the driver is Oracle, we need to get the rollback SQL before the transaction is committed.
 	if task.Type == api.TaskDatabaseDataUpdate && instance.Engine == db.Oracle {
 		updatedTask, err := stores.GetTaskV2ByID(ctx, task.ID)
@@ -245,7 +281,7 @@ func executeMigration(ctx context.Context, driverCtx context.Context, stores *st
 		}
 	}
 
-	if task.Type == api.TaskDatabaseDataUpdate && (instance.Engine == db.MySQL || instance.Engine == db.MariaDB) {
+	if task.Type == api.TaskDatabaseDataUpdate && (instance.Engine == db.MySQL || instance.Engine == db.MariaDB) && !priorBackup {
 		conn, err := driver.GetDB().Conn(ctx)
 		if err != nil {
 			return "", "", errors.Wrap(err, "failed to create connection")
@@ -266,9 +302,238 @@ func executeMigration(ctx context.Context, driverCtx context.Context, stores *st
 		}
 	}
 
+	if task.Type == api.TaskDatabaseDataUpdate && (instance.Engine == db.Postgres || priorBackup) {
+		payload := &api.TaskDatabaseDataUpdatePayload{}
+		if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+			return "", "", errors.Wrap(err, "invalid database data update payload")
+		}
+		if payload.RollbackEnabled && payload.RollbackSQLStatus == api.RollbackSQLStatusPending {
+			// The runner will periodically scan the map to generate rollback SQL asynchronously.
+			stateCfg.RollbackGenerate.Store(task.ID, task)
+		}
+	}
+
 	return migrationID, schema, nil
 }
 
+// backupPostgresData backs up the rows to be changed by the UPDATE or DELETE statement into the backup schema,
+// and records the backup tables in the task payload. The rollback SQL fails if the statement changes more than maxRows rows,
+// or the rows cannot be backed up, but the migration still runs.
+func backupPostgresData(ctx context.Context, driver db.Driver, task *store.TaskMessage, store *store.Store, statement string, maxRows int) (*store.TaskMessage, error) {
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, errors.Wrap(err, "invalid database data update payload")
+	}
+	if !payload.RollbackEnabled {
+		return task, nil
+	}
+	// We cannot support rollback SQL generation for large statements because backing up the data can take lots of resources.
+	if len(statement) > common.MaxSheetSizeForRollback {
+		return setRollbackSQLFailed(ctx, task, store, "rollback SQL isn't supported for large sheet")
+	}
+
+	backups, err := pg.GetDataBackups(statement, fmt.Sprintf("task%d", task.ID))
+	if err != nil {
+		// The rollback SQL isn't generated if the rows to be changed cannot be backed up, but the migration still runs.
+		return setRollbackSQLFailed(ctx, task, store, fmt.Sprintf("rollback SQL isn't supported: %v", err))
+	}
+	payload.RollbackBackupTables = nil
+	if len(backups) > 0 {
+		tx, err := driver.GetDB().BeginTx(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback()
+		for _, backup := range backups {
+			var count int
+			if err := tx.QueryRowContext(ctx, backup.GetCountSQL()).Scan(&count); err != nil {
+				return setRollbackSQLFailed(ctx, task, store, fmt.Sprintf("failed to count the rows to back up in table %q.%q: %v", backup.Schema, backup.Table, err))
+			}
+			if count > maxRows {
+				return setRollbackSQLFailed(ctx, task, store, fmt.Sprintf("rollback SQL isn't supported: the statement changes %d rows, exceeding the limit %d", count, maxRows))
+			}
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS "%s";`, pg.BackupSchemaName)); err != nil {
+			return setRollbackSQLFailed(ctx, task, store, fmt.Sprintf("failed to create the backup schema %q: %v", pg.BackupSchemaName, err))
+		}
+		for _, backup := range backups {
+			if _, err := tx.ExecContext(ctx, backup.Statement); err != nil {
+				return setRollbackSQLFailed(ctx, task, store, fmt.Sprintf("failed to back up table %q.%q: %v", backup.Schema, backup.Table, err))
+			}
+			payload.RollbackBackupTables = append(payload.RollbackBackupTables, &api.RollbackBackupTable{
+				Schema:      backup.Schema,
+				Table:       backup.Table,
+				BackupTable: backup.BackupTable,
+				IsDelete:    backup.IsDelete,
+			})
+		}
+		if err := tx.Commit(); err != nil {
+			return setRollbackSQLFailed(ctx, task, store, fmt.Sprintf("failed to commit the backup: %v", err))
+		}
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	patch := &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}
+	updatedTask, err := store.UpdateTaskV2(ctx, patch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to patch task %d with the backup tables", task.ID)
+	}
+	return updatedTask, nil
+}
+
+// mysqlPriorBackups backs up the rows to be changed by each UPDATE or DELETE statement right before executing it
+// in the migration transaction, so that the backup includes the changes of the earlier statements.
+type mysqlPriorBackups struct {
+	backups []*mysql.PriorBackup
+	// columns are the insertable columns of the tables of the backups.
+	columns [][]string
+	maxRows int
+}
+
+// prepareMySQLBackups creates the backup tables for the UPDATE and DELETE statements, and records them in the task payload.
+// It returns nil backups if the rollback SQL is disabled or unsupported for the statement, but the migration still runs.
+func prepareMySQLBackups(ctx context.Context, driver db.Driver, task *store.TaskMessage, store *store.Store, databaseName string, statement string, maxRows int) (*mysqlPriorBackups, *store.TaskMessage, error) {
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid database data update payload")
+	}
+	if !payload.RollbackEnabled {
+		return nil, task, nil
+	}
+	if len(statement) > common.MaxSheetSizeForRollback {
+		updatedTask, err := setRollbackSQLFailed(ctx, task, store, "rollback SQL isn't supported for large sheet")
+		return nil, updatedTask, err
+	}
+	if maxRows <= 0 {
+		maxRows = defaultPriorBackupMaxRows
+	}
+
+	backups, err := mysql.GetPriorBackups(statement, databaseName, fmt.Sprintf("task%d", task.ID))
+	if err != nil {
+		// The rollback SQL isn't generated if the statements cannot be split, but the migration still runs.
+		updatedTask, err := setRollbackSQLFailed(ctx, task, store, fmt.Sprintf("rollback SQL isn't supported: %v", err))
+		return nil, updatedTask, err
+	}
+	priorBackups := &mysqlPriorBackups{
+		backups: backups,
+		columns: make([][]string, len(backups)),
+		maxRows: maxRows,
+	}
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create connection")
+	}
+	defer conn.Close()
+	for i, backup := range backups {
+		if !backup.IsBackedUp() {
+			continue
+		}
+		columns, err := mysql.GetInsertableColumns(ctx, driver.GetDB(), backup.Database, backup.Table)
+		if err != nil {
+			backup.SkippedReason = err.Error()
+			continue
+		}
+		priorBackups.columns[i] = columns
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s`", mysql.BackupDatabaseName)); err != nil {
+			backup.SkippedReason = fmt.Sprintf("failed to create the backup database %q: %v", mysql.BackupDatabaseName, err)
+			continue
+		}
+		for _, createStatement := range backup.GetCreateSQL() {
+			if _, err := conn.ExecContext(ctx, createStatement); err != nil {
+				backup.SkippedReason = fmt.Sprintf("failed to create the backup table: %v", err)
+				break
+			}
+		}
+	}
+	// Record the backup tables before executing the statements, so that they are dropped even if the migration fails.
+	updatedTask, err := priorBackups.record(ctx, task, store)
+	if err != nil {
+		return nil, nil, err
+	}
+	return priorBackups, updatedTask, nil
+}
+
+// backUp backs up the rows to be changed by the statement of the index in the migration transaction.
+// The statement is skipped if it changes more than maxRows rows or its rows cannot be backed up, but the migration still runs.
+func (b *mysqlPriorBackups) backUp(ctx context.Context, tx *sql.Tx, index int) error {
+	if index >= len(b.backups) {
+		return errors.Errorf("statement %d has no backup, expect %d statements", index, len(b.backups))
+	}
+	backup := b.backups[index]
+	if !backup.IsBackedUp() {
+		return nil
+	}
+	var count int
+	if err := tx.QueryRowContext(ctx, backup.GetCountSQL()).Scan(&count); err != nil {
+		backup.SkippedReason = fmt.Sprintf("failed to count the rows to back up: %v", err)
+		return nil
+	}
+	if count > b.maxRows {
+		backup.SkippedReason = fmt.Sprintf("the statement changes %d rows, exceeding the limit %d", count, b.maxRows)
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, backup.GetBackupSQL(b.columns[index])); err != nil {
+		backup.SkippedReason = fmt.Sprintf("failed to back up the rows: %v", err)
+	}
+	return nil
+}
+
+// record records the backup tables and the skipped statements in the task payload.
+func (b *mysqlPriorBackups) record(ctx context.Context, task *store.TaskMessage, store *store.Store) (*store.TaskMessage, error) {
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, errors.Wrap(err, "invalid database data update payload")
+	}
+	payload.RollbackBackupTables = nil
+	for _, backup := range b.backups {
+		if backup.BackupTable == "" && backup.SkippedReason == "" {
+			// The statement doesn't change the rows.
+			continue
+		}
+		payload.RollbackBackupTables = append(payload.RollbackBackupTables, &api.RollbackBackupTable{
+			Schema:        backup.Database,
+			Table:         backup.Table,
+			BackupTable:   backup.BackupTable,
+			IsDelete:      backup.IsDelete,
+			SkippedReason: backup.SkippedReason,
+		})
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	patch := &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}
+	updatedTask, err := store.UpdateTaskV2(ctx, patch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to patch task %d with the backup tables", task.ID)
+	}
+	return updatedTask, nil
+}
+
+func setRollbackSQLFailed(ctx context.Context, task *store.TaskMessage, store *store.Store, rollbackError string) (*store.TaskMessage, error) {
+	rollbackSQLStatus := api.RollbackSQLStatusFailed
+	return store.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:                task.ID,
+		UpdaterID:         api.SystemBotID,
+		RollbackSQLStatus: &rollbackSQLStatus,
+		RollbackError:     &rollbackError,
+	})
+}
+
 func getSetOracleTransactionIDFunc(ctx context.Context, task *store.TaskMessage, store *store.Store) func(tx *sql.Tx) error {
 	return func(tx *sql.Tx) error {
 		payload := &api.TaskDatabaseDataUpdatePayload{}
@@ -561,7 +826,7 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 }
 
 func isWriteBack(ctx context.Context, stores *store.Store, license enterpriseAPI.LicenseService, project *store.ProjectMessage, repo *store.RepositoryMessage, task *store.TaskMessage) (string, error) {
-	if task.Type != api.TaskDatabaseSchemaBaseline && task.Type != api.TaskDatabaseSchemaUpdate && task.Type != api.TaskDatabaseSchemaUpdateGhostCutover {
+	if task.Type != api.TaskDatabaseSchemaBaseline && task.Type != api.TaskDatabaseSchemaUpdate && task.Type != api.TaskDatabaseSchemaUpdateGhostCutover && task.Type != api.TaskDatabaseSchemaUpdatePGOnlineCutover {
 		return "", nil
 	}
 	if repo == nil || repo.SchemaPathTemplate == "" {
@@ -0,0 +1,38 @@
+package taskrun
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// checkMaintenanceWindow returns true if the tasks may start at t.
+// Otherwise, it returns the start time of the next maintenance window, which is zero if there is no next window.
+func checkMaintenanceWindow(policy *storepb.MaintenanceWindowPolicy, t time.Time) (bool, time.Time, error) {
+	if len(policy.GetWindows()) == 0 {
+		return true, time.Time{}, nil
+	}
+	var next time.Time
+	for _, window := range policy.Windows {
+		schedule, err := common.ParseCron(window.Cron)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		location, err := time.LoadLocation(window.TimeZone)
+		if err != nil {
+			return false, time.Time{}, errors.Wrapf(err, "invalid time zone %q", window.TimeZone)
+		}
+		duration := window.Duration.AsDuration()
+		// The window [start, start + duration) contains t if it starts in (t - duration, t].
+		if start := schedule.Next(t.In(location).Add(-duration)); !start.IsZero() && !start.After(t) {
+			return true, time.Time{}, nil
+		}
+		if start := schedule.Next(t.In(location)); !start.IsZero() && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	return false, next, nil
+}
@@ -0,0 +1,68 @@
+package taskrun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestCheckMaintenanceWindow(t *testing.T) {
+	a := require.New(t)
+	policy := &storepb.MaintenanceWindowPolicy{
+		Windows: []*storepb.MaintenanceWindowPolicy_Window{
+			// 22:00 - 02:00 from Monday to Friday in New York.
+			{Cron: "0 22 * * 1-5", Duration: durationpb.New(4 * time.Hour), TimeZone: "America/New_York"},
+			// 12:00 - 12:30 on Sunday in UTC.
+			{Cron: "0 12 * * 0", Duration: durationpb.New(30 * time.Minute)},
+		},
+	}
+
+	tests := []struct {
+		t        time.Time
+		inWindow bool
+		next     time.Time
+	}{
+		{
+			// Friday 21:00 in New York.
+			t:    time.Date(2023, 10, 14, 1, 0, 0, 0, time.UTC),
+			next: time.Date(2023, 10, 14, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			// Friday 22:00 in New York.
+			t:        time.Date(2023, 10, 14, 2, 0, 0, 0, time.UTC),
+			inWindow: true,
+		},
+		{
+			// Saturday 01:59 in New York.
+			t:        time.Date(2023, 10, 14, 5, 59, 0, 0, time.UTC),
+			inWindow: true,
+		},
+		{
+			// Saturday 02:00 in New York.
+			t:    time.Date(2023, 10, 14, 6, 0, 0, 0, time.UTC),
+			next: time.Date(2023, 10, 15, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			t:        time.Date(2023, 10, 15, 12, 29, 59, 0, time.UTC),
+			inWindow: true,
+		},
+		{
+			t:    time.Date(2023, 10, 15, 12, 30, 0, 0, time.UTC),
+			next: time.Date(2023, 10, 17, 2, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		inWindow, next, err := checkMaintenanceWindow(policy, test.t)
+		a.NoError(err)
+		a.Equal(test.inWindow, inWindow, test.t)
+		a.True(test.next.Equal(next), "%v: want %v, got %v", test.t, test.next, next)
+	}
+
+	inWindow, _, err := checkMaintenanceWindow(&storepb.MaintenanceWindowPolicy{}, time.Now())
+	a.NoError(err)
+	a.True(inWindow)
+}
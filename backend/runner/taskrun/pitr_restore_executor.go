@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path"
@@ -23,7 +24,8 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/db/mysql"
 	"github.com/bytebase/bytebase/backend/plugin/db/pg"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
-	bbs3 "github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/codec"
 	"github.com/bytebase/bytebase/backend/runner/backuprun"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/store"
@@ -31,25 +33,25 @@ import (
 )
 
 // NewPITRRestoreExecutor creates a PITR restore task executor.
-func NewPITRRestoreExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, schemaSyncer *schemasync.Syncer, stateCfg *state.State, profile config.Profile) Executor {
+func NewPITRRestoreExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, storageBackends storage.Backends, schemaSyncer *schemasync.Syncer, stateCfg *state.State, profile config.Profile) Executor {
 	return &PITRRestoreExecutor{
-		store:        store,
-		dbFactory:    dbFactory,
-		s3Client:     s3Client,
-		schemaSyncer: schemaSyncer,
-		stateCfg:     stateCfg,
-		profile:      profile,
+		store:           store,
+		dbFactory:       dbFactory,
+		storageBackends: storageBackends,
+		schemaSyncer:    schemaSyncer,
+		stateCfg:        stateCfg,
+		profile:         profile,
 	}
 }
 
 // PITRRestoreExecutor is the PITR restore task executor.
 type PITRRestoreExecutor struct {
-	store        *store.Store
-	dbFactory    *dbfactory.DBFactory
-	s3Client     *bbs3.Client
-	schemaSyncer *schemasync.Syncer
-	stateCfg     *state.State
-	profile      config.Profile
+	store           *store.Store
+	dbFactory       *dbfactory.DBFactory
+	storageBackends storage.Backends
+	schemaSyncer    *schemasync.Syncer
+	stateCfg        *state.State
+	profile         config.Profile
 }
 
 // RunOnce will run the PITR restore task executor once.
@@ -75,15 +77,15 @@ func (exec *PITRRestoreExecutor) RunOnce(ctx context.Context, _ context.Context,
 
 	if payload.BackupID != nil {
 		// Restore Backup
-		resultPayload, err := exec.doBackupRestore(ctx, exec.store, exec.dbFactory, exec.s3Client, exec.schemaSyncer, exec.profile, task, payload)
+		resultPayload, err := exec.doBackupRestore(ctx, exec.store, exec.dbFactory, exec.schemaSyncer, exec.profile, task, payload)
 		return true, resultPayload, err
 	}
 
-	resultPayload, err := exec.doPITRRestore(ctx, exec.dbFactory, exec.s3Client, exec.profile, task, payload)
+	resultPayload, err := exec.doPITRRestore(ctx, exec.dbFactory, exec.profile, task, payload)
 	return true, resultPayload, err
 }
 
-func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, schemaSyncer *schemasync.Syncer, profile config.Profile, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
+func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, schemaSyncer *schemasync.Syncer, profile config.Profile, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
 	instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to find database for the backup")
@@ -141,7 +143,7 @@ func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *st
 	)
 
 	// Restore the database to the target database.
-	if err := exec.restoreDatabase(ctx, dbFactory, s3Client, profile, targetInstance, targetDatabase, backup); err != nil {
+	if err := exec.restoreDatabase(ctx, dbFactory, profile, targetInstance, targetDatabase, backup); err != nil {
 		return nil, err
 	}
 	// TODO(zp): This should be done in the same transaction as restoreDatabase to guarantee consistency.
@@ -180,7 +182,7 @@ func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *st
 	}, nil
 }
 
-func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
+func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *dbfactory.DBFactory, profile config.Profile, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
 	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
 		return nil, err
@@ -231,14 +233,21 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 		return nil, errors.Errorf("[internal] cast driver to mysql.Driver failed")
 	}
 
+	// The binlog files are archived in the default storage backend.
+	var binlogBackend storage.Backend
+	if profile.BackupStorageBackend != api.BackupStorageBackendLocal {
+		if binlogBackend, err = exec.storageBackends.Get(profile.BackupStorageBackend); err != nil {
+			return nil, err
+		}
+	}
 	slog.Debug("Downloading all binlog files")
-	if err := mysqlSourceDriver.FetchAllBinlogFiles(ctx, true /* downloadLatestBinlogFile */, s3Client); err != nil {
+	if err := mysqlSourceDriver.FetchAllBinlogFiles(ctx, true /* downloadLatestBinlogFile */, binlogBackend); err != nil {
 		return nil, err
 	}
 
 	targetTs := *payload.PointInTimeTs
 	slog.Debug("Getting latest backup before or equal to targetTs", slog.Int64("targetTs", targetTs))
-	backup, targetBinlogInfo, err := mysqlSourceDriver.GetLatestBackupBeforeOrEqualTs(ctx, backupList, targetTs, s3Client)
+	backup, targetBinlogInfo, err := mysqlSourceDriver.GetLatestBackupBeforeOrEqualTs(ctx, backupList, targetTs, binlogBackend)
 	if err != nil {
 		targetTsHuman := time.Unix(targetTs, 0).Format(time.RFC822)
 		slog.Error("Failed to get backup before or equal to time",
@@ -252,14 +261,16 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	slog.Debug("Got latest backup before or equal to targetTs", slog.String("backup", backup.Name))
 
 	backupAbsPathLocal := backuprun.GetBackupAbsFilePath(profile.DataDir, backup.DatabaseUID, backup.Name)
-	if backup.StorageBackend == api.BackupStorageBackendS3 {
-		if err := downloadBackupFileFromCloud(ctx, s3Client, backup.Path, backupAbsPathLocal); err != nil {
-			return nil, errors.Wrapf(err, "failed to download backup %q from S3", backup.Path)
+	if backup.StorageBackend != api.BackupStorageBackendLocal {
+		if err := downloadBackupFileFromCloud(ctx, exec.storageBackends, backup, backupAbsPathLocal); err != nil {
+			return nil, err
 		}
 		defer os.Remove(backupAbsPathLocal)
-		replayBinlogPathList, err := downloadBinlogFilesFromCloud(ctx, s3Client, startBinlogInfo, *targetBinlogInfo, binlogDir)
+	}
+	if binlogBackend != nil {
+		replayBinlogPathList, err := downloadBinlogFilesFromCloud(ctx, binlogBackend, startBinlogInfo, *targetBinlogInfo, binlogDir)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to download binlog files from %s to %s from S3", startBinlogInfo.FileName, targetBinlogInfo.FileName)
+			return nil, errors.Wrapf(err, "failed to download binlog files from %s to %s from the cloud storage", startBinlogInfo.FileName, targetBinlogInfo.FileName)
 		}
 		defer func() {
 			for _, binlogPath := range replayBinlogPathList {
@@ -270,11 +281,19 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 		}()
 	}
 
+	if err := backuprun.VerifyBackupFile(backup, backupAbsPathLocal); err != nil {
+		return nil, err
+	}
 	backupFile, err := os.Open(backupAbsPathLocal)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open backup file %q", backupAbsPathLocal)
 	}
 	defer backupFile.Close()
+	backupReader, err := newBackupReader(backupFile)
+	if err != nil {
+		return nil, err
+	}
+	defer backupReader.Close()
 	slog.Debug("Successfully opened backup file", slog.String("filename", backupAbsPathLocal))
 
 	slog.Debug("Start creating and restoring PITR database",
@@ -288,7 +307,7 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 
 	if payload.DatabaseName != nil {
 		// case 1: PITR to a new database.
-		if err := mysqlTargetDriver.RestoreBackupToDatabase(ctx, backupFile, *payload.DatabaseName); err != nil {
+		if err := mysqlTargetDriver.RestoreBackupToDatabase(ctx, backupReader, *payload.DatabaseName); err != nil {
 			slog.Error("failed to restore full backup in the new database",
 				slog.Int("issueID", issue.UID),
 				slog.String("databaseName", *payload.DatabaseName),
@@ -304,7 +323,7 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 		}
 	} else {
 		// case 2: in-place PITR.
-		if err := mysqlTargetDriver.RestoreBackupToPITRDatabase(ctx, backupFile, database.DatabaseName, issue.CreatedTime.Unix()); err != nil {
+		if err := mysqlTargetDriver.RestoreBackupToPITRDatabase(ctx, backupReader, database.DatabaseName, issue.CreatedTime.Unix()); err != nil {
 			slog.Error("failed to restore full backup in the PITR database",
 				slog.Int("issueID", issue.UID),
 				slog.String("databaseName", database.DatabaseName),
@@ -330,7 +349,7 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	}, nil
 }
 
-func downloadBinlogFilesFromCloud(ctx context.Context, client *bbs3.Client, startBinlogInfo, targetBinlogInfo api.BinlogInfo, binlogDir string) ([]string, error) {
+func downloadBinlogFilesFromCloud(ctx context.Context, backend storage.Backend, startBinlogInfo, targetBinlogInfo api.BinlogInfo, binlogDir string) ([]string, error) {
 	replayBinlogPathList, err := mysql.GetBinlogReplayList(startBinlogInfo, targetBinlogInfo, binlogDir)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get binlog replay list in directory %s", binlogDir)
@@ -338,14 +357,14 @@ func downloadBinlogFilesFromCloud(ctx context.Context, client *bbs3.Client, star
 	for _, binlogFilePath := range replayBinlogPathList {
 		// Use path.Join to compose a path on cloud which always uses / as the separator.
 		filePathOnCloud := path.Join(common.GetBinlogRelativeDir(binlogDir), filepath.Base(binlogFilePath))
-		if err := client.DownloadFileFromCloud(ctx, binlogFilePath, filePathOnCloud); err != nil {
+		if err := storage.DownloadFile(ctx, backend, binlogFilePath, filePathOnCloud); err != nil {
 			return nil, errors.Wrapf(err, "failed to download binlog file %s from the cloud storage", binlogFilePath)
 		}
 	}
 	return replayBinlogPathList, nil
 }
 
-func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, profile config.Profile, issue *store.IssueMessage, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
+func (exec *PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, profile config.Profile, issue *store.IssueMessage, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
 	if payload.BackupID == nil {
 		return nil, errors.Errorf("PITR for Postgres is not implemented")
 	}
@@ -358,11 +377,25 @@ func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores
 		return nil, errors.Errorf("backup with ID %d not found", *payload.BackupID)
 	}
 	backupFileName := backuprun.GetBackupAbsFilePath(profile.DataDir, backup.DatabaseUID, backup.Name)
+	if backup.StorageBackend != api.BackupStorageBackendLocal {
+		if err := downloadBackupFileFromCloud(ctx, exec.storageBackends, backup, backupFileName); err != nil {
+			return nil, err
+		}
+		defer os.Remove(backupFileName)
+	}
+	if err := backuprun.VerifyBackupFile(backup, backupFileName); err != nil {
+		return nil, err
+	}
 	backupFile, err := os.Open(backupFileName)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open backup file %q", backupFileName)
 	}
 	defer backupFile.Close()
+	backupReader, err := newBackupReader(backupFile)
+	if err != nil {
+		return nil, err
+	}
+	defer backupReader.Close()
 
 	instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
@@ -409,7 +442,7 @@ func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores
 		return nil, err
 	}
 	defer pitrDBDriver.Close(ctx)
-	if err := pitrDBDriver.Restore(ctx, backupFile); err != nil {
+	if err := pitrDBDriver.Restore(ctx, backupReader); err != nil {
 		return nil, errors.Wrapf(err, "failed to restore backup to the PITR database %q", pitrDatabaseName)
 	}
 	return &api.TaskRunResultPayload{
@@ -446,9 +479,14 @@ func (exec *PITRRestoreExecutor) updateProgress(ctx context.Context, driver *mys
 		for {
 			select {
 			case <-ticker.C:
+				// The restored bytes are counted after decompression, which may exceed the size of a compressed backup file.
+				completedUnit := driver.GetRestoredBackupBytes() + driver.GetReplayedBinlogBytes()
+				if completedUnit > totalUnit {
+					completedUnit = totalUnit
+				}
 				exec.stateCfg.TaskProgress.Store(taskID, api.Progress{
 					TotalUnit:     totalUnit,
-					CompletedUnit: driver.GetRestoredBackupBytes() + driver.GetReplayedBinlogBytes(),
+					CompletedUnit: completedUnit,
 					CreatedTs:     createdTs,
 					UpdatedTs:     time.Now().Unix(),
 				})
@@ -462,7 +500,7 @@ func (exec *PITRRestoreExecutor) updateProgress(ctx context.Context, driver *mys
 }
 
 // restoreDatabase will restore the database to the instance from the backup.
-func (*PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, instance *store.InstanceMessage, database *store.DatabaseMessage, backup *store.BackupMessage) error {
+func (exec *PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, profile config.Profile, instance *store.InstanceMessage, database *store.DatabaseMessage, backup *store.BackupMessage) error {
 	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
 	if err != nil {
 		return err
@@ -471,37 +509,53 @@ func (*PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfa
 
 	backupAbsPathLocal := filepath.Join(profile.DataDir, backup.Path)
 
-	if backup.StorageBackend == api.BackupStorageBackendS3 {
-		if err := downloadBackupFileFromCloud(ctx, s3Client, backup.Path, backupAbsPathLocal); err != nil {
-			return errors.Wrapf(err, "failed to download backup %q from S3", backup.Path)
+	if backup.StorageBackend != api.BackupStorageBackendLocal {
+		if err := downloadBackupFileFromCloud(ctx, exec.storageBackends, backup, backupAbsPathLocal); err != nil {
+			return err
 		}
 		defer os.Remove(backupAbsPathLocal)
 	}
 
+	if err := backuprun.VerifyBackupFile(backup, backupAbsPathLocal); err != nil {
+		return err
+	}
 	backupFileLocal, err := os.Open(backupAbsPathLocal)
 	if err != nil {
 		return errors.Wrapf(err, "failed to open backup file at %s", backupAbsPathLocal)
 	}
 	defer backupFileLocal.Close()
+	backupReader, err := newBackupReader(backupFileLocal)
+	if err != nil {
+		return err
+	}
+	defer backupReader.Close()
 
-	if err := driver.Restore(ctx, backupFileLocal); err != nil {
+	if err := driver.Restore(ctx, backupReader); err != nil {
 		return errors.Wrap(err, "failed to restore backup")
 	}
 
 	return nil
 }
 
-func downloadBackupFileFromCloud(ctx context.Context, s3Client *bbs3.Client, backupPath, backupAbsPathLocal string) error {
-	slog.Debug("Downloading backup file from s3 bucket.", slog.String("path", backupPath))
-	backupFileDownload, err := os.Create(backupAbsPathLocal)
+// newBackupReader returns the reader of the backup file, which decompresses and decrypts the backup artifact transparently.
+func newBackupReader(backupFile *os.File) (io.ReadCloser, error) {
+	r, err := codec.NewReader(backupFile, codec.ResolveKey)
 	if err != nil {
-		return errors.Wrapf(err, "failed to create local backup file %q for downloading from s3 bucket", backupAbsPathLocal)
+		return nil, errors.Wrapf(err, "failed to decode backup file %q", backupFile.Name())
+	}
+	return r, nil
+}
+
+func downloadBackupFileFromCloud(ctx context.Context, storageBackends storage.Backends, backup *store.BackupMessage, backupAbsPathLocal string) error {
+	backend, err := storageBackends.Get(backup.StorageBackend)
+	if err != nil {
+		return err
 	}
-	defer backupFileDownload.Close()
-	if _, err := s3Client.DownloadObject(ctx, backupPath, backupFileDownload); err != nil {
-		return errors.Wrapf(err, "failed to download backup file %q from s3 bucket", backupPath)
+	slog.Debug("Downloading backup file from the cloud storage.", slog.String("storageBackend", string(backup.StorageBackend)), slog.String("path", backup.Path))
+	if err := storage.DownloadFile(ctx, backend, backupAbsPathLocal, backup.Path); err != nil {
+		return errors.Wrapf(err, "failed to download backup %q from %s", backup.Path, backup.StorageBackend)
 	}
-	slog.Debug("Successfully downloaded backup file from s3 bucket.")
+	slog.Debug("Successfully downloaded backup file from the cloud storage.")
 	return nil
 }
 
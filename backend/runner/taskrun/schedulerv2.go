@@ -9,11 +9,14 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/metrics"
 	"github.com/bytebase/bytebase/backend/component/state"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
@@ -31,15 +34,18 @@ type SchedulerV2 struct {
 	stateCfg        *state.State
 	activityManager *activity.Manager
 	executorMap     map[api.TaskType]Executor
+	// replicaID is the ID of the replica that claims the task runs for execution.
+	replicaID string
 }
 
 // NewSchedulerV2 will create a new scheduler.
-func NewSchedulerV2(store *store.Store, stateCfg *state.State, activityManager *activity.Manager) *SchedulerV2 {
+func NewSchedulerV2(store *store.Store, stateCfg *state.State, activityManager *activity.Manager, replicaID string) *SchedulerV2 {
 	return &SchedulerV2{
 		store:           store,
 		stateCfg:        stateCfg,
 		activityManager: activityManager,
 		executorMap:     map[api.TaskType]Executor{},
+		replicaID:       replicaID,
 	}
 }
 
@@ -85,6 +91,10 @@ func (s *SchedulerV2) runOnce(ctx context.Context) {
 		}
 	}()
 
+	if err := s.cancelOrphanTaskRuns(ctx); err != nil {
+		slog.Error("failed to cancel orphan task runs", log.BBError(err))
+	}
+
 	if err := s.scheduleAutoRolloutTasks(ctx); err != nil {
 		slog.Error("failed to schedule auto rollout tasks", log.BBError(err))
 	}
@@ -98,6 +108,19 @@ func (s *SchedulerV2) runOnce(ctx context.Context) {
 	}
 }
 
+// cancelOrphanTaskRuns cancels the RUNNING task runs claimed by the replicas that are no longer alive,
+// e.g. the replica crashed or was shut down while executing the task run.
+func (s *SchedulerV2) cancelOrphanTaskRuns(ctx context.Context) error {
+	taskRunIDs, err := s.store.CancelOrphanTaskRuns(ctx, api.SystemBotID)
+	if err != nil {
+		return err
+	}
+	if len(taskRunIDs) > 0 {
+		slog.Warn("Canceled task runs claimed by dead replicas", slog.Any("taskRunIDs", taskRunIDs))
+	}
+	return nil
+}
+
 func (s *SchedulerV2) scheduleAutoRolloutTasks(ctx context.Context) error {
 	taskIDs, err := s.store.ListNotSkippedTasksWithNoTaskRun(ctx)
 	if err != nil {
@@ -124,18 +147,7 @@ func (s *SchedulerV2) scheduleAutoRolloutTask(ctx context.Context, taskUID int)
 	if instance.Deleted {
 		return nil
 	}
-	// TODO(p0ny): support create database with environment override.
-	environmentID := instance.EnvironmentID
-	if task.DatabaseID != nil {
-		database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
-		if err != nil {
-			return err
-		}
-		if database != nil {
-			environmentID = database.EffectiveEnvironmentID
-		}
-	}
-	environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &environmentID})
+	environment, err := s.getTaskEnvironment(ctx, task, instance)
 	if err != nil {
 		return err
 	}
@@ -236,6 +248,7 @@ func (s *SchedulerV2) schedulePendingTaskRuns(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to list pending tasks")
 	}
+	setTaskRunQueueDepth(api.TaskRunPending, taskRuns)
 	for _, taskRun := range taskRuns {
 		if err := s.schedulePendingTaskRun(ctx, taskRun); err != nil {
 			slog.Error("failed to schedule pending task run", log.BBError(err))
@@ -274,6 +287,37 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 		}
 	}
 
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get instance")
+	}
+	environment, err := s.getTaskEnvironment(ctx, task, instance)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get environment")
+	}
+	maintenanceWindowPolicy, err := s.store.GetMaintenanceWindowPolicy(ctx, environment.UID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get maintenance window policy for environment %q", environment.ResourceID)
+	}
+	inWindow, nextWindow, err := checkMaintenanceWindow(maintenanceWindowPolicy, time.Now())
+	if err != nil {
+		return errors.Wrapf(err, "failed to check maintenance window for environment %q", environment.ResourceID)
+	}
+	if !inWindow {
+		maintenanceWindow := &storepb.SchedulerInfo_WaitingCause_MaintenanceWindow{}
+		if !nextWindow.IsZero() {
+			maintenanceWindow.NextWindowTime = timestamppb.New(nextWindow)
+		}
+		return s.reportWaitingCause(ctx, taskRun, &storepb.SchedulerInfo_WaitingCause{
+			Cause: &storepb.SchedulerInfo_WaitingCause_MaintenanceWindow_{
+				MaintenanceWindow: maintenanceWindow,
+			},
+		})
+	}
+	if err := s.reportWaitingCause(ctx, taskRun, nil); err != nil {
+		return err
+	}
+
 	if _, err := s.store.UpdateTaskRunStatus(ctx, &store.TaskRunStatusPatch{
 		ID:        taskRun.ID,
 		UpdaterID: api.SystemBotID,
@@ -291,16 +335,27 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to list pending tasks")
 	}
+	setTaskRunQueueDepth(api.TaskRunRunning, taskRuns)
 
 	// Find the minimum task ID for each database.
 	// We only run the first (i.e. which has the minimum task ID) task for each database.
 	minTaskIDForDatabase := map[int]int{}
+	// Count the task runs executing on all replicas for the concurrency limits.
+	counter := newConcurrencyCounter()
 	for _, taskRun := range taskRuns {
 		task, err := s.store.GetTaskV2ByID(ctx, taskRun.TaskUID)
 		if err != nil {
 			slog.Error("failed to get task", slog.Int("task id", taskRun.TaskUID), log.BBError(err))
 			continue
 		}
+		if taskRun.ReplicaID != "" {
+			key, err := s.getConcurrencyKey(ctx, taskRun, task)
+			if err != nil {
+				slog.Error("failed to get concurrency key", slog.Int("task run id", taskRun.ID), log.BBError(err))
+			} else {
+				counter.add(key)
+			}
+		}
 		if task.DatabaseID == nil {
 			continue
 		}
@@ -340,16 +395,53 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 			)
 			continue
 		}
-		s.stateCfg.Lock()
-		if s.stateCfg.InstanceOutstandingConnections[task.InstanceID] >= state.InstanceMaximumConnectionNumber {
+		key, err := s.getConcurrencyKey(ctx, taskRun, task)
+		if err != nil {
+			slog.Error("failed to get concurrency key", slog.Int("task run id", taskRun.ID), log.BBError(err))
+			continue
+		}
+		limitedResource, err := s.getReachedConcurrencyLimit(ctx, key, counter)
+		if err != nil {
+			slog.Error("failed to check concurrency limits", slog.Int("task run id", taskRun.ID), log.BBError(err))
+			continue
+		}
+		if limitedResource == "" {
+			s.stateCfg.Lock()
+			if s.stateCfg.InstanceOutstandingConnections[task.InstanceID] >= state.InstanceMaximumConnectionNumber {
+				limitedResource = fmt.Sprintf("%s%s", common.InstanceNamePrefix, instance.ResourceID)
+			} else {
+				s.stateCfg.InstanceOutstandingConnections[task.InstanceID]++
+			}
 			s.stateCfg.Unlock()
+		}
+		if limitedResource != "" {
+			if err := s.reportWaitingCause(ctx, taskRun, &storepb.SchedulerInfo_WaitingCause{
+				Cause: &storepb.SchedulerInfo_WaitingCause_ConcurrencyLimit{
+					ConcurrencyLimit: limitedResource,
+				},
+			}); err != nil {
+				slog.Error("failed to report waiting cause", slog.Int("task run id", taskRun.ID), log.BBError(err))
+			}
 			continue
 		}
-		s.stateCfg.InstanceOutstandingConnections[task.InstanceID]++
-		s.stateCfg.Unlock()
+
+		// Claim the task run so that it's never executed by two replicas.
+		claimed, err := s.store.ClaimTaskRun(ctx, taskRun.ID, s.replicaID)
+		if err != nil || !claimed {
+			if err != nil {
+				slog.Error("failed to claim task run", slog.Int("task run id", taskRun.ID), log.BBError(err))
+			}
+			s.stateCfg.Lock()
+			s.stateCfg.InstanceOutstandingConnections[task.InstanceID]--
+			s.stateCfg.Unlock()
+			continue
+		}
+		counter.add(key)
 
 		s.stateCfg.RunningTaskRuns.Store(taskRun.ID, true)
-		go s.runTaskRunOnce(ctx, taskRun, task, executor)
+		// The execution is not canceled when the replica loses the leadership, otherwise the migration would be interrupted.
+		// The task run stays claimed by this replica until it finishes.
+		go s.runTaskRunOnce(context.WithoutCancel(ctx), taskRun, task, executor)
 	}
 
 	return nil
@@ -367,7 +459,17 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 	driverCtx, cancel := context.WithCancel(ctx)
 	s.stateCfg.RunningTaskRunsCancelFunc.Store(taskRun.ID, cancel)
 
+	start := time.Now()
 	done, result, err := RunExecutorOnce(ctx, driverCtx, executor, task)
+	if done {
+		status := api.TaskRunDone
+		if errors.Is(err, context.Canceled) {
+			status = api.TaskRunCanceled
+		} else if err != nil {
+			status = api.TaskRunFailed
+		}
+		metrics.ObserveDuration(metrics.TaskRunDuration.WithLabelValues(string(task.Type), string(status)), start)
+	}
 
 	if !done && err != nil {
 		slog.Debug("Encountered transient error running task, will retry",
@@ -376,6 +478,10 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 			slog.String("type", string(task.Type)),
 			log.BBError(err),
 		)
+		// Release the claim so that the task run can be retried by the current leader.
+		if err := s.store.ReleaseTaskRun(ctx, taskRun.ID, s.replicaID); err != nil {
+			slog.Error("Failed to release task run", slog.Int("id", taskRun.ID), log.BBError(err))
+		}
 		return
 	}
 
@@ -689,29 +795,16 @@ func (s *SchedulerV2) createActivityForTaskRunStatusUpdate(ctx context.Context,
 	}
 }
 
-// ClearRunningTaskRuns changes all RUNNING taskRuns to CANCELED.
-// When there are running taskRuns and Bytebase server is shutdown, these task executors are stopped, but the taskRuns' status are still RUNNING.
-// When Bytebase is restarted, the task scheduler will re-schedule those RUNNING tasks, which should be CANCELED instead.
-// So we change their status to CANCELED before starting the scheduler.
-// And corresponding taskRuns are also changed to CANCELED.
-func (s *SchedulerV2) ClearRunningTaskRuns(ctx context.Context) error {
-	runningTaskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
-		Status: &[]api.TaskRunStatus{api.TaskRunRunning},
-	})
-	if err != nil {
-		return errors.Wrap(err, "failed to list running task runs")
+// setTaskRunQueueDepth sets the queue depth of the task runs in the status by task type.
+func setTaskRunQueueDepth(status api.TaskRunStatus, taskRuns []*store.TaskRunMessage) {
+	depth := map[api.TaskType]int{}
+	for _, taskRun := range taskRuns {
+		depth[taskRun.TaskType]++
 	}
-
-	if len(runningTaskRuns) > 0 {
-		var taskRunIDs []int
-		for _, taskRun := range runningTaskRuns {
-			taskRunIDs = append(taskRunIDs, taskRun.ID)
-		}
-		if err := s.store.BatchPatchTaskRunStatus(ctx, taskRunIDs, api.TaskRunCanceled, api.SystemBotID); err != nil {
-			return errors.Wrapf(err, "failed to change task run %v's status to %s", taskRunIDs, api.TaskRunCanceled)
-		}
+	metrics.TaskRunQueueDepth.DeletePartialMatch(prometheus.Labels{"status": string(status)})
+	for taskType, count := range depth {
+		metrics.TaskRunQueueDepth.WithLabelValues(string(taskType), string(status)).Set(float64(count))
 	}
-	return nil
 }
 
 func tasksSkippedOrDone(tasks []*store.TaskMessage) (bool, error) {
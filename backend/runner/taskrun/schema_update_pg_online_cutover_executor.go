@@ -0,0 +1,123 @@
+package taskrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// NewSchemaUpdatePGOnlineCutoverExecutor creates a schema update (PostgreSQL online migration) cutover task executor.
+func NewSchemaUpdatePGOnlineCutoverExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, activityManager *activity.Manager, license enterpriseAPI.LicenseService, schemaSyncer *schemasync.Syncer, profile config.Profile) Executor {
+	return &SchemaUpdatePGOnlineCutoverExecutor{
+		store:           store,
+		dbFactory:       dbFactory,
+		activityManager: activityManager,
+		license:         license,
+		schemaSyncer:    schemaSyncer,
+		profile:         profile,
+	}
+}
+
+// SchemaUpdatePGOnlineCutoverExecutor is the schema update (PostgreSQL online migration) cutover task executor.
+// It replaces the original table with the shadow table synced by the sync task, and records the migration history.
+// The original table is kept as "_<table>_old", which is dropped by the users in a later change after verifying the migration.
+type SchemaUpdatePGOnlineCutoverExecutor struct {
+	store           *store.Store
+	dbFactory       *dbfactory.DBFactory
+	activityManager *activity.Manager
+	license         enterpriseAPI.LicenseService
+	schemaSyncer    *schemasync.Syncer
+	profile         config.Profile
+}
+
+// RunOnce will run SchemaUpdatePGOnlineCutover task once.
+func (e *SchemaUpdatePGOnlineCutoverExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage) (bool, *api.TaskRunResultPayload, error) {
+	if len(task.BlockedBy) != 1 {
+		return true, nil, errors.Errorf("failed to find task dag for ToTask %v", task.ID)
+	}
+	syncTaskID := task.BlockedBy[0]
+
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+
+	syncTask, err := e.store.GetTaskV2ByID(ctx, syncTaskID)
+	if err != nil {
+		return true, nil, errors.Wrap(err, "failed to get schema update PostgreSQL online sync task for cutover task")
+	}
+	payload := &api.TaskDatabaseSchemaUpdatePGOnlineSyncPayload{}
+	if err := json.Unmarshal([]byte(syncTask.Payload), payload); err != nil {
+		return true, nil, errors.Wrap(err, "invalid database schema update PostgreSQL online sync payload")
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, payload.SheetID)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get sheet statement by id: %d", payload.SheetID)
+	}
+
+	terminated, result, err := e.cutover(ctx, driverCtx, instance, database, task, statement, payload.SheetID, payload.SchemaVersion)
+	if err := e.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
+		slog.Error("failed to sync database schema",
+			slog.String("instanceName", instance.ResourceID),
+			slog.String("databaseName", database.DatabaseName),
+			log.BBError(err),
+		)
+	}
+
+	return terminated, result, err
+}
+
+func (e *SchemaUpdatePGOnlineCutoverExecutor) cutover(ctx context.Context, driverCtx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, task *store.TaskMessage, statement string, sheetID int, schemaVersion string) (bool, *api.TaskRunResultPayload, error) {
+	mi, err := getMigrationInfo(ctx, e.store, e.profile, task, db.Migrate, statement, schemaVersion)
+	if err != nil {
+		return true, nil, err
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
+	}
+	defer driver.Close(ctx)
+
+	// not using the rendered statement in the migration history because we want to avoid leaking the rendered statement
+	var oldTable string
+	execFunc := func(execCtx context.Context, renderedStatement string) error {
+		migration, err := pg.GetOnlineMigration(renderedStatement)
+		if err != nil {
+			return err
+		}
+		oldTable = migration.GetOldTable()
+		return retryOnLockNotAvailable(execCtx, func() error {
+			return migration.Cutover(execCtx, driver.GetDB(), pgOnlineLockTimeout)
+		})
+	}
+	migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, e.store, driver, mi, statement, &sheetID, execFunc)
+	if err != nil {
+		return true, nil, err
+	}
+
+	terminated, result, err := postMigration(ctx, e.store, e.activityManager, e.license, task, mi, migrationID, schema, &sheetID)
+	if result != nil && oldTable != "" {
+		result.Detail = fmt.Sprintf("%s. The original table is kept as %q, drop it after verifying the migration", result.Detail, oldTable)
+	}
+	return terminated, result, err
+}
@@ -0,0 +1,190 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+const (
+	// pgOnlineLockTimeout is the lock_timeout of the statements locking the original table in the PostgreSQL online migration.
+	// The statements waiting for the lock block the other queries on the table, so that they give up early and retry later.
+	pgOnlineLockTimeout = 3 * time.Second
+	// pgOnlineLockRetries is the number of attempts to acquire the lock of the original table.
+	pgOnlineLockRetries = 10
+	// pgOnlineLockRetryInterval is the interval between the attempts to acquire the lock of the original table.
+	pgOnlineLockRetryInterval = 5 * time.Second
+)
+
+// NewSchemaUpdatePGOnlineSyncExecutor creates a schema update (PostgreSQL online migration) sync task executor.
+func NewSchemaUpdatePGOnlineSyncExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State) Executor {
+	return &SchemaUpdatePGOnlineSyncExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+		stateCfg:  stateCfg,
+	}
+}
+
+// SchemaUpdatePGOnlineSyncExecutor is the schema update (PostgreSQL online migration) sync task executor.
+// It creates the shadow table with the change applied, installs the triggers replaying the row changes onto the shadow table,
+// and copies the existing rows in batches. The triggers keep the shadow table in sync until the cutover task runs.
+type SchemaUpdatePGOnlineSyncExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+	stateCfg  *state.State
+}
+
+// RunOnce will run SchemaUpdatePGOnlineSync task once.
+func (exec *SchemaUpdatePGOnlineSyncExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage) (terminated bool, result *api.TaskRunResultPayload, err error) {
+	payload := &api.TaskDatabaseSchemaUpdatePGOnlineSyncPayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return true, nil, errors.Wrap(err, "invalid database schema update PostgreSQL online sync payload")
+	}
+	statement, err := exec.store.GetSheetStatementByID(ctx, payload.SheetID)
+	if err != nil {
+		return true, nil, err
+	}
+
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	if instance == nil {
+		return true, nil, errors.Errorf("instance %d not found", task.InstanceID)
+	}
+	if instance.Engine != db.Postgres {
+		return true, nil, errors.Errorf("online migration is not supported for %s", instance.Engine)
+	}
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	if database == nil {
+		return true, nil, errors.Errorf("database not found")
+	}
+
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
+	renderedStatement := utils.RenderStatement(statement, materials)
+	migration, err := pg.GetOnlineMigration(renderedStatement)
+	if err != nil {
+		return true, nil, err
+	}
+
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+
+	if err := migration.Check(ctx, sqlDB); err != nil {
+		return true, nil, err
+	}
+	if err := retryOnLockNotAvailable(driverCtx, func() error {
+		return migration.Prepare(driverCtx, sqlDB, pgOnlineLockTimeout)
+	}); err != nil {
+		return true, nil, err
+	}
+	if err := exec.copyRows(driverCtx, task, sqlDB, migration); err != nil {
+		// Drop the shadow table and the triggers, so that the writes to the original table don't replay onto the abandoned shadow table.
+		if cleanupErr := retryOnLockNotAvailable(ctx, func() error {
+			return migration.Cleanup(ctx, sqlDB, pgOnlineLockTimeout)
+		}); cleanupErr != nil {
+			slog.Error("failed to clean up the PostgreSQL online migration",
+				slog.Int("task_id", task.ID),
+				slog.String("table", migration.Table),
+				log.BBError(cleanupErr),
+			)
+		}
+		return true, nil, err
+	}
+	return true, &api.TaskRunResultPayload{Detail: "sync done"}, nil
+}
+
+// copyRows copies the rows of the original table to the shadow table in batches of the primary key ranges.
+func (exec *SchemaUpdatePGOnlineSyncExecutor) copyRows(ctx context.Context, task *store.TaskMessage, sqlDB *sql.DB, migration *pg.OnlineMigration) error {
+	// The row count of the table is an estimate.
+	totalUnit, err := migration.GetRowEstimate(ctx, sqlDB)
+	if err != nil {
+		slog.Debug("failed to get the row estimate", slog.String("table", migration.Table), log.BBError(err))
+	}
+	var completedUnit int64
+	createdTs := time.Now().Unix()
+	reportProgress := func() {
+		exec.stateCfg.TaskProgress.Store(task.ID, api.Progress{
+			TotalUnit:     max(totalUnit, completedUnit),
+			CompletedUnit: completedUnit,
+			CreatedTs:     createdTs,
+			UpdatedTs:     time.Now().Unix(),
+		})
+	}
+	reportProgress()
+
+	var lower []string
+	for {
+		upper, _, err := getChunkUpperBound(ctx, sqlDB, migration, migration.PrimaryKey, lower, defaultBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return errors.Wrapf(err, "failed to get the primary key range of the batch after %v", lower)
+		}
+		chunkStatement, err := migration.GetChunkSQL(migration.PrimaryKey, lower != nil, upper != nil)
+		if err != nil {
+			return err
+		}
+		var args []any
+		for _, value := range append(slices.Clone(lower), upper...) {
+			args = append(args, value)
+		}
+		sqlResult, err := sqlDB.ExecContext(ctx, chunkStatement, args...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return errors.Wrapf(err, "failed to copy the rows after primary key %v to the shadow table", lower)
+		}
+		copiedRows, err := sqlResult.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "failed to get the copied rows of the batch")
+		}
+		completedUnit += copiedRows
+		if upper == nil {
+			totalUnit = completedUnit
+			reportProgress()
+			return nil
+		}
+		reportProgress()
+		lower = upper
+	}
+}
+
+// retryOnLockNotAvailable retries f while it fails to acquire the lock of the original table within the lock_timeout.
+func retryOnLockNotAvailable(ctx context.Context, f func() error) error {
+	var err error
+	for i := 0; i < pgOnlineLockRetries; i++ {
+		if err = f(); err == nil || !pg.IsLockNotAvailable(err) {
+			return err
+		}
+		slog.Debug("failed to acquire the lock of the original table, retrying", slog.Int("attempt", i+1), log.BBError(err))
+		if err := sleepWithContext(ctx, pgOnlineLockRetryInterval); err != nil {
+			return err
+		}
+	}
+	return errors.Wrapf(err, "failed to acquire the lock of the original table after %d attempts", pgOnlineLockRetries)
+}
@@ -0,0 +1,187 @@
+// Package usergroupsync is a runner that syncs the user group memberships from identity providers.
+package usergroupsync
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	userGroupSyncInterval = 1 * time.Hour
+)
+
+// NewSyncer creates a new user group syncer.
+func NewSyncer(store *store.Store) *Syncer {
+	return &Syncer{
+		store: store,
+	}
+}
+
+// Syncer is the user group syncer.
+// Only LDAP identity providers are synced periodically, as the groups of OAuth2 and OIDC
+// users can only be read from their tokens on login.
+type Syncer struct {
+	store *store.Store
+}
+
+// Run will run the user group syncer.
+func (s *Syncer) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(userGroupSyncInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("User group syncer started and will run every %s", userGroupSyncInterval.String()))
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Debug("User group syncer received context cancellation")
+			return
+		case <-ticker.C:
+			s.syncAll(ctx)
+		}
+	}
+}
+
+func (s *Syncer) syncAll(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				err = errors.Errorf("%v", r)
+			}
+			slog.Error("User group syncer PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+
+	idps, err := s.store.ListIdentityProviders(ctx, &store.FindIdentityProviderMessage{})
+	if err != nil {
+		slog.Error("Failed to list identity providers", log.BBError(err))
+		return
+	}
+	for _, idp := range idps {
+		if idp.Deleted || idp.Type != storepb.IdentityProviderType_LDAP || !IsGroupSyncEnabled(idp) {
+			continue
+		}
+		if err := s.syncLDAP(ctx, idp); err != nil {
+			slog.Error("Failed to sync user groups from LDAP", slog.String("idp", idp.ResourceID), log.BBError(err))
+		}
+	}
+}
+
+func (s *Syncer) syncLDAP(ctx context.Context, idp *store.IdentityProviderMessage) error {
+	idpConfig := idp.Config.GetLdapConfig()
+	ldapIDP, err := ldap.NewIdentityProvider(
+		ldap.IdentityProviderConfig{
+			Host:             idpConfig.Host,
+			Port:             int(idpConfig.Port),
+			SkipTLSVerify:    idpConfig.SkipTlsVerify,
+			BindDN:           idpConfig.BindDn,
+			BindPassword:     idpConfig.BindPassword,
+			BaseDN:           idpConfig.BaseDn,
+			UserFilter:       idpConfig.UserFilter,
+			SecurityProtocol: ldap.SecurityProtocol(idpConfig.SecurityProtocol),
+			FieldMapping:     idpConfig.FieldMapping,
+			GroupBaseDN:      idpConfig.GroupBaseDn,
+			GroupFilter:      idpConfig.GroupFilter,
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create LDAP identity provider")
+	}
+	userInfos, err := ldapIDP.ListUsers()
+	if err != nil {
+		return errors.Wrap(err, "failed to list LDAP users")
+	}
+	if len(userInfos) == 0 {
+		// An empty directory is more likely a misconfigured filter, so keep the memberships instead of removing them all.
+		slog.Warn("No user is found in LDAP, skip syncing user groups", slog.String("idp", idp.ResourceID))
+		return nil
+	}
+	linkedUsers, err := s.listLinkedUsers(ctx, idp)
+	if err != nil {
+		return err
+	}
+	var userIDs []int
+	for _, userInfo := range userInfos {
+		// Only the users who have signed in with the identity provider are synced.
+		user, ok := linkedUsers[userInfo.Identifier]
+		if !ok {
+			continue
+		}
+		userIDs = append(userIDs, user.ID)
+		if err := SyncUserGroups(ctx, s.store, idp, user.ID, userInfo.Groups); err != nil {
+			return errors.Wrapf(err, "failed to sync groups of user %q", user.Email)
+		}
+	}
+	// The users who are no longer in the directory lose their memberships, and so the project roles granted through the groups.
+	if err := s.store.RemoveStaleUserGroupMembers(ctx, idp.UID, userIDs); err != nil {
+		return errors.Wrap(err, "failed to remove the users no longer in LDAP from the groups")
+	}
+	return nil
+}
+
+// listLinkedUsers returns the users by their identifiers in the identity provider.
+// The directory users are only matched to the users who have signed in with the identity provider, rather than
+// by the emails which the users can change themselves. The identifiers linked to more than one user are skipped.
+func (s *Syncer) listLinkedUsers(ctx context.Context, idp *store.IdentityProviderMessage) (map[string]*store.UserMessage, error) {
+	users, err := s.store.ListUsers(ctx, &store.FindUserMessage{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list users")
+	}
+	linkedUsers := make(map[string]*store.UserMessage)
+	ambiguous := make(map[string]bool)
+	for _, user := range users {
+		for _, identity := range user.Profile.GetIdentities() {
+			if int(identity.IdpUid) != idp.UID {
+				continue
+			}
+			if existing, ok := linkedUsers[identity.Identifier]; ok && existing.ID != user.ID {
+				ambiguous[identity.Identifier] = true
+			}
+			linkedUsers[identity.Identifier] = user
+		}
+	}
+	for identifier := range ambiguous {
+		slog.Warn("Identity provider user is linked to more than one user, skip syncing user groups", slog.String("idp", idp.ResourceID), slog.String("identifier", identifier))
+		delete(linkedUsers, identifier)
+	}
+	return linkedUsers, nil
+}
+
+// IsGroupSyncEnabled returns whether the identity provider is configured to sync user groups.
+func IsGroupSyncEnabled(idp *store.IdentityProviderMessage) bool {
+	switch idp.Type {
+	case storepb.IdentityProviderType_OAUTH2:
+		return idp.Config.GetOauth2Config().GetFieldMapping().GetGroups() != ""
+	case storepb.IdentityProviderType_OIDC:
+		return idp.Config.GetOidcConfig().GetFieldMapping().GetGroups() != ""
+	case storepb.IdentityProviderType_LDAP:
+		ldapConfig := idp.Config.GetLdapConfig()
+		return ldapConfig.GetFieldMapping().GetGroups() != "" || ldapConfig.GetGroupBaseDn() != ""
+	default:
+		return false
+	}
+}
+
+// SyncUserGroups syncs the user's membership in the groups of the identity provider.
+// The groups missing in Bytebase are created, and the user is removed from the other groups of the identity provider.
+func SyncUserGroups(ctx context.Context, stores *store.Store, idp *store.IdentityProviderMessage, userID int, groups []string) error {
+	externalGroups := make(map[string]string)
+	for _, group := range groups {
+		title := group
+		if idp.Type == storepb.IdentityProviderType_LDAP {
+			title = ldap.GetGroupTitle(group)
+		}
+		externalGroups[group] = title
+	}
+	return stores.SyncUserGroupMembership(ctx, idp.UID, idp.ResourceID, userID, externalGroups)
+}
@@ -0,0 +1,181 @@
+// Package webhookdelivery is the runner for posting the project webhook deliveries.
+package webhookdelivery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/metrics"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	runnerInterval = 5 * time.Second
+	// batchSize is the max number of deliveries posted in one round.
+	batchSize = 100
+	// concurrency is the max number of deliveries posted concurrently.
+	concurrency = 10
+	// MaxAttempts is the max number of attempts before a delivery is marked DEAD.
+	MaxAttempts = 8
+	baseBackoff = 10 * time.Second
+	maxBackoff  = time.Hour
+	// cleanupInterval is the interval to delete the outdated deliveries.
+	cleanupInterval = time.Hour
+	// retentionPeriod is the period to keep the DONE and DEAD deliveries.
+	retentionPeriod = 30 * 24 * time.Hour
+)
+
+// NewRunner creates a webhook delivery runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store: store,
+	}
+}
+
+// Runner is the runner for posting the webhook deliveries.
+type Runner struct {
+	store *store.Store
+}
+
+// Run will run the webhook delivery runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(cleanupInterval)
+	defer cleanupTicker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Webhook delivery runner started and will run every %v", runnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.deliverReady(ctx)
+		case <-cleanupTicker.C:
+			r.deleteOutdated(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deleteOutdated deletes the finished deliveries older than the retention period.
+func (r *Runner) deleteOutdated(ctx context.Context) {
+	count, err := r.store.DeleteOutdatedWebhookDeliveries(ctx, time.Now().Add(-retentionPeriod))
+	if err != nil {
+		slog.Error("Failed to delete outdated webhook deliveries", log.BBError(err))
+		return
+	}
+	if count > 0 {
+		slog.Debug("Deleted outdated webhook deliveries", slog.Int64("count", count))
+	}
+}
+
+func (r *Runner) deliverReady(ctx context.Context) {
+	limit := batchSize
+	deliveries, err := r.store.ListWebhookDeliveries(ctx, &store.FindWebhookDeliveryMessage{
+		Ready: true,
+		Limit: &limit,
+	})
+	if err != nil {
+		slog.Error("Failed to list ready webhook deliveries", log.BBError(err))
+		return
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, delivery := range deliveries {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(delivery *store.WebhookDeliveryMessage) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := r.deliver(ctx, delivery); err != nil {
+				slog.Error("Failed to deliver webhook", slog.Int("delivery", delivery.ID), log.BBError(err))
+			}
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+// deliver posts the delivery to the webhook and records the result of the attempt.
+func (r *Runner) deliver(ctx context.Context, delivery *store.WebhookDeliveryMessage) error {
+	hook, err := r.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{ID: &delivery.WebhookID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get webhook %d", delivery.WebhookID)
+	}
+	if hook == nil {
+		// The deliveries are deleted along with the webhook.
+		return nil
+	}
+
+	var webhookCtx webhook.Context
+	if err := json.Unmarshal([]byte(delivery.Payload), &webhookCtx); err != nil {
+		return r.store.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDeliveryMessage{
+			ID:            delivery.ID,
+			Status:        store.WebhookDeliveryDead,
+			Attempt:       delivery.Attempt,
+			NextAttemptTs: delivery.NextAttemptTs,
+			LastError:     fmt.Sprintf("malformed payload: %v", err),
+		})
+	}
+	webhookCtx.URL = hook.URL
+	webhookCtx.Secret = hook.Secret
+	webhookCtx.DeliveryID = delivery.ID
+
+	update := getAttemptResult(delivery, webhook.Post(hook.Type, webhookCtx), time.Now())
+	if update.Status != store.WebhookDeliveryDone {
+		metrics.WebhookDeliveryFailures.WithLabelValues(hook.Type, string(update.Status)).Inc()
+	}
+	if update.Status == store.WebhookDeliveryDead {
+		// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning.
+		slog.Warn("Webhook delivery failed after the max attempts",
+			slog.String("webhook type", hook.Type),
+			slog.String("webhook name", hook.Title),
+			slog.Int("delivery", delivery.ID),
+			slog.String("error", update.LastError))
+	}
+	return r.store.UpdateWebhookDelivery(ctx, update)
+}
+
+// getAttemptResult returns the delivery update for the result of an attempt.
+func getAttemptResult(delivery *store.WebhookDeliveryMessage, postErr error, now time.Time) *store.UpdateWebhookDeliveryMessage {
+	update := &store.UpdateWebhookDeliveryMessage{
+		ID:            delivery.ID,
+		Status:        store.WebhookDeliveryDone,
+		Attempt:       delivery.Attempt + 1,
+		NextAttemptTs: delivery.NextAttemptTs,
+	}
+	if postErr == nil {
+		return update
+	}
+	update.LastError = postErr.Error()
+	if update.Attempt >= MaxAttempts {
+		update.Status = store.WebhookDeliveryDead
+		return update
+	}
+	update.Status = store.WebhookDeliveryPending
+	update.NextAttemptTs = now.Add(getBackoff(update.Attempt)).Unix()
+	return update
+}
+
+// getBackoff returns the delay before the next attempt after the given number of attempts,
+// which doubles for every attempt and is capped at maxBackoff.
+func getBackoff(attempt int) time.Duration {
+	backoff := baseBackoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return backoff
+}
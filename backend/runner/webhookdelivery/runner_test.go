@@ -0,0 +1,34 @@
+package webhookdelivery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetBackoff(t *testing.T) {
+	a := require.New(t)
+	a.Equal(10*time.Second, getBackoff(1))
+	a.Equal(20*time.Second, getBackoff(2))
+	a.Equal(40*time.Second, getBackoff(3))
+	a.Equal(320*time.Second, getBackoff(6))
+	a.Equal(time.Hour, getBackoff(10))
+}
+
+func TestGetAttemptResult(t *testing.T) {
+	a := require.New(t)
+	now := time.Unix(1700000000, 0)
+
+	update := getAttemptResult(&store.WebhookDeliveryMessage{ID: 1, Attempt: 0, NextAttemptTs: 100}, nil, now)
+	a.Equal(&store.UpdateWebhookDeliveryMessage{ID: 1, Status: store.WebhookDeliveryDone, Attempt: 1, NextAttemptTs: 100}, update)
+
+	update = getAttemptResult(&store.WebhookDeliveryMessage{ID: 1, Attempt: 1, NextAttemptTs: 100}, errors.New("status code: 500"), now)
+	a.Equal(&store.UpdateWebhookDeliveryMessage{ID: 1, Status: store.WebhookDeliveryPending, Attempt: 2, NextAttemptTs: now.Unix() + 20, LastError: "status code: 500"}, update)
+
+	update = getAttemptResult(&store.WebhookDeliveryMessage{ID: 1, Attempt: MaxAttempts - 1, NextAttemptTs: 100}, errors.New("timeout"), now)
+	a.Equal(&store.UpdateWebhookDeliveryMessage{ID: 1, Status: store.WebhookDeliveryDead, Attempt: MaxAttempts, NextAttemptTs: 100, LastError: "timeout"}, update)
+}
@@ -67,9 +67,9 @@ func configureGrpcRouters(
 		dbFactory,
 		schemaSyncer))
 	v1pb.RegisterProjectServiceServer(grpcServer, v1.NewProjectService(stores, activityManager, licenseService))
-	v1pb.RegisterDatabaseServiceServer(grpcServer, v1.NewDatabaseService(stores, backupRunner, schemaSyncer, licenseService))
+	v1pb.RegisterDatabaseServiceServer(grpcServer, v1.NewDatabaseService(stores, backupRunner, schemaSyncer, licenseService, profile))
 	v1pb.RegisterInstanceRoleServiceServer(grpcServer, v1.NewInstanceRoleService(stores, dbFactory))
-	v1pb.RegisterOrgPolicyServiceServer(grpcServer, v1.NewOrgPolicyService(stores, licenseService))
+	v1pb.RegisterOrgPolicyServiceServer(grpcServer, v1.NewOrgPolicyService(stores, profile, licenseService))
 	v1pb.RegisterIdentityProviderServiceServer(grpcServer, v1.NewIdentityProviderService(stores, licenseService))
 	v1pb.RegisterSettingServiceServer(grpcServer, v1.NewSettingService(stores, profile, licenseService, stateCfg))
 	v1pb.RegisterAnomalyServiceServer(grpcServer, v1.NewAnomalyService(stores))
@@ -81,6 +81,7 @@ func configureGrpcRouters(
 	rolloutService := v1.NewRolloutService(stores, licenseService, dbFactory, planCheckScheduler, stateCfg, activityManager)
 	v1pb.RegisterRolloutServiceServer(grpcServer, rolloutService)
 	v1pb.RegisterRoleServiceServer(grpcServer, v1.NewRoleService(stores, licenseService))
+	v1pb.RegisterUserGroupServiceServer(grpcServer, v1.NewUserGroupService(stores))
 	v1pb.RegisterSheetServiceServer(grpcServer, v1.NewSheetService(stores, licenseService))
 	v1pb.RegisterSchemaDesignServiceServer(grpcServer, v1.NewSchemaDesignService(stores, licenseService))
 	v1pb.RegisterCelServiceServer(grpcServer, v1.NewCelService())
@@ -141,6 +142,9 @@ func configureGrpcRouters(
 	if err := v1pb.RegisterRoleServiceHandler(ctx, mux, grpcConn); err != nil {
 		return nil, nil, err
 	}
+	if err := v1pb.RegisterUserGroupServiceHandler(ctx, mux, grpcConn); err != nil {
+		return nil, nil, err
+	}
 	if err := v1pb.RegisterSheetServiceHandler(ctx, mux, grpcConn); err != nil {
 		return nil, nil, err
 	}
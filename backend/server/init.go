@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,9 +12,15 @@ import (
 
 	"github.com/bytebase/bytebase/backend/api/auth"
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/config"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/metric"
 	metricCollector "github.com/bytebase/bytebase/backend/metric/collector"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/azblob"
+	"github.com/bytebase/bytebase/backend/plugin/storage/gcs"
+	"github.com/bytebase/bytebase/backend/plugin/storage/local"
+	bbs3 "github.com/bytebase/bytebase/backend/plugin/storage/s3"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -138,6 +146,32 @@ func (s *Server) getInitSetting(ctx context.Context, datastore *store.Store) (st
 		return "", "", 0, err
 	}
 
+	// initial masking algorithm setting
+	maskingAlgorithmSettingValue, err := protojson.Marshal(&storepb.MaskingAlgorithmSetting{})
+	if err != nil {
+		return "", "", 0, errors.Wrap(err, "failed to marshal initial masking algorithm setting")
+	}
+	if _, _, err := datastore.CreateSettingIfNotExistV2(ctx, &store.SettingMessage{
+		Name:        api.SettingMaskingAlgorithms,
+		Value:       string(maskingAlgorithmSettingValue),
+		Description: "The masking algorithm setting",
+	}, api.SystemBotID); err != nil {
+		return "", "", 0, err
+	}
+
+	// initial sensitive data discovery setting
+	sensitiveDataDiscoverySettingValue, err := protojson.Marshal(&storepb.SensitiveDataDiscoverySetting{})
+	if err != nil {
+		return "", "", 0, errors.Wrap(err, "failed to marshal initial sensitive data discovery setting")
+	}
+	if _, _, err := datastore.CreateSettingIfNotExistV2(ctx, &store.SettingMessage{
+		Name:        api.SettingSensitiveDataDiscovery,
+		Value:       string(sensitiveDataDiscoverySettingValue),
+		Description: "The sensitive data discovery setting",
+	}, api.SystemBotID); err != nil {
+		return "", "", 0, err
+	}
+
 	// initial workspace approval setting
 	approvalSettingValue, err := protojson.Marshal(&storepb.WorkspaceApprovalSetting{})
 	if err != nil {
@@ -224,3 +258,44 @@ func (s *Server) initMetricReporter() {
 	metricReporter.Register(metric.MemberCountMetricName, metricCollector.NewMemberCountCollector(s.store))
 	s.metricReporter = metricReporter
 }
+
+// newBackupStorageBackends creates the backup storage backends.
+// The local storage backend is rooted at the data directory, and the cloud ones are created from the backup buckets.
+func newBackupStorageBackends(ctx context.Context, profile *config.Profile) (storage.Backends, error) {
+	backends := storage.Backends{
+		api.BackupStorageBackendLocal: local.NewBackend(profile.DataDir),
+	}
+	for _, bucket := range profile.BackupBuckets {
+		switch bucket.StorageBackend {
+		case api.BackupStorageBackendS3:
+			credentials, err := bbs3.GetCredentialsFromFile(ctx, bucket.CredentialFile)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get credentials from file")
+			}
+			client, err := bbs3.NewClient(ctx, bucket.Region, bucket.Bucket, bucket.Endpoint, credentials)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to create AWS S3 client")
+			}
+			backends[bucket.StorageBackend] = client
+		case api.BackupStorageBackendGCS:
+			client, err := gcs.NewClient(ctx, bucket.Bucket, bucket.CredentialFile)
+			if err != nil {
+				return nil, err
+			}
+			backends[bucket.StorageBackend] = client
+		case api.BackupStorageBackendAzureBlob:
+			connectionString, err := os.ReadFile(bucket.CredentialFile)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read Azure Blob Storage connection string from file %s", bucket.CredentialFile)
+			}
+			client, err := azblob.NewClient(bucket.Bucket, strings.TrimSpace(string(connectionString)))
+			if err != nil {
+				return nil, err
+			}
+			backends[bucket.StorageBackend] = client
+		default:
+			return nil, errors.Errorf("unsupported backup storage backend %s", bucket.StorageBackend)
+		}
+	}
+	return backends, nil
+}
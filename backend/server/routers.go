@@ -16,6 +16,7 @@ import (
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
 	"google.golang.org/grpc"
 
+	"github.com/bytebase/bytebase/backend/api/scim"
 	"github.com/bytebase/bytebase/backend/common/log"
 )
 
@@ -27,10 +28,11 @@ func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, mux *grpcRuntim
 	e.HidePort = true
 	e.Use(recoverMiddleware)
 	grpcSkipper := func(c echo.Context) bool {
-		// Skip grpc and webhook calls.
+		// Skip grpc, webhook and SCIM calls.
 		return strings.HasPrefix(c.Request().URL.Path, "/bytebase.v1.") ||
 			strings.HasPrefix(c.Request().URL.Path, "/v1:adminExecute") ||
-			strings.HasPrefix(c.Request().URL.Path, webhookAPIPrefix)
+			strings.HasPrefix(c.Request().URL.Path, webhookAPIPrefix) ||
+			strings.HasPrefix(c.Request().URL.Path, scim.APIPrefix)
 	}
 	e.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
 		Skipper: grpcSkipper,
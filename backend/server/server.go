@@ -13,6 +13,7 @@ import (
 	grpcRuntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
@@ -22,18 +23,23 @@ import (
 
 	"github.com/bytebase/bytebase/backend/api/auth"
 	"github.com/bytebase/bytebase/backend/api/gitops"
+	"github.com/bytebase/bytebase/backend/api/scim"
 	v1 "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/cluster"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/metrics"
+	"github.com/bytebase/bytebase/backend/component/secret"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	enterpriseService "github.com/bytebase/bytebase/backend/enterprise/service"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/migrator"
-	bbs3 "github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/git"
 	"github.com/bytebase/bytebase/backend/resources/mongoutil"
 	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
@@ -43,10 +49,13 @@ import (
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
 	"github.com/bytebase/bytebase/backend/runner/relay"
+	"github.com/bytebase/bytebase/backend/runner/restoredrill"
 	"github.com/bytebase/bytebase/backend/runner/rollbackrun"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/runner/slowquerysync"
 	"github.com/bytebase/bytebase/backend/runner/taskrun"
+	"github.com/bytebase/bytebase/backend/runner/usergroupsync"
+	"github.com/bytebase/bytebase/backend/runner/webhookdelivery"
 	"github.com/bytebase/bytebase/backend/store"
 	_ "github.com/bytebase/bytebase/docs/openapi" // initial the swagger doc
 
@@ -127,13 +136,19 @@ type Server struct {
 	metricReporter     *metricreport.Reporter
 	schemaSyncer       *schemasync.Syncer
 	slowQuerySyncer    *slowquerysync.Syncer
+	userGroupSyncer    *usergroupsync.Syncer
 	mailSender         *mail.SlowQueryWeeklyMailSender
 	backupRunner       *backuprun.Runner
+	restoreDrillRunner *restoredrill.Runner
 	rollbackRunner     *rollbackrun.Runner
 	approvalRunner     *approval.Runner
 	relayRunner        *relay.Runner
+	webhookRunner      *webhookdelivery.Runner
 	runnerWG           sync.WaitGroup
 
+	// replica elects the leader among the replicas to run the runners.
+	replica *cluster.Replica
+
 	activityManager *activity.Manager
 
 	licenseService enterpriseAPI.LicenseService
@@ -159,7 +174,7 @@ type Server struct {
 	// Postgres utility binaries
 	pgBinDir string
 
-	s3Client *bbs3.Client
+	backupStorageBackends storage.Backends
 
 	// stateCfg is the shared in-momory state within the server.
 	stateCfg *state.State
@@ -184,9 +199,9 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	slog.Info(fmt.Sprintf("readonly=%t", profile.Readonly))
 	slog.Info(fmt.Sprintf("demoName=%s", profile.DemoName))
 	slog.Info(fmt.Sprintf("backupStorageBackend=%s", profile.BackupStorageBackend))
-	slog.Info(fmt.Sprintf("backupBucket=%s", profile.BackupBucket))
-	slog.Info(fmt.Sprintf("backupRegion=%s", profile.BackupRegion))
-	slog.Info(fmt.Sprintf("backupCredentialFile=%s", profile.BackupCredentialFile))
+	for _, bucket := range profile.BackupBuckets {
+		slog.Info(fmt.Sprintf("backupBucket=%s, storageBackend=%s, region=%s, endpoint=%s, credentialFile=%s", bucket.Bucket, bucket.StorageBackend, bucket.Region, bucket.Endpoint, bucket.CredentialFile))
+	}
 	slog.Info("-----Config END-------")
 
 	serverStarted := false
@@ -215,6 +230,11 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		return nil, err
 	}
 
+	// Keep the local mirrors of the plain Git repositories in the data directory rather than the shared temporary directory.
+	if err := git.Init(profile.DataDir); err != nil {
+		return nil, err
+	}
+
 	// Start a Postgres sample server. This is used for onboarding users without requiring them to
 	// configure an external instance.
 	if profile.SampleDatabasePort != 0 {
@@ -282,13 +302,16 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	// Cache the license.
 	s.licenseService.LoadSubscription(ctx)
 
-	secret, externalURL, tokenDuration, err := s.getInitSetting(ctx, storeInstance)
+	initSecret, externalURL, tokenDuration, err := s.getInitSetting(ctx, storeInstance)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to init config")
 	}
-	s.secret = secret
+	s.secret = initSecret
 	s.activityManager = activity.NewManager(storeInstance)
-	s.dbFactory = dbfactory.New(s.mysqlBinDir, s.mongoBinDir, s.pgBinDir, profile.DataDir, s.secret)
+	s.dbFactory = dbfactory.New(s.mysqlBinDir, s.mongoBinDir, s.pgBinDir, profile.DataDir, s.secret, secret.NewManager(secret.DefaultCacheTTL, profile.ExternalSecretAllowlist))
+	if err := prometheus.Register(s.dbFactory.Collector()); err != nil {
+		slog.Warn("failed to register the database connection pool collector", log.BBError(err))
+	}
 
 	// Configure echo server.
 	s.e = echo.New()
@@ -298,39 +321,44 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	gatewayModifier := auth.GatewayResponseModifier{ExternalURL: externalURL, TokenDuration: tokenDuration}
 	mux := grpcRuntime.NewServeMux(grpcRuntime.WithForwardResponseOption(gatewayModifier.Modify))
 
-	if profile.BackupBucket != "" {
-		credentials, err := bbs3.GetCredentialsFromFile(ctx, profile.BackupCredentialFile)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get credentials from file")
-		}
-		s3Client, err := bbs3.NewClient(ctx, profile.BackupRegion, profile.BackupBucket, credentials)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to create AWS S3 client")
-		}
-		s.s3Client = s3Client
+	backupStorageBackends, err := newBackupStorageBackends(ctx, &profile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create backup storage backends")
 	}
+	s.backupStorageBackends = backupStorageBackends
 
 	s.metricReporter = metricreport.NewReporter(s.store, s.licenseService, &s.profile, false)
 	s.schemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile, s.licenseService)
 	if !profile.Readonly {
 		s.slowQuerySyncer = slowquerysync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile)
-		s.backupRunner = backuprun.NewRunner(storeInstance, s.dbFactory, s.s3Client, s.stateCfg, &profile)
+		s.userGroupSyncer = usergroupsync.NewSyncer(storeInstance)
+		s.replica, err = cluster.NewReplica(storeInstance, s.stateCfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create replica")
+		}
+		s.backupRunner = backuprun.NewRunner(storeInstance, s.dbFactory, s.backupStorageBackends, s.stateCfg, &profile)
+		if profile.RestoreDrillInstance != "" {
+			s.restoreDrillRunner = restoredrill.NewRunner(storeInstance, s.dbFactory, s.backupStorageBackends, &profile)
+		}
 		s.rollbackRunner = rollbackrun.NewRunner(&profile, storeInstance, s.dbFactory, s.stateCfg)
 		s.mailSender = mail.NewSender(s.store, s.stateCfg)
 		s.relayRunner = relay.NewRunner(storeInstance, s.activityManager, s.stateCfg)
 		s.approvalRunner = approval.NewRunner(storeInstance, s.dbFactory, s.stateCfg, s.activityManager, s.relayRunner, s.licenseService)
+		s.webhookRunner = webhookdelivery.NewRunner(storeInstance)
 
-		s.taskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.activityManager)
+		s.taskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.activityManager, s.replica.ID())
 		s.taskSchedulerV2.Register(api.TaskGeneral, taskrun.NewDefaultExecutor())
 		s.taskSchedulerV2.Register(api.TaskDatabaseCreate, taskrun.NewDatabaseCreateExecutor(storeInstance, s.dbFactory, s.schemaSyncer, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaBaseline, taskrun.NewSchemaBaselineExecutor(storeInstance, s.dbFactory, s.activityManager, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdate, taskrun.NewSchemaUpdateExecutor(storeInstance, s.dbFactory, s.activityManager, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateSDL, taskrun.NewSchemaUpdateSDLExecutor(storeInstance, s.dbFactory, s.activityManager, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseDataUpdate, taskrun.NewDataUpdateExecutor(storeInstance, s.dbFactory, s.activityManager, s.licenseService, s.stateCfg, profile))
-		s.taskSchedulerV2.Register(api.TaskDatabaseBackup, taskrun.NewDatabaseBackupExecutor(storeInstance, s.dbFactory, s.s3Client, profile))
+		s.taskSchedulerV2.Register(api.TaskDatabaseBackup, taskrun.NewDatabaseBackupExecutor(storeInstance, s.dbFactory, s.backupStorageBackends, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostSync, taskrun.NewSchemaUpdateGhostSyncExecutor(storeInstance, s.stateCfg, s.secret))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostCutover, taskrun.NewSchemaUpdateGhostCutoverExecutor(storeInstance, s.dbFactory, s.activityManager, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
-		s.taskSchedulerV2.Register(api.TaskDatabaseRestorePITRRestore, taskrun.NewPITRRestoreExecutor(storeInstance, s.dbFactory, s.s3Client, s.schemaSyncer, s.stateCfg, profile))
+		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdatePGOnlineSync, taskrun.NewSchemaUpdatePGOnlineSyncExecutor(storeInstance, s.dbFactory, s.stateCfg))
+		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdatePGOnlineCutover, taskrun.NewSchemaUpdatePGOnlineCutoverExecutor(storeInstance, s.dbFactory, s.activityManager, s.licenseService, s.schemaSyncer, profile))
+		s.taskSchedulerV2.Register(api.TaskDatabaseRestorePITRRestore, taskrun.NewPITRRestoreExecutor(storeInstance, s.dbFactory, s.backupStorageBackends, s.schemaSyncer, s.stateCfg, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseRestorePITRCutover, taskrun.NewPITRCutoverExecutor(storeInstance, s.dbFactory, s.schemaSyncer, s.backupRunner, s.activityManager, profile))
 
 		s.planCheckScheduler = plancheck.NewScheduler(storeInstance, s.licenseService, s.stateCfg)
@@ -342,6 +370,8 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementAdvise, statementAdviseExecutor)
 		ghostSyncExecutor := plancheck.NewGhostSyncExecutor(storeInstance, s.secret)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseGhostSync, ghostSyncExecutor)
+		pgOnlineSyncExecutor := plancheck.NewPGOnlineSyncExecutor(storeInstance, s.dbFactory)
+		s.planCheckScheduler.Register(store.PlanCheckDatabasePGOnlineSync, pgOnlineSyncExecutor)
 		pitrMySQLExecutor := plancheck.NewPITRMySQLExecutor(storeInstance, s.dbFactory)
 		s.planCheckScheduler.Register(store.PlanCheckDatabasePITRMySQL, pitrMySQLExecutor)
 		statementReportExecutor := plancheck.NewStatementReportExecutor(storeInstance, s.dbFactory)
@@ -371,12 +401,14 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		grpc.InitialWindowSize(100000000),
 		grpc.InitialConnWindowSize(100000000),
 		grpc.ChainUnaryInterceptor(
+			metrics.UnaryInterceptor,
 			debugProvider.DebugInterceptor,
 			authProvider.AuthenticationInterceptor,
 			aclProvider.ACLInterceptor,
 			recoveryUnaryInterceptor,
 		),
 		grpc.ChainStreamInterceptor(
+			metrics.StreamInterceptor,
 			debugProvider.DebugStreamInterceptor,
 			authProvider.AuthenticationStreamInterceptor,
 			aclProvider.ACLStreamInterceptor,
@@ -407,6 +439,9 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	webhookGroup := s.e.Group(webhookAPIPrefix)
 	gitOpsService := gitops.NewService(s.store, s.dbFactory, s.activityManager, s.stateCfg, s.licenseService, rolloutService, issueService)
 	gitOpsService.RegisterWebhookRoutes(webhookGroup)
+	scimGroup := s.e.Group(scim.APIPrefix)
+	scimService := scim.NewService(s.store, s.licenseService)
+	scimService.RegisterRoutes(scimGroup)
 	apiGroup := s.e.Group(internalAPIPrefix)
 	s.registerDatabaseRoutes(apiGroup)
 
@@ -422,31 +457,9 @@ func (s *Server) Run(ctx context.Context, port int) error {
 	s.cancel = cancel
 	if !s.profile.Readonly {
 		// runnerWG waits for all goroutines to complete.
-		if err := s.taskSchedulerV2.ClearRunningTaskRuns(ctx); err != nil {
-			return errors.Wrap(err, "failed to clear existing RUNNING tasks before starting the task scheduler")
-		}
+		// Only the leader replica runs the runners.
 		s.runnerWG.Add(1)
-		go s.taskSchedulerV2.Run(ctx, &s.runnerWG)
-		s.runnerWG.Add(1)
-		go s.schemaSyncer.Run(ctx, &s.runnerWG)
-		s.runnerWG.Add(1)
-		go s.slowQuerySyncer.Run(ctx, &s.runnerWG)
-		s.runnerWG.Add(1)
-		go s.mailSender.Run(ctx, &s.runnerWG)
-		s.runnerWG.Add(1)
-		go s.backupRunner.Run(ctx, &s.runnerWG)
-		s.runnerWG.Add(1)
-		go s.rollbackRunner.Run(ctx, &s.runnerWG)
-		s.runnerWG.Add(1)
-		go s.approvalRunner.Run(ctx, &s.runnerWG)
-		s.runnerWG.Add(1)
-		go s.relayRunner.Run(ctx, &s.runnerWG)
-
-		s.runnerWG.Add(1)
-		go s.metricReporter.Run(ctx, &s.runnerWG)
-
-		s.runnerWG.Add(1)
-		go s.planCheckScheduler.Run(ctx, &s.runnerWG)
+		go s.replica.Run(ctx, &s.runnerWG, s.runLeaderRunners)
 	}
 
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", port+1))
@@ -461,6 +474,43 @@ func (s *Server) Run(ctx context.Context, port int) error {
 	return s.e.Start(fmt.Sprintf(":%d", port))
 }
 
+// runLeaderRunners starts the runners on the leader replica.
+// The context is canceled when the replica loses the leadership.
+func (s *Server) runLeaderRunners(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go s.taskSchedulerV2.Run(ctx, wg)
+	wg.Add(1)
+	go s.schemaSyncer.Run(ctx, wg)
+	wg.Add(1)
+	go s.schemaSyncer.RunDiscovery(ctx, wg)
+	wg.Add(1)
+	go s.slowQuerySyncer.Run(ctx, wg)
+	wg.Add(1)
+	go s.userGroupSyncer.Run(ctx, wg)
+	wg.Add(1)
+	go s.mailSender.Run(ctx, wg)
+	wg.Add(1)
+	go s.backupRunner.Run(ctx, wg)
+	if s.restoreDrillRunner != nil {
+		wg.Add(1)
+		go s.restoreDrillRunner.Run(ctx, wg)
+	}
+	wg.Add(1)
+	go s.rollbackRunner.Run(ctx, wg)
+	wg.Add(1)
+	go s.approvalRunner.Run(ctx, wg)
+	wg.Add(1)
+	go s.relayRunner.Run(ctx, wg)
+	wg.Add(1)
+	go s.webhookRunner.Run(ctx, wg)
+
+	wg.Add(1)
+	go s.metricReporter.Run(ctx, wg)
+
+	wg.Add(1)
+	go s.planCheckScheduler.Run(ctx, wg)
+}
+
 // Shutdown will shut down the server.
 func (s *Server) Shutdown(ctx context.Context) error {
 	slog.Info("Stopping Bytebase...")
@@ -45,7 +45,8 @@ func (s *Store) findBackupSettingsMatchImpl(ctx context.Context, match *BackupSe
 			hour,
 			day_of_week,
 			retention_period_ts,
-			hook_url
+			hook_url,
+			storage_backend
 		FROM backup_setting
 		WHERE
 			enabled = true
@@ -75,6 +76,7 @@ func (s *Store) findBackupSettingsMatchImpl(ctx context.Context, match *BackupSe
 			&backupSetting.DayOfWeek,
 			&backupSetting.RetentionPeriodTs,
 			&backupSetting.HookURL,
+			&backupSetting.StorageBackend,
 		); err != nil {
 			return nil, err
 		}
@@ -110,6 +112,9 @@ type BackupSettingMessage struct {
 	RetentionPeriodTs int
 	// HookURL is the URL to send the backup status.
 	HookURL string
+	// StorageBackend is the storage backend of the backups.
+	// Empty means inheriting from the environment backup plan policy.
+	StorageBackend api.BackupStorageBackend
 }
 
 // FindBackupSettingMessage is the message for finding backup setting.
@@ -249,8 +254,9 @@ func (s *Store) UpsertBackupSettingV2(ctx context.Context, principalUID int, ups
 			hour,
 			day_of_week,
 			retention_period_ts,
-			hook_url
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			hook_url,
+			storage_backend
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		ON CONFLICT (database_id)
 		DO UPDATE SET
 			enabled = EXCLUDED.enabled,
@@ -258,8 +264,9 @@ func (s *Store) UpsertBackupSettingV2(ctx context.Context, principalUID int, ups
 			day_of_week = EXCLUDED.day_of_week,
 			retention_period_ts = EXCLUDED.retention_period_ts,
 			updater_id = EXCLUDED.updater_id,
-			hook_url = EXCLUDED.hook_url
-		RETURNING id, database_id, updated_ts, enabled, hour, day_of_week, retention_period_ts, hook_url
+			hook_url = EXCLUDED.hook_url,
+			storage_backend = EXCLUDED.storage_backend
+		RETURNING id, database_id, updated_ts, enabled, hour, day_of_week, retention_period_ts, hook_url, storage_backend
 		`,
 		principalUID,
 		principalUID,
@@ -269,6 +276,7 @@ func (s *Store) UpsertBackupSettingV2(ctx context.Context, principalUID int, ups
 		upsert.DayOfWeek,
 		upsert.RetentionPeriodTs,
 		upsert.HookURL,
+		upsert.StorageBackend,
 	).Scan(
 		&backupSetting.ID,
 		&backupSetting.DatabaseUID,
@@ -278,6 +286,7 @@ func (s *Store) UpsertBackupSettingV2(ctx context.Context, principalUID int, ups
 		&backupSetting.DayOfWeek,
 		&backupSetting.RetentionPeriodTs,
 		&backupSetting.HookURL,
+		&backupSetting.StorageBackend,
 	); err != nil {
 		return nil, err
 	}
@@ -634,7 +643,8 @@ func (*Store) listBackupSettingImplV2(ctx context.Context, tx *Tx, find *FindBac
 			backup_setting.hour,
 			backup_setting.day_of_week,
 			backup_setting.retention_period_ts,
-			backup_setting.hook_url
+			backup_setting.hook_url,
+			backup_setting.storage_backend
 		FROM backup_setting `+
 		strings.Join(join, " ")+
 		` WHERE `+strings.Join(where, " AND "),
@@ -657,6 +667,7 @@ func (*Store) listBackupSettingImplV2(ctx context.Context, tx *Tx, find *FindBac
 			&backupSetting.DayOfWeek,
 			&backupSetting.RetentionPeriodTs,
 			&backupSetting.HookURL,
+			&backupSetting.StorageBackend,
 		); err != nil {
 			return nil, err
 		}
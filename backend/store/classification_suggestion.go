@@ -0,0 +1,216 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ClassificationSuggestionState is the review state of a classification suggestion.
+type ClassificationSuggestionState string
+
+const (
+	// ClassificationSuggestionPending is the state of the suggestions waiting for review.
+	ClassificationSuggestionPending ClassificationSuggestionState = "PENDING"
+	// ClassificationSuggestionAccepted is the state of the accepted suggestions.
+	ClassificationSuggestionAccepted ClassificationSuggestionState = "ACCEPTED"
+	// ClassificationSuggestionRejected is the state of the rejected suggestions.
+	ClassificationSuggestionRejected ClassificationSuggestionState = "REJECTED"
+)
+
+// ClassificationSuggestionMessage is the store model for a column classification proposed by the sensitive data discovery.
+type ClassificationSuggestionMessage struct {
+	DatabaseUID      int
+	Schema           string
+	Table            string
+	Column           string
+	ClassificationID string
+	DetectorID       string
+	Confidence       float64
+
+	// Output only fields.
+	ID        int
+	State     ClassificationSuggestionState
+	CreatedTs int64
+	UpdatedTs int64
+}
+
+// FindClassificationSuggestionMessage is the message for finding classification suggestions.
+type FindClassificationSuggestionMessage struct {
+	ID          *int
+	DatabaseUID *int
+	State       *ClassificationSuggestionState
+	Limit       *int
+	Offset      *int
+}
+
+// ReplaceClassificationSuggestions replaces the pending classification suggestions of a database.
+// The reviewed suggestions are kept, except the rejected ones proposing another classification, which are pending again.
+func (s *Store) ReplaceClassificationSuggestions(ctx context.Context, databaseUID int, suggestions []*ClassificationSuggestionMessage) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM classification_suggestion WHERE database_id = $1 AND state = $2
+	`, databaseUID, ClassificationSuggestionPending); err != nil {
+		return errors.Wrapf(err, "failed to delete pending classification suggestions")
+	}
+	for _, suggestion := range suggestions {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO classification_suggestion (
+				database_id,
+				schema,
+				"table",
+				"column",
+				classification_id,
+				detector_id,
+				confidence,
+				state
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (database_id, schema, "table", "column") DO UPDATE SET
+				classification_id = EXCLUDED.classification_id,
+				detector_id = EXCLUDED.detector_id,
+				confidence = EXCLUDED.confidence,
+				state = EXCLUDED.state
+			WHERE classification_suggestion.state = $9 AND classification_suggestion.classification_id <> EXCLUDED.classification_id
+		`,
+			databaseUID,
+			suggestion.Schema,
+			suggestion.Table,
+			suggestion.Column,
+			suggestion.ClassificationID,
+			suggestion.DetectorID,
+			suggestion.Confidence,
+			ClassificationSuggestionPending,
+			ClassificationSuggestionRejected,
+		); err != nil {
+			return errors.Wrapf(err, "failed to upsert classification suggestion")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrapf(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+// GetClassificationSuggestion gets a classification suggestion.
+func (s *Store) GetClassificationSuggestion(ctx context.Context, find *FindClassificationSuggestionMessage) (*ClassificationSuggestionMessage, error) {
+	suggestions, err := s.ListClassificationSuggestions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(suggestions) == 0 {
+		return nil, nil
+	}
+	if len(suggestions) > 1 {
+		return nil, errors.Errorf("expected find one classification suggestion with %+v, but found %d", find, len(suggestions))
+	}
+	return suggestions[0], nil
+}
+
+// ListClassificationSuggestions lists the classification suggestions in the descending order of the confidence.
+func (s *Store) ListClassificationSuggestions(ctx context.Context, find *FindClassificationSuggestionMessage) ([]*ClassificationSuggestionMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.DatabaseUID; v != nil {
+		where, args = append(where, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.State; v != nil {
+		where, args = append(where, fmt.Sprintf("state = $%d", len(args)+1)), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			id,
+			database_id,
+			schema,
+			"table",
+			"column",
+			classification_id,
+			detector_id,
+			confidence,
+			state,
+			created_ts,
+			updated_ts
+		FROM classification_suggestion
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY confidence DESC, id ASC`
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var suggestions []*ClassificationSuggestionMessage
+	for rows.Next() {
+		suggestion := &ClassificationSuggestionMessage{}
+		if err := rows.Scan(
+			&suggestion.ID,
+			&suggestion.DatabaseUID,
+			&suggestion.Schema,
+			&suggestion.Table,
+			&suggestion.Column,
+			&suggestion.ClassificationID,
+			&suggestion.DetectorID,
+			&suggestion.Confidence,
+			&suggestion.State,
+			&suggestion.CreatedTs,
+			&suggestion.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit transaction")
+	}
+	return suggestions, nil
+}
+
+// UpdateClassificationSuggestionState updates the review state of a classification suggestion from the old state to the new state.
+// It returns nil if the suggestion is not in the old state, so that concurrent reviews cannot both succeed.
+func (s *Store) UpdateClassificationSuggestionState(ctx context.Context, id int, oldState, newState ClassificationSuggestionState) (*ClassificationSuggestionMessage, error) {
+	result, err := s.db.db.ExecContext(ctx, `
+		UPDATE classification_suggestion
+		SET state = $1
+		WHERE id = $2 AND state = $3
+	`, newState, id, oldState)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update classification suggestion %d", id)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update classification suggestion %d", id)
+	}
+	if rowsAffected == 0 {
+		return nil, nil
+	}
+	return s.GetClassificationSuggestion(ctx, &FindClassificationSuggestionMessage{ID: &id})
+}
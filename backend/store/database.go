@@ -84,12 +84,12 @@ type FindDatabaseMessage struct {
 // GetDatabaseV2 gets a database.
 func (s *Store) GetDatabaseV2(ctx context.Context, find *FindDatabaseMessage) (*DatabaseMessage, error) {
 	if find.InstanceID != nil && find.DatabaseName != nil {
-		if database, ok := s.databaseCache.Load(getDatabaseCacheKey(*find.InstanceID, *find.DatabaseName)); ok {
+		if database, ok := s.loadCache(&s.databaseCache, getDatabaseCacheKey(*find.InstanceID, *find.DatabaseName)); ok {
 			return database.(*DatabaseMessage), nil
 		}
 	}
 	if find.UID != nil {
-		if database, ok := s.databaseIDCache.Load(*find.UID); ok {
+		if database, ok := s.loadCache(&s.databaseIDCache, *find.UID); ok {
 			return database.(*DatabaseMessage), nil
 		}
 	}
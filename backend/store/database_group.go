@@ -100,12 +100,12 @@ func (s *Store) ListDatabaseGroups(ctx context.Context, find *FindDatabaseGroupM
 // GetDatabaseGroup gets a database group.
 func (s *Store) GetDatabaseGroup(ctx context.Context, find *FindDatabaseGroupMessage) (*DatabaseGroupMessage, error) {
 	if find.ProjectUID != nil && find.ResourceID != nil && find.UID == nil {
-		if databaseGroup, ok := s.databaseGroupCache.Load(getDatabaseGroupCacheKey(*find.ProjectUID, *find.ResourceID)); ok {
+		if databaseGroup, ok := s.loadCache(&s.databaseGroupCache, getDatabaseGroupCacheKey(*find.ProjectUID, *find.ResourceID)); ok {
 			return databaseGroup.(*DatabaseGroupMessage), nil
 		}
 	}
 	if find.UID != nil && find.ProjectUID == nil && find.ResourceID == nil {
-		if databaseGroup, ok := s.databaseGroupIDCache.Load(*find.UID); ok {
+		if databaseGroup, ok := s.loadCache(&s.databaseGroupIDCache, *find.UID); ok {
 			return databaseGroup.(*DatabaseGroupMessage), nil
 		}
 	}
@@ -397,7 +397,7 @@ func (s *Store) ListSchemaGroups(ctx context.Context, find *FindSchemaGroupMessa
 // GetSchemaGroup gets a schema group.
 func (s *Store) GetSchemaGroup(ctx context.Context, find *FindSchemaGroupMessage) (*SchemaGroupMessage, error) {
 	if find.DatabaseGroupUID != nil && find.ResourceID != nil {
-		if schemaGroup, ok := s.schemaGroupCache.Load(getSchemaGroupCacheKey(*find.DatabaseGroupUID, *find.ResourceID)); ok {
+		if schemaGroup, ok := s.loadCache(&s.schemaGroupCache, getSchemaGroupCacheKey(*find.DatabaseGroupUID, *find.ResourceID)); ok {
 			return schemaGroup.(*SchemaGroupMessage), nil
 		}
 	}
@@ -152,7 +152,7 @@ func (s *Store) GetDBSchema(ctx context.Context, databaseID int) (*DBSchema, err
 		return true
 	})
 	if s.dbSchemaCache.MaxCost() != 1_000_000 || instanceCount <= 10 {
-		if dbSchema, ok := s.dbSchemaCache.Get(databaseID); ok {
+		if dbSchema, ok := s.getCache(s.dbSchemaCache, databaseID); ok {
 			return dbSchema.(*DBSchema), nil
 		}
 	}
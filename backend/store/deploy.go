@@ -128,7 +128,7 @@ func (s *Store) GetDeploymentConfigV2(ctx context.Context, projectUID int) (*Dep
 		return s.getDefaultDeploymentConfigV2(ctx)
 	}
 
-	if deploymentConfig, ok := s.projectIDDeploymentConfigCache.Load(projectUID); ok {
+	if deploymentConfig, ok := s.loadCache(&s.projectIDDeploymentConfigCache, projectUID); ok {
 		return deploymentConfig.(*DeploymentConfigMessage), nil
 	}
 	where, args := []string{"TRUE"}, []any{}
@@ -44,12 +44,12 @@ type UpdateEnvironmentMessage struct {
 // GetEnvironmentV2 gets environment by resource ID.
 func (s *Store) GetEnvironmentV2(ctx context.Context, find *FindEnvironmentMessage) (*EnvironmentMessage, error) {
 	if find.ResourceID != nil {
-		if environment, ok := s.environmentCache.Load(*find.ResourceID); ok {
+		if environment, ok := s.loadCache(&s.environmentCache, *find.ResourceID); ok {
 			return environment.(*EnvironmentMessage), nil
 		}
 	}
 	if find.UID != nil {
-		if environment, ok := s.environmentIDCache.Load(*find.UID); ok {
+		if environment, ok := s.loadCache(&s.environmentIDCache, *find.UID); ok {
 			return environment.(*EnvironmentMessage), nil
 		}
 	}
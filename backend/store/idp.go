@@ -114,7 +114,7 @@ func (s *Store) CreateIdentityProvider(ctx context.Context, create *IdentityProv
 // GetIdentityProvider gets an identity provider.
 func (s *Store) GetIdentityProvider(ctx context.Context, find *FindIdentityProviderMessage) (*IdentityProviderMessage, error) {
 	if find.ResourceID != nil {
-		if identityProvider, ok := s.idpCache.Load(*find.ResourceID); ok {
+		if identityProvider, ok := s.loadCache(&s.idpCache, *find.ResourceID); ok {
 			return identityProvider.(*IdentityProviderMessage), nil
 		}
 	}
@@ -64,12 +64,12 @@ type FindInstanceMessage struct {
 // GetInstanceV2 gets an instance by the resource_id.
 func (s *Store) GetInstanceV2(ctx context.Context, find *FindInstanceMessage) (*InstanceMessage, error) {
 	if find.ResourceID != nil {
-		if instance, ok := s.instanceCache.Load(getInstanceCacheKey(*find.ResourceID)); ok {
+		if instance, ok := s.loadCache(&s.instanceCache, getInstanceCacheKey(*find.ResourceID)); ok {
 			return instance.(*InstanceMessage), nil
 		}
 	}
 	if find.UID != nil {
-		if instance, ok := s.instanceIDCache.Load(*find.UID); ok {
+		if instance, ok := s.loadCache(&s.instanceIDCache, *find.UID); ok {
 			return instance.(*InstanceMessage), nil
 		}
 	}
@@ -106,12 +106,12 @@ type FindIssueMessage struct {
 // GetIssueV2 gets issue by issue UID.
 func (s *Store) GetIssueV2(ctx context.Context, find *FindIssueMessage) (*IssueMessage, error) {
 	if find.UID != nil {
-		if issue, ok := s.issueCache.Load(*find.UID); ok {
+		if issue, ok := s.loadCache(&s.issueCache, *find.UID); ok {
 			return issue.(*IssueMessage), nil
 		}
 	}
 	if find.PipelineID != nil {
-		if issue, ok := s.issueByPipelineCache.Load(*find.PipelineID); ok {
+		if issue, ok := s.loadCache(&s.issueByPipelineCache, *find.PipelineID); ok {
 			return issue.(*IssueMessage), nil
 		}
 	}
@@ -76,7 +76,7 @@ func (s *Store) CreatePipelineV2(ctx context.Context, create *PipelineMessage, c
 
 // GetPipelineV2ByID gets the pipeline by ID.
 func (s *Store) GetPipelineV2ByID(ctx context.Context, id int) (*PipelineMessage, error) {
-	if pipeline, ok := s.pipelineCache.Load(id); ok {
+	if pipeline, ok := s.loadCache(&s.pipelineCache, id); ok {
 		return pipeline.(*PipelineMessage), nil
 	}
 	pipelines, err := s.ListPipelineV2(ctx, &PipelineFind{ID: &id})
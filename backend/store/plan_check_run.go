@@ -29,6 +29,8 @@ const (
 	PlanCheckDatabaseConnect PlanCheckRunType = "bb.plan-check.database.connect"
 	// PlanCheckDatabaseGhostSync is the plan check type for the gh-ost sync task.
 	PlanCheckDatabaseGhostSync PlanCheckRunType = "bb.plan-check.database.ghost.sync"
+	// PlanCheckDatabasePGOnlineSync is the plan check type for the PostgreSQL online schema change sync task.
+	PlanCheckDatabasePGOnlineSync PlanCheckRunType = "bb.plan-check.database.pg-online.sync"
 	// PlanCheckDatabasePITRMySQL is the plan check type for MySQL PITR.
 	PlanCheckDatabasePITRMySQL PlanCheckRunType = "bb.plan-check.database.pitr.mysql"
 )
@@ -168,6 +168,80 @@ func (s *Store) GetMaskingExceptionPolicyByProjectUID(ctx context.Context, proje
 	return p, nil
 }
 
+// GetRowFilterPolicyByProjectUID gets the row filter policy for a project.
+func (s *Store) GetRowFilterPolicyByProjectUID(ctx context.Context, projectUID int) (*storepb.RowFilterPolicy, error) {
+	resourceType := api.PolicyResourceTypeProject
+	pType := api.PolicyTypeRowFilter
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &projectUID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		return &storepb.RowFilterPolicy{}, nil
+	}
+
+	p := new(storepb.RowFilterPolicy)
+	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// GetRolloutConcurrencyPolicy gets the rollout concurrency policy for an environment, a project or an instance.
+func (s *Store) GetRolloutConcurrencyPolicy(ctx context.Context, resourceType api.PolicyResourceType, resourceUID int) (*storepb.RolloutConcurrencyPolicy, error) {
+	pType := api.PolicyTypeRolloutConcurrency
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &resourceUID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		return &storepb.RolloutConcurrencyPolicy{}, nil
+	}
+
+	p := new(storepb.RolloutConcurrencyPolicy)
+	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// GetMaintenanceWindowPolicy gets the maintenance window policy for an environment.
+func (s *Store) GetMaintenanceWindowPolicy(ctx context.Context, environmentUID int) (*storepb.MaintenanceWindowPolicy, error) {
+	resourceType := api.PolicyResourceTypeEnvironment
+	pType := api.PolicyTypeMaintenanceWindow
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &environmentUID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		return &storepb.MaintenanceWindowPolicy{}, nil
+	}
+
+	p := new(storepb.MaintenanceWindowPolicy)
+	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
 // PolicyMessage is the mssage for policy.
 type PolicyMessage struct {
 	ResourceUID       int
@@ -204,7 +278,7 @@ type UpdatePolicyMessage struct {
 // GetPolicyV2 gets a policy.
 func (s *Store) GetPolicyV2(ctx context.Context, find *FindPolicyMessage) (*PolicyMessage, error) {
 	if find.ResourceType != nil && find.ResourceUID != nil && find.Type != nil {
-		if policy, ok := s.policyCache.Load(getPolicyCacheKey(*find.ResourceType, *find.ResourceUID, *find.Type)); ok {
+		if policy, ok := s.loadCache(&s.policyCache, getPolicyCacheKey(*find.ResourceType, *find.ResourceUID, *find.Type)); ok {
 			if policy == nil {
 				return nil, nil
 			}
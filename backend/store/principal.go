@@ -37,6 +37,7 @@ type UpdateUserMessage struct {
 	Delete       *bool
 	MFAConfig    *storepb.MFAConfig
 	Phone        *string
+	Profile      *storepb.UserProfile
 }
 
 // UserMessage is the message for an user.
@@ -51,7 +52,8 @@ type UserMessage struct {
 	MemberDeleted bool
 	MFAConfig     *storepb.MFAConfig
 	// Phone conforms E.164 format.
-	Phone string
+	Phone   string
+	Profile *storepb.UserProfile
 }
 
 // GetUser gets an user.
@@ -112,7 +114,7 @@ func (s *Store) ListUsers(ctx context.Context, find *FindUserMessage) ([]*UserMe
 
 // GetUserByID gets the user by ID.
 func (s *Store) GetUserByID(ctx context.Context, id int) (*UserMessage, error) {
-	if user, ok := s.userIDCache.Load(id); ok {
+	if user, ok := s.loadCache(&s.userIDCache, id); ok {
 		return user.(*UserMessage), nil
 	}
 
@@ -168,6 +170,7 @@ func (*Store) listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) (
 		principal.password_hash,
 		principal.mfa_config,
 		principal.phone,
+		principal.profile,
 		member.role,
 		member.row_status AS row_status
 	FROM principal
@@ -187,7 +190,7 @@ func (*Store) listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) (
 	for rows.Next() {
 		var userMessage UserMessage
 		var role, rowStatus sql.NullString
-		var mfaConfigBytes []byte
+		var mfaConfigBytes, profileBytes []byte
 		if err := rows.Scan(
 			&userMessage.ID,
 			&userMessage.Email,
@@ -196,6 +199,7 @@ func (*Store) listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) (
 			&userMessage.PasswordHash,
 			&mfaConfigBytes,
 			&userMessage.Phone,
+			&profileBytes,
 			&role,
 			&rowStatus,
 		); err != nil {
@@ -219,6 +223,11 @@ func (*Store) listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) (
 			return nil, err
 		}
 		userMessage.MFAConfig = &mfaConfig
+		profile := storepb.UserProfile{}
+		if err := decoder.Unmarshal(profileBytes, &profile); err != nil {
+			return nil, err
+		}
+		userMessage.Profile = &profile
 		userMessages = append(userMessages, &userMessage)
 	}
 	if err := rows.Err(); err != nil {
@@ -339,6 +348,13 @@ func (s *Store) UpdateUser(ctx context.Context, userID int, patch *UpdateUserMes
 		}
 		principalSet, principalArgs = append(principalSet, fmt.Sprintf("mfa_config = $%d", len(principalArgs)+1)), append(principalArgs, mfaConfigBytes)
 	}
+	if v := patch.Profile; v != nil {
+		profileBytes, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		principalSet, principalArgs = append(principalSet, fmt.Sprintf("profile = $%d", len(principalArgs)+1)), append(principalArgs, profileBytes)
+	}
 	principalArgs = append(principalArgs, userID)
 
 	memberSet, memberArgs := []string{"updater_id = $1"}, []any{fmt.Sprintf("%d", updaterID)}
@@ -361,12 +377,12 @@ func (s *Store) UpdateUser(ctx context.Context, userID int, patch *UpdateUserMes
 	defer tx.Rollback()
 
 	user := &UserMessage{}
-	var mfaConfigBytes []byte
+	var mfaConfigBytes, profileBytes []byte
 	if err := tx.QueryRowContext(ctx, fmt.Sprintf(`
 		UPDATE principal
 		SET `+strings.Join(principalSet, ", ")+`
 		WHERE id = $%d
-		RETURNING id, email, name, type, password_hash, mfa_config, phone
+		RETURNING id, email, name, type, password_hash, mfa_config, phone, profile
 	`, len(principalArgs)),
 		principalArgs...,
 	).Scan(
@@ -377,6 +393,7 @@ func (s *Store) UpdateUser(ctx context.Context, userID int, patch *UpdateUserMes
 		&user.PasswordHash,
 		&mfaConfigBytes,
 		&user.Phone,
+		&profileBytes,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -389,6 +406,11 @@ func (s *Store) UpdateUser(ctx context.Context, userID int, patch *UpdateUserMes
 		return nil, err
 	}
 	user.MFAConfig = &mfaConfig
+	profile := storepb.UserProfile{}
+	if err := decoder.Unmarshal(profileBytes, &profile); err != nil {
+		return nil, err
+	}
+	user.Profile = &profile
 
 	var rowStatus string
 	if err := tx.QueryRowContext(ctx, fmt.Sprintf(`
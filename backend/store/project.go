@@ -60,12 +60,12 @@ type UpdateProjectMessage struct {
 // GetProjectV2 gets project by resource ID.
 func (s *Store) GetProjectV2(ctx context.Context, find *FindProjectMessage) (*ProjectMessage, error) {
 	if find.ResourceID != nil {
-		if project, ok := s.projectCache.Load(*find.ResourceID); ok {
+		if project, ok := s.loadCache(&s.projectCache, *find.ResourceID); ok {
 			return project.(*ProjectMessage), nil
 		}
 	}
 	if find.UID != nil {
-		if project, ok := s.projectIDCache.Load(*find.UID); ok {
+		if project, ok := s.loadCache(&s.projectIDCache, *find.UID); ok {
 			return project.(*ProjectMessage), nil
 		}
 	}
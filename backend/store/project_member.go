@@ -26,6 +26,9 @@ func (m *IAMPolicyMessage) String() string {
 		for _, member := range binding.Members {
 			members = append(members, member.Email)
 		}
+		for _, group := range binding.Groups {
+			members = append(members, fmt.Sprintf("group:%s", group.ResourceID))
+		}
 		if binding.Condition == nil {
 			binding.Condition = &expr.Expr{}
 		}
@@ -67,15 +70,31 @@ func (m *IAMPolicyMessage) sort() error {
 		sort.Slice(binding.Members, func(i, j int) bool {
 			return binding.Members[i].ID < binding.Members[j].ID
 		})
+		sort.Slice(binding.Groups, func(i, j int) bool {
+			return binding.Groups[i].UID < binding.Groups[j].UID
+		})
 	}
 	return nil
 }
 
 // PolicyBinding is the IAM policy binding of a project.
 type PolicyBinding struct {
-	Role      api.Role
-	Members   []*UserMessage
+	Role    api.Role
+	Members []*UserMessage
+	// Groups are the user groups granted the role.
+	Groups    []*UserGroupMessage
 	Condition *expr.Expr
+
+	// groupMembers are the members of the groups, which is output only.
+	groupMembers []*UserMessage
+}
+
+// GetAllMembers returns the users granted the role, including the members of the user groups.
+func (b *PolicyBinding) GetAllMembers() []*UserMessage {
+	if len(b.groupMembers) == 0 {
+		return b.Members
+	}
+	return filterUser(append(append([]*UserMessage{}, b.Members...), b.groupMembers...))
 }
 
 // GetProjectPolicyMessage is the message to get project policy.
@@ -117,12 +136,12 @@ func (s *Store) GetProjectPolicy(ctx context.Context, find *GetProjectPolicyMess
 		return nil, errors.Errorf("GetProjectPolicy must set either resource ID or UID")
 	}
 	if find.ProjectID != nil {
-		if policy, ok := s.projectPolicyCache.Load(*find.ProjectID); ok {
+		if policy, ok := s.loadCache(&s.projectPolicyCache, *find.ProjectID); ok {
 			return policy.(*IAMPolicyMessage), nil
 		}
 	}
 	if find.UID != nil {
-		if policy, ok := s.projectIDPolicyCache.Load(*find.UID); ok {
+		if policy, ok := s.loadCache(&s.projectIDPolicyCache, *find.UID); ok {
 			return policy.(*IAMPolicyMessage), nil
 		}
 	}
@@ -239,6 +258,49 @@ func (s *Store) getProjectPolicyImpl(ctx context.Context, tx *Tx, find *GetProje
 		return nil, err
 	}
 
+	groupWhere, groupArgs := []string{"TRUE"}, []any{}
+	if v := find.ProjectID; v != nil {
+		groupWhere, groupArgs = append(groupWhere, fmt.Sprintf("project.resource_id = $%d", len(groupArgs)+1)), append(groupArgs, *v)
+	}
+	if v := find.UID; v != nil {
+		groupWhere, groupArgs = append(groupWhere, fmt.Sprintf("project.id = $%d", len(groupArgs)+1)), append(groupArgs, *v)
+	}
+	groupRoleMap := map[roleConditionMapKey][]int{}
+	groupRows, err := tx.QueryContext(ctx, `
+			SELECT
+				project_group_member.group_id,
+				project_group_member.role,
+				project_group_member.condition
+			FROM project_group_member
+			LEFT JOIN project ON project_group_member.project_id = project.id
+			WHERE `+strings.Join(groupWhere, " AND "),
+		groupArgs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer groupRows.Close()
+	for groupRows.Next() {
+		var role api.Role
+		var rawCondition string
+		var groupUID int
+		if err := groupRows.Scan(
+			&groupUID,
+			&role,
+			&rawCondition,
+		); err != nil {
+			return nil, err
+		}
+		key := roleConditionMapKey{role: role, condition: rawCondition}
+		groupRoleMap[key] = append(groupRoleMap[key], groupUID)
+		if _, ok := roleMap[key]; !ok {
+			roleMap[key] = nil
+		}
+	}
+	if err := groupRows.Err(); err != nil {
+		return nil, err
+	}
+
 	projectPolicy := &IAMPolicyMessage{}
 	for key, userUIDs := range roleMap {
 		var condition expr.Expr
@@ -253,6 +315,27 @@ func (s *Store) getProjectPolicyImpl(ctx context.Context, tx *Tx, find *GetProje
 			}
 			binding.Members = append(binding.Members, user)
 		}
+		for _, groupUID := range groupRoleMap[key] {
+			groupUID := groupUID
+			groups, err := listUserGroupsImpl(ctx, tx, &FindUserGroupMessage{UID: &groupUID})
+			if err != nil {
+				return nil, err
+			}
+			if len(groups) != 1 {
+				return nil, errors.Errorf("user group %d not found", groupUID)
+			}
+			binding.Groups = append(binding.Groups, groups[0])
+			for _, memberID := range groups[0].MemberIDs {
+				user, err := s.GetUserByID(ctx, memberID)
+				if err != nil {
+					return nil, err
+				}
+				if user == nil || user.MemberDeleted {
+					continue
+				}
+				binding.groupMembers = append(binding.groupMembers, user)
+			}
+		}
 		projectPolicy.Bindings = append(projectPolicy.Bindings, binding)
 	}
 	if err := projectPolicy.sort(); err != nil {
@@ -283,6 +366,10 @@ func (s *Store) setProjectIAMPolicyImpl(ctx context.Context, tx *Tx, set *IAMPol
 		}
 	}
 
+	if err := setProjectGroupIAMPolicyImpl(ctx, tx, set, creatorUID, projectUID); err != nil {
+		return err
+	}
+
 	if len(inserts.Bindings) > 0 {
 		args := []any{}
 		var placeholders []string
@@ -319,6 +406,47 @@ func (s *Store) setProjectIAMPolicyImpl(ctx context.Context, tx *Tx, set *IAMPol
 	return nil
 }
 
+// setProjectGroupIAMPolicyImpl replaces the project roles granted to user groups.
+func setProjectGroupIAMPolicyImpl(ctx context.Context, tx *Tx, set *IAMPolicyMessage, creatorUID int, projectUID int) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM project_group_member WHERE project_id = $1`, projectUID); err != nil {
+		return err
+	}
+	args := []any{}
+	var placeholders []string
+	for _, binding := range set.Bindings {
+		rawCondition, err := formatCondition(binding.Condition)
+		if err != nil {
+			return err
+		}
+		for _, group := range binding.Groups {
+			placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)", len(args)+1, len(args)+2, len(args)+3, len(args)+4, len(args)+5, len(args)+6))
+			args = append(args,
+				creatorUID,   // creator_id
+				creatorUID,   // updater_id
+				projectUID,   // project_id
+				binding.Role, // role
+				group.UID,    // group_id
+				rawCondition,
+			)
+		}
+	}
+	if len(placeholders) == 0 {
+		return nil
+	}
+	query := fmt.Sprintf(`INSERT INTO project_group_member (
+		creator_id,
+		updater_id,
+		project_id,
+		role,
+		group_id,
+		condition
+	) VALUES %s`, strings.Join(placeholders, ", "))
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (*Store) deleteProjectIAMPolicyImpl(ctx context.Context, tx *Tx, projectUID int, deletes *IAMPolicyMessage) error {
 	if len(deletes.Bindings) == 0 {
 		return nil
@@ -243,3 +243,15 @@ func TestGetIAMPolicyDiff(t *testing.T) {
 		require.Equal(t, tc.add.String(), add.String(), fmt.Sprintf("%d", i))
 	}
 }
+
+func TestPolicyBindingGetAllMembers(t *testing.T) {
+	binding := &PolicyBinding{
+		Members:      []*UserMessage{{ID: 1}, {ID: 2}},
+		groupMembers: []*UserMessage{{ID: 2}, {ID: 3}},
+	}
+	var ids []int
+	for _, member := range binding.GetAllMembers() {
+		ids = append(ids, member.ID)
+	}
+	require.Equal(t, []int{1, 2, 3}, ids)
+}
@@ -23,6 +23,10 @@ type ProjectWebhookMessage struct {
 	URL string
 	// ActivityList is the list of activities that the webhook is interested in.
 	ActivityList []string
+	// Secret is the key to sign the webhook payloads with HMAC-SHA256.
+	Secret string
+	// EventFilter is the CEL expression to filter the events, empty means all events.
+	EventFilter string
 	// Output only fields.
 	//
 	// ID is the unique identifier of the project webhook.
@@ -38,6 +42,10 @@ type UpdateProjectWebhookMessage struct {
 	URL *string
 	// ActivityList is the list of activities that the webhook is interested in.
 	ActivityList []string
+	// Secret is the key to sign the webhook payloads with HMAC-SHA256.
+	Secret *string
+	// EventFilter is the CEL expression to filter the events, empty means all events.
+	EventFilter *string
 }
 
 // FindProjectWebhookMessage is the message for finding project webhooks,
@@ -59,10 +67,12 @@ func (s *Store) CreateProjectWebhookV2(ctx context.Context, principalUID int, pr
 			type,
 			name,
 			url,
-			activity_list
+			activity_list,
+			secret,
+			event_filter
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, project_id, type, name, url, activity_list
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, project_id, type, name, url, activity_list, secret, event_filter
 	`
 	var projectWebhook ProjectWebhookMessage
 	var txtArray pgtype.TextArray
@@ -80,6 +90,8 @@ func (s *Store) CreateProjectWebhookV2(ctx context.Context, principalUID int, pr
 		create.Title,
 		create.URL,
 		create.ActivityList,
+		create.Secret,
+		create.EventFilter,
 	).Scan(
 		&projectWebhook.ID,
 		&projectWebhook.ProjectID,
@@ -87,6 +99,8 @@ func (s *Store) CreateProjectWebhookV2(ctx context.Context, principalUID int, pr
 		&projectWebhook.Title,
 		&projectWebhook.URL,
 		&txtArray,
+		&projectWebhook.Secret,
+		&projectWebhook.EventFilter,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, common.FormatDBErrorEmptyRowWithQuery(query)
@@ -165,6 +179,12 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, principalUID int, pr
 	if v := update.ActivityList; v != nil {
 		set, args = append(set, fmt.Sprintf("activity_list = $%d", len(args)+1)), append(args, v)
 	}
+	if v := update.Secret; v != nil {
+		set, args = append(set, fmt.Sprintf("secret = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.EventFilter; v != nil {
+		set, args = append(set, fmt.Sprintf("event_filter = $%d", len(args)+1)), append(args, *v)
+	}
 
 	args = append(args, projectWebhookID)
 
@@ -175,7 +195,7 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, principalUID int, pr
 	UPDATE project_webhook
 	SET `+strings.Join(set, ", ")+`
 	WHERE id = $%d
-	RETURNING id, project_id, type, name, url, activity_list
+	RETURNING id, project_id, type, name, url, activity_list, secret, event_filter
 `, len(args)),
 		args...,
 	).Scan(
@@ -185,6 +205,8 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, principalUID int, pr
 		&projectWebhook.Title,
 		&projectWebhook.URL,
 		&txtArray,
+		&projectWebhook.Secret,
+		&projectWebhook.EventFilter,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("project hook ID not found: %d", projectWebhookID)}
@@ -241,7 +263,9 @@ func (*Store) findProjectWebhookImplV2(ctx context.Context, tx *Tx, find *FindPr
 			type,
 			name,
 			url,
-			activity_list
+			activity_list,
+			secret,
+			event_filter
 		FROM project_webhook
 		WHERE `+strings.Join(where, " AND "),
 		args...,
@@ -262,6 +286,8 @@ func (*Store) findProjectWebhookImplV2(ctx context.Context, tx *Tx, find *FindPr
 			&projectWebhook.Title,
 			&projectWebhook.URL,
 			&txtArray,
+			&projectWebhook.Secret,
+			&projectWebhook.EventFilter,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/pkg/errors"
+)
+
+const (
+	// LeaderLeaseName is the name of the lease held by the leader replica.
+	LeaderLeaseName = "leader"
+	// replicaLeasePrefix is the name prefix of the leases held by the alive replicas.
+	replicaLeasePrefix = "replica/"
+)
+
+// GetReplicaLeaseName returns the name of the lease that the replica renews while it is alive.
+func GetReplicaLeaseName(replicaID string) string {
+	return replicaLeasePrefix + replicaID
+}
+
+// TryAcquireLease acquires or renews the lease for the holder.
+// It returns false if the lease is held by another holder and has not expired.
+func (s *Store) TryAcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	query := `
+		INSERT INTO replica_lease (name, holder, expire_ts)
+		VALUES ($1, $2, extract(epoch from now())::BIGINT + $3)
+		ON CONFLICT (name) DO UPDATE SET
+			holder = EXCLUDED.holder,
+			expire_ts = EXCLUDED.expire_ts
+		WHERE replica_lease.holder = EXCLUDED.holder OR replica_lease.expire_ts < extract(epoch from now())
+		RETURNING holder
+	`
+	var acquiredHolder string
+	if err := s.db.db.QueryRowContext(ctx, query, name, holder, int64(ttl.Seconds())).Scan(&acquiredHolder); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to acquire lease %q", name)
+	}
+	return acquiredHolder == holder, nil
+}
+
+// ReleaseLease releases the lease if it is held by the holder.
+func (s *Store) ReleaseLease(ctx context.Context, name, holder string) error {
+	if _, err := s.db.db.ExecContext(ctx, `DELETE FROM replica_lease WHERE name = $1 AND holder = $2`, name, holder); err != nil {
+		return errors.Wrapf(err, "failed to release lease %q", name)
+	}
+	return nil
+}
+
+// CountActiveReplicas returns the number of the replicas whose leases have not expired.
+func (s *Store) CountActiveReplicas(ctx context.Context) (int, error) {
+	var count int
+	if err := s.db.db.QueryRowContext(ctx, `
+		SELECT count(*) FROM replica_lease
+		WHERE name LIKE $1 AND expire_ts >= extract(epoch from now())`,
+		replicaLeasePrefix+"%",
+	).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "failed to count active replicas")
+	}
+	return count, nil
+}
+
+// Notify sends the notification payload to the Postgres channel for the other replicas.
+func (s *Store) Notify(ctx context.Context, channel, payload string) error {
+	if _, err := s.db.db.ExecContext(ctx, `SELECT pg_notify($1, $2)`, channel, payload); err != nil {
+		return errors.Wrapf(err, "failed to notify channel %q", channel)
+	}
+	return nil
+}
+
+// Listen listens to the Postgres channel and calls handle for every notification until the context is canceled.
+// It holds a dedicated connection from the pool.
+func (s *Store) Listen(ctx context.Context, channel string, handle func(payload string)) error {
+	conn, err := s.db.db.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get connection")
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		pgxConn := driverConn.(*stdlib.Conn).Conn()
+		if _, err := pgxConn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			return errors.Wrapf(err, "failed to listen channel %q", channel)
+		}
+		for {
+			notification, err := pgxConn.WaitForNotification(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return errors.Wrapf(err, "failed to wait for notification on channel %q", channel)
+			}
+			handle(notification.Payload)
+		}
+	})
+}
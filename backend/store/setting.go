@@ -14,7 +14,6 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
-	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
 // FindSettingMessage is the message for finding setting.
@@ -38,23 +37,6 @@ type SettingMessage struct {
 	CreatedTs   int64
 }
 
-var (
-	// Currently, we do not persist the algorithm setting, in order to use it conveniently, we hard code it here.
-	// TODO(zp): remove the following hard code when we persist the algorithm setting.
-	mockAlgorithmSetting = &v1pb.MaskingAlgorithmSetting{
-		Algorithms: []*v1pb.MaskingAlgorithmSetting_MaskingAlgorithm{
-			{
-				Id:    "substitution",
-				Title: "Substitution algorithm",
-			},
-			{
-				Id:    "hash-md5",
-				Title: "MD5 encryption",
-			},
-		},
-	}
-)
-
 // GetWorkspaceGeneralSetting gets the workspace general setting payload.
 func (s *Store) GetWorkspaceGeneralSetting(ctx context.Context) (*storepb.WorkspaceProfileSetting, error) {
 	settingName := api.SettingWorkspaceProfile
@@ -171,6 +153,66 @@ func (s *Store) GetDataClassificationSetting(ctx context.Context) (*storepb.Data
 	return payload, nil
 }
 
+// GetSemanticTypesSetting gets the semantic types setting.
+func (s *Store) GetSemanticTypesSetting(ctx context.Context) (*storepb.SemanticTypesSetting, error) {
+	settingName := api.SettingSemanticTypes
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.SemanticTypesSetting{}, nil
+	}
+
+	payload := new(storepb.SemanticTypesSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetSensitiveDataDiscoverySetting gets the sensitive data discovery setting.
+func (s *Store) GetSensitiveDataDiscoverySetting(ctx context.Context) (*storepb.SensitiveDataDiscoverySetting, error) {
+	settingName := api.SettingSensitiveDataDiscovery
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.SensitiveDataDiscoverySetting{}, nil
+	}
+
+	payload := new(storepb.SensitiveDataDiscoverySetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetMaskingAlgorithmSetting gets the masking algorithm setting.
+func (s *Store) GetMaskingAlgorithmSetting(ctx context.Context) (*storepb.MaskingAlgorithmSetting, error) {
+	settingName := api.SettingMaskingAlgorithms
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.MaskingAlgorithmSetting{}, nil
+	}
+
+	payload := new(storepb.MaskingAlgorithmSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
 // DeleteCache deletes the cache.
 func (s *Store) DeleteCache() {
 	s.settingCache = sync.Map{}
@@ -179,25 +221,11 @@ func (s *Store) DeleteCache() {
 // GetSettingV2 returns the setting by name.
 func (s *Store) GetSettingV2(ctx context.Context, find *FindSettingMessage) (*SettingMessage, error) {
 	if find.Name != nil && !find.Enforce {
-		if setting, ok := s.settingCache.Load(*find.Name); ok {
+		if setting, ok := s.loadCache(&s.settingCache, *find.Name); ok {
 			return setting.(*SettingMessage), nil
 		}
 	}
 
-	// TODO(zp): remove the following hard code when we persist the algorithm setting.
-	if find.Name != nil && *find.Name == api.SettingMaskingAlgorithms {
-		value, err := protojson.Marshal(mockAlgorithmSetting)
-		if err != nil {
-			return nil, err
-		}
-		setting := &SettingMessage{
-			Name:        api.SettingMaskingAlgorithms,
-			Value:       string(value),
-			Description: "",
-		}
-		return setting, nil
-	}
-
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to begin transaction")
@@ -236,18 +264,6 @@ func (s *Store) ListSettingV2(ctx context.Context, find *FindSettingMessage) ([]
 		return nil, errors.Wrap(err, "failed to commit transaction")
 	}
 
-	// TODO(zp): remove the following hard code when we persist the algorithm setting.
-	value, err := protojson.Marshal(mockAlgorithmSetting)
-	if err != nil {
-		return nil, err
-	}
-	setting := &SettingMessage{
-		Name:        api.SettingMaskingAlgorithms,
-		Value:       string(value),
-		Description: "",
-	}
-	settings = append(settings, setting)
-
 	for _, setting := range settings {
 		s.settingCache.Store(setting.Name, setting)
 	}
@@ -256,10 +272,6 @@ func (s *Store) ListSettingV2(ctx context.Context, find *FindSettingMessage) ([]
 
 // UpsertSettingV2 upserts the setting by name.
 func (s *Store) UpsertSettingV2(ctx context.Context, update *SetSettingMessage, principalUID int) (*SettingMessage, error) {
-	if update.Name == api.SettingMaskingAlgorithms {
-		return nil, errors.New("cannot update masking algorithm setting")
-	}
-
 	fields := []string{"creator_id", "updater_id", "name", "value"}
 	updateFields := []string{"value = EXCLUDED.value", "updater_id = EXCLUDED.updater_id"}
 	valuePlaceholders, args := []string{"$1", "$2", "$3", "$4"}, []any{principalUID, principalUID, update.Name, update.Value}
@@ -303,12 +315,7 @@ func (s *Store) UpsertSettingV2(ctx context.Context, update *SetSettingMessage,
 
 // CreateSettingIfNotExistV2 creates a new setting only if the named setting doesn't exist.
 func (s *Store) CreateSettingIfNotExistV2(ctx context.Context, create *SettingMessage, principalUID int) (*SettingMessage, bool, error) {
-	// TODO(zp): remove the following hard code when we persist the algorithm setting.
-	if create.Name == api.SettingMaskingAlgorithms {
-		return nil, false, errors.New("cannot create masking algorithm setting")
-	}
-
-	if setting, ok := s.settingCache.Load(create.Name); ok {
+	if setting, ok := s.loadCache(&s.settingCache, create.Name); ok {
 		return setting.(*SettingMessage), false, nil
 	}
 
@@ -354,11 +361,6 @@ func (s *Store) CreateSettingIfNotExistV2(ctx context.Context, create *SettingMe
 
 // DeleteSettingV2 deletes a setting by the name.
 func (s *Store) DeleteSettingV2(ctx context.Context, name api.SettingName) error {
-	// TODO(zp): remove the following hard code when we persist the algorithm setting.
-	if name == api.SettingMaskingAlgorithms {
-		return errors.New("cannot delete masking algorithm setting")
-	}
-
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed to begin transaction")
@@ -135,7 +135,7 @@ type PatchSheetMessage struct {
 
 // GetSheetStatementByID gets the statement of a sheet by ID.
 func (s *Store) GetSheetStatementByID(ctx context.Context, id int) (string, error) {
-	if statement, ok := s.sheetStatementCache.Get(id); ok {
+	if statement, ok := s.getCache(s.sheetStatementCache, id); ok {
 		return statement.(string), nil
 	}
 
@@ -207,7 +207,7 @@ func (s *Store) ListSheets(ctx context.Context, find *FindSheetMessage, currentP
 		where = append(where, fmt.Sprintf("(%s)", strings.Join(visibilitiesWhere, " OR ")))
 	}
 	if v := find.PrincipalID; v != nil {
-		where, args = append(where, fmt.Sprintf("sheet.project_id IN (SELECT project_id FROM project_member WHERE principal_id = $%d)", len(args)+1)), append(args, *v)
+		where, args = append(where, fmt.Sprintf("sheet.project_id IN (SELECT project_id FROM project_member WHERE principal_id = $%d UNION SELECT project_group_member.project_id FROM project_group_member JOIN user_group_member ON project_group_member.group_id = user_group_member.group_id WHERE user_group_member.principal_id = $%d)", len(args)+1, len(args)+1)), append(args, *v)
 	}
 	if v := find.OrganizerPrincipalIDStarred; v != nil {
 		where, args = append(where, fmt.Sprintf("sheet.id IN (SELECT sheet_id FROM sheet_organizer WHERE principal_id = $%d AND starred = true)", len(args)+1)), append(args, *v)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/dgraph-io/ristretto"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -49,6 +50,10 @@ type Store struct {
 	// sheetStatementCache caches the statement of a sheet.
 	sheetStatementCache *ristretto.Cache // map[sheetUID]sheetStatementString
 	vcsIDCache          sync.Map         // map[int]*ExternalVersionControlMessage
+
+	// cacheDisabled bypasses the caches on reads. The caches are disabled when more than one replica is active,
+	// as the writes through the other replicas don't invalidate the local caches.
+	cacheDisabled atomic.Bool
 }
 
 // New creates a new instance of Store.
@@ -85,6 +90,65 @@ func (s *Store) RefreshSwap(refresh bool) {
 	s.dbSchemaCache.UpdateMaxCost(cost)
 }
 
+// SetCacheEnabled enables or disables the caches.
+// The caches are purged when they are re-enabled, because they miss the writes through the other replicas in the meantime.
+func (s *Store) SetCacheEnabled(enabled bool) {
+	if s.cacheDisabled.Swap(!enabled) && enabled {
+		s.purgeCaches()
+	}
+}
+
+// loadCache loads the value from the cache, and always misses if the caches are disabled.
+func (s *Store) loadCache(cache *sync.Map, key any) (any, bool) {
+	if s.cacheDisabled.Load() {
+		return nil, false
+	}
+	return cache.Load(key)
+}
+
+// getCache gets the value from the cache, and always misses if the caches are disabled.
+func (s *Store) getCache(cache *ristretto.Cache, key any) (any, bool) {
+	if s.cacheDisabled.Load() {
+		return nil, false
+	}
+	return cache.Get(key)
+}
+
+func (s *Store) purgeCaches() {
+	for _, cache := range []*sync.Map{
+		&s.userIDCache,
+		&s.environmentCache,
+		&s.environmentIDCache,
+		&s.instanceCache,
+		&s.instanceIDCache,
+		&s.databaseCache,
+		&s.databaseIDCache,
+		&s.projectCache,
+		&s.projectIDCache,
+		&s.projectPolicyCache,
+		&s.projectIDPolicyCache,
+		&s.policyCache,
+		&s.issueCache,
+		&s.issueByPipelineCache,
+		&s.pipelineCache,
+		&s.settingCache,
+		&s.idpCache,
+		&s.projectIDDeploymentConfigCache,
+		&s.risksCache,
+		&s.databaseGroupCache,
+		&s.databaseGroupIDCache,
+		&s.schemaGroupCache,
+		&s.vcsIDCache,
+	} {
+		cache.Range(func(key, _ any) bool {
+			cache.Delete(key)
+			return true
+		})
+	}
+	s.dbSchemaCache.Clear()
+	s.sheetStatementCache.Clear()
+}
+
 // Close closes underlying db.
 func (s *Store) Close(ctx context.Context) error {
 	return s.db.Close(ctx)
@@ -35,6 +35,10 @@ type TaskRunMessage struct {
 	Updater   *UserMessage
 	UpdatedTs int64
 	ProjectID string
+	TaskType  api.TaskType
+	// ReplicaID is the replica that claimed the task run for execution, empty if the task run is not claimed.
+	ReplicaID     string
+	SchedulerInfo *storepb.SchedulerInfo
 }
 
 // FindTaskRunMessage is the message for finding task runs.
@@ -110,8 +114,11 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 			task_run.status,
 			task_run.code,
 			task_run.result,
+			task_run.replica_id,
+			task_run.scheduler_info,
 			task.pipeline_id,
 			task.stage_id,
+			task.type,
 			project.resource_id
 		FROM task_run
 		LEFT JOIN task ON task.id = task_run.task_id
@@ -129,6 +136,7 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 	var taskRuns []*TaskRunMessage
 	for rows.Next() {
 		var taskRun TaskRunMessage
+		var schedulerInfo string
 		if err := rows.Scan(
 			&taskRun.ID,
 			&taskRun.CreatorID,
@@ -140,8 +148,11 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 			&taskRun.Status,
 			&taskRun.Code,
 			&taskRun.Result,
+			&taskRun.ReplicaID,
+			&schedulerInfo,
 			&taskRun.PipelineUID,
 			&taskRun.StageUID,
+			&taskRun.TaskType,
 			&taskRun.ProjectID,
 		); err != nil {
 			return nil, err
@@ -154,6 +165,12 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 		}
 		taskRun.ResultProto = &resultProto
 
+		var schedulerInfoProto storepb.SchedulerInfo
+		if err := decoder.Unmarshal([]byte(schedulerInfo), &schedulerInfoProto); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal task run scheduler info: %s", schedulerInfo)
+		}
+		taskRun.SchedulerInfo = &schedulerInfoProto
+
 		taskRuns = append(taskRuns, &taskRun)
 	}
 	if err := rows.Err(); err != nil {
@@ -485,3 +502,85 @@ func (s *Store) BatchPatchTaskRunStatus(ctx context.Context, taskRunIDs []int, s
 	}
 	return nil
 }
+
+// UpdateTaskRunSchedulerInfo updates the scheduler info of a task run.
+func (s *Store) UpdateTaskRunSchedulerInfo(ctx context.Context, taskRunID int, schedulerInfo *storepb.SchedulerInfo) error {
+	schedulerInfoBytes, err := protojson.Marshal(schedulerInfo)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal scheduler info")
+	}
+	if _, err := s.db.db.ExecContext(ctx, `
+		UPDATE task_run
+		SET scheduler_info = $1
+		WHERE id = $2
+	`, string(schedulerInfoBytes), taskRunID); err != nil {
+		return errors.Wrapf(err, "failed to update scheduler info of task run %d", taskRunID)
+	}
+	return nil
+}
+
+// ClaimTaskRun claims the RUNNING task run for execution on the replica and clears its scheduler info.
+// It returns false if the task run is no longer running or has been claimed by another replica,
+// so that a task run is never executed by two replicas.
+func (s *Store) ClaimTaskRun(ctx context.Context, taskRunID int, replicaID string) (bool, error) {
+	query := `
+		UPDATE task_run
+		SET replica_id = $2, scheduler_info = '{}'
+		WHERE id = $1 AND status = $3 AND replica_id IN ('', $2)
+		RETURNING id
+	`
+	var id int
+	if err := s.db.db.QueryRowContext(ctx, query, taskRunID, replicaID, api.TaskRunRunning).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to claim task run %d", taskRunID)
+	}
+	return true, nil
+}
+
+// CancelOrphanTaskRuns changes the RUNNING task runs claimed by dead replicas to CANCELED.
+// A replica is dead if its replica lease has expired or been released.
+// The orphan task runs are not retried because the migration may have been partially applied.
+func (s *Store) CancelOrphanTaskRuns(ctx context.Context, updaterID int) ([]int, error) {
+	query := `
+		UPDATE task_run
+		SET status = $1, updater_id = $2
+		WHERE status = $3 AND replica_id != '' AND NOT EXISTS (
+			SELECT 1 FROM replica_lease
+			WHERE replica_lease.name = $4 || task_run.replica_id AND replica_lease.expire_ts >= extract(epoch from now())
+		)
+		RETURNING id
+	`
+	rows, err := s.db.db.QueryContext(ctx, query, api.TaskRunCanceled, updaterID, api.TaskRunRunning, replicaLeasePrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to cancel orphan task runs")
+	}
+	defer rows.Close()
+	var taskRunIDs []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		taskRunIDs = append(taskRunIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return taskRunIDs, nil
+}
+
+// ReleaseTaskRun releases the claim of the RUNNING task run so that any replica can execute it again.
+// It is used when the execution is not started due to a transient error.
+func (s *Store) ReleaseTaskRun(ctx context.Context, taskRunID int, replicaID string) error {
+	query := `
+		UPDATE task_run
+		SET replica_id = ''
+		WHERE id = $1 AND status = $3 AND replica_id = $2
+	`
+	if _, err := s.db.db.ExecContext(ctx, query, taskRunID, replicaID, api.TaskRunRunning); err != nil {
+		return errors.Wrapf(err, "failed to release task run %d", taskRunID)
+	}
+	return nil
+}
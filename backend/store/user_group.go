@@ -0,0 +1,444 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+// UserGroupMessage is the message for a user group.
+type UserGroupMessage struct {
+	ResourceID  string
+	Title       string
+	Description string
+	// IdentityProviderUID is the identity provider that the group is synced from.
+	// It is nil for the groups managed in Bytebase.
+	IdentityProviderUID *int
+	// ExternalID is the group identifier in the identity provider.
+	ExternalID string
+	// MemberIDs are the UIDs of the group members.
+	MemberIDs []int
+
+	// Output only fields
+	UID int
+}
+
+// FindUserGroupMessage is the message for finding user groups.
+type FindUserGroupMessage struct {
+	UID                 *int
+	ResourceID          *string
+	IdentityProviderUID *int
+	// MemberID finds the groups that the user belongs to.
+	MemberID *int
+}
+
+// UpdateUserGroupMessage is the message for updating a user group.
+type UpdateUserGroupMessage struct {
+	UID       int
+	UpdaterID int
+
+	Title       *string
+	Description *string
+	MemberIDs   *[]int
+}
+
+// GetUserGroup gets a user group.
+func (s *Store) GetUserGroup(ctx context.Context, find *FindUserGroupMessage) (*UserGroupMessage, error) {
+	groups, err := s.ListUserGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	if len(groups) > 1 {
+		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d user groups with filter %+v, expect 1", len(groups), find)}
+	}
+	return groups[0], nil
+}
+
+// ListUserGroups lists user groups.
+func (s *Store) ListUserGroups(ctx context.Context, find *FindUserGroupMessage) ([]*UserGroupMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	groups, err := listUserGroupsImpl(ctx, tx, find)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func listUserGroupsImpl(ctx context.Context, tx *Tx, find *FindUserGroupMessage) ([]*UserGroupMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("user_group.id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.ResourceID; v != nil {
+		where, args = append(where, fmt.Sprintf("user_group.resource_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.IdentityProviderUID; v != nil {
+		where, args = append(where, fmt.Sprintf("user_group.idp_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.MemberID; v != nil {
+		where, args = append(where, fmt.Sprintf("user_group.id IN (SELECT group_id FROM user_group_member WHERE principal_id = $%d)", len(args)+1)), append(args, *v)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			user_group.id,
+			user_group.resource_id,
+			user_group.title,
+			user_group.description,
+			user_group.idp_id,
+			user_group.external_id,
+			ARRAY(SELECT user_group_member.principal_id FROM user_group_member WHERE user_group_member.group_id = user_group.id ORDER BY user_group_member.principal_id)
+		FROM user_group
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY user_group.id`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []*UserGroupMessage
+	for rows.Next() {
+		group := &UserGroupMessage{}
+		var idpID sql.NullInt32
+		var memberIDs pgtype.Int4Array
+		if err := rows.Scan(
+			&group.UID,
+			&group.ResourceID,
+			&group.Title,
+			&group.Description,
+			&idpID,
+			&group.ExternalID,
+			&memberIDs,
+		); err != nil {
+			return nil, err
+		}
+		if idpID.Valid {
+			v := int(idpID.Int32)
+			group.IdentityProviderUID = &v
+		}
+		if err := memberIDs.AssignTo(&group.MemberIDs); err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// CreateUserGroup creates a user group.
+func (s *Store) CreateUserGroup(ctx context.Context, create *UserGroupMessage, creatorID int) (*UserGroupMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	group, err := createUserGroupImpl(ctx, tx, create, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.removeProjectPolicyCache()
+	return group, nil
+}
+
+func createUserGroupImpl(ctx context.Context, tx *Tx, create *UserGroupMessage, creatorID int) (*UserGroupMessage, error) {
+	group := &UserGroupMessage{
+		ResourceID:          create.ResourceID,
+		Title:               create.Title,
+		Description:         create.Description,
+		IdentityProviderUID: create.IdentityProviderUID,
+		ExternalID:          create.ExternalID,
+	}
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO user_group (
+			creator_id,
+			updater_id,
+			resource_id,
+			title,
+			description,
+			idp_id,
+			external_id
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`,
+		creatorID,
+		creatorID,
+		create.ResourceID,
+		create.Title,
+		create.Description,
+		create.IdentityProviderUID,
+		create.ExternalID,
+	).Scan(&group.UID); err != nil {
+		return nil, err
+	}
+	if err := setUserGroupMembersImpl(ctx, tx, group.UID, create.MemberIDs); err != nil {
+		return nil, err
+	}
+	group.MemberIDs = dedupeMemberIDs(create.MemberIDs)
+	return group, nil
+}
+
+// UpdateUserGroup updates a user group.
+func (s *Store) UpdateUserGroup(ctx context.Context, update *UpdateUserGroupMessage) (*UserGroupMessage, error) {
+	set, args := []string{"updater_id = $1"}, []any{update.UpdaterID}
+	if v := update.Title; v != nil {
+		set, args = append(set, fmt.Sprintf("title = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, fmt.Sprintf("description = $%d", len(args)+1)), append(args, *v)
+	}
+	args = append(args, update.UID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE user_group
+		SET `+strings.Join(set, ", ")+`
+		WHERE id = $%d`, len(args)),
+		args...,
+	); err != nil {
+		return nil, err
+	}
+	if v := update.MemberIDs; v != nil {
+		if err := setUserGroupMembersImpl(ctx, tx, update.UID, *v); err != nil {
+			return nil, err
+		}
+	}
+	groups, err := listUserGroupsImpl(ctx, tx, &FindUserGroupMessage{UID: &update.UID})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) != 1 {
+		return nil, errors.Errorf("user group %d not found", update.UID)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.removeProjectPolicyCache()
+	return groups[0], nil
+}
+
+// DeleteUserGroup deletes a user group, including its members and the project roles granted to it.
+func (s *Store) DeleteUserGroup(ctx context.Context, uid int) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM project_group_member WHERE group_id = $1`, uid); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_group_member WHERE group_id = $1`, uid); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_group WHERE id = $1`, uid); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.removeProjectPolicyCache()
+	return nil
+}
+
+// SyncUserGroupMembership syncs the membership of a user in the groups of an identity provider.
+// The groups are identified by their external IDs, and missing groups are created with the given titles.
+// The user is removed from the other groups of the identity provider.
+func (s *Store) SyncUserGroupMembership(ctx context.Context, idpUID int, idpResourceID string, userID int, externalGroups map[string]string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	groups, err := listUserGroupsImpl(ctx, tx, &FindUserGroupMessage{IdentityProviderUID: &idpUID})
+	if err != nil {
+		return err
+	}
+	groupByExternalID := make(map[string]*UserGroupMessage)
+	for _, group := range groups {
+		groupByExternalID[group.ExternalID] = group
+	}
+
+	changed := false
+	for externalID, title := range externalGroups {
+		if _, ok := groupByExternalID[externalID]; ok {
+			continue
+		}
+		group, err := createUserGroupImpl(ctx, tx, &UserGroupMessage{
+			ResourceID:          GetSyncedUserGroupResourceID(idpResourceID, externalID),
+			Title:               title,
+			IdentityProviderUID: &idpUID,
+			ExternalID:          externalID,
+		}, api.SystemBotID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create user group %q", externalID)
+		}
+		groupByExternalID[externalID] = group
+		changed = true
+	}
+
+	for externalID, group := range groupByExternalID {
+		_, want := externalGroups[externalID]
+		has := false
+		for _, memberID := range group.MemberIDs {
+			if memberID == userID {
+				has = true
+				break
+			}
+		}
+		if want && !has {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO user_group_member (group_id, principal_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`, group.UID, userID); err != nil {
+				return err
+			}
+			changed = true
+		}
+		if !want && has {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM user_group_member WHERE group_id = $1 AND principal_id = $2`, group.UID, userID); err != nil {
+				return err
+			}
+			changed = true
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	if changed {
+		s.removeProjectPolicyCache()
+	}
+	return nil
+}
+
+// RemoveStaleUserGroupMembers removes the members of the groups synced from the identity provider except the given users,
+// who are the users still in the directory of the identity provider.
+func (s *Store) RemoveStaleUserGroupMembers(ctx context.Context, idpUID int, memberIDs []int) error {
+	where, args := []string{"group_id IN (SELECT id FROM user_group WHERE idp_id = $1)"}, []any{idpUID}
+	if memberIDs = dedupeMemberIDs(memberIDs); len(memberIDs) > 0 {
+		var placeholders []string
+		for _, memberID := range memberIDs {
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)+1))
+			args = append(args, memberID)
+		}
+		where = append(where, fmt.Sprintf("principal_id NOT IN (%s)", strings.Join(placeholders, ", ")))
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	result, err := tx.ExecContext(ctx, `DELETE FROM user_group_member WHERE `+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	if removed > 0 {
+		s.removeProjectPolicyCache()
+	}
+	return nil
+}
+
+// GetSyncedUserGroupResourceID returns the resource ID of a group synced from an identity provider.
+// The external ID is slugified and truncated to fit in a resource ID, and a hash suffix keeps it unique.
+func GetSyncedUserGroupResourceID(idpResourceID, externalID string) string {
+	var b strings.Builder
+	b.WriteString(idpResourceID + "-")
+	for _, r := range strings.ToLower(externalID) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else if !strings.HasSuffix(b.String(), "-") {
+			b.WriteRune('-')
+		}
+	}
+	slug := b.String()
+	if len(slug) > 54 {
+		slug = slug[:54]
+	}
+	hash := sha256.Sum256([]byte(externalID))
+	return fmt.Sprintf("%s-%s", strings.TrimSuffix(slug, "-"), hex.EncodeToString(hash[:])[:8])
+}
+
+func setUserGroupMembersImpl(ctx context.Context, tx *Tx, groupUID int, memberIDs []int) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_group_member WHERE group_id = $1`, groupUID); err != nil {
+		return err
+	}
+	memberIDs = dedupeMemberIDs(memberIDs)
+	if len(memberIDs) == 0 {
+		return nil
+	}
+	var placeholders []string
+	args := []any{groupUID}
+	for _, memberID := range memberIDs {
+		placeholders = append(placeholders, fmt.Sprintf("($1, $%d)", len(args)+1))
+		args = append(args, memberID)
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO user_group_member (group_id, principal_id) VALUES %s`, strings.Join(placeholders, ", ")), args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func dedupeMemberIDs(memberIDs []int) []int {
+	var result []int
+	seen := make(map[int]bool)
+	for _, memberID := range memberIDs {
+		if seen[memberID] {
+			continue
+		}
+		seen[memberID] = true
+		result = append(result, memberID)
+	}
+	return result
+}
+
+// removeProjectPolicyCache removes all cached project IAM policies, as they contain the group members.
+func (s *Store) removeProjectPolicyCache() {
+	s.projectPolicyCache.Range(func(key, _ any) bool {
+		s.projectPolicyCache.Delete(key)
+		return true
+	})
+	s.projectIDPolicyCache.Range(func(key, _ any) bool {
+		s.projectIDPolicyCache.Delete(key)
+		return true
+	})
+}
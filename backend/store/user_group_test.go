@@ -0,0 +1,23 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSyncedUserGroupResourceID(t *testing.T) {
+	a := require.New(t)
+
+	id := GetSyncedUserGroupResourceID("ldap", "cn=dba,ou=Groups,dc=example,dc=com")
+	a.Regexp("^ldap-cn-dba-ou-groups-dc-example-dc-com-[0-9a-f]{8}$", id)
+	a.Equal(id, GetSyncedUserGroupResourceID("ldap", "cn=dba,ou=Groups,dc=example,dc=com"))
+
+	// The external IDs with the same slug get different resource IDs.
+	a.NotEqual(GetSyncedUserGroupResourceID("okta", "dba team"), GetSyncedUserGroupResourceID("okta", "dba-team"))
+
+	// The resource ID is truncated to a valid length.
+	long := GetSyncedUserGroupResourceID("ldap", "cn=a-very-long-group-name-that-exceeds-the-limit,ou=Groups,dc=example,dc=com")
+	a.LessOrEqual(len(long), 63)
+	a.Regexp("^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$", long)
+}
@@ -50,7 +50,7 @@ type findExternalVersionControlMessage struct {
 
 // GetExternalVersionControlV2 gets an external version control by ID.
 func (s *Store) GetExternalVersionControlV2(ctx context.Context, id int) (*ExternalVersionControlMessage, error) {
-	if vcs, ok := s.vcsIDCache.Load(id); ok {
+	if vcs, ok := s.loadCache(&s.vcsIDCache, id); ok {
 		return vcs.(*ExternalVersionControlMessage), nil
 	}
 
@@ -0,0 +1,236 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is the status of the deliveries waiting for the next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliveryDone is the status of the delivered deliveries.
+	WebhookDeliveryDone WebhookDeliveryStatus = "DONE"
+	// WebhookDeliveryDead is the status of the deliveries failed after the max attempts.
+	WebhookDeliveryDead WebhookDeliveryStatus = "DEAD"
+)
+
+// WebhookDeliveryMessage is the store model for a webhook delivery.
+type WebhookDeliveryMessage struct {
+	// WebhookID is the ID of the project webhook.
+	WebhookID int
+	// Payload is the webhook event in JSON.
+	Payload string
+
+	// Output only fields.
+	ID            int
+	Status        WebhookDeliveryStatus
+	Attempt       int
+	NextAttemptTs int64
+	LastError     string
+	CreatedTs     int64
+	UpdatedTs     int64
+}
+
+// FindWebhookDeliveryMessage is the message for finding webhook deliveries.
+type FindWebhookDeliveryMessage struct {
+	ID        *int
+	WebhookID *int
+	Status    *WebhookDeliveryStatus
+	// Ready finds the pending deliveries whose next attempt time has come.
+	Ready  bool
+	Limit  *int
+	Offset *int
+}
+
+// UpdateWebhookDeliveryMessage is the message for updating a webhook delivery.
+type UpdateWebhookDeliveryMessage struct {
+	ID            int
+	Status        WebhookDeliveryStatus
+	Attempt       int
+	NextAttemptTs int64
+	LastError     string
+}
+
+// CreateWebhookDeliveries creates the pending webhook deliveries.
+func (s *Store) CreateWebhookDeliveries(ctx context.Context, creates ...*WebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var deliveries []*WebhookDeliveryMessage
+	for _, create := range creates {
+		delivery := &WebhookDeliveryMessage{
+			WebhookID: create.WebhookID,
+			Payload:   create.Payload,
+		}
+		if err := tx.QueryRowContext(ctx, `
+			INSERT INTO webhook_delivery (
+				webhook_id,
+				status,
+				payload
+			)
+			VALUES ($1, $2, $3)
+			RETURNING id, status, attempt, next_attempt_ts, last_error, created_ts, updated_ts
+		`,
+			create.WebhookID,
+			WebhookDeliveryPending,
+			create.Payload,
+		).Scan(
+			&delivery.ID,
+			&delivery.Status,
+			&delivery.Attempt,
+			&delivery.NextAttemptTs,
+			&delivery.LastError,
+			&delivery.CreatedTs,
+			&delivery.UpdatedTs,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to create webhook delivery")
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit transaction")
+	}
+	return deliveries, nil
+}
+
+// GetWebhookDelivery gets a webhook delivery.
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	deliveries, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	if len(deliveries) > 1 {
+		return nil, errors.Errorf("expected find one webhook delivery with %+v, but found %d", find, len(deliveries))
+	}
+	return deliveries[0], nil
+}
+
+// ListWebhookDeliveries lists the webhook deliveries in the descending order of the ID,
+// or in the ascending order of the next attempt time if finding the ready deliveries.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.WebhookID; v != nil {
+		where, args = append(where, fmt.Sprintf("webhook_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Status; v != nil {
+		where, args = append(where, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	orderBy := "id DESC"
+	if find.Ready {
+		where, args = append(where, fmt.Sprintf("status = $%d", len(args)+1)), append(args, WebhookDeliveryPending)
+		where = append(where, "next_attempt_ts <= extract(epoch from now())")
+		orderBy = "next_attempt_ts ASC, id ASC"
+	}
+
+	query := `
+		SELECT
+			id,
+			webhook_id,
+			status,
+			payload,
+			attempt,
+			next_attempt_ts,
+			last_error,
+			created_ts,
+			updated_ts
+		FROM webhook_delivery
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY ` + orderBy
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		delivery := &WebhookDeliveryMessage{}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookID,
+			&delivery.Status,
+			&delivery.Payload,
+			&delivery.Attempt,
+			&delivery.NextAttemptTs,
+			&delivery.LastError,
+			&delivery.CreatedTs,
+			&delivery.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit transaction")
+	}
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery records the result of a delivery attempt.
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDeliveryMessage) error {
+	if _, err := s.db.db.ExecContext(ctx, `
+		UPDATE webhook_delivery
+		SET status = $1, attempt = $2, next_attempt_ts = $3, last_error = $4
+		WHERE id = $5
+	`,
+		update.Status,
+		update.Attempt,
+		update.NextAttemptTs,
+		update.LastError,
+		update.ID,
+	); err != nil {
+		return errors.Wrapf(err, "failed to update webhook delivery %d", update.ID)
+	}
+	return nil
+}
+
+// DeleteOutdatedWebhookDeliveries deletes the DONE and DEAD deliveries last updated before the given time.
+func (s *Store) DeleteOutdatedWebhookDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db.db.ExecContext(ctx, `
+		DELETE FROM webhook_delivery
+		WHERE status IN ($1, $2) AND updated_ts < $3
+	`,
+		WebhookDeliveryDone,
+		WebhookDeliveryDead,
+		before.Unix(),
+	)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete outdated webhook deliveries")
+	}
+	return result.RowsAffected()
+}
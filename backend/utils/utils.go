@@ -402,8 +402,12 @@ func ExecuteMigrationWithFunc(ctx context.Context, driverCtx context.Context, s
 	// Don't record schema if the database hasn't existed yet or is schemaless, e.g. MongoDB.
 	// For baseline migration, we also record the live schema to detect the schema drift.
 	// See https://bytebase.com/blog/what-is-database-schema-drift
-	if _, err := driver.Dump(ctx, &prevSchemaBuf, true /* schemaOnly */); err != nil {
-		return "", "", err
+	// The schema is left empty for the engines without schema dump.
+	hasSchemaDump := db.HasCapability(driver.GetType(), db.CapabilitySchemaDump)
+	if hasSchemaDump {
+		if _, err := driver.Dump(ctx, &prevSchemaBuf, true /* schemaOnly */); err != nil {
+			return "", "", err
+		}
 	}
 
 	insertedID, err := BeginMigration(ctx, s, m, prevSchemaBuf.String(), statement, sheetID)
@@ -457,6 +461,9 @@ func ExecuteMigrationWithFunc(ctx context.Context, driverCtx context.Context, s
 
 	// Phase 4 - Dump the schema after migration
 	var afterSchemaBuf bytes.Buffer
+	if !hasSchemaDump {
+		return insertedID, "", nil
+	}
 	if _, err := driver.Dump(ctx, &afterSchemaBuf, true /* schemaOnly */); err != nil {
 		// We will ignore the dump error if the database is dropped.
 		if strings.Contains(err.Error(), "not found") {
@@ -544,7 +551,7 @@ func EndMigration(ctx context.Context, storeInstance *store.Store, startedNs int
 // FindNextPendingStep finds the next pending step in the approval flow.
 func FindNextPendingStep(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) *storepb.ApprovalStep {
 	// We can do the finding like this for now because we are presuming that
-	// one step is approved by one approver.
+	// one step is decided by one approver, who made the last required approval or the rejection,
 	// and the approver status is either
 	// APPROVED or REJECTED.
 	if len(approvers) >= len(template.Flow.Steps) {
@@ -566,6 +573,77 @@ func FindRejectedStep(template *storepb.ApprovalTemplate, approvers []*storepb.I
 	return nil
 }
 
+// GetRequiredApproverCount returns the number of distinct approvers required to approve the node.
+func GetRequiredApproverCount(node *storepb.ApprovalNode) int {
+	if node.RequiredApproverCount > 1 {
+		return int(node.RequiredApproverCount)
+	}
+	return 1
+}
+
+// HasApprovedStep checks if the principal has approved the next pending step.
+func HasApprovedStep(approval *storepb.IssuePayloadApproval, principalID int32) bool {
+	step := int32(len(approval.Approvers))
+	for _, approver := range approval.StepApprovers {
+		if approver.Step == step && approver.PrincipalId == principalID && approver.Status == storepb.IssuePayloadApproval_Approver_APPROVED {
+			return true
+		}
+	}
+	return false
+}
+
+// ApproveStep records the approval of the principal on the next pending step.
+// The step is approved if it has got enough distinct approvers, and it returns true in that case.
+func ApproveStep(approval *storepb.IssuePayloadApproval, principalID int32) (bool, error) {
+	if len(approval.ApprovalTemplates) != 1 {
+		return false, errors.Errorf("expecting one approval template but got %d", len(approval.ApprovalTemplates))
+	}
+	step := FindNextPendingStep(approval.ApprovalTemplates[0], approval.Approvers)
+	if step == nil {
+		return false, errors.Errorf("no pending approval step")
+	}
+	if len(step.Nodes) != 1 {
+		return false, errors.Errorf("expecting one node but got %v", len(step.Nodes))
+	}
+	if HasApprovedStep(approval, principalID) {
+		return false, errors.Errorf("principal %d has approved the step", principalID)
+	}
+
+	stepIndex := int32(len(approval.Approvers))
+	approval.StepApprovers = append(approval.StepApprovers, &storepb.IssuePayloadApproval_StepApprover{
+		Step:        stepIndex,
+		Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
+		PrincipalId: principalID,
+	})
+	approvedCount := 0
+	for _, approver := range approval.StepApprovers {
+		if approver.Step == stepIndex && approver.Status == storepb.IssuePayloadApproval_Approver_APPROVED {
+			approvedCount++
+		}
+	}
+	if approvedCount < GetRequiredApproverCount(step.Nodes[0]) {
+		return false, nil
+	}
+	approval.Approvers = append(approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+		Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
+		PrincipalId: principalID,
+	})
+	return true, nil
+}
+
+// RejectStep records the rejection of the principal on the next pending step, which rejects the step.
+func RejectStep(approval *storepb.IssuePayloadApproval, principalID int32) {
+	approval.StepApprovers = append(approval.StepApprovers, &storepb.IssuePayloadApproval_StepApprover{
+		Step:        int32(len(approval.Approvers)),
+		Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
+		PrincipalId: principalID,
+	})
+	approval.Approvers = append(approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+		Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
+		PrincipalId: principalID,
+	})
+}
+
 // CheckApprovalApproved checks if the approval is approved.
 func CheckApprovalApproved(approval *storepb.IssuePayloadApproval) (bool, error) {
 	if approval == nil || !approval.ApprovalFindingDone {
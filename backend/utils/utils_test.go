@@ -432,3 +432,66 @@ func TestGetRenderedStatement(t *testing.T) {
 		assert.Equal(t, tc.expected, actual)
 	}
 }
+
+func TestApproveStep(t *testing.T) {
+	a := require.New(t)
+	approval := &storepb.IssuePayloadApproval{
+		ApprovalFindingDone: true,
+		ApprovalTemplates: []*storepb.ApprovalTemplate{
+			{
+				Flow: &storepb.ApprovalFlow{
+					Steps: []*storepb.ApprovalStep{
+						{
+							Type: storepb.ApprovalStep_ANY,
+							Nodes: []*storepb.ApprovalNode{
+								{
+									Type: storepb.ApprovalNode_ANY_IN_GROUP,
+									Payload: &storepb.ApprovalNode_UserList_{
+										UserList: &storepb.ApprovalNode_UserList{Users: []string{"users/101", "users/102", "users/103"}},
+									},
+									RequiredApproverCount: 2,
+								},
+							},
+						},
+						{
+							Type: storepb.ApprovalStep_ANY,
+							Nodes: []*storepb.ApprovalNode{
+								{
+									Type: storepb.ApprovalNode_ANY_IN_GROUP,
+									Payload: &storepb.ApprovalNode_GroupValue_{
+										GroupValue: storepb.ApprovalNode_WORKSPACE_DBA,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	approved, err := ApproveStep(approval, 101)
+	a.NoError(err)
+	a.False(approved)
+	a.Len(approval.Approvers, 0)
+	a.True(HasApprovedStep(approval, 101))
+
+	_, err = ApproveStep(approval, 101)
+	a.Error(err)
+
+	approved, err = ApproveStep(approval, 102)
+	a.NoError(err)
+	a.True(approved)
+	a.Len(approval.Approvers, 1)
+	a.False(HasApprovedStep(approval, 101))
+
+	approved, err = ApproveStep(approval, 101)
+	a.NoError(err)
+	a.True(approved)
+	a.Len(approval.Approvers, 2)
+
+	done, err := CheckApprovalApproved(approval)
+	a.NoError(err)
+	a.True(done)
+	a.Len(approval.StepApprovers, 3)
+}
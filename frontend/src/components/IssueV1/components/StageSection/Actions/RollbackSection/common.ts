@@ -58,6 +58,9 @@ export const useRollbackContext = () => {
       case Engine.ORACLE:
         // We don't have a check for oracle similar to the MySQL version check.
         break;
+      case Engine.POSTGRES:
+        // The changed rows are backed up before executing the statements.
+        break;
       default:
         return "NONE";
     }
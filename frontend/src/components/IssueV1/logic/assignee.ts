@@ -257,6 +257,8 @@ export const taskTypeToDeploymentType = (
     case Task_Type.DATABASE_CREATE:
       return DeploymentType.DATABASE_CREATE;
     case Task_Type.DATABASE_SCHEMA_UPDATE:
+    case Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC:
+    case Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER:
       return DeploymentType.DATABASE_DDL;
     case Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_CUTOVER:
     case Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_SYNC:
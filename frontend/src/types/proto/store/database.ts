@@ -421,6 +421,11 @@ export interface ColumnConfig {
   /** The name is the name of a column. */
   name: string;
   semanticTypeId: string;
+  /**
+   * classification_id is the data classification of the column when the column comment does not contain one.
+   * It's set by accepting the classification suggestion of the sensitive data discovery.
+   */
+  classificationId: string;
 }
 
 function createBaseDatabaseMetadata(): DatabaseMetadata {
@@ -2760,7 +2765,7 @@ export const TableConfig = {
 };
 
 function createBaseColumnConfig(): ColumnConfig {
-  return { name: "", semanticTypeId: "" };
+  return { name: "", semanticTypeId: "", classificationId: "" };
 }
 
 export const ColumnConfig = {
@@ -2771,6 +2776,9 @@ export const ColumnConfig = {
     if (message.semanticTypeId !== "") {
       writer.uint32(18).string(message.semanticTypeId);
     }
+    if (message.classificationId !== "") {
+      writer.uint32(26).string(message.classificationId);
+    }
     return writer;
   },
 
@@ -2795,6 +2803,13 @@ export const ColumnConfig = {
 
           message.semanticTypeId = reader.string();
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.classificationId = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2808,6 +2823,7 @@ export const ColumnConfig = {
     return {
       name: isSet(object.name) ? String(object.name) : "",
       semanticTypeId: isSet(object.semanticTypeId) ? String(object.semanticTypeId) : "",
+      classificationId: isSet(object.classificationId) ? String(object.classificationId) : "",
     };
   },
 
@@ -2815,6 +2831,7 @@ export const ColumnConfig = {
     const obj: any = {};
     message.name !== undefined && (obj.name = message.name);
     message.semanticTypeId !== undefined && (obj.semanticTypeId = message.semanticTypeId);
+    message.classificationId !== undefined && (obj.classificationId = message.classificationId);
     return obj;
   },
 
@@ -2826,6 +2843,7 @@ export const ColumnConfig = {
     const message = createBaseColumnConfig();
     message.name = object.name ?? "";
     message.semanticTypeId = object.semanticTypeId ?? "";
+    message.classificationId = object.classificationId ?? "";
     return message;
   },
 };
@@ -14,6 +14,20 @@ export interface InstanceOptions {
   schemaTenantMode: boolean;
   /** How often the instance is synced. */
   syncInterval: Duration | undefined;
+  priorBackup: InstanceOptions_PriorBackup | undefined;
+}
+
+/**
+ * PriorBackup is the config for backing up the rows to be changed by the data change tasks with rollback enabled.
+ * It is an alternative to generating the rollback SQL from the binlog, and only supported for MySQL instances.
+ */
+export interface InstanceOptions_PriorBackup {
+  enabled: boolean;
+  /**
+   * The max number of rows to back up for a statement. Statements changing more rows are not backed up.
+   * The default is 10000 if it is zero.
+   */
+  maxRows: number;
 }
 
 /** InstanceMetadata is the metadata for instances. */
@@ -27,7 +41,7 @@ export interface InstanceMetadata {
 }
 
 function createBaseInstanceOptions(): InstanceOptions {
-  return { schemaTenantMode: false, syncInterval: undefined };
+  return { schemaTenantMode: false, syncInterval: undefined, priorBackup: undefined };
 }
 
 export const InstanceOptions = {
@@ -38,6 +52,9 @@ export const InstanceOptions = {
     if (message.syncInterval !== undefined) {
       Duration.encode(message.syncInterval, writer.uint32(18).fork()).ldelim();
     }
+    if (message.priorBackup !== undefined) {
+      InstanceOptions_PriorBackup.encode(message.priorBackup, writer.uint32(26).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -62,6 +79,13 @@ export const InstanceOptions = {
 
           message.syncInterval = Duration.decode(reader, reader.uint32());
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.priorBackup = InstanceOptions_PriorBackup.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -75,6 +99,7 @@ export const InstanceOptions = {
     return {
       schemaTenantMode: isSet(object.schemaTenantMode) ? Boolean(object.schemaTenantMode) : false,
       syncInterval: isSet(object.syncInterval) ? Duration.fromJSON(object.syncInterval) : undefined,
+      priorBackup: isSet(object.priorBackup) ? InstanceOptions_PriorBackup.fromJSON(object.priorBackup) : undefined,
     };
   },
 
@@ -83,6 +108,8 @@ export const InstanceOptions = {
     message.schemaTenantMode !== undefined && (obj.schemaTenantMode = message.schemaTenantMode);
     message.syncInterval !== undefined &&
       (obj.syncInterval = message.syncInterval ? Duration.toJSON(message.syncInterval) : undefined);
+    message.priorBackup !== undefined &&
+      (obj.priorBackup = message.priorBackup ? InstanceOptions_PriorBackup.toJSON(message.priorBackup) : undefined);
     return obj;
   },
 
@@ -96,6 +123,80 @@ export const InstanceOptions = {
     message.syncInterval = (object.syncInterval !== undefined && object.syncInterval !== null)
       ? Duration.fromPartial(object.syncInterval)
       : undefined;
+    message.priorBackup = (object.priorBackup !== undefined && object.priorBackup !== null)
+      ? InstanceOptions_PriorBackup.fromPartial(object.priorBackup)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseInstanceOptions_PriorBackup(): InstanceOptions_PriorBackup {
+  return { enabled: false, maxRows: 0 };
+}
+
+export const InstanceOptions_PriorBackup = {
+  encode(message: InstanceOptions_PriorBackup, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled === true) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.maxRows !== 0) {
+      writer.uint32(16).int32(message.maxRows);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstanceOptions_PriorBackup {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseInstanceOptions_PriorBackup();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.maxRows = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): InstanceOptions_PriorBackup {
+    return {
+      enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
+      maxRows: isSet(object.maxRows) ? Number(object.maxRows) : 0,
+    };
+  },
+
+  toJSON(message: InstanceOptions_PriorBackup): unknown {
+    const obj: any = {};
+    message.enabled !== undefined && (obj.enabled = message.enabled);
+    message.maxRows !== undefined && (obj.maxRows = Math.round(message.maxRows));
+    return obj;
+  },
+
+  create(base?: DeepPartial<InstanceOptions_PriorBackup>): InstanceOptions_PriorBackup {
+    return InstanceOptions_PriorBackup.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<InstanceOptions_PriorBackup>): InstanceOptions_PriorBackup {
+    const message = createBaseInstanceOptions_PriorBackup();
+    message.enabled = object.enabled ?? false;
+    message.maxRows = object.maxRows ?? 0;
     return message;
   },
 };
@@ -87,6 +87,8 @@ export interface PlanConfig_ChangeDatabaseConfig {
   /** If RollbackEnabled, build the RollbackSheetID of the task. */
   rollbackEnabled: boolean;
   rollbackDetail?: PlanConfig_ChangeDatabaseConfig_RollbackDetail | undefined;
+  /** The DML change is executed in batches if batch_config is set. */
+  batchConfig?: PlanConfig_ChangeDatabaseConfig_BatchConfig | undefined;
 }
 
 /** Type is the database change type. */
@@ -108,6 +110,8 @@ export enum PlanConfig_ChangeDatabaseConfig_Type {
   BRANCH = 5,
   /** DATA - Used for DML change. */
   DATA = 6,
+  /** MIGRATE_PG_ONLINE - Used for DDL changes on PostgreSQL tables via a shadow table synced by triggers. */
+  MIGRATE_PG_ONLINE = 7,
   UNRECOGNIZED = -1,
 }
 
@@ -134,6 +138,9 @@ export function planConfig_ChangeDatabaseConfig_TypeFromJSON(object: any): PlanC
     case 6:
     case "DATA":
       return PlanConfig_ChangeDatabaseConfig_Type.DATA;
+    case 7:
+    case "MIGRATE_PG_ONLINE":
+      return PlanConfig_ChangeDatabaseConfig_Type.MIGRATE_PG_ONLINE;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -157,6 +164,8 @@ export function planConfig_ChangeDatabaseConfig_TypeToJSON(object: PlanConfig_Ch
       return "BRANCH";
     case PlanConfig_ChangeDatabaseConfig_Type.DATA:
       return "DATA";
+    case PlanConfig_ChangeDatabaseConfig_Type.MIGRATE_PG_ONLINE:
+      return "MIGRATE_PG_ONLINE";
     case PlanConfig_ChangeDatabaseConfig_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -176,6 +185,22 @@ export interface PlanConfig_ChangeDatabaseConfig_RollbackDetail {
   rollbackFromIssue: string;
 }
 
+/**
+ * BatchConfig executes a single-table UPDATE or DELETE statement in batches of the primary key ranges.
+ * Each batch is committed separately, so that the execution can resume from the last committed batch.
+ */
+export interface PlanConfig_ChangeDatabaseConfig_BatchConfig {
+  /** The number of rows in the primary key range of a batch. */
+  batchSize: number;
+  /** The maximum number of rows changed per second. There is no limit if it's zero. */
+  maxRowsPerSecond: number;
+  /**
+   * The execution is paused while the replication lag exceeds max_replication_lag_seconds.
+   * There is no limit if it's zero.
+   */
+  maxReplicationLagSeconds: number;
+}
+
 export interface PlanConfig_RestoreDatabaseConfig {
   /**
    * The resource name of the target to restore.
@@ -729,7 +754,15 @@ export const PlanConfig_CreateDatabaseConfig_LabelsEntry = {
 };
 
 function createBasePlanConfig_ChangeDatabaseConfig(): PlanConfig_ChangeDatabaseConfig {
-  return { target: "", sheet: "", type: 0, schemaVersion: "", rollbackEnabled: false, rollbackDetail: undefined };
+  return {
+    target: "",
+    sheet: "",
+    type: 0,
+    schemaVersion: "",
+    rollbackEnabled: false,
+    rollbackDetail: undefined,
+    batchConfig: undefined,
+  };
 }
 
 export const PlanConfig_ChangeDatabaseConfig = {
@@ -752,6 +785,9 @@ export const PlanConfig_ChangeDatabaseConfig = {
     if (message.rollbackDetail !== undefined) {
       PlanConfig_ChangeDatabaseConfig_RollbackDetail.encode(message.rollbackDetail, writer.uint32(50).fork()).ldelim();
     }
+    if (message.batchConfig !== undefined) {
+      PlanConfig_ChangeDatabaseConfig_BatchConfig.encode(message.batchConfig, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -804,6 +840,13 @@ export const PlanConfig_ChangeDatabaseConfig = {
 
           message.rollbackDetail = PlanConfig_ChangeDatabaseConfig_RollbackDetail.decode(reader, reader.uint32());
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.batchConfig = PlanConfig_ChangeDatabaseConfig_BatchConfig.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -823,6 +866,9 @@ export const PlanConfig_ChangeDatabaseConfig = {
       rollbackDetail: isSet(object.rollbackDetail)
         ? PlanConfig_ChangeDatabaseConfig_RollbackDetail.fromJSON(object.rollbackDetail)
         : undefined,
+      batchConfig: isSet(object.batchConfig)
+        ? PlanConfig_ChangeDatabaseConfig_BatchConfig.fromJSON(object.batchConfig)
+        : undefined,
     };
   },
 
@@ -836,6 +882,9 @@ export const PlanConfig_ChangeDatabaseConfig = {
     message.rollbackDetail !== undefined && (obj.rollbackDetail = message.rollbackDetail
       ? PlanConfig_ChangeDatabaseConfig_RollbackDetail.toJSON(message.rollbackDetail)
       : undefined);
+    message.batchConfig !== undefined && (obj.batchConfig = message.batchConfig
+      ? PlanConfig_ChangeDatabaseConfig_BatchConfig.toJSON(message.batchConfig)
+      : undefined);
     return obj;
   },
 
@@ -853,6 +902,9 @@ export const PlanConfig_ChangeDatabaseConfig = {
     message.rollbackDetail = (object.rollbackDetail !== undefined && object.rollbackDetail !== null)
       ? PlanConfig_ChangeDatabaseConfig_RollbackDetail.fromPartial(object.rollbackDetail)
       : undefined;
+    message.batchConfig = (object.batchConfig !== undefined && object.batchConfig !== null)
+      ? PlanConfig_ChangeDatabaseConfig_BatchConfig.fromPartial(object.batchConfig)
+      : undefined;
     return message;
   },
 };
@@ -935,6 +987,91 @@ export const PlanConfig_ChangeDatabaseConfig_RollbackDetail = {
   },
 };
 
+function createBasePlanConfig_ChangeDatabaseConfig_BatchConfig(): PlanConfig_ChangeDatabaseConfig_BatchConfig {
+  return { batchSize: 0, maxRowsPerSecond: 0, maxReplicationLagSeconds: 0 };
+}
+
+export const PlanConfig_ChangeDatabaseConfig_BatchConfig = {
+  encode(message: PlanConfig_ChangeDatabaseConfig_BatchConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.batchSize !== 0) {
+      writer.uint32(8).int32(message.batchSize);
+    }
+    if (message.maxRowsPerSecond !== 0) {
+      writer.uint32(16).int32(message.maxRowsPerSecond);
+    }
+    if (message.maxReplicationLagSeconds !== 0) {
+      writer.uint32(24).int32(message.maxReplicationLagSeconds);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanConfig_ChangeDatabaseConfig_BatchConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanConfig_ChangeDatabaseConfig_BatchConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.batchSize = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.maxRowsPerSecond = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.maxReplicationLagSeconds = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanConfig_ChangeDatabaseConfig_BatchConfig {
+    return {
+      batchSize: isSet(object.batchSize) ? Number(object.batchSize) : 0,
+      maxRowsPerSecond: isSet(object.maxRowsPerSecond) ? Number(object.maxRowsPerSecond) : 0,
+      maxReplicationLagSeconds: isSet(object.maxReplicationLagSeconds) ? Number(object.maxReplicationLagSeconds) : 0,
+    };
+  },
+
+  toJSON(message: PlanConfig_ChangeDatabaseConfig_BatchConfig): unknown {
+    const obj: any = {};
+    message.batchSize !== undefined && (obj.batchSize = Math.round(message.batchSize));
+    message.maxRowsPerSecond !== undefined && (obj.maxRowsPerSecond = Math.round(message.maxRowsPerSecond));
+    message.maxReplicationLagSeconds !== undefined &&
+      (obj.maxReplicationLagSeconds = Math.round(message.maxReplicationLagSeconds));
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanConfig_ChangeDatabaseConfig_BatchConfig>): PlanConfig_ChangeDatabaseConfig_BatchConfig {
+    return PlanConfig_ChangeDatabaseConfig_BatchConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<PlanConfig_ChangeDatabaseConfig_BatchConfig>): PlanConfig_ChangeDatabaseConfig_BatchConfig {
+    const message = createBasePlanConfig_ChangeDatabaseConfig_BatchConfig();
+    message.batchSize = object.batchSize ?? 0;
+    message.maxRowsPerSecond = object.maxRowsPerSecond ?? 0;
+    message.maxReplicationLagSeconds = object.maxReplicationLagSeconds ?? 0;
+    return message;
+  },
+};
+
 function createBasePlanConfig_RestoreDatabaseConfig(): PlanConfig_RestoreDatabaseConfig {
   return { target: "", createDatabaseConfig: undefined, backup: undefined, pointInTime: undefined };
 }
@@ -116,6 +116,31 @@ export interface MaskingRulePolicy_MaskingRule {
   id: string;
   condition: Expr | undefined;
   maskingLevel: MaskingLevel;
+  /**
+   * masking_algorithm_id is the masking algorithm for the columns hitting the rule.
+   * If it is empty, the algorithm is decided by the semantic type of the column.
+   */
+  maskingAlgorithmId: string;
+}
+
+/** RowFilterPolicy is the policy for filtering the rows returned by the SQL Editor queries. */
+export interface RowFilterPolicy {
+  /** The rows of a table are filtered by all the rules applying to the table. */
+  rules: RowFilterPolicy_RowFilterRule[];
+}
+
+export interface RowFilterPolicy_RowFilterRule {
+  /** A unique identifier for a node in UUID format. */
+  id: string;
+  /** The condition selects the principals and the tables the rule applies to. */
+  condition:
+    | Expr
+    | undefined;
+  /**
+   * The SQL predicate template filtering the rows of the tables, e.g. "tenant_id = {{user.attribute.tenant}}".
+   * The placeholders {{user.attribute.<key>}} are replaced with the quoted attribute values of the principal set by the workspace owners.
+   */
+  predicate: string;
 }
 
 function createBaseIamPolicy(): IamPolicy {
@@ -656,7 +681,7 @@ export const MaskingRulePolicy = {
 };
 
 function createBaseMaskingRulePolicy_MaskingRule(): MaskingRulePolicy_MaskingRule {
-  return { id: "", condition: undefined, maskingLevel: 0 };
+  return { id: "", condition: undefined, maskingLevel: 0, maskingAlgorithmId: "" };
 }
 
 export const MaskingRulePolicy_MaskingRule = {
@@ -670,6 +695,9 @@ export const MaskingRulePolicy_MaskingRule = {
     if (message.maskingLevel !== 0) {
       writer.uint32(24).int32(message.maskingLevel);
     }
+    if (message.maskingAlgorithmId !== "") {
+      writer.uint32(34).string(message.maskingAlgorithmId);
+    }
     return writer;
   },
 
@@ -701,6 +729,13 @@ export const MaskingRulePolicy_MaskingRule = {
 
           message.maskingLevel = reader.int32() as any;
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.maskingAlgorithmId = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -715,6 +750,7 @@ export const MaskingRulePolicy_MaskingRule = {
       id: isSet(object.id) ? String(object.id) : "",
       condition: isSet(object.condition) ? Expr.fromJSON(object.condition) : undefined,
       maskingLevel: isSet(object.maskingLevel) ? maskingLevelFromJSON(object.maskingLevel) : 0,
+      maskingAlgorithmId: isSet(object.maskingAlgorithmId) ? String(object.maskingAlgorithmId) : "",
     };
   },
 
@@ -723,6 +759,7 @@ export const MaskingRulePolicy_MaskingRule = {
     message.id !== undefined && (obj.id = message.id);
     message.condition !== undefined && (obj.condition = message.condition ? Expr.toJSON(message.condition) : undefined);
     message.maskingLevel !== undefined && (obj.maskingLevel = maskingLevelToJSON(message.maskingLevel));
+    message.maskingAlgorithmId !== undefined && (obj.maskingAlgorithmId = message.maskingAlgorithmId);
     return obj;
   },
 
@@ -737,6 +774,155 @@ export const MaskingRulePolicy_MaskingRule = {
       ? Expr.fromPartial(object.condition)
       : undefined;
     message.maskingLevel = object.maskingLevel ?? 0;
+    message.maskingAlgorithmId = object.maskingAlgorithmId ?? "";
+    return message;
+  },
+};
+
+function createBaseRowFilterPolicy(): RowFilterPolicy {
+  return { rules: [] };
+}
+
+export const RowFilterPolicy = {
+  encode(message: RowFilterPolicy, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.rules) {
+      RowFilterPolicy_RowFilterRule.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RowFilterPolicy {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRowFilterPolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.rules.push(RowFilterPolicy_RowFilterRule.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RowFilterPolicy {
+    return {
+      rules: Array.isArray(object?.rules) ? object.rules.map((e: any) => RowFilterPolicy_RowFilterRule.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: RowFilterPolicy): unknown {
+    const obj: any = {};
+    if (message.rules) {
+      obj.rules = message.rules.map((e) => e ? RowFilterPolicy_RowFilterRule.toJSON(e) : undefined);
+    } else {
+      obj.rules = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RowFilterPolicy>): RowFilterPolicy {
+    return RowFilterPolicy.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<RowFilterPolicy>): RowFilterPolicy {
+    const message = createBaseRowFilterPolicy();
+    message.rules = object.rules?.map((e) => RowFilterPolicy_RowFilterRule.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRowFilterPolicy_RowFilterRule(): RowFilterPolicy_RowFilterRule {
+  return { id: "", condition: undefined, predicate: "" };
+}
+
+export const RowFilterPolicy_RowFilterRule = {
+  encode(message: RowFilterPolicy_RowFilterRule, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.condition !== undefined) {
+      Expr.encode(message.condition, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.predicate !== "") {
+      writer.uint32(26).string(message.predicate);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RowFilterPolicy_RowFilterRule {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRowFilterPolicy_RowFilterRule();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.condition = Expr.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.predicate = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RowFilterPolicy_RowFilterRule {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      condition: isSet(object.condition) ? Expr.fromJSON(object.condition) : undefined,
+      predicate: isSet(object.predicate) ? String(object.predicate) : "",
+    };
+  },
+
+  toJSON(message: RowFilterPolicy_RowFilterRule): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.condition !== undefined && (obj.condition = message.condition ? Expr.toJSON(message.condition) : undefined);
+    message.predicate !== undefined && (obj.predicate = message.predicate);
+    return obj;
+  },
+
+  create(base?: DeepPartial<RowFilterPolicy_RowFilterRule>): RowFilterPolicy_RowFilterRule {
+    return RowFilterPolicy_RowFilterRule.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<RowFilterPolicy_RowFilterRule>): RowFilterPolicy_RowFilterRule {
+    const message = createBaseRowFilterPolicy_RowFilterRule();
+    message.id = object.id ?? "";
+    message.condition = (object.condition !== undefined && object.condition !== null)
+      ? Expr.fromPartial(object.condition)
+      : undefined;
+    message.predicate = object.predicate ?? "";
     return message;
   },
 };
@@ -296,6 +296,8 @@ export interface DataClassificationSetting_DataClassificationConfig_DataClassifi
   title: string;
   description: string;
   levelId?: string | undefined;
+  /** semantic_type_id is the semantic type of the columns in the classification, which decides the masking algorithms. */
+  semanticTypeId: string;
 }
 
 export interface DataClassificationSetting_DataClassificationConfig_ClassificationEntry {
@@ -320,6 +322,238 @@ export interface SemanticTypesSetting_SemanticType {
   fullMaskAlgorithmId: string;
 }
 
+export interface MaskingAlgorithmSetting {
+  algorithms: MaskingAlgorithmSetting_MaskingAlgorithm[];
+}
+
+export interface MaskingAlgorithmSetting_MaskingAlgorithm {
+  /** id is the uuid for masking algorithm. */
+  id: string;
+  /** the title of the masking algorithm, it should not be empty. */
+  title: string;
+  /** the description of the masking algorithm, it can be empty. */
+  description: string;
+  fullMask?: MaskingAlgorithmSetting_MaskingAlgorithm_FullMask | undefined;
+  hashMask?: MaskingAlgorithmSetting_MaskingAlgorithm_HashMask | undefined;
+  innerOuterMask?: MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask | undefined;
+  emailMask?: MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask | undefined;
+  rangeMask?: MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask | undefined;
+  dateTruncateMask?: MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask | undefined;
+  regexMask?: MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask | undefined;
+}
+
+/** FullMask replaces the whole value with the substitution. */
+export interface MaskingAlgorithmSetting_MaskingAlgorithm_FullMask {
+  /** substitution is the string replacing the value, "******" if empty. */
+  substitution: string;
+}
+
+/**
+ * HashMask replaces the value with the hex encoded SHA-256 of the salt and the value.
+ * The same values are masked to the same hash, so the masked columns can still be joined on.
+ */
+export interface MaskingAlgorithmSetting_MaskingAlgorithm_HashMask {
+  salt: string;
+}
+
+/**
+ * InnerOuterMask keeps the first prefix_length and the last suffix_length characters, and replaces the other characters.
+ * The value no longer than prefix_length + suffix_length is replaced entirely.
+ */
+export interface MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask {
+  prefixLength: number;
+  suffixLength: number;
+  /** substitution is the string replacing each masked character, "*" if empty. */
+  substitution: string;
+}
+
+/**
+ * EmailMask replaces the local part of the email address and keeps the domain.
+ * The value which is not an email address is replaced entirely.
+ */
+export interface MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask {
+  /** substitution is the string replacing the local part, "******" if empty. */
+  substitution: string;
+}
+
+/**
+ * RangeMask replaces the numeric value with the bucket containing it, e.g. "[30, 40)" for 37 with bucket size 10.
+ * The value which is not a number is replaced with "******".
+ */
+export interface MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask {
+  bucketSize: number;
+}
+
+/**
+ * DateTruncateMask truncates the date or time value to the unit.
+ * The value which is not a date or time is replaced with "******".
+ */
+export interface MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask {
+  unit: MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit;
+}
+
+export enum MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit {
+  UNIT_UNSPECIFIED = 0,
+  YEAR = 1,
+  MONTH = 2,
+  DAY = 3,
+  HOUR = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function maskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_UnitFromJSON(
+  object: any,
+): MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit {
+  switch (object) {
+    case 0:
+    case "UNIT_UNSPECIFIED":
+      return MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.UNIT_UNSPECIFIED;
+    case 1:
+    case "YEAR":
+      return MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.YEAR;
+    case 2:
+    case "MONTH":
+      return MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.MONTH;
+    case 3:
+    case "DAY":
+      return MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.DAY;
+    case 4:
+    case "HOUR":
+      return MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.HOUR;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.UNRECOGNIZED;
+  }
+}
+
+export function maskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_UnitToJSON(
+  object: MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit,
+): string {
+  switch (object) {
+    case MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.UNIT_UNSPECIFIED:
+      return "UNIT_UNSPECIFIED";
+    case MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.YEAR:
+      return "YEAR";
+    case MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.MONTH:
+      return "MONTH";
+    case MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.DAY:
+      return "DAY";
+    case MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.HOUR:
+      return "HOUR";
+    case MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_Unit.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+/** RegexMask replaces the matches of the pattern with the replacement, which can refer to the submatches like $1. */
+export interface MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask {
+  /** pattern is the RE2 regular expression. */
+  pattern: string;
+  replacement: string;
+}
+
+export interface SensitiveDataDiscoverySetting {
+  /**
+   * enabled turns on the discovery job, which samples the column values through the read-only data source
+   * and proposes the data classification of the sensitive columns for review.
+   */
+  enabled: boolean;
+  /** sample_size is the number of rows sampled from each table, 100 if zero. */
+  sampleSize: number;
+  /** min_confidence is the minimal confidence in [0, 1] for a suggestion to be proposed, 0.5 if zero. */
+  minConfidence: number;
+  /** detectors are the detectors used in the discovery, the built-in detectors need to be added to be used. */
+  detectors: SensitiveDataDiscoverySetting_Detector[];
+}
+
+export interface SensitiveDataDiscoverySetting_Detector {
+  /** id is the uuid for detector. */
+  id: string;
+  title: string;
+  type: SensitiveDataDiscoverySetting_Detector_Type;
+  /** value_pattern is the RE2 regular expression matching the whole value, for the CUSTOM detector only. */
+  valuePattern: string;
+  /**
+   * column_name_pattern is the RE2 regular expression matching the column name.
+   * It overrides the built-in column name heuristics if set.
+   */
+  columnNamePattern: string;
+  /** classification_id is the data classification proposed for the detected columns. */
+  classificationId: string;
+}
+
+export enum SensitiveDataDiscoverySetting_Detector_Type {
+  TYPE_UNSPECIFIED = 0,
+  /** CUSTOM - CUSTOM matches the values with value_pattern and the column name with column_name_pattern. */
+  CUSTOM = 1,
+  EMAIL = 2,
+  PHONE = 3,
+  /** NATIONAL_ID - NATIONAL_ID matches the US social security numbers and the China resident identity card numbers. */
+  NATIONAL_ID = 4,
+  /** CREDIT_CARD - CREDIT_CARD matches the card numbers passing the Luhn check. */
+  CREDIT_CARD = 5,
+  IP_ADDRESS = 6,
+  UNRECOGNIZED = -1,
+}
+
+export function sensitiveDataDiscoverySetting_Detector_TypeFromJSON(
+  object: any,
+): SensitiveDataDiscoverySetting_Detector_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return SensitiveDataDiscoverySetting_Detector_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "CUSTOM":
+      return SensitiveDataDiscoverySetting_Detector_Type.CUSTOM;
+    case 2:
+    case "EMAIL":
+      return SensitiveDataDiscoverySetting_Detector_Type.EMAIL;
+    case 3:
+    case "PHONE":
+      return SensitiveDataDiscoverySetting_Detector_Type.PHONE;
+    case 4:
+    case "NATIONAL_ID":
+      return SensitiveDataDiscoverySetting_Detector_Type.NATIONAL_ID;
+    case 5:
+    case "CREDIT_CARD":
+      return SensitiveDataDiscoverySetting_Detector_Type.CREDIT_CARD;
+    case 6:
+    case "IP_ADDRESS":
+      return SensitiveDataDiscoverySetting_Detector_Type.IP_ADDRESS;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return SensitiveDataDiscoverySetting_Detector_Type.UNRECOGNIZED;
+  }
+}
+
+export function sensitiveDataDiscoverySetting_Detector_TypeToJSON(
+  object: SensitiveDataDiscoverySetting_Detector_Type,
+): string {
+  switch (object) {
+    case SensitiveDataDiscoverySetting_Detector_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case SensitiveDataDiscoverySetting_Detector_Type.CUSTOM:
+      return "CUSTOM";
+    case SensitiveDataDiscoverySetting_Detector_Type.EMAIL:
+      return "EMAIL";
+    case SensitiveDataDiscoverySetting_Detector_Type.PHONE:
+      return "PHONE";
+    case SensitiveDataDiscoverySetting_Detector_Type.NATIONAL_ID:
+      return "NATIONAL_ID";
+    case SensitiveDataDiscoverySetting_Detector_Type.CREDIT_CARD:
+      return "CREDIT_CARD";
+    case SensitiveDataDiscoverySetting_Detector_Type.IP_ADDRESS:
+      return "IP_ADDRESS";
+    case SensitiveDataDiscoverySetting_Detector_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 function createBaseWorkspaceProfileSetting(): WorkspaceProfileSetting {
   return {
     externalUrl: "",
@@ -1811,7 +2045,7 @@ export const DataClassificationSetting_DataClassificationConfig_Level = {
 };
 
 function createBaseDataClassificationSetting_DataClassificationConfig_DataClassification(): DataClassificationSetting_DataClassificationConfig_DataClassification {
-  return { id: "", title: "", description: "", levelId: undefined };
+  return { id: "", title: "", description: "", levelId: undefined, semanticTypeId: "" };
 }
 
 export const DataClassificationSetting_DataClassificationConfig_DataClassification = {
@@ -1831,6 +2065,9 @@ export const DataClassificationSetting_DataClassificationConfig_DataClassificati
     if (message.levelId !== undefined) {
       writer.uint32(34).string(message.levelId);
     }
+    if (message.semanticTypeId !== "") {
+      writer.uint32(42).string(message.semanticTypeId);
+    }
     return writer;
   },
 
@@ -1872,6 +2109,13 @@ export const DataClassificationSetting_DataClassificationConfig_DataClassificati
 
           message.levelId = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.semanticTypeId = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1887,6 +2131,7 @@ export const DataClassificationSetting_DataClassificationConfig_DataClassificati
       title: isSet(object.title) ? String(object.title) : "",
       description: isSet(object.description) ? String(object.description) : "",
       levelId: isSet(object.levelId) ? String(object.levelId) : undefined,
+      semanticTypeId: isSet(object.semanticTypeId) ? String(object.semanticTypeId) : "",
     };
   },
 
@@ -1896,6 +2141,7 @@ export const DataClassificationSetting_DataClassificationConfig_DataClassificati
     message.title !== undefined && (obj.title = message.title);
     message.description !== undefined && (obj.description = message.description);
     message.levelId !== undefined && (obj.levelId = message.levelId);
+    message.semanticTypeId !== undefined && (obj.semanticTypeId = message.semanticTypeId);
     return obj;
   },
 
@@ -1913,6 +2159,7 @@ export const DataClassificationSetting_DataClassificationConfig_DataClassificati
     message.title = object.title ?? "";
     message.description = object.description ?? "";
     message.levelId = object.levelId ?? undefined;
+    message.semanticTypeId = object.semanticTypeId ?? "";
     return message;
   },
 };
@@ -2183,6 +2430,1024 @@ export const SemanticTypesSetting_SemanticType = {
     return message;
   },
 };
+function createBaseMaskingAlgorithmSetting(): MaskingAlgorithmSetting {
+  return { algorithms: [] };
+}
+
+export const MaskingAlgorithmSetting = {
+  encode(message: MaskingAlgorithmSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.algorithms) {
+      MaskingAlgorithmSetting_MaskingAlgorithm.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.algorithms.push(MaskingAlgorithmSetting_MaskingAlgorithm.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting {
+    return {
+      algorithms: Array.isArray(object?.algorithms)
+        ? object.algorithms.map((e: any) => MaskingAlgorithmSetting_MaskingAlgorithm.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting): unknown {
+    const obj: any = {};
+    if (message.algorithms) {
+      obj.algorithms = message.algorithms.map((e) =>
+        e ? MaskingAlgorithmSetting_MaskingAlgorithm.toJSON(e) : undefined
+      );
+    } else {
+      obj.algorithms = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<MaskingAlgorithmSetting>): MaskingAlgorithmSetting {
+    return MaskingAlgorithmSetting.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<MaskingAlgorithmSetting>): MaskingAlgorithmSetting {
+    const message = createBaseMaskingAlgorithmSetting();
+    message.algorithms = object.algorithms?.map((e) => MaskingAlgorithmSetting_MaskingAlgorithm.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithmSetting_MaskingAlgorithm(): MaskingAlgorithmSetting_MaskingAlgorithm {
+  return {
+    id: "",
+    title: "",
+    description: "",
+    fullMask: undefined,
+    hashMask: undefined,
+    innerOuterMask: undefined,
+    emailMask: undefined,
+    rangeMask: undefined,
+    dateTruncateMask: undefined,
+    regexMask: undefined,
+  };
+}
+
+export const MaskingAlgorithmSetting_MaskingAlgorithm = {
+  encode(message: MaskingAlgorithmSetting_MaskingAlgorithm, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.description !== "") {
+      writer.uint32(26).string(message.description);
+    }
+    if (message.fullMask !== undefined) {
+      MaskingAlgorithmSetting_MaskingAlgorithm_FullMask.encode(message.fullMask, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.hashMask !== undefined) {
+      MaskingAlgorithmSetting_MaskingAlgorithm_HashMask.encode(message.hashMask, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.innerOuterMask !== undefined) {
+      MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask.encode(message.innerOuterMask, writer.uint32(50).fork())
+        .ldelim();
+    }
+    if (message.emailMask !== undefined) {
+      MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask.encode(message.emailMask, writer.uint32(58).fork()).ldelim();
+    }
+    if (message.rangeMask !== undefined) {
+      MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask.encode(message.rangeMask, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.dateTruncateMask !== undefined) {
+      MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask.encode(
+        message.dateTruncateMask,
+        writer.uint32(74).fork(),
+      ).ldelim();
+    }
+    if (message.regexMask !== undefined) {
+      MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask.encode(message.regexMask, writer.uint32(82).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting_MaskingAlgorithm {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.fullMask = MaskingAlgorithmSetting_MaskingAlgorithm_FullMask.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.hashMask = MaskingAlgorithmSetting_MaskingAlgorithm_HashMask.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.innerOuterMask = MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.emailMask = MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask.decode(reader, reader.uint32());
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.rangeMask = MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.dateTruncateMask = MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.regexMask = MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting_MaskingAlgorithm {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      title: isSet(object.title) ? String(object.title) : "",
+      description: isSet(object.description) ? String(object.description) : "",
+      fullMask: isSet(object.fullMask)
+        ? MaskingAlgorithmSetting_MaskingAlgorithm_FullMask.fromJSON(object.fullMask)
+        : undefined,
+      hashMask: isSet(object.hashMask)
+        ? MaskingAlgorithmSetting_MaskingAlgorithm_HashMask.fromJSON(object.hashMask)
+        : undefined,
+      innerOuterMask: isSet(object.innerOuterMask)
+        ? MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask.fromJSON(object.innerOuterMask)
+        : undefined,
+      emailMask: isSet(object.emailMask)
+        ? MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask.fromJSON(object.emailMask)
+        : undefined,
+      rangeMask: isSet(object.rangeMask)
+        ? MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask.fromJSON(object.rangeMask)
+        : undefined,
+      dateTruncateMask: isSet(object.dateTruncateMask)
+        ? MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask.fromJSON(object.dateTruncateMask)
+        : undefined,
+      regexMask: isSet(object.regexMask)
+        ? MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask.fromJSON(object.regexMask)
+        : undefined,
+    };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting_MaskingAlgorithm): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.title !== undefined && (obj.title = message.title);
+    message.description !== undefined && (obj.description = message.description);
+    message.fullMask !== undefined && (obj.fullMask = message.fullMask
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_FullMask.toJSON(message.fullMask)
+      : undefined);
+    message.hashMask !== undefined && (obj.hashMask = message.hashMask
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_HashMask.toJSON(message.hashMask)
+      : undefined);
+    message.innerOuterMask !== undefined && (obj.innerOuterMask = message.innerOuterMask
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask.toJSON(message.innerOuterMask)
+      : undefined);
+    message.emailMask !== undefined && (obj.emailMask = message.emailMask
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask.toJSON(message.emailMask)
+      : undefined);
+    message.rangeMask !== undefined && (obj.rangeMask = message.rangeMask
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask.toJSON(message.rangeMask)
+      : undefined);
+    message.dateTruncateMask !== undefined && (obj.dateTruncateMask = message.dateTruncateMask
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask.toJSON(message.dateTruncateMask)
+      : undefined);
+    message.regexMask !== undefined && (obj.regexMask = message.regexMask
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask.toJSON(message.regexMask)
+      : undefined);
+    return obj;
+  },
+
+  create(base?: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm>): MaskingAlgorithmSetting_MaskingAlgorithm {
+    return MaskingAlgorithmSetting_MaskingAlgorithm.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm>): MaskingAlgorithmSetting_MaskingAlgorithm {
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm();
+    message.id = object.id ?? "";
+    message.title = object.title ?? "";
+    message.description = object.description ?? "";
+    message.fullMask = (object.fullMask !== undefined && object.fullMask !== null)
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_FullMask.fromPartial(object.fullMask)
+      : undefined;
+    message.hashMask = (object.hashMask !== undefined && object.hashMask !== null)
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_HashMask.fromPartial(object.hashMask)
+      : undefined;
+    message.innerOuterMask = (object.innerOuterMask !== undefined && object.innerOuterMask !== null)
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask.fromPartial(object.innerOuterMask)
+      : undefined;
+    message.emailMask = (object.emailMask !== undefined && object.emailMask !== null)
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask.fromPartial(object.emailMask)
+      : undefined;
+    message.rangeMask = (object.rangeMask !== undefined && object.rangeMask !== null)
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask.fromPartial(object.rangeMask)
+      : undefined;
+    message.dateTruncateMask = (object.dateTruncateMask !== undefined && object.dateTruncateMask !== null)
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask.fromPartial(object.dateTruncateMask)
+      : undefined;
+    message.regexMask = (object.regexMask !== undefined && object.regexMask !== null)
+      ? MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask.fromPartial(object.regexMask)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithmSetting_MaskingAlgorithm_FullMask(): MaskingAlgorithmSetting_MaskingAlgorithm_FullMask {
+  return { substitution: "" };
+}
+
+export const MaskingAlgorithmSetting_MaskingAlgorithm_FullMask = {
+  encode(
+    message: MaskingAlgorithmSetting_MaskingAlgorithm_FullMask,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.substitution !== "") {
+      writer.uint32(10).string(message.substitution);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting_MaskingAlgorithm_FullMask {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_FullMask();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.substitution = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting_MaskingAlgorithm_FullMask {
+    return { substitution: isSet(object.substitution) ? String(object.substitution) : "" };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting_MaskingAlgorithm_FullMask): unknown {
+    const obj: any = {};
+    message.substitution !== undefined && (obj.substitution = message.substitution);
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_FullMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_FullMask {
+    return MaskingAlgorithmSetting_MaskingAlgorithm_FullMask.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_FullMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_FullMask {
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_FullMask();
+    message.substitution = object.substitution ?? "";
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithmSetting_MaskingAlgorithm_HashMask(): MaskingAlgorithmSetting_MaskingAlgorithm_HashMask {
+  return { salt: "" };
+}
+
+export const MaskingAlgorithmSetting_MaskingAlgorithm_HashMask = {
+  encode(
+    message: MaskingAlgorithmSetting_MaskingAlgorithm_HashMask,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.salt !== "") {
+      writer.uint32(10).string(message.salt);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting_MaskingAlgorithm_HashMask {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_HashMask();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.salt = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting_MaskingAlgorithm_HashMask {
+    return { salt: isSet(object.salt) ? String(object.salt) : "" };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting_MaskingAlgorithm_HashMask): unknown {
+    const obj: any = {};
+    message.salt !== undefined && (obj.salt = message.salt);
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_HashMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_HashMask {
+    return MaskingAlgorithmSetting_MaskingAlgorithm_HashMask.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_HashMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_HashMask {
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_HashMask();
+    message.salt = object.salt ?? "";
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask(): MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask {
+  return { prefixLength: 0, suffixLength: 0, substitution: "" };
+}
+
+export const MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask = {
+  encode(
+    message: MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.prefixLength !== 0) {
+      writer.uint32(8).int32(message.prefixLength);
+    }
+    if (message.suffixLength !== 0) {
+      writer.uint32(16).int32(message.suffixLength);
+    }
+    if (message.substitution !== "") {
+      writer.uint32(26).string(message.substitution);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.prefixLength = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.suffixLength = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.substitution = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask {
+    return {
+      prefixLength: isSet(object.prefixLength) ? Number(object.prefixLength) : 0,
+      suffixLength: isSet(object.suffixLength) ? Number(object.suffixLength) : 0,
+      substitution: isSet(object.substitution) ? String(object.substitution) : "",
+    };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask): unknown {
+    const obj: any = {};
+    message.prefixLength !== undefined && (obj.prefixLength = Math.round(message.prefixLength));
+    message.suffixLength !== undefined && (obj.suffixLength = Math.round(message.suffixLength));
+    message.substitution !== undefined && (obj.substitution = message.substitution);
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask {
+    return MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask {
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_InnerOuterMask();
+    message.prefixLength = object.prefixLength ?? 0;
+    message.suffixLength = object.suffixLength ?? 0;
+    message.substitution = object.substitution ?? "";
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithmSetting_MaskingAlgorithm_EmailMask(): MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask {
+  return { substitution: "" };
+}
+
+export const MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask = {
+  encode(
+    message: MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.substitution !== "") {
+      writer.uint32(10).string(message.substitution);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_EmailMask();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.substitution = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask {
+    return { substitution: isSet(object.substitution) ? String(object.substitution) : "" };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask): unknown {
+    const obj: any = {};
+    message.substitution !== undefined && (obj.substitution = message.substitution);
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask {
+    return MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_EmailMask {
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_EmailMask();
+    message.substitution = object.substitution ?? "";
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithmSetting_MaskingAlgorithm_RangeMask(): MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask {
+  return { bucketSize: 0 };
+}
+
+export const MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask = {
+  encode(
+    message: MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.bucketSize !== 0) {
+      writer.uint32(9).double(message.bucketSize);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_RangeMask();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 9) {
+            break;
+          }
+
+          message.bucketSize = reader.double();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask {
+    return { bucketSize: isSet(object.bucketSize) ? Number(object.bucketSize) : 0 };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask): unknown {
+    const obj: any = {};
+    message.bucketSize !== undefined && (obj.bucketSize = message.bucketSize);
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask {
+    return MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_RangeMask {
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_RangeMask();
+    message.bucketSize = object.bucketSize ?? 0;
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask(): MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask {
+  return { unit: 0 };
+}
+
+export const MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask = {
+  encode(
+    message: MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.unit !== 0) {
+      writer.uint32(8).int32(message.unit);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.unit = reader.int32() as any;
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask {
+    return {
+      unit: isSet(object.unit)
+        ? maskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_UnitFromJSON(object.unit)
+        : 0,
+    };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask): unknown {
+    const obj: any = {};
+    message.unit !== undefined &&
+      (obj.unit = maskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask_UnitToJSON(message.unit));
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask {
+    return MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask {
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_DateTruncateMask();
+    message.unit = object.unit ?? 0;
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithmSetting_MaskingAlgorithm_RegexMask(): MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask {
+  return { pattern: "", replacement: "" };
+}
+
+export const MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask = {
+  encode(
+    message: MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.pattern !== "") {
+      writer.uint32(10).string(message.pattern);
+    }
+    if (message.replacement !== "") {
+      writer.uint32(18).string(message.replacement);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_RegexMask();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.pattern = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.replacement = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask {
+    return {
+      pattern: isSet(object.pattern) ? String(object.pattern) : "",
+      replacement: isSet(object.replacement) ? String(object.replacement) : "",
+    };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask): unknown {
+    const obj: any = {};
+    message.pattern !== undefined && (obj.pattern = message.pattern);
+    message.replacement !== undefined && (obj.replacement = message.replacement);
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask {
+    return MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask>,
+  ): MaskingAlgorithmSetting_MaskingAlgorithm_RegexMask {
+    const message = createBaseMaskingAlgorithmSetting_MaskingAlgorithm_RegexMask();
+    message.pattern = object.pattern ?? "";
+    message.replacement = object.replacement ?? "";
+    return message;
+  },
+};
+
+function createBaseSensitiveDataDiscoverySetting(): SensitiveDataDiscoverySetting {
+  return { enabled: false, sampleSize: 0, minConfidence: 0, detectors: [] };
+}
+
+export const SensitiveDataDiscoverySetting = {
+  encode(message: SensitiveDataDiscoverySetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled === true) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.sampleSize !== 0) {
+      writer.uint32(16).int32(message.sampleSize);
+    }
+    if (message.minConfidence !== 0) {
+      writer.uint32(25).double(message.minConfidence);
+    }
+    for (const v of message.detectors) {
+      SensitiveDataDiscoverySetting_Detector.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SensitiveDataDiscoverySetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSensitiveDataDiscoverySetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.sampleSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 25) {
+            break;
+          }
+
+          message.minConfidence = reader.double();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.detectors.push(SensitiveDataDiscoverySetting_Detector.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SensitiveDataDiscoverySetting {
+    return {
+      enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
+      sampleSize: isSet(object.sampleSize) ? Number(object.sampleSize) : 0,
+      minConfidence: isSet(object.minConfidence) ? Number(object.minConfidence) : 0,
+      detectors: Array.isArray(object?.detectors)
+        ? object.detectors.map((e: any) => SensitiveDataDiscoverySetting_Detector.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: SensitiveDataDiscoverySetting): unknown {
+    const obj: any = {};
+    message.enabled !== undefined && (obj.enabled = message.enabled);
+    message.sampleSize !== undefined && (obj.sampleSize = Math.round(message.sampleSize));
+    message.minConfidence !== undefined && (obj.minConfidence = message.minConfidence);
+    if (message.detectors) {
+      obj.detectors = message.detectors.map((e) => e ? SensitiveDataDiscoverySetting_Detector.toJSON(e) : undefined);
+    } else {
+      obj.detectors = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SensitiveDataDiscoverySetting>): SensitiveDataDiscoverySetting {
+    return SensitiveDataDiscoverySetting.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SensitiveDataDiscoverySetting>): SensitiveDataDiscoverySetting {
+    const message = createBaseSensitiveDataDiscoverySetting();
+    message.enabled = object.enabled ?? false;
+    message.sampleSize = object.sampleSize ?? 0;
+    message.minConfidence = object.minConfidence ?? 0;
+    message.detectors = object.detectors?.map((e) => SensitiveDataDiscoverySetting_Detector.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseSensitiveDataDiscoverySetting_Detector(): SensitiveDataDiscoverySetting_Detector {
+  return { id: "", title: "", type: 0, valuePattern: "", columnNamePattern: "", classificationId: "" };
+}
+
+export const SensitiveDataDiscoverySetting_Detector = {
+  encode(message: SensitiveDataDiscoverySetting_Detector, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.type !== 0) {
+      writer.uint32(24).int32(message.type);
+    }
+    if (message.valuePattern !== "") {
+      writer.uint32(34).string(message.valuePattern);
+    }
+    if (message.columnNamePattern !== "") {
+      writer.uint32(42).string(message.columnNamePattern);
+    }
+    if (message.classificationId !== "") {
+      writer.uint32(50).string(message.classificationId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SensitiveDataDiscoverySetting_Detector {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSensitiveDataDiscoverySetting_Detector();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.valuePattern = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.columnNamePattern = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.classificationId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SensitiveDataDiscoverySetting_Detector {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      title: isSet(object.title) ? String(object.title) : "",
+      type: isSet(object.type) ? sensitiveDataDiscoverySetting_Detector_TypeFromJSON(object.type) : 0,
+      valuePattern: isSet(object.valuePattern) ? String(object.valuePattern) : "",
+      columnNamePattern: isSet(object.columnNamePattern) ? String(object.columnNamePattern) : "",
+      classificationId: isSet(object.classificationId) ? String(object.classificationId) : "",
+    };
+  },
+
+  toJSON(message: SensitiveDataDiscoverySetting_Detector): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.title !== undefined && (obj.title = message.title);
+    message.type !== undefined && (obj.type = sensitiveDataDiscoverySetting_Detector_TypeToJSON(message.type));
+    message.valuePattern !== undefined && (obj.valuePattern = message.valuePattern);
+    message.columnNamePattern !== undefined && (obj.columnNamePattern = message.columnNamePattern);
+    message.classificationId !== undefined && (obj.classificationId = message.classificationId);
+    return obj;
+  },
+
+  create(base?: DeepPartial<SensitiveDataDiscoverySetting_Detector>): SensitiveDataDiscoverySetting_Detector {
+    return SensitiveDataDiscoverySetting_Detector.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SensitiveDataDiscoverySetting_Detector>): SensitiveDataDiscoverySetting_Detector {
+    const message = createBaseSensitiveDataDiscoverySetting_Detector();
+    message.id = object.id ?? "";
+    message.title = object.title ?? "";
+    message.type = object.type ?? 0;
+    message.valuePattern = object.valuePattern ?? "";
+    message.columnNamePattern = object.columnNamePattern ?? "";
+    message.classificationId = object.classificationId ?? "";
+    return message;
+  },
+};
 
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
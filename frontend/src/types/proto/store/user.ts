@@ -15,6 +15,30 @@ export interface MFAConfig {
   tempRecoveryCodes: string[];
 }
 
+/** UserProfile is the profile of a user. */
+export interface UserProfile {
+  /** The attributes of the user, e.g. the tenant or the region, which are referenced by the row filter policies. */
+  attributes: { [key: string]: string };
+  /**
+   * The identities of the user in the identity providers, linked when the user signs in with them.
+   * The user group sync matches the directory users by them rather than the emails, which the users can change themselves.
+   */
+  identities: IdentityProviderIdentity[];
+}
+
+export interface UserProfile_AttributesEntry {
+  key: string;
+  value: string;
+}
+
+/** IdentityProviderIdentity is the identity of a user in an identity provider. */
+export interface IdentityProviderIdentity {
+  /** The UID of the identity provider. */
+  idpUid: number;
+  /** The identifier of the user in the identity provider, e.g. the LDAP uid. */
+  identifier: string;
+}
+
 function createBaseMFAConfig(): MFAConfig {
   return { otpSecret: "", tempOtpSecret: "", recoveryCodes: [], tempRecoveryCodes: [] };
 }
@@ -122,6 +146,243 @@ export const MFAConfig = {
   },
 };
 
+function createBaseUserProfile(): UserProfile {
+  return { attributes: {}, identities: [] };
+}
+
+export const UserProfile = {
+  encode(message: UserProfile, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    Object.entries(message.attributes).forEach(([key, value]) => {
+      UserProfile_AttributesEntry.encode({ key: key as any, value }, writer.uint32(10).fork()).ldelim();
+    });
+    for (const v of message.identities) {
+      IdentityProviderIdentity.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UserProfile {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUserProfile();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          const entry1 = UserProfile_AttributesEntry.decode(reader, reader.uint32());
+          if (entry1.value !== undefined) {
+            message.attributes[entry1.key] = entry1.value;
+          }
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.identities.push(IdentityProviderIdentity.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UserProfile {
+    return {
+      attributes: isObject(object.attributes)
+        ? Object.entries(object.attributes).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
+      identities: Array.isArray(object?.identities)
+        ? object.identities.map((e: any) => IdentityProviderIdentity.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: UserProfile): unknown {
+    const obj: any = {};
+    obj.attributes = {};
+    if (message.attributes) {
+      Object.entries(message.attributes).forEach(([k, v]) => {
+        obj.attributes[k] = v;
+      });
+    }
+    if (message.identities) {
+      obj.identities = message.identities.map((e) => e ? IdentityProviderIdentity.toJSON(e) : undefined);
+    } else {
+      obj.identities = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UserProfile>): UserProfile {
+    return UserProfile.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<UserProfile>): UserProfile {
+    const message = createBaseUserProfile();
+    message.attributes = Object.entries(object.attributes ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = String(value);
+        }
+        return acc;
+      },
+      {},
+    );
+    message.identities = object.identities?.map((e) => IdentityProviderIdentity.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseUserProfile_AttributesEntry(): UserProfile_AttributesEntry {
+  return { key: "", value: "" };
+}
+
+export const UserProfile_AttributesEntry = {
+  encode(message: UserProfile_AttributesEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UserProfile_AttributesEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUserProfile_AttributesEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UserProfile_AttributesEntry {
+    return { key: isSet(object.key) ? String(object.key) : "", value: isSet(object.value) ? String(object.value) : "" };
+  },
+
+  toJSON(message: UserProfile_AttributesEntry): unknown {
+    const obj: any = {};
+    message.key !== undefined && (obj.key = message.key);
+    message.value !== undefined && (obj.value = message.value);
+    return obj;
+  },
+
+  create(base?: DeepPartial<UserProfile_AttributesEntry>): UserProfile_AttributesEntry {
+    return UserProfile_AttributesEntry.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<UserProfile_AttributesEntry>): UserProfile_AttributesEntry {
+    const message = createBaseUserProfile_AttributesEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseIdentityProviderIdentity(): IdentityProviderIdentity {
+  return { idpUid: 0, identifier: "" };
+}
+
+export const IdentityProviderIdentity = {
+  encode(message: IdentityProviderIdentity, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.idpUid !== 0) {
+      writer.uint32(8).int32(message.idpUid);
+    }
+    if (message.identifier !== "") {
+      writer.uint32(18).string(message.identifier);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IdentityProviderIdentity {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentityProviderIdentity();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.idpUid = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.identifier = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IdentityProviderIdentity {
+    return {
+      idpUid: isSet(object.idpUid) ? Number(object.idpUid) : 0,
+      identifier: isSet(object.identifier) ? String(object.identifier) : "",
+    };
+  },
+
+  toJSON(message: IdentityProviderIdentity): unknown {
+    const obj: any = {};
+    message.idpUid !== undefined && (obj.idpUid = Math.round(message.idpUid));
+    message.identifier !== undefined && (obj.identifier = message.identifier);
+    return obj;
+  },
+
+  create(base?: DeepPartial<IdentityProviderIdentity>): IdentityProviderIdentity {
+    return IdentityProviderIdentity.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<IdentityProviderIdentity>): IdentityProviderIdentity {
+    const message = createBaseIdentityProviderIdentity();
+    message.idpUid = object.idpUid ?? 0;
+    message.identifier = object.identifier ?? "";
+    return message;
+  },
+};
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
@@ -129,6 +390,10 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function isObject(value: any): boolean {
+  return typeof value === "object" && value !== null;
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
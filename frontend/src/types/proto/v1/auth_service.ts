@@ -253,6 +253,16 @@ export interface User {
    * Could be empty.
    */
   phone: string;
+  /**
+   * The attributes of the user, e.g. the tenant or the region, which are referenced by the row filter policies.
+   * Only the workspace owner can update the attributes.
+   */
+  attributes: { [key: string]: string };
+}
+
+export interface User_AttributesEntry {
+  key: string;
+  value: string;
 }
 
 function createBaseGetUserRequest(): GetUserRequest {
@@ -1224,6 +1234,7 @@ function createBaseUser(): User {
     mfaSecret: "",
     recoveryCodes: [],
     phone: "",
+    attributes: {},
   };
 }
 
@@ -1265,6 +1276,9 @@ export const User = {
     if (message.phone !== "") {
       writer.uint32(98).string(message.phone);
     }
+    Object.entries(message.attributes).forEach(([key, value]) => {
+      User_AttributesEntry.encode({ key: key as any, value }, writer.uint32(106).fork()).ldelim();
+    });
     return writer;
   },
 
@@ -1359,6 +1373,16 @@ export const User = {
 
           message.phone = reader.string();
           continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          const entry13 = User_AttributesEntry.decode(reader, reader.uint32());
+          if (entry13.value !== undefined) {
+            message.attributes[entry13.key] = entry13.value;
+          }
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1382,6 +1406,12 @@ export const User = {
       mfaSecret: isSet(object.mfaSecret) ? String(object.mfaSecret) : "",
       recoveryCodes: Array.isArray(object?.recoveryCodes) ? object.recoveryCodes.map((e: any) => String(e)) : [],
       phone: isSet(object.phone) ? String(object.phone) : "",
+      attributes: isObject(object.attributes)
+        ? Object.entries(object.attributes).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
     };
   },
 
@@ -1403,6 +1433,12 @@ export const User = {
       obj.recoveryCodes = [];
     }
     message.phone !== undefined && (obj.phone = message.phone);
+    obj.attributes = {};
+    if (message.attributes) {
+      Object.entries(message.attributes).forEach(([k, v]) => {
+        obj.attributes[k] = v;
+      });
+    }
     return obj;
   },
 
@@ -1424,6 +1460,83 @@ export const User = {
     message.mfaSecret = object.mfaSecret ?? "";
     message.recoveryCodes = object.recoveryCodes?.map((e) => e) || [];
     message.phone = object.phone ?? "";
+    message.attributes = Object.entries(object.attributes ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = String(value);
+        }
+        return acc;
+      },
+      {},
+    );
+    return message;
+  },
+};
+
+function createBaseUser_AttributesEntry(): User_AttributesEntry {
+  return { key: "", value: "" };
+}
+
+export const User_AttributesEntry = {
+  encode(message: User_AttributesEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): User_AttributesEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUser_AttributesEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): User_AttributesEntry {
+    return { key: isSet(object.key) ? String(object.key) : "", value: isSet(object.value) ? String(object.value) : "" };
+  },
+
+  toJSON(message: User_AttributesEntry): unknown {
+    const obj: any = {};
+    message.key !== undefined && (obj.key = message.key);
+    message.value !== undefined && (obj.value = message.value);
+    return obj;
+  },
+
+  create(base?: DeepPartial<User_AttributesEntry>): User_AttributesEntry {
+    return User_AttributesEntry.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<User_AttributesEntry>): User_AttributesEntry {
+    const message = createBaseUser_AttributesEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
@@ -1689,6 +1802,10 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function isObject(value: any): boolean {
+  return typeof value === "object" && value !== null;
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
@@ -215,6 +215,9 @@ export enum ExportFormat {
   JSON = 2,
   SQL = 3,
   XLSX = 4,
+  PARQUET = 5,
+  /** NDJSON - Newline delimited JSON, one object per row. */
+  NDJSON = 6,
   UNRECOGNIZED = -1,
 }
 
@@ -235,6 +238,12 @@ export function exportFormatFromJSON(object: any): ExportFormat {
     case 4:
     case "XLSX":
       return ExportFormat.XLSX;
+    case 5:
+    case "PARQUET":
+      return ExportFormat.PARQUET;
+    case 6:
+    case "NDJSON":
+      return ExportFormat.NDJSON;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -254,8 +263,67 @@ export function exportFormatToJSON(object: ExportFormat): string {
       return "SQL";
     case ExportFormat.XLSX:
       return "XLSX";
+    case ExportFormat.PARQUET:
+      return "PARQUET";
+    case ExportFormat.NDJSON:
+      return "NDJSON";
     case ExportFormat.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
   }
 }
+
+export enum BackupStorageBackend {
+  BACKUP_STORAGE_BACKEND_UNSPECIFIED = 0,
+  /** LOCAL - The local disk of the Bytebase server. */
+  LOCAL = 1,
+  /** S3 - AWS S3 or S3-compatible storages such as MinIO. */
+  S3 = 2,
+  /** GCS - Google Cloud Storage. */
+  GCS = 3,
+  /** AZURE_BLOB - Azure Blob Storage. */
+  AZURE_BLOB = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function backupStorageBackendFromJSON(object: any): BackupStorageBackend {
+  switch (object) {
+    case 0:
+    case "BACKUP_STORAGE_BACKEND_UNSPECIFIED":
+      return BackupStorageBackend.BACKUP_STORAGE_BACKEND_UNSPECIFIED;
+    case 1:
+    case "LOCAL":
+      return BackupStorageBackend.LOCAL;
+    case 2:
+    case "S3":
+      return BackupStorageBackend.S3;
+    case 3:
+    case "GCS":
+      return BackupStorageBackend.GCS;
+    case 4:
+    case "AZURE_BLOB":
+      return BackupStorageBackend.AZURE_BLOB;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return BackupStorageBackend.UNRECOGNIZED;
+  }
+}
+
+export function backupStorageBackendToJSON(object: BackupStorageBackend): string {
+  switch (object) {
+    case BackupStorageBackend.BACKUP_STORAGE_BACKEND_UNSPECIFIED:
+      return "BACKUP_STORAGE_BACKEND_UNSPECIFIED";
+    case BackupStorageBackend.LOCAL:
+      return "LOCAL";
+    case BackupStorageBackend.S3:
+      return "S3";
+    case BackupStorageBackend.GCS:
+      return "GCS";
+    case BackupStorageBackend.AZURE_BLOB:
+      return "AZURE_BLOB";
+    case BackupStorageBackend.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
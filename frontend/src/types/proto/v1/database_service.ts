@@ -6,7 +6,7 @@ import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
 import { Timestamp } from "../google/protobuf/timestamp";
 import { StringValue } from "../google/protobuf/wrappers";
-import { MaskingLevel, maskingLevelFromJSON, maskingLevelToJSON, State, stateFromJSON, stateToJSON } from "./common";
+import { BackupStorageBackend, backupStorageBackendFromJSON, backupStorageBackendToJSON, MaskingLevel, maskingLevelFromJSON, maskingLevelToJSON, State, stateFromJSON, stateToJSON } from "./common";
 import { PushEvent } from "./vcs";
 
 export const protobufPackage = "bytebase.v1";
@@ -722,6 +722,11 @@ export interface ColumnConfig {
   /** The name is the name of a column. */
   name: string;
   semanticTypeId: string;
+  /**
+   * classification_id is the data classification of the column when the column comment does not contain one.
+   * It's set by accepting the classification suggestion of the sensitive data discovery.
+   */
+  classificationId: string;
 }
 
 /** DatabaseSchema is the metadata for databases. */
@@ -755,6 +760,11 @@ export interface BackupSetting {
   cronSchedule: string;
   /** hook_url(https://www.bytebase.com/docs/disaster-recovery/backup/#post-backup-webhook) is the URL to send a notification when a backup is created. */
   hookUrl: string;
+  /**
+   * The storage backend for the backups of the database.
+   * If not specified, the storage backend of the environment backup plan policy is used.
+   */
+  storageBackend: BackupStorageBackend;
 }
 
 /** The message of the backup. */
@@ -779,6 +789,8 @@ export interface Backup {
   /** The comment of the backup. */
   comment: string;
   uid: string;
+  /** The storage backend of the backup. */
+  storageBackend: BackupStorageBackend;
 }
 
 /** The type of the backup. */
@@ -1352,6 +1364,126 @@ export interface GetChangeHistoryRequest {
   sdlFormat: boolean;
 }
 
+export interface ListClassificationSuggestionsRequest {
+  /**
+   * The parent of the classification suggestions.
+   * Format: instances/{instance}/databases/{database}
+   */
+  parent: string;
+  /**
+   * The maximum number of suggestions to return. The service may return fewer than
+   * this value.
+   * If unspecified, at most 100 suggestions will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   */
+  pageSize: number;
+  /**
+   * A page token, received from a previous `ListClassificationSuggestions` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * When paginating, all other parameters provided to `ListClassificationSuggestions` must match
+   * the call that provided the page token.
+   */
+  pageToken: string;
+  /**
+   * The filter of the suggestions.
+   * Supported filter: state, e.g. state = "PENDING".
+   */
+  filter: string;
+}
+
+export interface ListClassificationSuggestionsResponse {
+  /** The list of classification suggestions. */
+  classificationSuggestions: ClassificationSuggestion[];
+  /**
+   * A token, which can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   */
+  nextPageToken: string;
+}
+
+export interface UpdateClassificationSuggestionRequest {
+  /**
+   * The classification suggestion to review.
+   *
+   * The suggestion's `name` field is used to identify the suggestion to update.
+   * Format: instances/{instance}/databases/{database}/classificationSuggestions/{suggestion}
+   */
+  classificationSuggestion:
+    | ClassificationSuggestion
+    | undefined;
+  /** The list of fields to update. Only the state can be updated. */
+  updateMask: string[] | undefined;
+}
+
+/** ClassificationSuggestion is the data classification of a column proposed by the sensitive data discovery. */
+export interface ClassificationSuggestion {
+  /**
+   * The name of the suggestion.
+   * Format: instances/{instance}/databases/{database}/classificationSuggestions/{suggestion}
+   */
+  name: string;
+  schema: string;
+  table: string;
+  column: string;
+  /** The proposed data classification id. */
+  classificationId: string;
+  /** The id of the detector matching the column. */
+  detectorId: string;
+  /** The confidence in [0, 1] that the column contains the sensitive data of the classification. */
+  confidence: number;
+  /** The review state of the suggestion. Only the PENDING suggestions can be accepted or rejected. */
+  state: ClassificationSuggestion_State;
+  createTime: Date | undefined;
+  updateTime: Date | undefined;
+}
+
+export enum ClassificationSuggestion_State {
+  STATE_UNSPECIFIED = 0,
+  PENDING = 1,
+  /** ACCEPTED - ACCEPTED suggestions set the classification of the column. */
+  ACCEPTED = 2,
+  REJECTED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function classificationSuggestion_StateFromJSON(object: any): ClassificationSuggestion_State {
+  switch (object) {
+    case 0:
+    case "STATE_UNSPECIFIED":
+      return ClassificationSuggestion_State.STATE_UNSPECIFIED;
+    case 1:
+    case "PENDING":
+      return ClassificationSuggestion_State.PENDING;
+    case 2:
+    case "ACCEPTED":
+      return ClassificationSuggestion_State.ACCEPTED;
+    case 3:
+    case "REJECTED":
+      return ClassificationSuggestion_State.REJECTED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ClassificationSuggestion_State.UNRECOGNIZED;
+  }
+}
+
+export function classificationSuggestion_StateToJSON(object: ClassificationSuggestion_State): string {
+  switch (object) {
+    case ClassificationSuggestion_State.STATE_UNSPECIFIED:
+      return "STATE_UNSPECIFIED";
+    case ClassificationSuggestion_State.PENDING:
+      return "PENDING";
+    case ClassificationSuggestion_State.ACCEPTED:
+      return "ACCEPTED";
+    case ClassificationSuggestion_State.REJECTED:
+      return "REJECTED";
+    case ClassificationSuggestion_State.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 function createBaseGetDatabaseRequest(): GetDatabaseRequest {
   return { name: "" };
 }
@@ -4908,7 +5040,7 @@ export const TableConfig = {
 };
 
 function createBaseColumnConfig(): ColumnConfig {
-  return { name: "", semanticTypeId: "" };
+  return { name: "", semanticTypeId: "", classificationId: "" };
 }
 
 export const ColumnConfig = {
@@ -4919,6 +5051,9 @@ export const ColumnConfig = {
     if (message.semanticTypeId !== "") {
       writer.uint32(18).string(message.semanticTypeId);
     }
+    if (message.classificationId !== "") {
+      writer.uint32(26).string(message.classificationId);
+    }
     return writer;
   },
 
@@ -4943,6 +5078,13 @@ export const ColumnConfig = {
 
           message.semanticTypeId = reader.string();
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.classificationId = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -4956,6 +5098,7 @@ export const ColumnConfig = {
     return {
       name: isSet(object.name) ? String(object.name) : "",
       semanticTypeId: isSet(object.semanticTypeId) ? String(object.semanticTypeId) : "",
+      classificationId: isSet(object.classificationId) ? String(object.classificationId) : "",
     };
   },
 
@@ -4963,6 +5106,7 @@ export const ColumnConfig = {
     const obj: any = {};
     message.name !== undefined && (obj.name = message.name);
     message.semanticTypeId !== undefined && (obj.semanticTypeId = message.semanticTypeId);
+    message.classificationId !== undefined && (obj.classificationId = message.classificationId);
     return obj;
   },
 
@@ -4974,6 +5118,7 @@ export const ColumnConfig = {
     const message = createBaseColumnConfig();
     message.name = object.name ?? "";
     message.semanticTypeId = object.semanticTypeId ?? "";
+    message.classificationId = object.classificationId ?? "";
     return message;
   },
 };
@@ -5035,7 +5180,7 @@ export const DatabaseSchema = {
 };
 
 function createBaseBackupSetting(): BackupSetting {
-  return { name: "", backupRetainDuration: undefined, cronSchedule: "", hookUrl: "" };
+  return { name: "", backupRetainDuration: undefined, cronSchedule: "", hookUrl: "", storageBackend: 0 };
 }
 
 export const BackupSetting = {
@@ -5052,6 +5197,9 @@ export const BackupSetting = {
     if (message.hookUrl !== "") {
       writer.uint32(34).string(message.hookUrl);
     }
+    if (message.storageBackend !== 0) {
+      writer.uint32(40).int32(message.storageBackend);
+    }
     return writer;
   },
 
@@ -5090,6 +5238,13 @@ export const BackupSetting = {
 
           message.hookUrl = reader.string();
           continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.storageBackend = reader.int32() as any;
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -5107,6 +5262,7 @@ export const BackupSetting = {
         : undefined,
       cronSchedule: isSet(object.cronSchedule) ? String(object.cronSchedule) : "",
       hookUrl: isSet(object.hookUrl) ? String(object.hookUrl) : "",
+      storageBackend: isSet(object.storageBackend) ? backupStorageBackendFromJSON(object.storageBackend) : 0,
     };
   },
 
@@ -5118,6 +5274,7 @@ export const BackupSetting = {
       : undefined);
     message.cronSchedule !== undefined && (obj.cronSchedule = message.cronSchedule);
     message.hookUrl !== undefined && (obj.hookUrl = message.hookUrl);
+    message.storageBackend !== undefined && (obj.storageBackend = backupStorageBackendToJSON(message.storageBackend));
     return obj;
   },
 
@@ -5133,12 +5290,22 @@ export const BackupSetting = {
       : undefined;
     message.cronSchedule = object.cronSchedule ?? "";
     message.hookUrl = object.hookUrl ?? "";
+    message.storageBackend = object.storageBackend ?? 0;
     return message;
   },
 };
 
 function createBaseBackup(): Backup {
-  return { name: "", createTime: undefined, updateTime: undefined, state: 0, backupType: 0, comment: "", uid: "" };
+  return {
+    name: "",
+    createTime: undefined,
+    updateTime: undefined,
+    state: 0,
+    backupType: 0,
+    comment: "",
+    uid: "",
+    storageBackend: 0,
+  };
 }
 
 export const Backup = {
@@ -5164,6 +5331,9 @@ export const Backup = {
     if (message.uid !== "") {
       writer.uint32(58).string(message.uid);
     }
+    if (message.storageBackend !== 0) {
+      writer.uint32(64).int32(message.storageBackend);
+    }
     return writer;
   },
 
@@ -5223,6 +5393,13 @@ export const Backup = {
 
           message.uid = reader.string();
           continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.storageBackend = reader.int32() as any;
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -5241,6 +5418,7 @@ export const Backup = {
       backupType: isSet(object.backupType) ? backup_BackupTypeFromJSON(object.backupType) : 0,
       comment: isSet(object.comment) ? String(object.comment) : "",
       uid: isSet(object.uid) ? String(object.uid) : "",
+      storageBackend: isSet(object.storageBackend) ? backupStorageBackendFromJSON(object.storageBackend) : 0,
     };
   },
 
@@ -5253,6 +5431,7 @@ export const Backup = {
     message.backupType !== undefined && (obj.backupType = backup_BackupTypeToJSON(message.backupType));
     message.comment !== undefined && (obj.comment = message.comment);
     message.uid !== undefined && (obj.uid = message.uid);
+    message.storageBackend !== undefined && (obj.storageBackend = backupStorageBackendToJSON(message.storageBackend));
     return obj;
   },
 
@@ -5269,6 +5448,7 @@ export const Backup = {
     message.backupType = object.backupType ?? 0;
     message.comment = object.comment ?? "";
     message.uid = object.uid ?? "";
+    message.storageBackend = object.storageBackend ?? 0;
     return message;
   },
 };
@@ -7288,160 +7468,602 @@ export const GetChangeHistoryRequest = {
   },
 };
 
-export type DatabaseServiceDefinition = typeof DatabaseServiceDefinition;
-export const DatabaseServiceDefinition = {
-  name: "DatabaseService",
-  fullName: "bytebase.v1.DatabaseService",
-  methods: {
-    getDatabase: {
-      name: "GetDatabase",
-      requestType: GetDatabaseRequest,
-      requestStream: false,
-      responseType: Database,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([4, 110, 97, 109, 101])],
-          578365826: [
-            new Uint8Array([
-              36,
-              18,
-              34,
-              47,
-              118,
-              49,
-              47,
-              123,
-              110,
-              97,
-              109,
-              101,
-              61,
-              105,
-              110,
-              115,
-              116,
-              97,
-              110,
-              99,
-              101,
-              115,
-              47,
-              42,
-              47,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              115,
-              47,
-              42,
-              125,
-            ]),
-          ],
-        },
-      },
-    },
-    listDatabases: {
-      name: "ListDatabases",
-      requestType: ListDatabasesRequest,
-      requestStream: false,
-      responseType: ListDatabasesResponse,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([0])],
-          578365826: [
-            new Uint8Array([
-              36,
-              18,
-              34,
-              47,
-              118,
-              49,
-              47,
-              123,
-              112,
-              97,
-              114,
-              101,
-              110,
-              116,
-              61,
-              105,
-              110,
-              115,
-              116,
-              97,
-              110,
-              99,
-              101,
-              115,
-              47,
-              42,
-              125,
-              47,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              115,
-            ]),
-          ],
-        },
-      },
-    },
-    /** Search for databases that the caller has both projects.get permission on, and also satisfy the specified query. */
-    searchDatabases: {
-      name: "SearchDatabases",
-      requestType: SearchDatabasesRequest,
-      requestStream: false,
-      responseType: SearchDatabasesResponse,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([0])],
-          578365826: [
-            new Uint8Array([
-              43,
-              18,
-              41,
-              47,
-              118,
-              49,
-              47,
-              123,
-              112,
-              97,
-              114,
-              101,
-              110,
-              116,
-              61,
-              105,
-              110,
-              115,
-              116,
-              97,
-              110,
-              99,
-              101,
-              115,
-              47,
-              42,
-              125,
-              47,
-              100,
-              97,
-              116,
+function createBaseListClassificationSuggestionsRequest(): ListClassificationSuggestionsRequest {
+  return { parent: "", pageSize: 0, pageToken: "", filter: "" };
+}
+
+export const ListClassificationSuggestionsRequest = {
+  encode(message: ListClassificationSuggestionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    if (message.filter !== "") {
+      writer.uint32(34).string(message.filter);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListClassificationSuggestionsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListClassificationSuggestionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.filter = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListClassificationSuggestionsRequest {
+    return {
+      parent: isSet(object.parent) ? String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? String(object.pageToken) : "",
+      filter: isSet(object.filter) ? String(object.filter) : "",
+    };
+  },
+
+  toJSON(message: ListClassificationSuggestionsRequest): unknown {
+    const obj: any = {};
+    message.parent !== undefined && (obj.parent = message.parent);
+    message.pageSize !== undefined && (obj.pageSize = Math.round(message.pageSize));
+    message.pageToken !== undefined && (obj.pageToken = message.pageToken);
+    message.filter !== undefined && (obj.filter = message.filter);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListClassificationSuggestionsRequest>): ListClassificationSuggestionsRequest {
+    return ListClassificationSuggestionsRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListClassificationSuggestionsRequest>): ListClassificationSuggestionsRequest {
+    const message = createBaseListClassificationSuggestionsRequest();
+    message.parent = object.parent ?? "";
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    message.filter = object.filter ?? "";
+    return message;
+  },
+};
+
+function createBaseListClassificationSuggestionsResponse(): ListClassificationSuggestionsResponse {
+  return { classificationSuggestions: [], nextPageToken: "" };
+}
+
+export const ListClassificationSuggestionsResponse = {
+  encode(message: ListClassificationSuggestionsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.classificationSuggestions) {
+      ClassificationSuggestion.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListClassificationSuggestionsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListClassificationSuggestionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.classificationSuggestions.push(ClassificationSuggestion.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListClassificationSuggestionsResponse {
+    return {
+      classificationSuggestions: Array.isArray(object?.classificationSuggestions)
+        ? object.classificationSuggestions.map((e: any) => ClassificationSuggestion.fromJSON(e))
+        : [],
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
+    };
+  },
+
+  toJSON(message: ListClassificationSuggestionsResponse): unknown {
+    const obj: any = {};
+    if (message.classificationSuggestions) {
+      obj.classificationSuggestions = message.classificationSuggestions.map((e) =>
+        e ? ClassificationSuggestion.toJSON(e) : undefined
+      );
+    } else {
+      obj.classificationSuggestions = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListClassificationSuggestionsResponse>): ListClassificationSuggestionsResponse {
+    return ListClassificationSuggestionsResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListClassificationSuggestionsResponse>): ListClassificationSuggestionsResponse {
+    const message = createBaseListClassificationSuggestionsResponse();
+    message.classificationSuggestions =
+      object.classificationSuggestions?.map((e) => ClassificationSuggestion.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseUpdateClassificationSuggestionRequest(): UpdateClassificationSuggestionRequest {
+  return { classificationSuggestion: undefined, updateMask: undefined };
+}
+
+export const UpdateClassificationSuggestionRequest = {
+  encode(message: UpdateClassificationSuggestionRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.classificationSuggestion !== undefined) {
+      ClassificationSuggestion.encode(message.classificationSuggestion, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateClassificationSuggestionRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateClassificationSuggestionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.classificationSuggestion = ClassificationSuggestion.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateClassificationSuggestionRequest {
+    return {
+      classificationSuggestion: isSet(object.classificationSuggestion)
+        ? ClassificationSuggestion.fromJSON(object.classificationSuggestion)
+        : undefined,
+      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+    };
+  },
+
+  toJSON(message: UpdateClassificationSuggestionRequest): unknown {
+    const obj: any = {};
+    message.classificationSuggestion !== undefined &&
+      (obj.classificationSuggestion = message.classificationSuggestion
+        ? ClassificationSuggestion.toJSON(message.classificationSuggestion)
+        : undefined);
+    message.updateMask !== undefined && (obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask)));
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateClassificationSuggestionRequest>): UpdateClassificationSuggestionRequest {
+    return UpdateClassificationSuggestionRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<UpdateClassificationSuggestionRequest>): UpdateClassificationSuggestionRequest {
+    const message = createBaseUpdateClassificationSuggestionRequest();
+    message.classificationSuggestion =
+      (object.classificationSuggestion !== undefined && object.classificationSuggestion !== null)
+        ? ClassificationSuggestion.fromPartial(object.classificationSuggestion)
+        : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    return message;
+  },
+};
+
+function createBaseClassificationSuggestion(): ClassificationSuggestion {
+  return {
+    name: "",
+    schema: "",
+    table: "",
+    column: "",
+    classificationId: "",
+    detectorId: "",
+    confidence: 0,
+    state: 0,
+    createTime: undefined,
+    updateTime: undefined,
+  };
+}
+
+export const ClassificationSuggestion = {
+  encode(message: ClassificationSuggestion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.schema !== "") {
+      writer.uint32(18).string(message.schema);
+    }
+    if (message.table !== "") {
+      writer.uint32(26).string(message.table);
+    }
+    if (message.column !== "") {
+      writer.uint32(34).string(message.column);
+    }
+    if (message.classificationId !== "") {
+      writer.uint32(42).string(message.classificationId);
+    }
+    if (message.detectorId !== "") {
+      writer.uint32(50).string(message.detectorId);
+    }
+    if (message.confidence !== 0) {
+      writer.uint32(57).double(message.confidence);
+    }
+    if (message.state !== 0) {
+      writer.uint32(64).int32(message.state);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(74).fork()).ldelim();
+    }
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(82).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ClassificationSuggestion {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseClassificationSuggestion();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.schema = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.table = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.column = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.classificationId = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.detectorId = reader.string();
+          continue;
+        case 7:
+          if (tag !== 57) {
+            break;
+          }
+
+          message.confidence = reader.double();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.state = reader.int32() as any;
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ClassificationSuggestion {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      schema: isSet(object.schema) ? String(object.schema) : "",
+      table: isSet(object.table) ? String(object.table) : "",
+      column: isSet(object.column) ? String(object.column) : "",
+      classificationId: isSet(object.classificationId) ? String(object.classificationId) : "",
+      detectorId: isSet(object.detectorId) ? String(object.detectorId) : "",
+      confidence: isSet(object.confidence) ? Number(object.confidence) : 0,
+      state: isSet(object.state) ? classificationSuggestion_StateFromJSON(object.state) : 0,
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+    };
+  },
+
+  toJSON(message: ClassificationSuggestion): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.schema !== undefined && (obj.schema = message.schema);
+    message.table !== undefined && (obj.table = message.table);
+    message.column !== undefined && (obj.column = message.column);
+    message.classificationId !== undefined && (obj.classificationId = message.classificationId);
+    message.detectorId !== undefined && (obj.detectorId = message.detectorId);
+    message.confidence !== undefined && (obj.confidence = message.confidence);
+    message.state !== undefined && (obj.state = classificationSuggestion_StateToJSON(message.state));
+    message.createTime !== undefined && (obj.createTime = message.createTime.toISOString());
+    message.updateTime !== undefined && (obj.updateTime = message.updateTime.toISOString());
+    return obj;
+  },
+
+  create(base?: DeepPartial<ClassificationSuggestion>): ClassificationSuggestion {
+    return ClassificationSuggestion.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ClassificationSuggestion>): ClassificationSuggestion {
+    const message = createBaseClassificationSuggestion();
+    message.name = object.name ?? "";
+    message.schema = object.schema ?? "";
+    message.table = object.table ?? "";
+    message.column = object.column ?? "";
+    message.classificationId = object.classificationId ?? "";
+    message.detectorId = object.detectorId ?? "";
+    message.confidence = object.confidence ?? 0;
+    message.state = object.state ?? 0;
+    message.createTime = object.createTime ?? undefined;
+    message.updateTime = object.updateTime ?? undefined;
+    return message;
+  },
+};
+
+export type DatabaseServiceDefinition = typeof DatabaseServiceDefinition;
+export const DatabaseServiceDefinition = {
+  name: "DatabaseService",
+  fullName: "bytebase.v1.DatabaseService",
+  methods: {
+    getDatabase: {
+      name: "GetDatabase",
+      requestType: GetDatabaseRequest,
+      requestStream: false,
+      responseType: Database,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          578365826: [
+            new Uint8Array([
+              36,
+              18,
+              34,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    listDatabases: {
+      name: "ListDatabases",
+      requestType: ListDatabasesRequest,
+      requestStream: false,
+      responseType: ListDatabasesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          578365826: [
+            new Uint8Array([
+              36,
+              18,
+              34,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** Search for databases that the caller has both projects.get permission on, and also satisfy the specified query. */
+    searchDatabases: {
+      name: "SearchDatabases",
+      requestType: SearchDatabasesRequest,
+      requestStream: false,
+      responseType: SearchDatabasesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          578365826: [
+            new Uint8Array([
+              43,
+              18,
+              41,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              97,
+              116,
               97,
               98,
               97,
@@ -8794,6 +9416,262 @@ export const DatabaseServiceDefinition = {
         },
       },
     },
+    listClassificationSuggestions: {
+      name: "ListClassificationSuggestions",
+      requestType: ListClassificationSuggestionsRequest,
+      requestStream: false,
+      responseType: ListClassificationSuggestionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          578365826: [
+            new Uint8Array([
+              64,
+              18,
+              62,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+              47,
+              99,
+              108,
+              97,
+              115,
+              115,
+              105,
+              102,
+              105,
+              99,
+              97,
+              116,
+              105,
+              111,
+              110,
+              83,
+              117,
+              103,
+              103,
+              101,
+              115,
+              116,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    updateClassificationSuggestion: {
+      name: "UpdateClassificationSuggestion",
+      requestType: UpdateClassificationSuggestionRequest,
+      requestStream: false,
+      responseType: ClassificationSuggestion,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [
+            new Uint8Array([
+              37,
+              99,
+              108,
+              97,
+              115,
+              115,
+              105,
+              102,
+              105,
+              99,
+              97,
+              116,
+              105,
+              111,
+              110,
+              95,
+              115,
+              117,
+              103,
+              103,
+              101,
+              115,
+              116,
+              105,
+              111,
+              110,
+              44,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+              95,
+              109,
+              97,
+              115,
+              107,
+            ]),
+          ],
+          578365826: [
+            new Uint8Array([
+              117,
+              58,
+              25,
+              99,
+              108,
+              97,
+              115,
+              115,
+              105,
+              102,
+              105,
+              99,
+              97,
+              116,
+              105,
+              111,
+              110,
+              95,
+              115,
+              117,
+              103,
+              103,
+              101,
+              115,
+              116,
+              105,
+              111,
+              110,
+              50,
+              88,
+              47,
+              118,
+              49,
+              47,
+              123,
+              99,
+              108,
+              97,
+              115,
+              115,
+              105,
+              102,
+              105,
+              99,
+              97,
+              116,
+              105,
+              111,
+              110,
+              95,
+              115,
+              117,
+              103,
+              103,
+              101,
+              115,
+              116,
+              105,
+              111,
+              110,
+              46,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              47,
+              99,
+              108,
+              97,
+              115,
+              115,
+              105,
+              102,
+              105,
+              99,
+              97,
+              116,
+              105,
+              111,
+              110,
+              83,
+              117,
+              103,
+              103,
+              101,
+              115,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -129,6 +129,10 @@ export enum ExternalVersionControl_Type {
   BITBUCKET = 3,
   /** AZURE_DEVOPS - Azure DevOps. Using for Azure DevOps GitOps workflow. */
   AZURE_DEVOPS = 4,
+  /** GIT - Plain Git server over HTTPS or SSH. Using for Git servers without a supported hosting API. */
+  GIT = 5,
+  /** GITEA - Gitea type. Using for self-hosted Gitea and Forgejo. */
+  GITEA = 6,
   UNRECOGNIZED = -1,
 }
 
@@ -149,6 +153,12 @@ export function externalVersionControl_TypeFromJSON(object: any): ExternalVersio
     case 4:
     case "AZURE_DEVOPS":
       return ExternalVersionControl_Type.AZURE_DEVOPS;
+    case 5:
+    case "GIT":
+      return ExternalVersionControl_Type.GIT;
+    case 6:
+    case "GITEA":
+      return ExternalVersionControl_Type.GITEA;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -168,6 +178,10 @@ export function externalVersionControl_TypeToJSON(object: ExternalVersionControl
       return "BITBUCKET";
     case ExternalVersionControl_Type.AZURE_DEVOPS:
       return "AZURE_DEVOPS";
+    case ExternalVersionControl_Type.GIT:
+      return "GIT";
+    case ExternalVersionControl_Type.GITEA:
+      return "GITEA";
     case ExternalVersionControl_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -273,6 +273,16 @@ export interface LDAPIdentityProviderConfig {
    * server.
    */
   fieldMapping: FieldMapping | undefined;
+  /**
+   * GroupBaseDN is the base DN to search for the groups of users, e.g.
+   * "ou=groups,dc=example,dc=com". The group search is disabled if it is empty.
+   */
+  groupBaseDn: string;
+  /**
+   * GroupFilter is the filter to search for the groups of a user, e.g.
+   * "(member=%s)", where "%s" is replaced by the DN of the user.
+   */
+  groupFilter: string;
 }
 
 /**
@@ -294,6 +304,11 @@ export interface FieldMapping {
   email: string;
   /** Phone is the field name of primary phone in 3rd-party idp user info. */
   phone: string;
+  /**
+   * Groups is the field name of the groups in 3rd-party idp user info, e.g. `groups`
+   * in OIDC claims or `memberOf` in LDAP attributes.
+   */
+  groups: string;
 }
 
 function createBaseGetIdentityProviderRequest(): GetIdentityProviderRequest {
@@ -1526,6 +1541,8 @@ function createBaseLDAPIdentityProviderConfig(): LDAPIdentityProviderConfig {
     userFilter: "",
     securityProtocol: "",
     fieldMapping: undefined,
+    groupBaseDn: "",
+    groupFilter: "",
   };
 }
 
@@ -1558,6 +1575,12 @@ export const LDAPIdentityProviderConfig = {
     if (message.fieldMapping !== undefined) {
       FieldMapping.encode(message.fieldMapping, writer.uint32(74).fork()).ldelim();
     }
+    if (message.groupBaseDn !== "") {
+      writer.uint32(82).string(message.groupBaseDn);
+    }
+    if (message.groupFilter !== "") {
+      writer.uint32(90).string(message.groupFilter);
+    }
     return writer;
   },
 
@@ -1631,6 +1654,20 @@ export const LDAPIdentityProviderConfig = {
 
           message.fieldMapping = FieldMapping.decode(reader, reader.uint32());
           continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.groupBaseDn = reader.string();
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.groupFilter = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1651,6 +1688,8 @@ export const LDAPIdentityProviderConfig = {
       userFilter: isSet(object.userFilter) ? String(object.userFilter) : "",
       securityProtocol: isSet(object.securityProtocol) ? String(object.securityProtocol) : "",
       fieldMapping: isSet(object.fieldMapping) ? FieldMapping.fromJSON(object.fieldMapping) : undefined,
+      groupBaseDn: isSet(object.groupBaseDn) ? String(object.groupBaseDn) : "",
+      groupFilter: isSet(object.groupFilter) ? String(object.groupFilter) : "",
     };
   },
 
@@ -1666,6 +1705,8 @@ export const LDAPIdentityProviderConfig = {
     message.securityProtocol !== undefined && (obj.securityProtocol = message.securityProtocol);
     message.fieldMapping !== undefined &&
       (obj.fieldMapping = message.fieldMapping ? FieldMapping.toJSON(message.fieldMapping) : undefined);
+    message.groupBaseDn !== undefined && (obj.groupBaseDn = message.groupBaseDn);
+    message.groupFilter !== undefined && (obj.groupFilter = message.groupFilter);
     return obj;
   },
 
@@ -1686,12 +1727,14 @@ export const LDAPIdentityProviderConfig = {
     message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
       ? FieldMapping.fromPartial(object.fieldMapping)
       : undefined;
+    message.groupBaseDn = object.groupBaseDn ?? "";
+    message.groupFilter = object.groupFilter ?? "";
     return message;
   },
 };
 
 function createBaseFieldMapping(): FieldMapping {
-  return { identifier: "", displayName: "", email: "", phone: "" };
+  return { identifier: "", displayName: "", email: "", phone: "", groups: "" };
 }
 
 export const FieldMapping = {
@@ -1708,6 +1751,9 @@ export const FieldMapping = {
     if (message.phone !== "") {
       writer.uint32(34).string(message.phone);
     }
+    if (message.groups !== "") {
+      writer.uint32(42).string(message.groups);
+    }
     return writer;
   },
 
@@ -1746,6 +1792,13 @@ export const FieldMapping = {
 
           message.phone = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.groups = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1761,6 +1814,7 @@ export const FieldMapping = {
       displayName: isSet(object.displayName) ? String(object.displayName) : "",
       email: isSet(object.email) ? String(object.email) : "",
       phone: isSet(object.phone) ? String(object.phone) : "",
+      groups: isSet(object.groups) ? String(object.groups) : "",
     };
   },
 
@@ -1770,6 +1824,7 @@ export const FieldMapping = {
     message.displayName !== undefined && (obj.displayName = message.displayName);
     message.email !== undefined && (obj.email = message.email);
     message.phone !== undefined && (obj.phone = message.phone);
+    message.groups !== undefined && (obj.groups = message.groups);
     return obj;
   },
 
@@ -1783,6 +1838,7 @@ export const FieldMapping = {
     message.displayName = object.displayName ?? "";
     message.email = object.email ?? "";
     message.phone = object.phone ?? "";
+    message.groups = object.groups ?? "";
     return message;
   },
 };
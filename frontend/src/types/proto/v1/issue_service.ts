@@ -279,6 +279,11 @@ export interface Issue {
   rollout: string;
   /** Used if the issue type is GRANT_REQUEST. */
   grantRequest: GrantRequest | undefined;
+  /**
+   * The approvals and rejections of all approvers, in the order they are made.
+   * It tracks the progress of the steps requiring multiple approvers.
+   */
+  stepApprovers: Issue_StepApprover[];
 }
 
 export enum Issue_Type {
@@ -372,6 +377,14 @@ export function issue_Approver_StatusToJSON(object: Issue_Approver_Status): stri
   }
 }
 
+export interface Issue_StepApprover {
+  /** The index of the step in the approval flow. */
+  step: number;
+  status: Issue_Approver_Status;
+  /** Format: users/hello@world.com */
+  principal: string;
+}
+
 export interface GrantRequest {
   /**
    * The requested role.
@@ -459,6 +472,20 @@ export interface ApprovalNode {
   /** Format: roles/{role} */
   role?: string | undefined;
   externalNodeId?: string | undefined;
+  userList?:
+    | ApprovalNode_UserList
+    | undefined;
+  /** Format: groups/{group} */
+  userGroup?:
+    | string
+    | undefined;
+  /**
+   * The number of distinct approvers required to approve the node.
+   * The node requires one approver if the value is not positive.
+   */
+  requiredApproverCount: number;
+  /** If true, the issue creator cannot approve the node. */
+  disallowSelfApproval: boolean;
 }
 
 /**
@@ -558,6 +585,12 @@ export function approvalNode_GroupValueToJSON(object: ApprovalNode_GroupValue):
   }
 }
 
+/** UserList is the list of users who can approve the node. */
+export interface ApprovalNode_UserList {
+  /** Format: users/hello@world.com */
+  users: string[];
+}
+
 export interface CreateIssueCommentRequest {
   /**
    * The issue name
@@ -1538,6 +1571,7 @@ function createBaseIssue(): Issue {
     plan: "",
     rollout: "",
     grantRequest: undefined,
+    stepApprovers: [],
   };
 }
 
@@ -1600,6 +1634,9 @@ export const Issue = {
     if (message.grantRequest !== undefined) {
       GrantRequest.encode(message.grantRequest, writer.uint32(154).fork()).ldelim();
     }
+    for (const v of message.stepApprovers) {
+      Issue_StepApprover.encode(v!, writer.uint32(162).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1743,6 +1780,13 @@ export const Issue = {
 
           message.grantRequest = GrantRequest.decode(reader, reader.uint32());
           continue;
+        case 20:
+          if (tag !== 162) {
+            break;
+          }
+
+          message.stepApprovers.push(Issue_StepApprover.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1775,6 +1819,7 @@ export const Issue = {
       plan: isSet(object.plan) ? String(object.plan) : "",
       rollout: isSet(object.rollout) ? String(object.rollout) : "",
       grantRequest: isSet(object.grantRequest) ? GrantRequest.fromJSON(object.grantRequest) : undefined,
+      stepApprovers: Array.isArray(object?.stepApprovers) ? object.stepApprovers.map((e: any) => Issue_StepApprover.fromJSON(e)) : [],
     };
   },
 
@@ -1812,6 +1857,11 @@ export const Issue = {
     message.rollout !== undefined && (obj.rollout = message.rollout);
     message.grantRequest !== undefined &&
       (obj.grantRequest = message.grantRequest ? GrantRequest.toJSON(message.grantRequest) : undefined);
+    if (message.stepApprovers) {
+      obj.stepApprovers = message.stepApprovers.map((e) => e ? Issue_StepApprover.toJSON(e) : undefined);
+    } else {
+      obj.stepApprovers = [];
+    }
     return obj;
   },
 
@@ -1842,6 +1892,7 @@ export const Issue = {
     message.grantRequest = (object.grantRequest !== undefined && object.grantRequest !== null)
       ? GrantRequest.fromPartial(object.grantRequest)
       : undefined;
+    message.stepApprovers = object.stepApprovers?.map((e) => Issue_StepApprover.fromPartial(e)) || [];
     return message;
   },
 };
@@ -1917,6 +1968,90 @@ export const Issue_Approver = {
   },
 };
 
+function createBaseIssue_StepApprover(): Issue_StepApprover {
+  return { step: 0, status: 0, principal: "" };
+}
+
+export const Issue_StepApprover = {
+  encode(message: Issue_StepApprover, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.step !== 0) {
+      writer.uint32(8).int32(message.step);
+    }
+    if (message.status !== 0) {
+      writer.uint32(16).int32(message.status);
+    }
+    if (message.principal !== "") {
+      writer.uint32(26).string(message.principal);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Issue_StepApprover {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssue_StepApprover();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.step = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.principal = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Issue_StepApprover {
+    return {
+      step: isSet(object.step) ? Number(object.step) : 0,
+      status: isSet(object.status) ? issue_Approver_StatusFromJSON(object.status) : 0,
+      principal: isSet(object.principal) ? String(object.principal) : "",
+    };
+  },
+
+  toJSON(message: Issue_StepApprover): unknown {
+    const obj: any = {};
+    message.step !== undefined && (obj.step = Math.round(message.step));
+    message.status !== undefined && (obj.status = issue_Approver_StatusToJSON(message.status));
+    message.principal !== undefined && (obj.principal = message.principal);
+    return obj;
+  },
+
+  create(base?: DeepPartial<Issue_StepApprover>): Issue_StepApprover {
+    return Issue_StepApprover.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<Issue_StepApprover>): Issue_StepApprover {
+    const message = createBaseIssue_StepApprover();
+    message.step = object.step ?? 0;
+    message.status = object.status ?? 0;
+    message.principal = object.principal ?? "";
+    return message;
+  },
+};
+
 function createBaseGrantRequest(): GrantRequest {
   return { role: "", user: "", condition: undefined, expiration: undefined };
 }
@@ -2254,7 +2389,16 @@ export const ApprovalStep = {
 };
 
 function createBaseApprovalNode(): ApprovalNode {
-  return { type: 0, groupValue: undefined, role: undefined, externalNodeId: undefined };
+  return {
+    type: 0,
+    groupValue: undefined,
+    role: undefined,
+    externalNodeId: undefined,
+    userList: undefined,
+    userGroup: undefined,
+    requiredApproverCount: 0,
+    disallowSelfApproval: false,
+  };
 }
 
 export const ApprovalNode = {
@@ -2271,6 +2415,18 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       writer.uint32(34).string(message.externalNodeId);
     }
+    if (message.userList !== undefined) {
+      ApprovalNode_UserList.encode(message.userList, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.userGroup !== undefined) {
+      writer.uint32(66).string(message.userGroup);
+    }
+    if (message.requiredApproverCount !== 0) {
+      writer.uint32(48).int32(message.requiredApproverCount);
+    }
+    if (message.disallowSelfApproval === true) {
+      writer.uint32(56).bool(message.disallowSelfApproval);
+    }
     return writer;
   },
 
@@ -2309,6 +2465,34 @@ export const ApprovalNode = {
 
           message.externalNodeId = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.userList = ApprovalNode_UserList.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.requiredApproverCount = reader.int32();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.disallowSelfApproval = reader.bool();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.userGroup = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2324,6 +2508,10 @@ export const ApprovalNode = {
       groupValue: isSet(object.groupValue) ? approvalNode_GroupValueFromJSON(object.groupValue) : undefined,
       role: isSet(object.role) ? String(object.role) : undefined,
       externalNodeId: isSet(object.externalNodeId) ? String(object.externalNodeId) : undefined,
+      userList: isSet(object.userList) ? ApprovalNode_UserList.fromJSON(object.userList) : undefined,
+      userGroup: isSet(object.userGroup) ? String(object.userGroup) : undefined,
+      requiredApproverCount: isSet(object.requiredApproverCount) ? Number(object.requiredApproverCount) : 0,
+      disallowSelfApproval: isSet(object.disallowSelfApproval) ? Boolean(object.disallowSelfApproval) : false,
     };
   },
 
@@ -2336,6 +2524,10 @@ export const ApprovalNode = {
         : undefined);
     message.role !== undefined && (obj.role = message.role);
     message.externalNodeId !== undefined && (obj.externalNodeId = message.externalNodeId);
+    message.userList !== undefined && (obj.userList = message.userList ? ApprovalNode_UserList.toJSON(message.userList) : undefined);
+    message.userGroup !== undefined && (obj.userGroup = message.userGroup);
+    message.requiredApproverCount !== undefined && (obj.requiredApproverCount = Math.round(message.requiredApproverCount));
+    message.disallowSelfApproval !== undefined && (obj.disallowSelfApproval = message.disallowSelfApproval);
     return obj;
   },
 
@@ -2349,6 +2541,72 @@ export const ApprovalNode = {
     message.groupValue = object.groupValue ?? undefined;
     message.role = object.role ?? undefined;
     message.externalNodeId = object.externalNodeId ?? undefined;
+    message.userList = (object.userList !== undefined && object.userList !== null)
+      ? ApprovalNode_UserList.fromPartial(object.userList)
+      : undefined;
+    message.userGroup = object.userGroup ?? undefined;
+    message.requiredApproverCount = object.requiredApproverCount ?? 0;
+    message.disallowSelfApproval = object.disallowSelfApproval ?? false;
+    return message;
+  },
+};
+
+function createBaseApprovalNode_UserList(): ApprovalNode_UserList {
+  return { users: [] };
+}
+
+export const ApprovalNode_UserList = {
+  encode(message: ApprovalNode_UserList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.users) {
+      writer.uint32(10).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ApprovalNode_UserList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApprovalNode_UserList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.users.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ApprovalNode_UserList {
+    return { users: Array.isArray(object?.users) ? object.users.map((e: any) => String(e)) : [] };
+  },
+
+  toJSON(message: ApprovalNode_UserList): unknown {
+    const obj: any = {};
+    if (message.users) {
+      obj.users = message.users.map((e) => e);
+    } else {
+      obj.users = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ApprovalNode_UserList>): ApprovalNode_UserList {
+    return ApprovalNode_UserList.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ApprovalNode_UserList>): ApprovalNode_UserList {
+    const message = createBaseApprovalNode_UserList();
+    message.users = object.users?.map((e) => e) || [];
     return message;
   },
 };
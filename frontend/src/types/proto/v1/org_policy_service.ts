@@ -4,7 +4,7 @@ import { Duration } from "../google/protobuf/duration";
 import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
 import { Expr } from "../google/type/expr";
-import { Engine, engineFromJSON, engineToJSON, MaskingLevel, maskingLevelFromJSON, maskingLevelToJSON } from "./common";
+import { BackupStorageBackend, backupStorageBackendFromJSON, backupStorageBackendToJSON, Engine, engineFromJSON, engineToJSON, MaskingLevel, maskingLevelFromJSON, maskingLevelToJSON } from "./common";
 import { DeploymentType, deploymentTypeFromJSON, deploymentTypeToJSON } from "./deployment";
 import { IamPolicy } from "./iam_policy";
 
@@ -21,6 +21,9 @@ export enum PolicyType {
   DISABLE_COPY_DATA = 8,
   MASKING_RULE = 9,
   MASKING_EXCEPTION = 10,
+  ROLLOUT_CONCURRENCY = 11,
+  MAINTENANCE_WINDOW = 12,
+  ROW_FILTER = 13,
   UNRECOGNIZED = -1,
 }
 
@@ -56,6 +59,15 @@ export function policyTypeFromJSON(object: any): PolicyType {
     case 10:
     case "MASKING_EXCEPTION":
       return PolicyType.MASKING_EXCEPTION;
+    case 11:
+    case "ROLLOUT_CONCURRENCY":
+      return PolicyType.ROLLOUT_CONCURRENCY;
+    case 12:
+    case "MAINTENANCE_WINDOW":
+      return PolicyType.MAINTENANCE_WINDOW;
+    case 13:
+    case "ROW_FILTER":
+      return PolicyType.ROW_FILTER;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -85,6 +97,12 @@ export function policyTypeToJSON(object: PolicyType): string {
       return "MASKING_RULE";
     case PolicyType.MASKING_EXCEPTION:
       return "MASKING_EXCEPTION";
+    case PolicyType.ROLLOUT_CONCURRENCY:
+      return "ROLLOUT_CONCURRENCY";
+    case PolicyType.MAINTENANCE_WINDOW:
+      return "MAINTENANCE_WINDOW";
+    case PolicyType.ROW_FILTER:
+      return "ROW_FILTER";
     case PolicyType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -436,6 +454,9 @@ export interface Policy {
   disableCopyDataPolicy?: DisableCopyDataPolicy | undefined;
   maskingRulePolicy?: MaskingRulePolicy | undefined;
   maskingExceptionPolicy?: MaskingExceptionPolicy | undefined;
+  rolloutConcurrencyPolicy?: RolloutConcurrencyPolicy | undefined;
+  maintenanceWindowPolicy?: MaintenanceWindowPolicy | undefined;
+  rowFilterPolicy?: RowFilterPolicy | undefined;
   enforce: boolean;
   /** The resource type for the policy. */
   resourceType: PolicyResourceType;
@@ -457,6 +478,11 @@ export interface DeploymentApprovalStrategy {
 export interface BackupPlanPolicy {
   schedule: BackupPlanSchedule;
   retentionDuration: Duration | undefined;
+  /**
+   * The storage backend for the backups of databases in the environment.
+   * If not specified, the default storage backend of the server is used.
+   */
+  storageBackend: BackupStorageBackend;
 }
 
 export interface SlowQueryPolicy {
@@ -563,6 +589,57 @@ export interface MaskingRulePolicy_MaskingRule {
   id: string;
   condition: Expr | undefined;
   maskingLevel: MaskingLevel;
+  /**
+   * masking_algorithm_id is the masking algorithm for the columns hitting the rule.
+   * If it is empty, the algorithm is decided by the semantic type of the column.
+   */
+  maskingAlgorithmId: string;
+}
+
+export interface RolloutConcurrencyPolicy {
+  /** The maximum number of the task runs executing concurrently. 0 means unlimited. */
+  maxRunningTaskRuns: number;
+}
+
+export interface MaintenanceWindowPolicy {
+  /**
+   * The tasks may start during any of the windows.
+   * The tasks may start at any time if there is no window.
+   */
+  windows: MaintenanceWindowPolicy_Window[];
+}
+
+export interface MaintenanceWindowPolicy_Window {
+  /**
+   * The cron expression of the window start in the format of "minute hour day-of-month month day-of-week",
+   * e.g. "0 22 * * 1-5" starts the window at 22:00 from Monday to Friday.
+   */
+  cron: string;
+  /** The duration of the window. */
+  duration:
+    | Duration
+    | undefined;
+  /** The IANA time zone of the cron expression, e.g. "America/New_York". Default to UTC. */
+  timeZone: string;
+}
+
+export interface RowFilterPolicy {
+  /** The rows of a table are filtered by all the rules applying to the table. */
+  rules: RowFilterPolicy_RowFilterRule[];
+}
+
+export interface RowFilterPolicy_RowFilterRule {
+  /** A unique identifier for a node in UUID format. */
+  id: string;
+  /** The condition selects the principals and the tables the rule applies to. */
+  condition:
+    | Expr
+    | undefined;
+  /**
+   * The SQL predicate template filtering the rows of the tables, e.g. "tenant_id = {{user.attribute.tenant}}".
+   * The placeholders {{user.attribute.<key>}} are replaced with the quoted attribute values of the principal set by the workspace owners.
+   */
+  predicate: string;
 }
 
 function createBaseCreatePolicyRequest(): CreatePolicyRequest {
@@ -1050,6 +1127,9 @@ function createBasePolicy(): Policy {
     disableCopyDataPolicy: undefined,
     maskingRulePolicy: undefined,
     maskingExceptionPolicy: undefined,
+    rolloutConcurrencyPolicy: undefined,
+    maintenanceWindowPolicy: undefined,
+    rowFilterPolicy: undefined,
     enforce: false,
     resourceType: 0,
     resourceUid: "",
@@ -1097,6 +1177,15 @@ export const Policy = {
     if (message.maskingExceptionPolicy !== undefined) {
       MaskingExceptionPolicy.encode(message.maskingExceptionPolicy, writer.uint32(146).fork()).ldelim();
     }
+    if (message.rolloutConcurrencyPolicy !== undefined) {
+      RolloutConcurrencyPolicy.encode(message.rolloutConcurrencyPolicy, writer.uint32(154).fork()).ldelim();
+    }
+    if (message.maintenanceWindowPolicy !== undefined) {
+      MaintenanceWindowPolicy.encode(message.maintenanceWindowPolicy, writer.uint32(162).fork()).ldelim();
+    }
+    if (message.rowFilterPolicy !== undefined) {
+      RowFilterPolicy.encode(message.rowFilterPolicy, writer.uint32(170).fork()).ldelim();
+    }
     if (message.enforce === true) {
       writer.uint32(104).bool(message.enforce);
     }
@@ -1207,6 +1296,27 @@ export const Policy = {
 
           message.maskingExceptionPolicy = MaskingExceptionPolicy.decode(reader, reader.uint32());
           continue;
+        case 19:
+          if (tag !== 154) {
+            break;
+          }
+
+          message.rolloutConcurrencyPolicy = RolloutConcurrencyPolicy.decode(reader, reader.uint32());
+          continue;
+        case 20:
+          if (tag !== 162) {
+            break;
+          }
+
+          message.maintenanceWindowPolicy = MaintenanceWindowPolicy.decode(reader, reader.uint32());
+          continue;
+        case 21:
+          if (tag !== 170) {
+            break;
+          }
+
+          message.rowFilterPolicy = RowFilterPolicy.decode(reader, reader.uint32());
+          continue;
         case 13:
           if (tag !== 104) {
             break;
@@ -1260,6 +1370,13 @@ export const Policy = {
       maskingExceptionPolicy: isSet(object.maskingExceptionPolicy)
         ? MaskingExceptionPolicy.fromJSON(object.maskingExceptionPolicy)
         : undefined,
+      rolloutConcurrencyPolicy: isSet(object.rolloutConcurrencyPolicy)
+        ? RolloutConcurrencyPolicy.fromJSON(object.rolloutConcurrencyPolicy)
+        : undefined,
+      maintenanceWindowPolicy: isSet(object.maintenanceWindowPolicy)
+        ? MaintenanceWindowPolicy.fromJSON(object.maintenanceWindowPolicy)
+        : undefined,
+      rowFilterPolicy: isSet(object.rowFilterPolicy) ? RowFilterPolicy.fromJSON(object.rowFilterPolicy) : undefined,
       enforce: isSet(object.enforce) ? Boolean(object.enforce) : false,
       resourceType: isSet(object.resourceType) ? policyResourceTypeFromJSON(object.resourceType) : 0,
       resourceUid: isSet(object.resourceUid) ? String(object.resourceUid) : "",
@@ -1294,6 +1411,14 @@ export const Policy = {
     message.maskingExceptionPolicy !== undefined && (obj.maskingExceptionPolicy = message.maskingExceptionPolicy
       ? MaskingExceptionPolicy.toJSON(message.maskingExceptionPolicy)
       : undefined);
+    message.rolloutConcurrencyPolicy !== undefined && (obj.rolloutConcurrencyPolicy = message.rolloutConcurrencyPolicy
+      ? RolloutConcurrencyPolicy.toJSON(message.rolloutConcurrencyPolicy)
+      : undefined);
+    message.maintenanceWindowPolicy !== undefined && (obj.maintenanceWindowPolicy = message.maintenanceWindowPolicy
+      ? MaintenanceWindowPolicy.toJSON(message.maintenanceWindowPolicy)
+      : undefined);
+    message.rowFilterPolicy !== undefined &&
+      (obj.rowFilterPolicy = message.rowFilterPolicy ? RowFilterPolicy.toJSON(message.rowFilterPolicy) : undefined);
     message.enforce !== undefined && (obj.enforce = message.enforce);
     message.resourceType !== undefined && (obj.resourceType = policyResourceTypeToJSON(message.resourceType));
     message.resourceUid !== undefined && (obj.resourceUid = message.resourceUid);
@@ -1340,6 +1465,17 @@ export const Policy = {
       (object.maskingExceptionPolicy !== undefined && object.maskingExceptionPolicy !== null)
         ? MaskingExceptionPolicy.fromPartial(object.maskingExceptionPolicy)
         : undefined;
+    message.rolloutConcurrencyPolicy =
+      (object.rolloutConcurrencyPolicy !== undefined && object.rolloutConcurrencyPolicy !== null)
+        ? RolloutConcurrencyPolicy.fromPartial(object.rolloutConcurrencyPolicy)
+        : undefined;
+    message.maintenanceWindowPolicy =
+      (object.maintenanceWindowPolicy !== undefined && object.maintenanceWindowPolicy !== null)
+        ? MaintenanceWindowPolicy.fromPartial(object.maintenanceWindowPolicy)
+        : undefined;
+    message.rowFilterPolicy = (object.rowFilterPolicy !== undefined && object.rowFilterPolicy !== null)
+      ? RowFilterPolicy.fromPartial(object.rowFilterPolicy)
+      : undefined;
     message.enforce = object.enforce ?? false;
     message.resourceType = object.resourceType ?? 0;
     message.resourceUid = object.resourceUid ?? "";
@@ -1512,7 +1648,7 @@ export const DeploymentApprovalStrategy = {
 };
 
 function createBaseBackupPlanPolicy(): BackupPlanPolicy {
-  return { schedule: 0, retentionDuration: undefined };
+  return { schedule: 0, retentionDuration: undefined, storageBackend: 0 };
 }
 
 export const BackupPlanPolicy = {
@@ -1523,6 +1659,9 @@ export const BackupPlanPolicy = {
     if (message.retentionDuration !== undefined) {
       Duration.encode(message.retentionDuration, writer.uint32(18).fork()).ldelim();
     }
+    if (message.storageBackend !== 0) {
+      writer.uint32(24).int32(message.storageBackend);
+    }
     return writer;
   },
 
@@ -1547,6 +1686,13 @@ export const BackupPlanPolicy = {
 
           message.retentionDuration = Duration.decode(reader, reader.uint32());
           continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.storageBackend = reader.int32() as any;
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1560,6 +1706,7 @@ export const BackupPlanPolicy = {
     return {
       schedule: isSet(object.schedule) ? backupPlanScheduleFromJSON(object.schedule) : 0,
       retentionDuration: isSet(object.retentionDuration) ? Duration.fromJSON(object.retentionDuration) : undefined,
+      storageBackend: isSet(object.storageBackend) ? backupStorageBackendFromJSON(object.storageBackend) : 0,
     };
   },
 
@@ -1568,6 +1715,7 @@ export const BackupPlanPolicy = {
     message.schedule !== undefined && (obj.schedule = backupPlanScheduleToJSON(message.schedule));
     message.retentionDuration !== undefined &&
       (obj.retentionDuration = message.retentionDuration ? Duration.toJSON(message.retentionDuration) : undefined);
+    message.storageBackend !== undefined && (obj.storageBackend = backupStorageBackendToJSON(message.storageBackend));
     return obj;
   },
 
@@ -1581,6 +1729,7 @@ export const BackupPlanPolicy = {
     message.retentionDuration = (object.retentionDuration !== undefined && object.retentionDuration !== null)
       ? Duration.fromPartial(object.retentionDuration)
       : undefined;
+    message.storageBackend = object.storageBackend ?? 0;
     return message;
   },
 };
@@ -2270,7 +2419,7 @@ export const MaskingRulePolicy = {
 };
 
 function createBaseMaskingRulePolicy_MaskingRule(): MaskingRulePolicy_MaskingRule {
-  return { id: "", condition: undefined, maskingLevel: 0 };
+  return { id: "", condition: undefined, maskingLevel: 0, maskingAlgorithmId: "" };
 }
 
 export const MaskingRulePolicy_MaskingRule = {
@@ -2284,6 +2433,9 @@ export const MaskingRulePolicy_MaskingRule = {
     if (message.maskingLevel !== 0) {
       writer.uint32(24).int32(message.maskingLevel);
     }
+    if (message.maskingAlgorithmId !== "") {
+      writer.uint32(34).string(message.maskingAlgorithmId);
+    }
     return writer;
   },
 
@@ -2315,6 +2467,13 @@ export const MaskingRulePolicy_MaskingRule = {
 
           message.maskingLevel = reader.int32() as any;
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.maskingAlgorithmId = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2329,6 +2488,7 @@ export const MaskingRulePolicy_MaskingRule = {
       id: isSet(object.id) ? String(object.id) : "",
       condition: isSet(object.condition) ? Expr.fromJSON(object.condition) : undefined,
       maskingLevel: isSet(object.maskingLevel) ? maskingLevelFromJSON(object.maskingLevel) : 0,
+      maskingAlgorithmId: isSet(object.maskingAlgorithmId) ? String(object.maskingAlgorithmId) : "",
     };
   },
 
@@ -2337,6 +2497,7 @@ export const MaskingRulePolicy_MaskingRule = {
     message.id !== undefined && (obj.id = message.id);
     message.condition !== undefined && (obj.condition = message.condition ? Expr.toJSON(message.condition) : undefined);
     message.maskingLevel !== undefined && (obj.maskingLevel = maskingLevelToJSON(message.maskingLevel));
+    message.maskingAlgorithmId !== undefined && (obj.maskingAlgorithmId = message.maskingAlgorithmId);
     return obj;
   },
 
@@ -2351,6 +2512,361 @@ export const MaskingRulePolicy_MaskingRule = {
       ? Expr.fromPartial(object.condition)
       : undefined;
     message.maskingLevel = object.maskingLevel ?? 0;
+    message.maskingAlgorithmId = object.maskingAlgorithmId ?? "";
+    return message;
+  },
+};
+
+function createBaseRolloutConcurrencyPolicy(): RolloutConcurrencyPolicy {
+  return { maxRunningTaskRuns: 0 };
+}
+
+export const RolloutConcurrencyPolicy = {
+  encode(message: RolloutConcurrencyPolicy, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.maxRunningTaskRuns !== 0) {
+      writer.uint32(8).int32(message.maxRunningTaskRuns);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RolloutConcurrencyPolicy {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRolloutConcurrencyPolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.maxRunningTaskRuns = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RolloutConcurrencyPolicy {
+    return { maxRunningTaskRuns: isSet(object.maxRunningTaskRuns) ? Number(object.maxRunningTaskRuns) : 0 };
+  },
+
+  toJSON(message: RolloutConcurrencyPolicy): unknown {
+    const obj: any = {};
+    message.maxRunningTaskRuns !== undefined && (obj.maxRunningTaskRuns = Math.round(message.maxRunningTaskRuns));
+    return obj;
+  },
+
+  create(base?: DeepPartial<RolloutConcurrencyPolicy>): RolloutConcurrencyPolicy {
+    return RolloutConcurrencyPolicy.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<RolloutConcurrencyPolicy>): RolloutConcurrencyPolicy {
+    const message = createBaseRolloutConcurrencyPolicy();
+    message.maxRunningTaskRuns = object.maxRunningTaskRuns ?? 0;
+    return message;
+  },
+};
+
+function createBaseMaintenanceWindowPolicy(): MaintenanceWindowPolicy {
+  return { windows: [] };
+}
+
+export const MaintenanceWindowPolicy = {
+  encode(message: MaintenanceWindowPolicy, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.windows) {
+      MaintenanceWindowPolicy_Window.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaintenanceWindowPolicy {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaintenanceWindowPolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.windows.push(MaintenanceWindowPolicy_Window.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaintenanceWindowPolicy {
+    return {
+      windows: Array.isArray(object?.windows)
+        ? object.windows.map((e: any) => MaintenanceWindowPolicy_Window.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: MaintenanceWindowPolicy): unknown {
+    const obj: any = {};
+    if (message.windows) {
+      obj.windows = message.windows.map((e) => e ? MaintenanceWindowPolicy_Window.toJSON(e) : undefined);
+    } else {
+      obj.windows = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<MaintenanceWindowPolicy>): MaintenanceWindowPolicy {
+    return MaintenanceWindowPolicy.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<MaintenanceWindowPolicy>): MaintenanceWindowPolicy {
+    const message = createBaseMaintenanceWindowPolicy();
+    message.windows = object.windows?.map((e) => MaintenanceWindowPolicy_Window.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseMaintenanceWindowPolicy_Window(): MaintenanceWindowPolicy_Window {
+  return { cron: "", duration: undefined, timeZone: "" };
+}
+
+export const MaintenanceWindowPolicy_Window = {
+  encode(message: MaintenanceWindowPolicy_Window, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.cron !== "") {
+      writer.uint32(10).string(message.cron);
+    }
+    if (message.duration !== undefined) {
+      Duration.encode(message.duration, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.timeZone !== "") {
+      writer.uint32(26).string(message.timeZone);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaintenanceWindowPolicy_Window {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaintenanceWindowPolicy_Window();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.cron = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.duration = Duration.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.timeZone = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaintenanceWindowPolicy_Window {
+    return {
+      cron: isSet(object.cron) ? String(object.cron) : "",
+      duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
+      timeZone: isSet(object.timeZone) ? String(object.timeZone) : "",
+    };
+  },
+
+  toJSON(message: MaintenanceWindowPolicy_Window): unknown {
+    const obj: any = {};
+    message.cron !== undefined && (obj.cron = message.cron);
+    message.duration !== undefined && (obj.duration = message.duration ? Duration.toJSON(message.duration) : undefined);
+    message.timeZone !== undefined && (obj.timeZone = message.timeZone);
+    return obj;
+  },
+
+  create(base?: DeepPartial<MaintenanceWindowPolicy_Window>): MaintenanceWindowPolicy_Window {
+    return MaintenanceWindowPolicy_Window.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<MaintenanceWindowPolicy_Window>): MaintenanceWindowPolicy_Window {
+    const message = createBaseMaintenanceWindowPolicy_Window();
+    message.cron = object.cron ?? "";
+    message.duration = (object.duration !== undefined && object.duration !== null)
+      ? Duration.fromPartial(object.duration)
+      : undefined;
+    message.timeZone = object.timeZone ?? "";
+    return message;
+  },
+};
+
+function createBaseRowFilterPolicy(): RowFilterPolicy {
+  return { rules: [] };
+}
+
+export const RowFilterPolicy = {
+  encode(message: RowFilterPolicy, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.rules) {
+      RowFilterPolicy_RowFilterRule.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RowFilterPolicy {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRowFilterPolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.rules.push(RowFilterPolicy_RowFilterRule.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RowFilterPolicy {
+    return {
+      rules: Array.isArray(object?.rules) ? object.rules.map((e: any) => RowFilterPolicy_RowFilterRule.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: RowFilterPolicy): unknown {
+    const obj: any = {};
+    if (message.rules) {
+      obj.rules = message.rules.map((e) => e ? RowFilterPolicy_RowFilterRule.toJSON(e) : undefined);
+    } else {
+      obj.rules = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RowFilterPolicy>): RowFilterPolicy {
+    return RowFilterPolicy.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<RowFilterPolicy>): RowFilterPolicy {
+    const message = createBaseRowFilterPolicy();
+    message.rules = object.rules?.map((e) => RowFilterPolicy_RowFilterRule.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRowFilterPolicy_RowFilterRule(): RowFilterPolicy_RowFilterRule {
+  return { id: "", condition: undefined, predicate: "" };
+}
+
+export const RowFilterPolicy_RowFilterRule = {
+  encode(message: RowFilterPolicy_RowFilterRule, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.condition !== undefined) {
+      Expr.encode(message.condition, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.predicate !== "") {
+      writer.uint32(26).string(message.predicate);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RowFilterPolicy_RowFilterRule {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRowFilterPolicy_RowFilterRule();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.condition = Expr.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.predicate = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RowFilterPolicy_RowFilterRule {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      condition: isSet(object.condition) ? Expr.fromJSON(object.condition) : undefined,
+      predicate: isSet(object.predicate) ? String(object.predicate) : "",
+    };
+  },
+
+  toJSON(message: RowFilterPolicy_RowFilterRule): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.condition !== undefined && (obj.condition = message.condition ? Expr.toJSON(message.condition) : undefined);
+    message.predicate !== undefined && (obj.predicate = message.predicate);
+    return obj;
+  },
+
+  create(base?: DeepPartial<RowFilterPolicy_RowFilterRule>): RowFilterPolicy_RowFilterRule {
+    return RowFilterPolicy_RowFilterRule.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<RowFilterPolicy_RowFilterRule>): RowFilterPolicy_RowFilterRule {
+    const message = createBaseRowFilterPolicy_RowFilterRule();
+    message.id = object.id ?? "";
+    message.condition = (object.condition !== undefined && object.condition !== null)
+      ? Expr.fromPartial(object.condition)
+      : undefined;
+    message.predicate = object.predicate ?? "";
     return message;
   },
 };
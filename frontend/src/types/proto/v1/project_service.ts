@@ -2,6 +2,7 @@
 import _m0 from "protobufjs/minimal";
 import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
+import { Timestamp } from "../google/protobuf/timestamp";
 import { Expr } from "../google/type/expr";
 import { State, stateFromJSON, stateToJSON } from "./common";
 import { ProjectGitOpsInfo } from "./externalvs_service";
@@ -598,6 +599,116 @@ export interface TestWebhookResponse {
   error: string;
 }
 
+export interface ListWebhookDeliveriesRequest {
+  /**
+   * The parent webhook of the deliveries.
+   * Format: projects/{project}/webhooks/{webhook}
+   */
+  parent: string;
+  /**
+   * The maximum number of deliveries to return. The service may return fewer than
+   * this value.
+   * If unspecified, at most 10 deliveries will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   */
+  pageSize: number;
+  /**
+   * A page token, received from a previous `ListWebhookDeliveries` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * When paginating, all other parameters provided to `ListWebhookDeliveries` must match
+   * the call that provided the page token.
+   */
+  pageToken: string;
+}
+
+export interface ListWebhookDeliveriesResponse {
+  /** The deliveries of the webhook, from the newest to the oldest. */
+  deliveries: WebhookDelivery[];
+  /**
+   * A token, which can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   */
+  nextPageToken: string;
+}
+
+export interface RedeliverWebhookRequest {
+  /**
+   * The delivery to redeliver, the payload is delivered again as a new delivery.
+   * Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+   */
+  name: string;
+}
+
+export interface WebhookDelivery {
+  /**
+   * The name of the delivery.
+   * Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+   */
+  name: string;
+  status: WebhookDelivery_Status;
+  /** The webhook event in JSON. */
+  payload: string;
+  /** The number of attempts. */
+  attempt: number;
+  /** The time of the next attempt if the status is PENDING. */
+  nextAttemptTime:
+    | Date
+    | undefined;
+  /** The error of the last attempt, empty if the last attempt succeeded. */
+  lastError: string;
+  createTime: Date | undefined;
+  updateTime: Date | undefined;
+}
+
+export enum WebhookDelivery_Status {
+  STATUS_UNSPECIFIED = 0,
+  /** PENDING - The delivery is waiting for the next attempt. */
+  PENDING = 1,
+  /** DONE - The delivery is delivered. */
+  DONE = 2,
+  /** DEAD - The delivery failed after the max attempts. */
+  DEAD = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function webhookDelivery_StatusFromJSON(object: any): WebhookDelivery_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return WebhookDelivery_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "PENDING":
+      return WebhookDelivery_Status.PENDING;
+    case 2:
+    case "DONE":
+      return WebhookDelivery_Status.DONE;
+    case 3:
+    case "DEAD":
+      return WebhookDelivery_Status.DEAD;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return WebhookDelivery_Status.UNRECOGNIZED;
+  }
+}
+
+export function webhookDelivery_StatusToJSON(object: WebhookDelivery_Status): string {
+  switch (object) {
+    case WebhookDelivery_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case WebhookDelivery_Status.PENDING:
+      return "PENDING";
+    case WebhookDelivery_Status.DONE:
+      return "DONE";
+    case WebhookDelivery_Status.DEAD:
+      return "DEAD";
+    case WebhookDelivery_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 export interface Webhook {
   /**
    * name is the name of the webhook, generated by the server.
@@ -622,6 +733,27 @@ export interface Webhook {
    * - TYPE_ISSUE_COMMENT_CREAT
    */
   notificationTypes: Activity_Type[];
+  /**
+   * secret is the key to sign the request body with HMAC-SHA256, the signature is sent in the
+   * X-Bytebase-Signature-256 header in the format of "sha256={hex}".
+   * Bytebase generates a random secret if it is not set on creation.
+   * It is only returned in the response of AddWebhook, and an empty secret on update keeps the existing one.
+   */
+  secret: string;
+  /**
+   * event_filter is the CEL expression to filter the events sent to the webhook, in addition to notification_types.
+   * The webhook receives all events of the notification types if it is empty.
+   * The following variables are supported:
+   * - event_type: the activity type, e.g. "bb.issue.create".
+   * - level: one of "INFO", "SUCCESS", "WARN" and "ERROR".
+   * - issue_type: the issue type, e.g. "bb.issue.database.general".
+   * - task_status: the status of the task, e.g. "FAILED".
+   * - environment_ids: the environment resource IDs of the affected databases.
+   * - database_names: the affected databases in the format of "instances/{instance}/databases/{database}".
+   * For example:
+   * level == "ERROR" && "prod" in environment_ids
+   */
+  eventFilter: string;
 }
 
 export enum Webhook_Type {
@@ -3214,8 +3346,381 @@ export const TestWebhookResponse = {
   },
 };
 
+function createBaseListWebhookDeliveriesRequest(): ListWebhookDeliveriesRequest {
+  return { parent: "", pageSize: 0, pageToken: "" };
+}
+
+export const ListWebhookDeliveriesRequest = {
+  encode(message: ListWebhookDeliveriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListWebhookDeliveriesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListWebhookDeliveriesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListWebhookDeliveriesRequest {
+    return {
+      parent: isSet(object.parent) ? String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? String(object.pageToken) : "",
+    };
+  },
+
+  toJSON(message: ListWebhookDeliveriesRequest): unknown {
+    const obj: any = {};
+    message.parent !== undefined && (obj.parent = message.parent);
+    message.pageSize !== undefined && (obj.pageSize = Math.round(message.pageSize));
+    message.pageToken !== undefined && (obj.pageToken = message.pageToken);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListWebhookDeliveriesRequest>): ListWebhookDeliveriesRequest {
+    return ListWebhookDeliveriesRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListWebhookDeliveriesRequest>): ListWebhookDeliveriesRequest {
+    const message = createBaseListWebhookDeliveriesRequest();
+    message.parent = object.parent ?? "";
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseListWebhookDeliveriesResponse(): ListWebhookDeliveriesResponse {
+  return { deliveries: [], nextPageToken: "" };
+}
+
+export const ListWebhookDeliveriesResponse = {
+  encode(message: ListWebhookDeliveriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.deliveries) {
+      WebhookDelivery.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListWebhookDeliveriesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListWebhookDeliveriesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.deliveries.push(WebhookDelivery.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListWebhookDeliveriesResponse {
+    return {
+      deliveries: Array.isArray(object?.deliveries) ? object.deliveries.map((e: any) => WebhookDelivery.fromJSON(e)) : [],
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
+    };
+  },
+
+  toJSON(message: ListWebhookDeliveriesResponse): unknown {
+    const obj: any = {};
+    if (message.deliveries) {
+      obj.deliveries = message.deliveries.map((e) => e ? WebhookDelivery.toJSON(e) : undefined);
+    } else {
+      obj.deliveries = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListWebhookDeliveriesResponse>): ListWebhookDeliveriesResponse {
+    return ListWebhookDeliveriesResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListWebhookDeliveriesResponse>): ListWebhookDeliveriesResponse {
+    const message = createBaseListWebhookDeliveriesResponse();
+    message.deliveries = object.deliveries?.map((e) => WebhookDelivery.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseRedeliverWebhookRequest(): RedeliverWebhookRequest {
+  return { name: "" };
+}
+
+export const RedeliverWebhookRequest = {
+  encode(message: RedeliverWebhookRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RedeliverWebhookRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRedeliverWebhookRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RedeliverWebhookRequest {
+    return { name: isSet(object.name) ? String(object.name) : "" };
+  },
+
+  toJSON(message: RedeliverWebhookRequest): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    return obj;
+  },
+
+  create(base?: DeepPartial<RedeliverWebhookRequest>): RedeliverWebhookRequest {
+    return RedeliverWebhookRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<RedeliverWebhookRequest>): RedeliverWebhookRequest {
+    const message = createBaseRedeliverWebhookRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseWebhookDelivery(): WebhookDelivery {
+  return {
+    name: "",
+    status: 0,
+    payload: "",
+    attempt: 0,
+    nextAttemptTime: undefined,
+    lastError: "",
+    createTime: undefined,
+    updateTime: undefined,
+  };
+}
+
+export const WebhookDelivery = {
+  encode(message: WebhookDelivery, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.status !== 0) {
+      writer.uint32(16).int32(message.status);
+    }
+    if (message.payload !== "") {
+      writer.uint32(26).string(message.payload);
+    }
+    if (message.attempt !== 0) {
+      writer.uint32(32).int32(message.attempt);
+    }
+    if (message.nextAttemptTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.nextAttemptTime), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.lastError !== "") {
+      writer.uint32(50).string(message.lastError);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(58).fork()).ldelim();
+    }
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(66).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WebhookDelivery {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWebhookDelivery();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.payload = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.attempt = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.nextAttemptTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.lastError = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WebhookDelivery {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      status: isSet(object.status) ? webhookDelivery_StatusFromJSON(object.status) : 0,
+      payload: isSet(object.payload) ? String(object.payload) : "",
+      attempt: isSet(object.attempt) ? Number(object.attempt) : 0,
+      nextAttemptTime: isSet(object.nextAttemptTime) ? fromJsonTimestamp(object.nextAttemptTime) : undefined,
+      lastError: isSet(object.lastError) ? String(object.lastError) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+    };
+  },
+
+  toJSON(message: WebhookDelivery): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.status !== undefined && (obj.status = webhookDelivery_StatusToJSON(message.status));
+    message.payload !== undefined && (obj.payload = message.payload);
+    message.attempt !== undefined && (obj.attempt = Math.round(message.attempt));
+    message.nextAttemptTime !== undefined && (obj.nextAttemptTime = message.nextAttemptTime.toISOString());
+    message.lastError !== undefined && (obj.lastError = message.lastError);
+    message.createTime !== undefined && (obj.createTime = message.createTime.toISOString());
+    message.updateTime !== undefined && (obj.updateTime = message.updateTime.toISOString());
+    return obj;
+  },
+
+  create(base?: DeepPartial<WebhookDelivery>): WebhookDelivery {
+    return WebhookDelivery.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<WebhookDelivery>): WebhookDelivery {
+    const message = createBaseWebhookDelivery();
+    message.name = object.name ?? "";
+    message.status = object.status ?? 0;
+    message.payload = object.payload ?? "";
+    message.attempt = object.attempt ?? 0;
+    message.nextAttemptTime = object.nextAttemptTime ?? undefined;
+    message.lastError = object.lastError ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.updateTime = object.updateTime ?? undefined;
+    return message;
+  },
+};
+
 function createBaseWebhook(): Webhook {
-  return { name: "", type: 0, title: "", url: "", notificationTypes: [] };
+  return { name: "", type: 0, title: "", url: "", notificationTypes: [], secret: "", eventFilter: "" };
 }
 
 export const Webhook = {
@@ -3237,6 +3742,12 @@ export const Webhook = {
       writer.int32(v);
     }
     writer.ldelim();
+    if (message.secret !== "") {
+      writer.uint32(50).string(message.secret);
+    }
+    if (message.eventFilter !== "") {
+      writer.uint32(58).string(message.eventFilter);
+    }
     return writer;
   },
 
@@ -3292,6 +3803,20 @@ export const Webhook = {
           }
 
           break;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.secret = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.eventFilter = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3310,6 +3835,8 @@ export const Webhook = {
       notificationTypes: Array.isArray(object?.notificationTypes)
         ? object.notificationTypes.map((e: any) => activity_TypeFromJSON(e))
         : [],
+      secret: isSet(object.secret) ? String(object.secret) : "",
+      eventFilter: isSet(object.eventFilter) ? String(object.eventFilter) : "",
     };
   },
 
@@ -3324,6 +3851,8 @@ export const Webhook = {
     } else {
       obj.notificationTypes = [];
     }
+    message.secret !== undefined && (obj.secret = message.secret);
+    message.eventFilter !== undefined && (obj.eventFilter = message.eventFilter);
     return obj;
   },
 
@@ -3338,6 +3867,8 @@ export const Webhook = {
     message.title = object.title ?? "";
     message.url = object.url ?? "";
     message.notificationTypes = object.notificationTypes?.map((e) => e) || [];
+    message.secret = object.secret ?? "";
+    message.eventFilter = object.eventFilter ?? "";
     return message;
   },
 };
@@ -6323,6 +6854,147 @@ export const ProjectServiceDefinition = {
         },
       },
     },
+    listWebhookDeliveries: {
+      name: "ListWebhookDeliveries",
+      requestType: ListWebhookDeliveriesRequest,
+      requestStream: false,
+      responseType: ListWebhookDeliveriesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          578365826: [
+            new Uint8Array([
+              47,
+              18,
+              45,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              119,
+              101,
+              98,
+              104,
+              111,
+              111,
+              107,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              101,
+              108,
+              105,
+              118,
+              101,
+              114,
+              105,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    redeliverWebhook: {
+      name: "RedeliverWebhook",
+      requestType: RedeliverWebhookRequest,
+      requestStream: false,
+      responseType: WebhookDelivery,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          578365826: [
+            new Uint8Array([
+              60,
+              58,
+              1,
+              42,
+              34,
+              55,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              119,
+              101,
+              98,
+              104,
+              111,
+              111,
+              107,
+              115,
+              47,
+              42,
+              47,
+              100,
+              101,
+              108,
+              105,
+              118,
+              101,
+              114,
+              105,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              114,
+              101,
+              100,
+              101,
+              108,
+              105,
+              118,
+              101,
+              114,
+            ]),
+          ],
+        },
+      },
+    },
     updateProjectGitOpsInfo: {
       name: "UpdateProjectGitOpsInfo",
       requestType: UpdateProjectGitOpsInfoRequest,
@@ -7546,6 +8218,28 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
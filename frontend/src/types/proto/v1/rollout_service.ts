@@ -171,6 +171,8 @@ export interface Plan_ChangeDatabaseConfig {
   /** If RollbackEnabled, build the RollbackSheetID of the task. */
   rollbackEnabled: boolean;
   rollbackDetail?: Plan_ChangeDatabaseConfig_RollbackDetail | undefined;
+  /** The DML change is executed in batches if batch_config is set. */
+  batchConfig?: Plan_ChangeDatabaseConfig_BatchConfig | undefined;
 }
 
 /** Type is the database change type. */
@@ -192,6 +194,11 @@ export enum Plan_ChangeDatabaseConfig_Type {
   BRANCH = 5,
   /** DATA - Used for DML change. */
   DATA = 6,
+  /**
+   * MIGRATE_PG_ONLINE - Used for DDL changes on PostgreSQL tables via a shadow table synced by triggers.
+   * The original table is kept as "_<table>_old" after the cutover, and should be dropped in a later change.
+   */
+  MIGRATE_PG_ONLINE = 7,
   UNRECOGNIZED = -1,
 }
 
@@ -218,6 +225,9 @@ export function plan_ChangeDatabaseConfig_TypeFromJSON(object: any): Plan_Change
     case 6:
     case "DATA":
       return Plan_ChangeDatabaseConfig_Type.DATA;
+    case 7:
+    case "MIGRATE_PG_ONLINE":
+      return Plan_ChangeDatabaseConfig_Type.MIGRATE_PG_ONLINE;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -241,6 +251,8 @@ export function plan_ChangeDatabaseConfig_TypeToJSON(object: Plan_ChangeDatabase
       return "BRANCH";
     case Plan_ChangeDatabaseConfig_Type.DATA:
       return "DATA";
+    case Plan_ChangeDatabaseConfig_Type.MIGRATE_PG_ONLINE:
+      return "MIGRATE_PG_ONLINE";
     case Plan_ChangeDatabaseConfig_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -260,6 +272,24 @@ export interface Plan_ChangeDatabaseConfig_RollbackDetail {
   rollbackFromIssue: string;
 }
 
+/**
+ * BatchConfig executes a single-table UPDATE or DELETE statement in batches of the primary key ranges.
+ * Each batch is committed separately, so that the execution can resume from the last committed batch.
+ * The batches are executed at least once. A batch committed right before an interruption is executed again on resuming,
+ * so that the statement must be idempotent, e.g. "SET a = a + 1" is not.
+ */
+export interface Plan_ChangeDatabaseConfig_BatchConfig {
+  /** The number of rows in the primary key range of a batch. */
+  batchSize: number;
+  /** The maximum number of rows changed per second. There is no limit if it's zero. */
+  maxRowsPerSecond: number;
+  /**
+   * The execution is paused while the replication lag exceeds max_replication_lag_seconds.
+   * There is no limit if it's zero.
+   */
+  maxReplicationLagSeconds: number;
+}
+
 export interface Plan_RestoreDatabaseConfig {
   /**
    * The resource name of the target to restore.
@@ -396,6 +426,7 @@ export enum PlanCheckRun_Type {
   DATABASE_CONNECT = 6,
   DATABASE_GHOST_SYNC = 7,
   DATABASE_PITR_MYSQL = 8,
+  DATABASE_PG_ONLINE_SYNC = 9,
   UNRECOGNIZED = -1,
 }
 
@@ -428,6 +459,9 @@ export function planCheckRun_TypeFromJSON(object: any): PlanCheckRun_Type {
     case 8:
     case "DATABASE_PITR_MYSQL":
       return PlanCheckRun_Type.DATABASE_PITR_MYSQL;
+    case 9:
+    case "DATABASE_PG_ONLINE_SYNC":
+      return PlanCheckRun_Type.DATABASE_PG_ONLINE_SYNC;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -455,6 +489,8 @@ export function planCheckRun_TypeToJSON(object: PlanCheckRun_Type): string {
       return "DATABASE_GHOST_SYNC";
     case PlanCheckRun_Type.DATABASE_PITR_MYSQL:
       return "DATABASE_PITR_MYSQL";
+    case PlanCheckRun_Type.DATABASE_PG_ONLINE_SYNC:
+      return "DATABASE_PG_ONLINE_SYNC";
     case PlanCheckRun_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -795,6 +831,10 @@ export enum Task_Type {
   DATABASE_RESTORE_RESTORE = 10,
   /** DATABASE_RESTORE_CUTOVER - use payload nil */
   DATABASE_RESTORE_CUTOVER = 11,
+  /** DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC - use payload DatabaseSchemaUpdate */
+  DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC = 12,
+  /** DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER - use payload nil */
+  DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER = 13,
   UNRECOGNIZED = -1,
 }
 
@@ -836,6 +876,12 @@ export function task_TypeFromJSON(object: any): Task_Type {
     case 11:
     case "DATABASE_RESTORE_CUTOVER":
       return Task_Type.DATABASE_RESTORE_CUTOVER;
+    case 12:
+    case "DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC":
+      return Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC;
+    case 13:
+    case "DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER":
+      return Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -869,6 +915,10 @@ export function task_TypeToJSON(object: Task_Type): string {
       return "DATABASE_RESTORE_RESTORE";
     case Task_Type.DATABASE_RESTORE_CUTOVER:
       return "DATABASE_RESTORE_CUTOVER";
+    case Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC:
+      return "DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC";
+    case Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER:
+      return "DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER";
     case Task_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -1035,6 +1085,8 @@ export interface TaskRun {
    */
   changeHistory: string;
   schemaVersion: string;
+  /** The scheduler info is set if the task run is waiting to execute. */
+  schedulerInfo: TaskRun_SchedulerInfo | undefined;
 }
 
 export enum TaskRun_Status {
@@ -1094,6 +1146,29 @@ export function taskRun_StatusToJSON(object: TaskRun_Status): string {
   }
 }
 
+export interface TaskRun_SchedulerInfo {
+  reportTime: Date | undefined;
+  waitingCause: TaskRun_SchedulerInfo_WaitingCause | undefined;
+}
+
+export interface TaskRun_SchedulerInfo_WaitingCause {
+  /**
+   * The name of the resource whose concurrency limit is reached.
+   * Format: instances/{instance}, environments/{environment} or projects/{project}.
+   */
+  concurrencyLimit?: string | undefined;
+  /** The task run is waiting for the maintenance window. */
+  maintenanceWindow?: TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow | undefined;
+}
+
+export interface TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow {
+  /**
+   * The start time of the next maintenance window.
+   * It is unset if there is no next maintenance window.
+   */
+  nextWindowTime: Date | undefined;
+}
+
 function createBaseGetPlanRequest(): GetPlanRequest {
   return { name: "" };
 }
@@ -2048,7 +2123,15 @@ export const Plan_CreateDatabaseConfig_LabelsEntry = {
 };
 
 function createBasePlan_ChangeDatabaseConfig(): Plan_ChangeDatabaseConfig {
-  return { target: "", sheet: "", type: 0, schemaVersion: "", rollbackEnabled: false, rollbackDetail: undefined };
+  return {
+    target: "",
+    sheet: "",
+    type: 0,
+    schemaVersion: "",
+    rollbackEnabled: false,
+    rollbackDetail: undefined,
+    batchConfig: undefined,
+  };
 }
 
 export const Plan_ChangeDatabaseConfig = {
@@ -2071,6 +2154,9 @@ export const Plan_ChangeDatabaseConfig = {
     if (message.rollbackDetail !== undefined) {
       Plan_ChangeDatabaseConfig_RollbackDetail.encode(message.rollbackDetail, writer.uint32(50).fork()).ldelim();
     }
+    if (message.batchConfig !== undefined) {
+      Plan_ChangeDatabaseConfig_BatchConfig.encode(message.batchConfig, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2123,6 +2209,13 @@ export const Plan_ChangeDatabaseConfig = {
 
           message.rollbackDetail = Plan_ChangeDatabaseConfig_RollbackDetail.decode(reader, reader.uint32());
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.batchConfig = Plan_ChangeDatabaseConfig_BatchConfig.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2142,6 +2235,9 @@ export const Plan_ChangeDatabaseConfig = {
       rollbackDetail: isSet(object.rollbackDetail)
         ? Plan_ChangeDatabaseConfig_RollbackDetail.fromJSON(object.rollbackDetail)
         : undefined,
+      batchConfig: isSet(object.batchConfig)
+        ? Plan_ChangeDatabaseConfig_BatchConfig.fromJSON(object.batchConfig)
+        : undefined,
     };
   },
 
@@ -2155,6 +2251,9 @@ export const Plan_ChangeDatabaseConfig = {
     message.rollbackDetail !== undefined && (obj.rollbackDetail = message.rollbackDetail
       ? Plan_ChangeDatabaseConfig_RollbackDetail.toJSON(message.rollbackDetail)
       : undefined);
+    message.batchConfig !== undefined && (obj.batchConfig = message.batchConfig
+      ? Plan_ChangeDatabaseConfig_BatchConfig.toJSON(message.batchConfig)
+      : undefined);
     return obj;
   },
 
@@ -2172,6 +2271,9 @@ export const Plan_ChangeDatabaseConfig = {
     message.rollbackDetail = (object.rollbackDetail !== undefined && object.rollbackDetail !== null)
       ? Plan_ChangeDatabaseConfig_RollbackDetail.fromPartial(object.rollbackDetail)
       : undefined;
+    message.batchConfig = (object.batchConfig !== undefined && object.batchConfig !== null)
+      ? Plan_ChangeDatabaseConfig_BatchConfig.fromPartial(object.batchConfig)
+      : undefined;
     return message;
   },
 };
@@ -2247,6 +2349,91 @@ export const Plan_ChangeDatabaseConfig_RollbackDetail = {
   },
 };
 
+function createBasePlan_ChangeDatabaseConfig_BatchConfig(): Plan_ChangeDatabaseConfig_BatchConfig {
+  return { batchSize: 0, maxRowsPerSecond: 0, maxReplicationLagSeconds: 0 };
+}
+
+export const Plan_ChangeDatabaseConfig_BatchConfig = {
+  encode(message: Plan_ChangeDatabaseConfig_BatchConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.batchSize !== 0) {
+      writer.uint32(8).int32(message.batchSize);
+    }
+    if (message.maxRowsPerSecond !== 0) {
+      writer.uint32(16).int32(message.maxRowsPerSecond);
+    }
+    if (message.maxReplicationLagSeconds !== 0) {
+      writer.uint32(24).int32(message.maxReplicationLagSeconds);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_ChangeDatabaseConfig_BatchConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlan_ChangeDatabaseConfig_BatchConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.batchSize = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.maxRowsPerSecond = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.maxReplicationLagSeconds = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Plan_ChangeDatabaseConfig_BatchConfig {
+    return {
+      batchSize: isSet(object.batchSize) ? Number(object.batchSize) : 0,
+      maxRowsPerSecond: isSet(object.maxRowsPerSecond) ? Number(object.maxRowsPerSecond) : 0,
+      maxReplicationLagSeconds: isSet(object.maxReplicationLagSeconds) ? Number(object.maxReplicationLagSeconds) : 0,
+    };
+  },
+
+  toJSON(message: Plan_ChangeDatabaseConfig_BatchConfig): unknown {
+    const obj: any = {};
+    message.batchSize !== undefined && (obj.batchSize = Math.round(message.batchSize));
+    message.maxRowsPerSecond !== undefined && (obj.maxRowsPerSecond = Math.round(message.maxRowsPerSecond));
+    message.maxReplicationLagSeconds !== undefined &&
+      (obj.maxReplicationLagSeconds = Math.round(message.maxReplicationLagSeconds));
+    return obj;
+  },
+
+  create(base?: DeepPartial<Plan_ChangeDatabaseConfig_BatchConfig>): Plan_ChangeDatabaseConfig_BatchConfig {
+    return Plan_ChangeDatabaseConfig_BatchConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<Plan_ChangeDatabaseConfig_BatchConfig>): Plan_ChangeDatabaseConfig_BatchConfig {
+    const message = createBasePlan_ChangeDatabaseConfig_BatchConfig();
+    message.batchSize = object.batchSize ?? 0;
+    message.maxRowsPerSecond = object.maxRowsPerSecond ?? 0;
+    message.maxReplicationLagSeconds = object.maxReplicationLagSeconds ?? 0;
+    return message;
+  },
+};
+
 function createBasePlan_RestoreDatabaseConfig(): Plan_RestoreDatabaseConfig {
   return { target: "", createDatabaseConfig: undefined, backup: undefined, pointInTime: undefined };
 }
@@ -5058,6 +5245,7 @@ function createBaseTaskRun(): TaskRun {
     detail: "",
     changeHistory: "",
     schemaVersion: "",
+    schedulerInfo: undefined,
   };
 }
 
@@ -5096,6 +5284,9 @@ export const TaskRun = {
     if (message.schemaVersion !== "") {
       writer.uint32(90).string(message.schemaVersion);
     }
+    if (message.schedulerInfo !== undefined) {
+      TaskRun_SchedulerInfo.encode(message.schedulerInfo, writer.uint32(98).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -5183,6 +5374,13 @@ export const TaskRun = {
 
           message.schemaVersion = reader.string();
           continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.schedulerInfo = TaskRun_SchedulerInfo.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -5205,6 +5403,7 @@ export const TaskRun = {
       detail: isSet(object.detail) ? String(object.detail) : "",
       changeHistory: isSet(object.changeHistory) ? String(object.changeHistory) : "",
       schemaVersion: isSet(object.schemaVersion) ? String(object.schemaVersion) : "",
+      schedulerInfo: isSet(object.schedulerInfo) ? TaskRun_SchedulerInfo.fromJSON(object.schedulerInfo) : undefined,
     };
   },
 
@@ -5221,6 +5420,8 @@ export const TaskRun = {
     message.detail !== undefined && (obj.detail = message.detail);
     message.changeHistory !== undefined && (obj.changeHistory = message.changeHistory);
     message.schemaVersion !== undefined && (obj.schemaVersion = message.schemaVersion);
+    message.schedulerInfo !== undefined &&
+      (obj.schedulerInfo = message.schedulerInfo ? TaskRun_SchedulerInfo.toJSON(message.schedulerInfo) : undefined);
     return obj;
   },
 
@@ -5241,6 +5442,231 @@ export const TaskRun = {
     message.detail = object.detail ?? "";
     message.changeHistory = object.changeHistory ?? "";
     message.schemaVersion = object.schemaVersion ?? "";
+    message.schedulerInfo = (object.schedulerInfo !== undefined && object.schedulerInfo !== null)
+      ? TaskRun_SchedulerInfo.fromPartial(object.schedulerInfo)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseTaskRun_SchedulerInfo(): TaskRun_SchedulerInfo {
+  return { reportTime: undefined, waitingCause: undefined };
+}
+
+export const TaskRun_SchedulerInfo = {
+  encode(message: TaskRun_SchedulerInfo, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.reportTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.reportTime), writer.uint32(10).fork()).ldelim();
+    }
+    if (message.waitingCause !== undefined) {
+      TaskRun_SchedulerInfo_WaitingCause.encode(message.waitingCause, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TaskRun_SchedulerInfo {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTaskRun_SchedulerInfo();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.reportTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.waitingCause = TaskRun_SchedulerInfo_WaitingCause.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TaskRun_SchedulerInfo {
+    return {
+      reportTime: isSet(object.reportTime) ? fromJsonTimestamp(object.reportTime) : undefined,
+      waitingCause: isSet(object.waitingCause)
+        ? TaskRun_SchedulerInfo_WaitingCause.fromJSON(object.waitingCause)
+        : undefined,
+    };
+  },
+
+  toJSON(message: TaskRun_SchedulerInfo): unknown {
+    const obj: any = {};
+    message.reportTime !== undefined && (obj.reportTime = message.reportTime.toISOString());
+    message.waitingCause !== undefined &&
+      (obj.waitingCause = message.waitingCause
+        ? TaskRun_SchedulerInfo_WaitingCause.toJSON(message.waitingCause)
+        : undefined);
+    return obj;
+  },
+
+  create(base?: DeepPartial<TaskRun_SchedulerInfo>): TaskRun_SchedulerInfo {
+    return TaskRun_SchedulerInfo.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<TaskRun_SchedulerInfo>): TaskRun_SchedulerInfo {
+    const message = createBaseTaskRun_SchedulerInfo();
+    message.reportTime = object.reportTime ?? undefined;
+    message.waitingCause = (object.waitingCause !== undefined && object.waitingCause !== null)
+      ? TaskRun_SchedulerInfo_WaitingCause.fromPartial(object.waitingCause)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseTaskRun_SchedulerInfo_WaitingCause(): TaskRun_SchedulerInfo_WaitingCause {
+  return { concurrencyLimit: undefined, maintenanceWindow: undefined };
+}
+
+export const TaskRun_SchedulerInfo_WaitingCause = {
+  encode(message: TaskRun_SchedulerInfo_WaitingCause, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.concurrencyLimit !== undefined) {
+      writer.uint32(10).string(message.concurrencyLimit);
+    }
+    if (message.maintenanceWindow !== undefined) {
+      TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow.encode(message.maintenanceWindow, writer.uint32(18).fork())
+        .ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TaskRun_SchedulerInfo_WaitingCause {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTaskRun_SchedulerInfo_WaitingCause();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.concurrencyLimit = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.maintenanceWindow = TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TaskRun_SchedulerInfo_WaitingCause {
+    return {
+      concurrencyLimit: isSet(object.concurrencyLimit) ? String(object.concurrencyLimit) : undefined,
+      maintenanceWindow: isSet(object.maintenanceWindow)
+        ? TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow.fromJSON(object.maintenanceWindow)
+        : undefined,
+    };
+  },
+
+  toJSON(message: TaskRun_SchedulerInfo_WaitingCause): unknown {
+    const obj: any = {};
+    message.concurrencyLimit !== undefined && (obj.concurrencyLimit = message.concurrencyLimit);
+    message.maintenanceWindow !== undefined && (obj.maintenanceWindow = message.maintenanceWindow
+      ? TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow.toJSON(message.maintenanceWindow)
+      : undefined);
+    return obj;
+  },
+
+  create(base?: DeepPartial<TaskRun_SchedulerInfo_WaitingCause>): TaskRun_SchedulerInfo_WaitingCause {
+    return TaskRun_SchedulerInfo_WaitingCause.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<TaskRun_SchedulerInfo_WaitingCause>): TaskRun_SchedulerInfo_WaitingCause {
+    const message = createBaseTaskRun_SchedulerInfo_WaitingCause();
+    message.concurrencyLimit = object.concurrencyLimit ?? undefined;
+    message.maintenanceWindow = (object.maintenanceWindow !== undefined && object.maintenanceWindow !== null)
+      ? TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow.fromPartial(object.maintenanceWindow)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseTaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow(): TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow {
+  return { nextWindowTime: undefined };
+}
+
+export const TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow = {
+  encode(
+    message: TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.nextWindowTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.nextWindowTime), writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.nextWindowTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow {
+    return { nextWindowTime: isSet(object.nextWindowTime) ? fromJsonTimestamp(object.nextWindowTime) : undefined };
+  },
+
+  toJSON(message: TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow): unknown {
+    const obj: any = {};
+    message.nextWindowTime !== undefined && (obj.nextWindowTime = message.nextWindowTime.toISOString());
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow>,
+  ): TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow {
+    return TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow>,
+  ): TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow {
+    const message = createBaseTaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow();
+    message.nextWindowTime = object.nextWindowTime ?? undefined;
     return message;
   },
 };
@@ -57,7 +57,10 @@ export interface ExportRequest {
   connectionDatabase: string;
   /** The SQL statement to execute. */
   statement: string;
-  /** The maximum number of rows to return. */
+  /**
+   * The maximum number of rows to return.
+   * For ExportStream, a non-positive limit exports all rows.
+   */
   limit: number;
   /** The export format. */
   format: ExportFormat;
@@ -69,7 +72,10 @@ export interface ExportRequest {
 }
 
 export interface ExportResponse {
-  /** The export file content. */
+  /**
+   * The export file content.
+   * For ExportStream, it's a chunk of the file content and the chunks should be concatenated in order.
+   */
   content: Uint8Array;
 }
 
@@ -1944,6 +1950,66 @@ export const SQLServiceDefinition = {
         },
       },
     },
+    /**
+     * ExportStream exports the SQL query result as a stream of file content chunks.
+     * The rows are written as the database produces them, so the result size is not bounded by the server memory.
+     */
+    exportStream: {
+      name: "ExportStream",
+      requestType: ExportRequest,
+      requestStream: false,
+      responseType: ExportResponse,
+      responseStream: true,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              40,
+              58,
+              1,
+              42,
+              34,
+              35,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              101,
+              120,
+              112,
+              111,
+              114,
+              116,
+              83,
+              116,
+              114,
+              101,
+              97,
+              109,
+            ]),
+          ],
+        },
+      },
+    },
     adminExecute: {
       name: "AdminExecute",
       requestType: AdminExecuteRequest,
@@ -0,0 +1,807 @@
+/* eslint-disable */
+import _m0 from "protobufjs/minimal";
+import { Empty } from "../google/protobuf/empty";
+import { FieldMask } from "../google/protobuf/field_mask";
+
+export const protobufPackage = "bytebase.v1";
+
+export interface GetUserGroupRequest {
+  /**
+   * The name of the group to retrieve.
+   * Format: groups/{group}
+   */
+  name: string;
+}
+
+export interface ListUserGroupsRequest {
+  /**
+   * The maximum number of groups to return. The service may return fewer than
+   * this value.
+   * If unspecified, at most 50 groups will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   */
+  pageSize: number;
+  /**
+   * A page token, received from a previous `ListUserGroups` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * When paginating, all other parameters provided to `ListUserGroups` must match
+   * the call that provided the page token.
+   */
+  pageToken: string;
+}
+
+export interface ListUserGroupsResponse {
+  groups: UserGroup[];
+  /**
+   * A token, which can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   */
+  nextPageToken: string;
+}
+
+export interface CreateUserGroupRequest {
+  group:
+    | UserGroup
+    | undefined;
+  /**
+   * The ID to use for the group, which will become the final component
+   * of the group's resource name.
+   *
+   * This value should be 4-63 characters, and valid characters
+   * are /[a-z][0-9]-/.
+   */
+  groupId: string;
+}
+
+export interface UpdateUserGroupRequest {
+  /**
+   * The group to update.
+   *
+   * The group's `name` field is used to identify the group to update.
+   * Format: groups/{group}
+   */
+  group:
+    | UserGroup
+    | undefined;
+  /** The list of fields to update. */
+  updateMask: string[] | undefined;
+}
+
+export interface DeleteUserGroupRequest {
+  /**
+   * The name of the group to delete.
+   * Format: groups/{group}
+   */
+  name: string;
+}
+
+export interface UserGroup {
+  /**
+   * The name of the group.
+   * Format: groups/{group}
+   */
+  name: string;
+  title: string;
+  description: string;
+  /**
+   * The members of the group.
+   * Format: users/hello@world.com
+   */
+  members: string[];
+  /**
+   * The identity provider that the group is synced from.
+   * The members of a synced group are managed by the identity provider.
+   * Format: idps/{identity_provider}
+   */
+  identityProvider: string;
+  /**
+   * The group identifier in the identity provider, e.g. the DN of an LDAP group
+   * or the value in the OIDC groups claim.
+   */
+  externalId: string;
+}
+
+function createBaseGetUserGroupRequest(): GetUserGroupRequest {
+  return { name: "" };
+}
+
+export const GetUserGroupRequest = {
+  encode(message: GetUserGroupRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetUserGroupRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetUserGroupRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetUserGroupRequest {
+    return { name: isSet(object.name) ? String(object.name) : "" };
+  },
+
+  toJSON(message: GetUserGroupRequest): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetUserGroupRequest>): GetUserGroupRequest {
+    return GetUserGroupRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<GetUserGroupRequest>): GetUserGroupRequest {
+    const message = createBaseGetUserGroupRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseListUserGroupsRequest(): ListUserGroupsRequest {
+  return { pageSize: 0, pageToken: "" };
+}
+
+export const ListUserGroupsRequest = {
+  encode(message: ListUserGroupsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pageSize !== 0) {
+      writer.uint32(8).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(18).string(message.pageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListUserGroupsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListUserGroupsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListUserGroupsRequest {
+    return {
+      pageSize: isSet(object.pageSize) ? Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? String(object.pageToken) : "",
+    };
+  },
+
+  toJSON(message: ListUserGroupsRequest): unknown {
+    const obj: any = {};
+    message.pageSize !== undefined && (obj.pageSize = Math.round(message.pageSize));
+    message.pageToken !== undefined && (obj.pageToken = message.pageToken);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListUserGroupsRequest>): ListUserGroupsRequest {
+    return ListUserGroupsRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListUserGroupsRequest>): ListUserGroupsRequest {
+    const message = createBaseListUserGroupsRequest();
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseListUserGroupsResponse(): ListUserGroupsResponse {
+  return { groups: [], nextPageToken: "" };
+}
+
+export const ListUserGroupsResponse = {
+  encode(message: ListUserGroupsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.groups) {
+      UserGroup.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListUserGroupsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListUserGroupsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.groups.push(UserGroup.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListUserGroupsResponse {
+    return {
+      groups: Array.isArray(object?.groups) ? object.groups.map((e: any) => UserGroup.fromJSON(e)) : [],
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
+    };
+  },
+
+  toJSON(message: ListUserGroupsResponse): unknown {
+    const obj: any = {};
+    if (message.groups) {
+      obj.groups = message.groups.map((e) => e ? UserGroup.toJSON(e) : undefined);
+    } else {
+      obj.groups = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListUserGroupsResponse>): ListUserGroupsResponse {
+    return ListUserGroupsResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListUserGroupsResponse>): ListUserGroupsResponse {
+    const message = createBaseListUserGroupsResponse();
+    message.groups = object.groups?.map((e) => UserGroup.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseCreateUserGroupRequest(): CreateUserGroupRequest {
+  return { group: undefined, groupId: "" };
+}
+
+export const CreateUserGroupRequest = {
+  encode(message: CreateUserGroupRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.group !== undefined) {
+      UserGroup.encode(message.group, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.groupId !== "") {
+      writer.uint32(18).string(message.groupId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateUserGroupRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateUserGroupRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.group = UserGroup.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.groupId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateUserGroupRequest {
+    return {
+      group: isSet(object.group) ? UserGroup.fromJSON(object.group) : undefined,
+      groupId: isSet(object.groupId) ? String(object.groupId) : "",
+    };
+  },
+
+  toJSON(message: CreateUserGroupRequest): unknown {
+    const obj: any = {};
+    message.group !== undefined && (obj.group = message.group ? UserGroup.toJSON(message.group) : undefined);
+    message.groupId !== undefined && (obj.groupId = message.groupId);
+    return obj;
+  },
+
+  create(base?: DeepPartial<CreateUserGroupRequest>): CreateUserGroupRequest {
+    return CreateUserGroupRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<CreateUserGroupRequest>): CreateUserGroupRequest {
+    const message = createBaseCreateUserGroupRequest();
+    message.group = (object.group !== undefined && object.group !== null)
+      ? UserGroup.fromPartial(object.group)
+      : undefined;
+    message.groupId = object.groupId ?? "";
+    return message;
+  },
+};
+
+function createBaseUpdateUserGroupRequest(): UpdateUserGroupRequest {
+  return { group: undefined, updateMask: undefined };
+}
+
+export const UpdateUserGroupRequest = {
+  encode(message: UpdateUserGroupRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.group !== undefined) {
+      UserGroup.encode(message.group, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateUserGroupRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateUserGroupRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.group = UserGroup.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateUserGroupRequest {
+    return {
+      group: isSet(object.group) ? UserGroup.fromJSON(object.group) : undefined,
+      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+    };
+  },
+
+  toJSON(message: UpdateUserGroupRequest): unknown {
+    const obj: any = {};
+    message.group !== undefined && (obj.group = message.group ? UserGroup.toJSON(message.group) : undefined);
+    message.updateMask !== undefined && (obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask)));
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateUserGroupRequest>): UpdateUserGroupRequest {
+    return UpdateUserGroupRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<UpdateUserGroupRequest>): UpdateUserGroupRequest {
+    const message = createBaseUpdateUserGroupRequest();
+    message.group = (object.group !== undefined && object.group !== null)
+      ? UserGroup.fromPartial(object.group)
+      : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    return message;
+  },
+};
+
+function createBaseDeleteUserGroupRequest(): DeleteUserGroupRequest {
+  return { name: "" };
+}
+
+export const DeleteUserGroupRequest = {
+  encode(message: DeleteUserGroupRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteUserGroupRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteUserGroupRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeleteUserGroupRequest {
+    return { name: isSet(object.name) ? String(object.name) : "" };
+  },
+
+  toJSON(message: DeleteUserGroupRequest): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    return obj;
+  },
+
+  create(base?: DeepPartial<DeleteUserGroupRequest>): DeleteUserGroupRequest {
+    return DeleteUserGroupRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<DeleteUserGroupRequest>): DeleteUserGroupRequest {
+    const message = createBaseDeleteUserGroupRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseUserGroup(): UserGroup {
+  return { name: "", title: "", description: "", members: [], identityProvider: "", externalId: "" };
+}
+
+export const UserGroup = {
+  encode(message: UserGroup, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.description !== "") {
+      writer.uint32(26).string(message.description);
+    }
+    for (const v of message.members) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.identityProvider !== "") {
+      writer.uint32(42).string(message.identityProvider);
+    }
+    if (message.externalId !== "") {
+      writer.uint32(50).string(message.externalId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UserGroup {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUserGroup();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.members.push(reader.string());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.identityProvider = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.externalId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UserGroup {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      title: isSet(object.title) ? String(object.title) : "",
+      description: isSet(object.description) ? String(object.description) : "",
+      members: Array.isArray(object?.members) ? object.members.map((e: any) => String(e)) : [],
+      identityProvider: isSet(object.identityProvider) ? String(object.identityProvider) : "",
+      externalId: isSet(object.externalId) ? String(object.externalId) : "",
+    };
+  },
+
+  toJSON(message: UserGroup): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.title !== undefined && (obj.title = message.title);
+    message.description !== undefined && (obj.description = message.description);
+    if (message.members) {
+      obj.members = message.members.map((e) => e);
+    } else {
+      obj.members = [];
+    }
+    message.identityProvider !== undefined && (obj.identityProvider = message.identityProvider);
+    message.externalId !== undefined && (obj.externalId = message.externalId);
+    return obj;
+  },
+
+  create(base?: DeepPartial<UserGroup>): UserGroup {
+    return UserGroup.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<UserGroup>): UserGroup {
+    const message = createBaseUserGroup();
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.description = object.description ?? "";
+    message.members = object.members?.map((e) => e) || [];
+    message.identityProvider = object.identityProvider ?? "";
+    message.externalId = object.externalId ?? "";
+    return message;
+  },
+};
+
+export type UserGroupServiceDefinition = typeof UserGroupServiceDefinition;
+export const UserGroupServiceDefinition = {
+  name: "UserGroupService",
+  fullName: "bytebase.v1.UserGroupService",
+  methods: {
+    getUserGroup: {
+      name: "GetUserGroup",
+      requestType: GetUserGroupRequest,
+      requestStream: false,
+      responseType: UserGroup,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          578365826: [
+            new Uint8Array([
+              21,
+              18,
+              19,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              103,
+              114,
+              111,
+              117,
+              112,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    listUserGroups: {
+      name: "ListUserGroups",
+      requestType: ListUserGroupsRequest,
+      requestStream: false,
+      responseType: ListUserGroupsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          578365826: [new Uint8Array([12, 18, 10, 47, 118, 49, 47, 103, 114, 111, 117, 112, 115])],
+        },
+      },
+    },
+    createUserGroup: {
+      name: "CreateUserGroup",
+      requestType: CreateUserGroupRequest,
+      requestStream: false,
+      responseType: UserGroup,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([19, 58, 5, 103, 114, 111, 117, 112, 34, 10, 47, 118, 49, 47, 103, 114, 111, 117, 112, 115]),
+          ],
+        },
+      },
+    },
+    updateUserGroup: {
+      name: "UpdateUserGroup",
+      requestType: UpdateUserGroupRequest,
+      requestStream: false,
+      responseType: UserGroup,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([17, 103, 114, 111, 117, 112, 44, 117, 112, 100, 97, 116, 101, 95, 109, 97, 115, 107])],
+          578365826: [
+            new Uint8Array([
+              34,
+              58,
+              5,
+              103,
+              114,
+              111,
+              117,
+              112,
+              50,
+              25,
+              47,
+              118,
+              49,
+              47,
+              123,
+              103,
+              114,
+              111,
+              117,
+              112,
+              46,
+              110,
+              97,
+              109,
+              101,
+              61,
+              103,
+              114,
+              111,
+              117,
+              112,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    deleteUserGroup: {
+      name: "DeleteUserGroup",
+      requestType: DeleteUserGroupRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          578365826: [
+            new Uint8Array([
+              21,
+              42,
+              19,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              103,
+              114,
+              111,
+              117,
+              112,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+  },
+} as const;
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Array<infer U> ? Array<DeepPartial<U>> : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
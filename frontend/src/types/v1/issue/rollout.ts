@@ -69,9 +69,11 @@ export const TaskTypeListWithStatement: Task_Type[] = [
   Task_Type.DATABASE_SCHEMA_UPDATE,
   Task_Type.DATABASE_SCHEMA_UPDATE_SDL,
   Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_SYNC,
+  Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC,
 ];
 
 export const TaskTypeListWithProgress: Task_Type[] = [
   Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_SYNC,
+  Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC,
   Task_Type.DATABASE_RESTORE_RESTORE,
 ];
@@ -220,6 +220,9 @@ const (
 	ExportFormat_JSON               ExportFormat = 2
 	ExportFormat_SQL                ExportFormat = 3
 	ExportFormat_XLSX               ExportFormat = 4
+	ExportFormat_PARQUET            ExportFormat = 5
+	// Newline delimited JSON, one object per row.
+	ExportFormat_NDJSON ExportFormat = 6
 )
 
 // Enum value maps for ExportFormat.
@@ -230,6 +233,8 @@ var (
 		2: "JSON",
 		3: "SQL",
 		4: "XLSX",
+		5: "PARQUET",
+		6: "NDJSON",
 	}
 	ExportFormat_value = map[string]int32{
 		"FORMAT_UNSPECIFIED": 0,
@@ -237,6 +242,8 @@ var (
 		"JSON":               2,
 		"SQL":                3,
 		"XLSX":               4,
+		"PARQUET":            5,
+		"NDJSON":             6,
 	}
 )
 
@@ -267,6 +274,65 @@ func (ExportFormat) EnumDescriptor() ([]byte, []int) {
 	return file_v1_common_proto_rawDescGZIP(), []int{3}
 }
 
+type BackupStorageBackend int32
+
+const (
+	BackupStorageBackend_BACKUP_STORAGE_BACKEND_UNSPECIFIED BackupStorageBackend = 0
+	// The local disk of the Bytebase server.
+	BackupStorageBackend_LOCAL BackupStorageBackend = 1
+	// AWS S3 or S3-compatible storages such as MinIO.
+	BackupStorageBackend_S3 BackupStorageBackend = 2
+	// Google Cloud Storage.
+	BackupStorageBackend_GCS BackupStorageBackend = 3
+	// Azure Blob Storage.
+	BackupStorageBackend_AZURE_BLOB BackupStorageBackend = 4
+)
+
+// Enum value maps for BackupStorageBackend.
+var (
+	BackupStorageBackend_name = map[int32]string{
+		0: "BACKUP_STORAGE_BACKEND_UNSPECIFIED",
+		1: "LOCAL",
+		2: "S3",
+		3: "GCS",
+		4: "AZURE_BLOB",
+	}
+	BackupStorageBackend_value = map[string]int32{
+		"BACKUP_STORAGE_BACKEND_UNSPECIFIED": 0,
+		"LOCAL":                              1,
+		"S3":                                 2,
+		"GCS":                                3,
+		"AZURE_BLOB":                         4,
+	}
+)
+
+func (x BackupStorageBackend) Enum() *BackupStorageBackend {
+	p := new(BackupStorageBackend)
+	*p = x
+	return p
+}
+
+func (x BackupStorageBackend) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackupStorageBackend) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_common_proto_enumTypes[4].Descriptor()
+}
+
+func (BackupStorageBackend) Type() protoreflect.EnumType {
+	return &file_v1_common_proto_enumTypes[4]
+}
+
+func (x BackupStorageBackend) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackupStorageBackend.Descriptor instead.
+func (BackupStorageBackend) EnumDescriptor() ([]byte, []int) {
+	return file_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
 var File_v1_common_proto protoreflect.FileDescriptor
 
 var file_v1_common_proto_rawDesc = []byte{
@@ -295,13 +361,22 @@ var file_v1_common_proto_rawDesc = []byte{
 	0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
 	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12,
 	0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
-	0x46, 0x55, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x4c, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x55, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x65, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
 	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
 	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07,
 	0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
 	0x02, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x51, 0x4c, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x58, 0x4c,
-	0x53, 0x58, 0x10, 0x04, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x58, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10,
+	0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x06, 0x2a, 0x6a, 0x0a,
+	0x14, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x22, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f,
+	0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x53, 0x33, 0x10, 0x02,
+	0x12, 0x07, 0x0a, 0x03, 0x47, 0x43, 0x53, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x5a, 0x55,
+	0x52, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x42, 0x10, 0x04, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -316,12 +391,13 @@ func file_v1_common_proto_rawDescGZIP() []byte {
 	return file_v1_common_proto_rawDescData
 }
 
-var file_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_v1_common_proto_goTypes = []interface{}{
-	(State)(0),        // 0: bytebase.v1.State
-	(Engine)(0),       // 1: bytebase.v1.Engine
-	(MaskingLevel)(0), // 2: bytebase.v1.MaskingLevel
-	(ExportFormat)(0), // 3: bytebase.v1.ExportFormat
+	(State)(0),                // 0: bytebase.v1.State
+	(Engine)(0),               // 1: bytebase.v1.Engine
+	(MaskingLevel)(0),         // 2: bytebase.v1.MaskingLevel
+	(ExportFormat)(0),         // 3: bytebase.v1.ExportFormat
+	(BackupStorageBackend)(0), // 4: bytebase.v1.BackupStorageBackend
 }
 var file_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -341,7 +417,7 @@ func file_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_common_proto_rawDesc,
-			NumEnums:      4,
+			NumEnums:      5,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
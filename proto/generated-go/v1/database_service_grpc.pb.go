@@ -20,27 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DatabaseService_GetDatabase_FullMethodName            = "/bytebase.v1.DatabaseService/GetDatabase"
-	DatabaseService_ListDatabases_FullMethodName          = "/bytebase.v1.DatabaseService/ListDatabases"
-	DatabaseService_SearchDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/SearchDatabases"
-	DatabaseService_UpdateDatabase_FullMethodName         = "/bytebase.v1.DatabaseService/UpdateDatabase"
-	DatabaseService_BatchUpdateDatabases_FullMethodName   = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
-	DatabaseService_SyncDatabase_FullMethodName           = "/bytebase.v1.DatabaseService/SyncDatabase"
-	DatabaseService_GetDatabaseMetadata_FullMethodName    = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
-	DatabaseService_UpdateDatabaseMetadata_FullMethodName = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
-	DatabaseService_GetDatabaseSchema_FullMethodName      = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
-	DatabaseService_DiffSchema_FullMethodName             = "/bytebase.v1.DatabaseService/DiffSchema"
-	DatabaseService_GetBackupSetting_FullMethodName       = "/bytebase.v1.DatabaseService/GetBackupSetting"
-	DatabaseService_UpdateBackupSetting_FullMethodName    = "/bytebase.v1.DatabaseService/UpdateBackupSetting"
-	DatabaseService_CreateBackup_FullMethodName           = "/bytebase.v1.DatabaseService/CreateBackup"
-	DatabaseService_ListBackups_FullMethodName            = "/bytebase.v1.DatabaseService/ListBackups"
-	DatabaseService_ListSlowQueries_FullMethodName        = "/bytebase.v1.DatabaseService/ListSlowQueries"
-	DatabaseService_ListSecrets_FullMethodName            = "/bytebase.v1.DatabaseService/ListSecrets"
-	DatabaseService_UpdateSecret_FullMethodName           = "/bytebase.v1.DatabaseService/UpdateSecret"
-	DatabaseService_DeleteSecret_FullMethodName           = "/bytebase.v1.DatabaseService/DeleteSecret"
-	DatabaseService_AdviseIndex_FullMethodName            = "/bytebase.v1.DatabaseService/AdviseIndex"
-	DatabaseService_ListChangeHistories_FullMethodName    = "/bytebase.v1.DatabaseService/ListChangeHistories"
-	DatabaseService_GetChangeHistory_FullMethodName       = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_GetDatabase_FullMethodName                    = "/bytebase.v1.DatabaseService/GetDatabase"
+	DatabaseService_ListDatabases_FullMethodName                  = "/bytebase.v1.DatabaseService/ListDatabases"
+	DatabaseService_SearchDatabases_FullMethodName                = "/bytebase.v1.DatabaseService/SearchDatabases"
+	DatabaseService_UpdateDatabase_FullMethodName                 = "/bytebase.v1.DatabaseService/UpdateDatabase"
+	DatabaseService_BatchUpdateDatabases_FullMethodName           = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
+	DatabaseService_SyncDatabase_FullMethodName                   = "/bytebase.v1.DatabaseService/SyncDatabase"
+	DatabaseService_GetDatabaseMetadata_FullMethodName            = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
+	DatabaseService_UpdateDatabaseMetadata_FullMethodName         = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
+	DatabaseService_GetDatabaseSchema_FullMethodName              = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
+	DatabaseService_DiffSchema_FullMethodName                     = "/bytebase.v1.DatabaseService/DiffSchema"
+	DatabaseService_GetBackupSetting_FullMethodName               = "/bytebase.v1.DatabaseService/GetBackupSetting"
+	DatabaseService_UpdateBackupSetting_FullMethodName            = "/bytebase.v1.DatabaseService/UpdateBackupSetting"
+	DatabaseService_CreateBackup_FullMethodName                   = "/bytebase.v1.DatabaseService/CreateBackup"
+	DatabaseService_ListBackups_FullMethodName                    = "/bytebase.v1.DatabaseService/ListBackups"
+	DatabaseService_ListSlowQueries_FullMethodName                = "/bytebase.v1.DatabaseService/ListSlowQueries"
+	DatabaseService_ListSecrets_FullMethodName                    = "/bytebase.v1.DatabaseService/ListSecrets"
+	DatabaseService_UpdateSecret_FullMethodName                   = "/bytebase.v1.DatabaseService/UpdateSecret"
+	DatabaseService_DeleteSecret_FullMethodName                   = "/bytebase.v1.DatabaseService/DeleteSecret"
+	DatabaseService_AdviseIndex_FullMethodName                    = "/bytebase.v1.DatabaseService/AdviseIndex"
+	DatabaseService_ListChangeHistories_FullMethodName            = "/bytebase.v1.DatabaseService/ListChangeHistories"
+	DatabaseService_GetChangeHistory_FullMethodName               = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_ListClassificationSuggestions_FullMethodName  = "/bytebase.v1.DatabaseService/ListClassificationSuggestions"
+	DatabaseService_UpdateClassificationSuggestion_FullMethodName = "/bytebase.v1.DatabaseService/UpdateClassificationSuggestion"
 )
 
 // DatabaseServiceClient is the client API for DatabaseService service.
@@ -69,6 +71,8 @@ type DatabaseServiceClient interface {
 	AdviseIndex(ctx context.Context, in *AdviseIndexRequest, opts ...grpc.CallOption) (*AdviseIndexResponse, error)
 	ListChangeHistories(ctx context.Context, in *ListChangeHistoriesRequest, opts ...grpc.CallOption) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(ctx context.Context, in *GetChangeHistoryRequest, opts ...grpc.CallOption) (*ChangeHistory, error)
+	ListClassificationSuggestions(ctx context.Context, in *ListClassificationSuggestionsRequest, opts ...grpc.CallOption) (*ListClassificationSuggestionsResponse, error)
+	UpdateClassificationSuggestion(ctx context.Context, in *UpdateClassificationSuggestionRequest, opts ...grpc.CallOption) (*ClassificationSuggestion, error)
 }
 
 type databaseServiceClient struct {
@@ -268,6 +272,24 @@ func (c *databaseServiceClient) GetChangeHistory(ctx context.Context, in *GetCha
 	return out, nil
 }
 
+func (c *databaseServiceClient) ListClassificationSuggestions(ctx context.Context, in *ListClassificationSuggestionsRequest, opts ...grpc.CallOption) (*ListClassificationSuggestionsResponse, error) {
+	out := new(ListClassificationSuggestionsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ListClassificationSuggestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) UpdateClassificationSuggestion(ctx context.Context, in *UpdateClassificationSuggestionRequest, opts ...grpc.CallOption) (*ClassificationSuggestion, error) {
+	out := new(ClassificationSuggestion)
+	err := c.cc.Invoke(ctx, DatabaseService_UpdateClassificationSuggestion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseServiceServer is the server API for DatabaseService service.
 // All implementations must embed UnimplementedDatabaseServiceServer
 // for forward compatibility
@@ -294,6 +316,8 @@ type DatabaseServiceServer interface {
 	AdviseIndex(context.Context, *AdviseIndexRequest) (*AdviseIndexResponse, error)
 	ListChangeHistories(context.Context, *ListChangeHistoriesRequest) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error)
+	ListClassificationSuggestions(context.Context, *ListClassificationSuggestionsRequest) (*ListClassificationSuggestionsResponse, error)
+	UpdateClassificationSuggestion(context.Context, *UpdateClassificationSuggestionRequest) (*ClassificationSuggestion, error)
 	mustEmbedUnimplementedDatabaseServiceServer()
 }
 
@@ -364,6 +388,12 @@ func (UnimplementedDatabaseServiceServer) ListChangeHistories(context.Context, *
 func (UnimplementedDatabaseServiceServer) GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChangeHistory not implemented")
 }
+func (UnimplementedDatabaseServiceServer) ListClassificationSuggestions(context.Context, *ListClassificationSuggestionsRequest) (*ListClassificationSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClassificationSuggestions not implemented")
+}
+func (UnimplementedDatabaseServiceServer) UpdateClassificationSuggestion(context.Context, *UpdateClassificationSuggestionRequest) (*ClassificationSuggestion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassificationSuggestion not implemented")
+}
 func (UnimplementedDatabaseServiceServer) mustEmbedUnimplementedDatabaseServiceServer() {}
 
 // UnsafeDatabaseServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -755,6 +785,42 @@ func _DatabaseService_GetChangeHistory_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_ListClassificationSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClassificationSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ListClassificationSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ListClassificationSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ListClassificationSuggestions(ctx, req.(*ListClassificationSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_UpdateClassificationSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClassificationSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).UpdateClassificationSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_UpdateClassificationSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).UpdateClassificationSuggestion(ctx, req.(*UpdateClassificationSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DatabaseService_ServiceDesc is the grpc.ServiceDesc for DatabaseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -846,6 +912,14 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChangeHistory",
 			Handler:    _DatabaseService_GetChangeHistory_Handler,
 		},
+		{
+			MethodName: "ListClassificationSuggestions",
+			Handler:    _DatabaseService_ListClassificationSuggestions_Handler,
+		},
+		{
+			MethodName: "UpdateClassificationSuggestion",
+			Handler:    _DatabaseService_UpdateClassificationSuggestion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/database_service.proto",
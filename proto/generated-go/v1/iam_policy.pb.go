@@ -81,6 +81,10 @@ type Binding struct {
 	// Format: roles/{role}
 	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	// Specifies the principals requesting access for a Bytebase resource.
+	// `members` can have the following values:
+	//
+	// * `user:{emailid}`: An email address that represents a specific Bytebase account. For example, `alice@example.com`.
+	// * `group:{group}`: A user group. For example, `group:dba`.
 	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
 	// The condition that is associated with this binding.
 	// If the condition evaluates to true, then this binding applies to the current request.
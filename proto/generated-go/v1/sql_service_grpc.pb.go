@@ -22,6 +22,7 @@ const (
 	SQLService_Pretty_FullMethodName        = "/bytebase.v1.SQLService/Pretty"
 	SQLService_Query_FullMethodName         = "/bytebase.v1.SQLService/Query"
 	SQLService_Export_FullMethodName        = "/bytebase.v1.SQLService/Export"
+	SQLService_ExportStream_FullMethodName  = "/bytebase.v1.SQLService/ExportStream"
 	SQLService_AdminExecute_FullMethodName  = "/bytebase.v1.SQLService/AdminExecute"
 	SQLService_DifferPreview_FullMethodName = "/bytebase.v1.SQLService/DifferPreview"
 	SQLService_Check_FullMethodName         = "/bytebase.v1.SQLService/Check"
@@ -34,6 +35,9 @@ type SQLServiceClient interface {
 	Pretty(ctx context.Context, in *PrettyRequest, opts ...grpc.CallOption) (*PrettyResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	// ExportStream exports the SQL query result as a stream of file content chunks.
+	// The rows are written as the database produces them, so the result size is not bounded by the server memory.
+	ExportStream(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (SQLService_ExportStreamClient, error)
 	AdminExecute(ctx context.Context, opts ...grpc.CallOption) (SQLService_AdminExecuteClient, error)
 	DifferPreview(ctx context.Context, in *DifferPreviewRequest, opts ...grpc.CallOption) (*DifferPreviewResponse, error)
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
@@ -74,8 +78,40 @@ func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts .
 	return out, nil
 }
 
+func (c *sQLServiceClient) ExportStream(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (SQLService_ExportStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_ExportStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sQLServiceExportStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SQLService_ExportStreamClient interface {
+	Recv() (*ExportResponse, error)
+	grpc.ClientStream
+}
+
+type sQLServiceExportStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *sQLServiceExportStreamClient) Recv() (*ExportResponse, error) {
+	m := new(ExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *sQLServiceClient) AdminExecute(ctx context.Context, opts ...grpc.CallOption) (SQLService_AdminExecuteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_AdminExecute_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[1], SQLService_AdminExecute_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +166,9 @@ type SQLServiceServer interface {
 	Pretty(context.Context, *PrettyRequest) (*PrettyResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	// ExportStream exports the SQL query result as a stream of file content chunks.
+	// The rows are written as the database produces them, so the result size is not bounded by the server memory.
+	ExportStream(*ExportRequest, SQLService_ExportStreamServer) error
 	AdminExecute(SQLService_AdminExecuteServer) error
 	DifferPreview(context.Context, *DifferPreviewRequest) (*DifferPreviewResponse, error)
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
@@ -149,6 +188,9 @@ func (UnimplementedSQLServiceServer) Query(context.Context, *QueryRequest) (*Que
 func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedSQLServiceServer) ExportStream(*ExportRequest, SQLService_ExportStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStream not implemented")
+}
 func (UnimplementedSQLServiceServer) AdminExecute(SQLService_AdminExecuteServer) error {
 	return status.Errorf(codes.Unimplemented, "method AdminExecute not implemented")
 }
@@ -225,6 +267,27 @@ func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_ExportStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SQLServiceServer).ExportStream(m, &sQLServiceExportStreamServer{stream})
+}
+
+type SQLService_ExportStreamServer interface {
+	Send(*ExportResponse) error
+	grpc.ServerStream
+}
+
+type sQLServiceExportStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *sQLServiceExportStreamServer) Send(m *ExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _SQLService_AdminExecute_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(SQLServiceServer).AdminExecute(&sQLServiceAdminExecuteServer{stream})
 }
@@ -316,6 +379,11 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportStream",
+			Handler:       _SQLService_ExportStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "AdminExecute",
 			Handler:       _SQLService_AdminExecute_Handler,